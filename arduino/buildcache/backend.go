@@ -0,0 +1,99 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package buildcache
+
+import (
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Backend is a storage for the cache entries
+type Backend interface {
+	// Get returns the entry with the given key or nil if the entry is not stored in the backend
+	Get(key string) (Entry, error)
+	// Put stores the entry with the given key
+	Put(key string, entry Entry) error
+}
+
+// TieredBackend looks up the entries in a local backend first, and then in a
+// remote one. The entries found in the remote backend are stored in the local
+// one, so they are downloaded only once. If the remote backend fails it's not
+// used anymore, to avoid slowing down the build with repeated failures.
+type TieredBackend struct {
+	local          Backend
+	remote         Backend
+	remoteReadOnly bool
+
+	remoteFailed   bool
+	remoteFailedMx sync.Mutex
+}
+
+// NewTieredBackend returns a TieredBackend. If remoteReadOnly is true the new
+// entries are stored only in the local backend.
+func NewTieredBackend(local, remote Backend, remoteReadOnly bool) *TieredBackend {
+	return &TieredBackend{local: local, remote: remote, remoteReadOnly: remoteReadOnly}
+}
+
+func (t *TieredBackend) remoteAvailable() bool {
+	t.remoteFailedMx.Lock()
+	defer t.remoteFailedMx.Unlock()
+	return !t.remoteFailed
+}
+
+func (t *TieredBackend) disableRemote(err error) {
+	t.remoteFailedMx.Lock()
+	defer t.remoteFailedMx.Unlock()
+	if !t.remoteFailed {
+		logrus.WithError(err).Warn("Remote compilation cache disabled")
+	}
+	t.remoteFailed = true
+}
+
+// Get returns the entry with the given key from the local backend or, if
+// missing, from the remote one.
+func (t *TieredBackend) Get(key string) (Entry, error) {
+	entry, err := t.local.Get(key)
+	if err != nil || entry != nil || !t.remoteAvailable() {
+		return entry, err
+	}
+	entry, err = t.remote.Get(key)
+	if err != nil {
+		t.disableRemote(err)
+		return nil, err
+	}
+	if entry != nil {
+		if err := t.local.Put(key, entry); err != nil {
+			return nil, err
+		}
+	}
+	return entry, nil
+}
+
+// Put stores the entry in the local backend and, if writable, in the remote one
+func (t *TieredBackend) Put(key string, entry Entry) error {
+	if err := t.local.Put(key, entry); err != nil {
+		return err
+	}
+	if t.remoteReadOnly || !t.remoteAvailable() {
+		return nil
+	}
+	if err := t.remote.Put(key, entry); err != nil {
+		t.disableRemote(err)
+		return err
+	}
+	return nil
+}
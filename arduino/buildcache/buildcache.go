@@ -0,0 +1,261 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package buildcache
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/arduino/arduino-cli/i18n"
+	"github.com/arduino/go-paths-helper"
+)
+
+var tr = i18n.Tr
+
+// Entry is the content of a cache entry: a set of named files
+type Entry map[string][]byte
+
+// Cache is a content-addressed store of compilation results shared between builds.
+// Each entry is saved in a folder named after its key, the least recently used
+// entries are evicted when the total size of the cache exceeds the limit.
+type Cache struct {
+	dir *paths.Path
+}
+
+// Stats contains information about the content of the cache
+type Stats struct {
+	Entries int
+	Size    int64
+}
+
+type entryInfo struct {
+	path       *paths.Path
+	size       int64
+	lastAccess time.Time
+}
+
+// New returns a Cache that stores its entries in the given folder
+func New(dir *paths.Path) *Cache {
+	return &Cache{dir: dir}
+}
+
+// Dir returns the folder containing the cache entries
+func (c *Cache) Dir() *paths.Path {
+	return c.dir
+}
+
+func (c *Cache) entryDir(key string) (*paths.Path, error) {
+	if len(key) < 3 || strings.ContainsAny(key, `/\.`) {
+		return nil, fmt.Errorf(tr("invalid cache key: %s"), key)
+	}
+	return c.dir.Join(key[:2], key), nil
+}
+
+// Get returns the entry with the given key, the entry is marked as the most
+// recently used. If the entry is not in the cache nil is returned.
+func (c *Cache) Get(key string) (Entry, error) {
+	entryDir, err := c.entryDir(key)
+	if err != nil {
+		return nil, err
+	}
+	if !entryDir.IsDir() {
+		return nil, nil
+	}
+	files, err := entryDir.ReadDir()
+	if err != nil {
+		return nil, err
+	}
+	entry := Entry{}
+	for _, file := range files {
+		data, err := file.ReadFile()
+		if err != nil {
+			// The entry has been evicted meanwhile
+			if os.IsNotExist(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		entry[file.Base()] = data
+	}
+	now := time.Now()
+	_ = entryDir.Chtimes(now, now)
+	return entry, nil
+}
+
+// Put saves the entry with the given key. The entry is written in a temporary
+// folder and then moved in place, so concurrent readers never see partial entries.
+func (c *Cache) Put(key string, entry Entry) error {
+	entryDir, err := c.entryDir(key)
+	if err != nil {
+		return err
+	}
+	if entryDir.IsDir() {
+		return nil
+	}
+	if err := entryDir.Parent().MkdirAll(); err != nil {
+		return err
+	}
+	tmpDir, err := entryDir.Parent().MkTempDir(".tmp-")
+	if err != nil {
+		return err
+	}
+	for name, data := range entry {
+		if err := tmpDir.Join(name).WriteFile(data); err != nil {
+			tmpDir.RemoveAll()
+			return err
+		}
+	}
+	if err := tmpDir.Rename(entryDir); err != nil {
+		tmpDir.RemoveAll()
+		// Another build stored the same entry meanwhile
+		if entryDir.IsDir() {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+func (c *Cache) entries() ([]*entryInfo, error) {
+	if !c.dir.IsDir() {
+		return nil, nil
+	}
+	buckets, err := c.dir.ReadDir()
+	if err != nil {
+		return nil, err
+	}
+	buckets.FilterDirs()
+	res := []*entryInfo{}
+	for _, bucket := range buckets {
+		entryDirs, err := bucket.ReadDir()
+		if err != nil {
+			return nil, err
+		}
+		entryDirs.FilterDirs()
+		entryDirs.FilterOutHiddenFiles()
+		for _, entryDir := range entryDirs {
+			info, err := entryDir.Stat()
+			if err != nil {
+				continue
+			}
+			entry := &entryInfo{path: entryDir, lastAccess: info.ModTime()}
+			files, err := entryDir.ReadDir()
+			if err != nil {
+				continue
+			}
+			for _, file := range files {
+				if info, err := file.Stat(); err == nil {
+					entry.size += info.Size()
+				}
+			}
+			res = append(res, entry)
+		}
+	}
+	return res, nil
+}
+
+// Stats returns the number of entries and the total size of the cache
+func (c *Cache) Stats() (*Stats, error) {
+	entries, err := c.entries()
+	if err != nil {
+		return nil, err
+	}
+	stats := &Stats{}
+	for _, entry := range entries {
+		stats.Entries++
+		stats.Size += entry.size
+	}
+	return stats, nil
+}
+
+// Trim removes the least recently used entries until the total size of the
+// cache is less than or equal to maxSize. The stats of the removed entries are
+// returned.
+func (c *Cache) Trim(maxSize int64) (*Stats, error) {
+	entries, err := c.entries()
+	if err != nil {
+		return nil, err
+	}
+	var size int64
+	for _, entry := range entries {
+		size += entry.size
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].lastAccess.Before(entries[j].lastAccess)
+	})
+	removed := &Stats{}
+	for _, entry := range entries {
+		if size <= maxSize {
+			break
+		}
+		if err := entry.path.RemoveAll(); err != nil {
+			return removed, err
+		}
+		size -= entry.size
+		removed.Entries++
+		removed.Size += entry.size
+	}
+	return removed, nil
+}
+
+// Clean removes all the entries of the cache
+func (c *Cache) Clean() error {
+	return c.dir.RemoveAll()
+}
+
+var sizeUnits = []struct {
+	suffix     string
+	multiplier int64
+}{
+	{"TB", 1 << 40},
+	{"GB", 1 << 30},
+	{"MB", 1 << 20},
+	{"KB", 1 << 10},
+	{"B", 1},
+}
+
+// ParseSize parses a size expressed in bytes, optionally with one of the
+// KB, MB, GB or TB suffixes (e.g. "500MB")
+func ParseSize(size string) (int64, error) {
+	s := strings.ToUpper(strings.TrimSpace(size))
+	multiplier := int64(1)
+	for _, unit := range sizeUnits {
+		if strings.HasSuffix(s, unit.suffix) {
+			s = strings.TrimSpace(strings.TrimSuffix(s, unit.suffix))
+			multiplier = unit.multiplier
+			break
+		}
+	}
+	value, err := strconv.ParseFloat(s, 64)
+	if err != nil || value < 0 {
+		return 0, fmt.Errorf(tr("invalid size: %s"), size)
+	}
+	return int64(value * float64(multiplier)), nil
+}
+
+// FormatSize returns a human readable representation of the given size in bytes
+func FormatSize(size int64) string {
+	for _, unit := range sizeUnits {
+		if size >= unit.multiplier && unit.multiplier > 1 {
+			return strconv.FormatFloat(float64(size)/float64(unit.multiplier), 'f', 1, 64) + " " + unit.suffix
+		}
+	}
+	return fmt.Sprintf("%d B", size)
+}
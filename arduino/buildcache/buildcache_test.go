@@ -0,0 +1,117 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package buildcache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheGetPut(t *testing.T) {
+	dir, err := paths.MkTempDir("", "buildcache-test")
+	require.NoError(t, err)
+	defer dir.RemoveAll()
+	cache := New(dir.Join("cache"))
+
+	entry, err := cache.Get("aabbcc")
+	require.NoError(t, err)
+	require.Nil(t, entry)
+
+	require.NoError(t, cache.Put("aabbcc", Entry{"object": []byte("obj"), "stderr": []byte{}}))
+	entry, err = cache.Get("aabbcc")
+	require.NoError(t, err)
+	require.Equal(t, Entry{"object": []byte("obj"), "stderr": []byte{}}, entry)
+	require.True(t, dir.Join("cache", "aa", "aabbcc").IsDir())
+
+	// Storing again the same key is a no-op
+	require.NoError(t, cache.Put("aabbcc", Entry{"object": []byte("other")}))
+	entry, err = cache.Get("aabbcc")
+	require.NoError(t, err)
+	require.Equal(t, []byte("obj"), entry["object"])
+
+	_, err = cache.Get("../aabbcc")
+	require.Error(t, err)
+	require.Error(t, cache.Put("a", Entry{}))
+
+	stats, err := cache.Stats()
+	require.NoError(t, err)
+	require.Equal(t, &Stats{Entries: 1, Size: 3}, stats)
+
+	require.NoError(t, cache.Clean())
+	stats, err = cache.Stats()
+	require.NoError(t, err)
+	require.Equal(t, &Stats{}, stats)
+}
+
+func TestCacheTrim(t *testing.T) {
+	dir, err := paths.MkTempDir("", "buildcache-test")
+	require.NoError(t, err)
+	defer dir.RemoveAll()
+	cache := New(dir)
+
+	keys := []string{"aa01", "aa02", "bb03", "cc04"}
+	for i, key := range keys {
+		require.NoError(t, cache.Put(key, Entry{"object": make([]byte, 100)}))
+		// Simulate entries used at different times
+		accessTime := time.Now().Add(time.Duration(i-10) * time.Hour)
+		require.NoError(t, dir.Join(key[:2], key).Chtimes(accessTime, accessTime))
+	}
+	// Using an entry makes it the most recently used
+	entry, err := cache.Get("aa01")
+	require.NoError(t, err)
+	require.NotNil(t, entry)
+
+	removed, err := cache.Trim(250)
+	require.NoError(t, err)
+	require.Equal(t, &Stats{Entries: 2, Size: 200}, removed)
+	for key, present := range map[string]bool{"aa01": true, "aa02": false, "bb03": false, "cc04": true} {
+		require.Equal(t, present, dir.Join(key[:2], key).IsDir(), key)
+	}
+
+	removed, err = cache.Trim(0)
+	require.NoError(t, err)
+	require.Equal(t, &Stats{Entries: 2, Size: 200}, removed)
+	stats, err := cache.Stats()
+	require.NoError(t, err)
+	require.Equal(t, 0, stats.Entries)
+}
+
+func TestParseSize(t *testing.T) {
+	for input, expected := range map[string]int64{
+		"0":      0,
+		"1234":   1234,
+		"10B":    10,
+		"1KB":    1024,
+		"1.5 kb": 1536,
+		"500MB":  500 * 1024 * 1024,
+		"2GB":    2 * 1024 * 1024 * 1024,
+		" 1TB ":  1024 * 1024 * 1024 * 1024,
+	} {
+		size, err := ParseSize(input)
+		require.NoError(t, err, input)
+		require.Equal(t, expected, size, input)
+	}
+	for _, input := range []string{"", "GB", "-1MB", "ten"} {
+		_, err := ParseSize(input)
+		require.Error(t, err, input)
+	}
+	require.Equal(t, "512 B", FormatSize(512))
+	require.Equal(t, "1.5 KB", FormatSize(1536))
+	require.Equal(t, "5.0 GB", FormatSize(5*1024*1024*1024))
+}
@@ -0,0 +1,177 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package buildcache
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/arduino/arduino-cli/httpclient"
+)
+
+// HTTPBackend stores the cache entries on a remote server using a simple
+// protocol: each entry is a gzip compressed tar archive, downloaded with a GET
+// and uploaded with a PUT request to the server URL followed by the entry key.
+// Any static file server that accepts PUT requests can be used. Credentials
+// may be provided in the URL and are sent using HTTP basic authentication.
+type HTTPBackend struct {
+	baseURL *url.URL
+	client  *http.Client
+}
+
+// NewHTTPBackend returns an HTTPBackend using the server at the given URL
+func NewHTTPBackend(serverURL string) (*HTTPBackend, error) {
+	baseURL, err := url.Parse(serverURL)
+	if err != nil {
+		return nil, fmt.Errorf(tr("invalid cache server URL: %s"), err)
+	}
+	if baseURL.Scheme != "http" && baseURL.Scheme != "https" {
+		return nil, fmt.Errorf(tr("invalid cache server URL: %s"), serverURL)
+	}
+	if !strings.HasSuffix(baseURL.Path, "/") {
+		baseURL.Path += "/"
+	}
+	client, err := httpclient.New()
+	if err != nil {
+		return nil, err
+	}
+	client.Timeout = 30 * time.Second
+	return &HTTPBackend{
+		baseURL: baseURL,
+		client:  client,
+	}, nil
+}
+
+func (h *HTTPBackend) entryURL(key string) (string, error) {
+	if len(key) < 3 || strings.ContainsAny(key, `/\.?#`) {
+		return "", fmt.Errorf(tr("invalid cache key: %s"), key)
+	}
+	return h.baseURL.ResolveReference(&url.URL{Path: key}).String(), nil
+}
+
+// Get downloads the entry with the given key, nil is returned if the server
+// replies with 404 Not Found.
+func (h *HTTPBackend) Get(key string) (Entry, error) {
+	entryURL, err := h.entryURL(key)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := h.client.Get(entryURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf(tr("cache server replied %[1]s to GET %[2]s"), resp.Status, key)
+	}
+	return decodeEntry(resp.Body)
+}
+
+// Put uploads the entry with the given key
+func (h *HTTPBackend) Put(key string, entry Entry) error {
+	entryURL, err := h.entryURL(key)
+	if err != nil {
+		return err
+	}
+	data, err := encodeEntry(entry)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPut, entryURL, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/gzip")
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf(tr("cache server replied %[1]s to PUT %[2]s"), resp.Status, key)
+	}
+	return nil
+}
+
+// encodeEntry returns the entry as a gzip compressed tar archive, files are
+// sorted by name so the same entry is always encoded in the same way
+func encodeEntry(entry Entry) ([]byte, error) {
+	names := []string{}
+	for name := range entry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for _, name := range names {
+		data := entry[name]
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(data))}); err != nil {
+			return nil, err
+		}
+		if _, err := tw.Write(data); err != nil {
+			return nil, err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gz.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeEntry(r io.Reader) (Entry, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf(tr("invalid cache entry: %s"), err)
+	}
+	archive := tar.NewReader(gz)
+	entry := Entry{}
+	for {
+		header, err := archive.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf(tr("invalid cache entry: %s"), err)
+		}
+		if strings.ContainsAny(header.Name, `/\`) || header.Name == ".." {
+			return nil, fmt.Errorf(tr("invalid cache entry: %s"), header.Name)
+		}
+		data, err := ioutil.ReadAll(archive)
+		if err != nil {
+			return nil, fmt.Errorf(tr("invalid cache entry: %s"), err)
+		}
+		entry[header.Name] = data
+	}
+	return entry, nil
+}
@@ -0,0 +1,174 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package buildcache
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/arduino/arduino-cli/configuration"
+	"github.com/arduino/arduino-cli/httpclient"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+// testCacheServer is a minimal static file server accepting PUT requests
+type testCacheServer struct {
+	files     map[string][]byte
+	mx        sync.Mutex
+	gets      int
+	puts      int
+	userAgent string
+}
+
+func (s *testCacheServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	s.userAgent = r.UserAgent()
+	if user, pass, ok := r.BasicAuth(); !ok || user != "user" || pass != "secret" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		s.gets++
+		data, ok := s.files[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write(data)
+	case http.MethodPut:
+		s.puts++
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		s.files[r.URL.Path] = data
+		w.WriteHeader(http.StatusCreated)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func newTestCacheServer(t *testing.T) (*testCacheServer, string) {
+	handler := &testCacheServer{files: map[string][]byte{}}
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return handler, strings.Replace(server.URL, "http://", "http://user:secret@", 1) + "/cache"
+}
+
+func TestHTTPBackend(t *testing.T) {
+	configuration.Settings = configuration.Init("")
+	server, serverURL := newTestCacheServer(t)
+	backend, err := NewHTTPBackend(serverURL)
+	require.NoError(t, err)
+
+	entry, err := backend.Get("aabbcc")
+	require.NoError(t, err)
+	require.Nil(t, entry)
+
+	stored := Entry{"object": []byte("obj"), "deps": []byte("{object_file}: a.cpp"), "stderr": []byte{}}
+	require.NoError(t, backend.Put("aabbcc", stored))
+	require.Contains(t, server.files, "/cache/aabbcc")
+	require.Equal(t, httpclient.UserAgent(), server.userAgent)
+
+	entry, err = backend.Get("aabbcc")
+	require.NoError(t, err)
+	require.Equal(t, stored, entry)
+
+	_, err = backend.Get("../aabbcc")
+	require.Error(t, err)
+
+	// Wrong credentials
+	unauthorized, err := NewHTTPBackend(strings.Replace(serverURL, "secret", "wrong", 1))
+	require.NoError(t, err)
+	_, err = unauthorized.Get("aabbcc")
+	require.Error(t, err)
+	require.Error(t, unauthorized.Put("aabbcc", stored))
+
+	_, err = NewHTTPBackend("ftp://example.com/cache")
+	require.Error(t, err)
+}
+
+func TestEntryEncoding(t *testing.T) {
+	entry := Entry{"b": []byte("second"), "a": []byte("first"), "empty": []byte{}}
+	data, err := encodeEntry(entry)
+	require.NoError(t, err)
+	again, err := encodeEntry(entry)
+	require.NoError(t, err)
+	require.Equal(t, data, again)
+
+	decoded, err := decodeEntry(strings.NewReader(string(data)))
+	require.NoError(t, err)
+	require.Equal(t, entry, decoded)
+
+	_, err = decodeEntry(strings.NewReader("not an archive"))
+	require.Error(t, err)
+}
+
+func TestTieredBackend(t *testing.T) {
+	configuration.Settings = configuration.Init("")
+	server, serverURL := newTestCacheServer(t)
+	remote, err := NewHTTPBackend(serverURL)
+	require.NoError(t, err)
+	dir, err := paths.MkTempDir("", "buildcache-test")
+	require.NoError(t, err)
+	defer dir.RemoveAll()
+
+	// Entries are stored both locally and remotely
+	machine1 := NewTieredBackend(New(dir.Join("machine1")), remote, false)
+	require.NoError(t, machine1.Put("aabbcc", Entry{"object": []byte("obj")}))
+	require.Equal(t, 1, server.puts)
+
+	// Entries found remotely are stored locally
+	machine2Local := New(dir.Join("machine2"))
+	machine2 := NewTieredBackend(machine2Local, remote, true)
+	entry, err := machine2.Get("aabbcc")
+	require.NoError(t, err)
+	require.Equal(t, Entry{"object": []byte("obj")}, entry)
+	require.Equal(t, 1, server.gets)
+	entry, err = machine2Local.Get("aabbcc")
+	require.NoError(t, err)
+	require.NotNil(t, entry)
+	entry, err = machine2.Get("aabbcc")
+	require.NoError(t, err)
+	require.NotNil(t, entry)
+	require.Equal(t, 1, server.gets)
+
+	// Read only remote backends are not written
+	require.NoError(t, machine2.Put("ddeeff", Entry{"object": []byte("obj2")}))
+	require.Equal(t, 1, server.puts)
+	entry, err = machine2.Get("ddeeff")
+	require.NoError(t, err)
+	require.NotNil(t, entry)
+
+	// A failing remote backend is not used anymore
+	broken, err := NewHTTPBackend("http://127.0.0.1:1/cache")
+	require.NoError(t, err)
+	machine3 := NewTieredBackend(New(dir.Join("machine3")), broken, false)
+	_, err = machine3.Get("aabbcc")
+	require.Error(t, err)
+	entry, err = machine3.Get("aabbcc")
+	require.NoError(t, err)
+	require.Nil(t, entry)
+	require.NoError(t, machine3.Put("aabbcc", Entry{"object": []byte("obj")}))
+}
@@ -0,0 +1,276 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package builder
+
+import (
+	"bufio"
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+)
+
+// CompilerDiagnostic is an error or a warning reported by the compiler
+type CompilerDiagnostic struct {
+	Severity string
+	Message  string
+	Location
+	// Context contains the lines that precede the diagnostic explaining where it
+	// happened, like "In function 'void setup()'" or "In file included from ..."
+	Context []*CompilerDiagnosticContext
+	Notes   []*CompilerDiagnosticNote
+	FixIts  []*CompilerDiagnosticFixIt
+}
+
+// Location is a position in a source file, Line and Column are 1-based and
+// are 0 if not reported by the compiler
+type Location struct {
+	File   string
+	Line   int
+	Column int
+}
+
+// CompilerDiagnosticContext is a line of context of a CompilerDiagnostic
+type CompilerDiagnosticContext struct {
+	Message string
+	Location
+}
+
+// CompilerDiagnosticNote is a note attached to a CompilerDiagnostic
+type CompilerDiagnosticNote struct {
+	Message string
+	Location
+}
+
+// CompilerDiagnosticFixIt is a replacement of a portion of source code
+// suggested by the compiler to fix a CompilerDiagnostic. The End position
+// is exclusive.
+type CompilerDiagnosticFixIt struct {
+	File        string
+	StartLine   int
+	StartColumn int
+	EndLine     int
+	EndColumn   int
+	Replacement string
+}
+
+var (
+	// /path/file.cpp:12:5: error: message
+	diagnosticRegexp = regexp.MustCompile(`^(.+?):(\d+):(?:(\d+):)? (fatal error|error|warning|note): (.*)$`)
+	// cc1plus: warning: message
+	toolDiagnosticRegexp = regexp.MustCompile(`^([^\s:]+): (fatal error|error|warning|note): (.*)$`)
+	// In file included from /path/file.h:3:0,
+	//                  from /path/file.cpp:1:
+	includedFromRegexp = regexp.MustCompile(`^(?:In file included |\s+)from (.+?):(\d+)(?::(\d+))?[,:]$`)
+	// /path/file.cpp: In function 'void setup()':
+	scopeRegexp = regexp.MustCompile(`^(.+?): ((?:In |At ).*):$`)
+	// /path/file.cpp:12:5:   required from here
+	requiredFromRegexp = regexp.MustCompile(`^(.+?):(\d+):(?:(\d+):)?   (.*)$`)
+	// fix-it:"/path/file.cpp":{12:5-12:8}:"replacement"
+	fixItRegexp = regexp.MustCompile(`^fix-it:("(?:[^"\\]|\\.)*"):\{(\d+):(\d+)-(\d+):(\d+)\}:("(?:[^"\\]|\\.)*")$`)
+)
+
+// ParseCompilerDiagnostics parses the output of GCC and Clang and returns the
+// reported diagnostics. Notes are attached to the preceding diagnostic, fix-its
+// are available only if the compiler is run with -fdiagnostics-parseable-fixits.
+func ParseCompilerDiagnostics(output []byte) []*CompilerDiagnostic {
+	res := []*CompilerDiagnostic{}
+	var last *CompilerDiagnostic
+	context := []*CompilerDiagnosticContext{}
+
+	add := func(severity, message string, location Location) {
+		if severity == "note" && last != nil {
+			last.Notes = append(last.Notes, &CompilerDiagnosticNote{Message: message, Location: location})
+			context = []*CompilerDiagnosticContext{}
+			return
+		}
+		if severity == "fatal error" {
+			severity = "error"
+		}
+		last = &CompilerDiagnostic{
+			Severity: severity,
+			Message:  message,
+			Location: location,
+			Context:  context,
+		}
+		res = append(res, last)
+		context = []*CompilerDiagnosticContext{}
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if m := fixItRegexp.FindStringSubmatch(line); m != nil {
+			if last == nil {
+				continue
+			}
+			file, err1 := strconv.Unquote(m[1])
+			replacement, err2 := strconv.Unquote(m[6])
+			if err1 != nil || err2 != nil {
+				continue
+			}
+			last.FixIts = append(last.FixIts, &CompilerDiagnosticFixIt{
+				File:        file,
+				StartLine:   atoi(m[2]),
+				StartColumn: atoi(m[3]),
+				EndLine:     atoi(m[4]),
+				EndColumn:   atoi(m[5]),
+				Replacement: replacement,
+			})
+		} else if m := diagnosticRegexp.FindStringSubmatch(line); m != nil {
+			add(m[4], m[5], Location{File: m[1], Line: atoi(m[2]), Column: atoi(m[3])})
+		} else if m := includedFromRegexp.FindStringSubmatch(line); m != nil {
+			context = append(context, &CompilerDiagnosticContext{
+				Message:  "included from",
+				Location: Location{File: m[1], Line: atoi(m[2]), Column: atoi(m[3])},
+			})
+		} else if m := scopeRegexp.FindStringSubmatch(line); m != nil {
+			context = append(context, &CompilerDiagnosticContext{
+				Message:  m[2],
+				Location: Location{File: m[1]},
+			})
+		} else if m := requiredFromRegexp.FindStringSubmatch(line); m != nil {
+			context = append(context, &CompilerDiagnosticContext{
+				Message:  m[4],
+				Location: Location{File: m[1], Line: atoi(m[2]), Column: atoi(m[3])},
+			})
+		} else if m := toolDiagnosticRegexp.FindStringSubmatch(line); m != nil {
+			add(m[2], m[3], Location{})
+		}
+		// Other lines (source code excerpts, carets...) are ignored
+	}
+	return res
+}
+
+func atoi(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
+
+// MapLocations replaces all the locations of the diagnostic with the ones
+// returned by mapper
+func (d *CompilerDiagnostic) MapLocations(mapper func(Location) Location) {
+	d.Location = mapper(d.Location)
+	for _, c := range d.Context {
+		c.Location = mapper(c.Location)
+	}
+	for _, n := range d.Notes {
+		n.Location = mapper(n.Location)
+	}
+	for _, f := range d.FixIts {
+		start := mapper(Location{File: f.File, Line: f.StartLine, Column: f.StartColumn})
+		end := mapper(Location{File: f.File, Line: f.EndLine, Column: f.EndColumn})
+		f.File, f.StartLine, f.EndLine = start.File, start.Line, end.Line
+	}
+}
+
+// ToRPC converts the diagnostic into a *rpc.CompileDiagnostic
+func (d *CompilerDiagnostic) ToRPC() *rpc.CompileDiagnostic {
+	res := &rpc.CompileDiagnostic{
+		Severity: d.Severity,
+		Message:  d.Message,
+		File:     d.File,
+		Line:     int64(d.Line),
+		Column:   int64(d.Column),
+	}
+	for _, c := range d.Context {
+		res.Context = append(res.Context, &rpc.CompileDiagnosticContext{
+			Message: c.Message,
+			File:    c.File,
+			Line:    int64(c.Line),
+			Column:  int64(c.Column),
+		})
+	}
+	for _, n := range d.Notes {
+		res.Notes = append(res.Notes, &rpc.CompileDiagnosticNote{
+			Message: n.Message,
+			File:    n.File,
+			Line:    int64(n.Line),
+			Column:  int64(n.Column),
+		})
+	}
+	for _, f := range d.FixIts {
+		res.FixIts = append(res.FixIts, &rpc.CompileDiagnosticFixIt{
+			File:        f.File,
+			StartLine:   int64(f.StartLine),
+			StartColumn: int64(f.StartColumn),
+			EndLine:     int64(f.EndLine),
+			EndColumn:   int64(f.EndColumn),
+			Replacement: f.Replacement,
+		})
+	}
+	return res
+}
+
+// lineDirectiveRegexp matches both `#line 12 "file"` and the `# 12 "file"` markers
+// emitted by the preprocessor
+var lineDirectiveRegexp = regexp.MustCompile(`^\s*#\s*(?:line\s+)?(\d+)(?:\s+("(?:[^"\\]|\\.)*"))?`)
+
+type lineDirective struct {
+	physicalLine int
+	line         int
+	file         string
+}
+
+// LineMap maps the lines of a generated source file, like the merged sketch,
+// to the original source files using the #line directives it contains
+type LineMap struct {
+	directives []*lineDirective
+}
+
+// NewLineMap returns the LineMap of the given source code
+func NewLineMap(source []byte) *LineMap {
+	res := &LineMap{}
+	file := ""
+	scanner := bufio.NewScanner(bytes.NewReader(source))
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for physicalLine := 1; scanner.Scan(); physicalLine++ {
+		m := lineDirectiveRegexp.FindStringSubmatch(scanner.Text())
+		if m == nil {
+			continue
+		}
+		if m[2] != "" {
+			if unquoted, err := strconv.Unquote(m[2]); err == nil {
+				file = unquoted
+			}
+		}
+		res.directives = append(res.directives, &lineDirective{
+			physicalLine: physicalLine,
+			line:         atoi(m[1]),
+			file:         file,
+		})
+	}
+	return res
+}
+
+// Map returns the file and the line that generated the given physical line.
+// If the physical line is not preceded by a #line directive, or the directive
+// doesn't specify a file, false is returned.
+func (m *LineMap) Map(physicalLine int) (string, int, bool) {
+	var directive *lineDirective
+	for _, d := range m.directives {
+		if d.physicalLine >= physicalLine {
+			break
+		}
+		directive = d
+	}
+	if directive == nil || directive.file == "" {
+		return "", 0, false
+	}
+	return directive.file, directive.line + physicalLine - directive.physicalLine - 1, true
+}
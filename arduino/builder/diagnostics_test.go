@@ -0,0 +1,117 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package builder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCompilerDiagnostics(t *testing.T) {
+	output := []byte(`In file included from /tmp/sketch/Blink.ino:1:0:
+/tmp/lib/MyLib.h: In function 'void foo()':
+/tmp/lib/MyLib.h:4:7: warning: unused variable 'a' [-Wunused-variable]
+   int a = 0;
+       ^
+/tmp/sketch/Blink.ino: In function 'void setup()':
+/tmp/sketch/Blink.ino:5:3: error: 'mylb' was not declared in this scope
+   mylb();
+   ^~~~
+fix-it:"/tmp/sketch/Blink.ino":{5:3-5:7}:"mylib"
+/tmp/sketch/Blink.ino:2:6: note: 'mylib' declared here
+ void mylib() {}
+      ^~~~~
+C:\Users\me\sketch\sketch.ino:3:1: fatal error: Missing.h: No such file or directory
+cc1plus: warning: command line option '-Wfoo' is valid for C but not for C++
+compilation terminated.
+`)
+	diags := ParseCompilerDiagnostics(output)
+	require.Len(t, diags, 4)
+
+	require.Equal(t, "warning", diags[0].Severity)
+	require.Equal(t, "unused variable 'a' [-Wunused-variable]", diags[0].Message)
+	require.Equal(t, Location{File: "/tmp/lib/MyLib.h", Line: 4, Column: 7}, diags[0].Location)
+	require.Len(t, diags[0].Context, 2)
+	require.Equal(t, "included from", diags[0].Context[0].Message)
+	require.Equal(t, Location{File: "/tmp/sketch/Blink.ino", Line: 1}, diags[0].Context[0].Location)
+	require.Equal(t, "In function 'void foo()'", diags[0].Context[1].Message)
+	require.Equal(t, Location{File: "/tmp/lib/MyLib.h"}, diags[0].Context[1].Location)
+
+	require.Equal(t, "error", diags[1].Severity)
+	require.Equal(t, "'mylb' was not declared in this scope", diags[1].Message)
+	require.Equal(t, Location{File: "/tmp/sketch/Blink.ino", Line: 5, Column: 3}, diags[1].Location)
+	require.Len(t, diags[1].Context, 1)
+	require.Equal(t, "In function 'void setup()'", diags[1].Context[0].Message)
+	require.Len(t, diags[1].Notes, 1)
+	require.Equal(t, "'mylib' declared here", diags[1].Notes[0].Message)
+	require.Equal(t, Location{File: "/tmp/sketch/Blink.ino", Line: 2, Column: 6}, diags[1].Notes[0].Location)
+	require.Equal(t, []*CompilerDiagnosticFixIt{{
+		File:        "/tmp/sketch/Blink.ino",
+		StartLine:   5,
+		StartColumn: 3,
+		EndLine:     5,
+		EndColumn:   7,
+		Replacement: "mylib",
+	}}, diags[1].FixIts)
+
+	require.Equal(t, "error", diags[2].Severity)
+	require.Equal(t, "Missing.h: No such file or directory", diags[2].Message)
+	require.Equal(t, Location{File: `C:\Users\me\sketch\sketch.ino`, Line: 3, Column: 1}, diags[2].Location)
+
+	require.Equal(t, "warning", diags[3].Severity)
+	require.Equal(t, "command line option '-Wfoo' is valid for C but not for C++", diags[3].Message)
+	require.Equal(t, Location{}, diags[3].Location)
+
+	require.Empty(t, ParseCompilerDiagnostics([]byte("compilation terminated.\n")))
+}
+
+func TestLineMap(t *testing.T) {
+	source := []byte(`#include <Arduino.h>
+#line 1 "/tmp/sketch/Blink.ino"
+void setup() {
+}
+#line 1 "/tmp/sketch/Other.ino"
+void loop() {
+# 10 "/tmp/sketch/Other.ino"
+  foo();
+}
+`)
+	lineMap := NewLineMap(source)
+
+	_, _, ok := lineMap.Map(1)
+	require.False(t, ok)
+
+	file, line, ok := lineMap.Map(3)
+	require.True(t, ok)
+	require.Equal(t, "/tmp/sketch/Blink.ino", file)
+	require.Equal(t, 1, line)
+
+	file, line, ok = lineMap.Map(4)
+	require.True(t, ok)
+	require.Equal(t, "/tmp/sketch/Blink.ino", file)
+	require.Equal(t, 2, line)
+
+	file, line, ok = lineMap.Map(6)
+	require.True(t, ok)
+	require.Equal(t, "/tmp/sketch/Other.ino", file)
+	require.Equal(t, 1, line)
+
+	file, line, ok = lineMap.Map(8)
+	require.True(t, ok)
+	require.Equal(t, "/tmp/sketch/Other.ino", file)
+	require.Equal(t, 10, line)
+}
@@ -0,0 +1,190 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package packageindex
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/arduino/go-paths-helper"
+	semver "go.bug.st/relaxed-semver"
+)
+
+// BoardsDBFileName is the name of the file, stored in the data directory
+// together with the package indexes, containing the BoardsDB
+const BoardsDBFileName = "board_ids.json"
+
+// BoardsDB is a database of the USB VID/PID of the boards listed in the
+// package indexes. It allows to identify a board even if the platform
+// providing it is not installed, without querying online services.
+type BoardsDB struct {
+	Boards []*BoardsDBEntry `json:"boards"`
+}
+
+// BoardsDBEntry is a board identified by an USB VID/PID in the latest release
+// of a platform
+type BoardsDBEntry struct {
+	VID             string `json:"vid"`
+	PID             string `json:"pid"`
+	Name            string `json:"name"`
+	Platform        string `json:"platform"`
+	PlatformName    string `json:"platform_name"`
+	PlatformVersion string `json:"platform_version"`
+	Maintainer      string `json:"maintainer"`
+
+	version *semver.Version
+}
+
+// NewBoardsDB creates an empty BoardsDB
+func NewBoardsDB() *BoardsDB {
+	return &BoardsDB{Boards: []*BoardsDBEntry{}}
+}
+
+// AddIndex adds to the database the boards of the latest release of each
+// platform contained in the index. If a platform is already present in the
+// database its boards are replaced, unless the release already present is
+// newer.
+func (db *BoardsDB) AddIndex(index *Index) {
+	latest := map[string]*indexPlatformRelease{}
+	maintainers := map[string]string{}
+	for _, inPackage := range index.Packages {
+		for _, inPlatformRelease := range inPackage.Platforms {
+			if inPlatformRelease.Version == nil {
+				continue
+			}
+			id := inPackage.Name + ":" + inPlatformRelease.Architecture
+			if current, ok := latest[id]; !ok || inPlatformRelease.Version.GreaterThan(current.Version) {
+				latest[id] = inPlatformRelease
+				maintainers[id] = inPackage.Maintainer
+			}
+		}
+	}
+
+	for id, inPlatformRelease := range latest {
+		if existing, found := db.platformVersion(id); found {
+			if existing != nil && existing.GreaterThan(inPlatformRelease.Version) {
+				continue
+			}
+			db.removePlatform(id)
+		}
+		for _, board := range inPlatformRelease.Boards {
+			for _, boardID := range board.ID {
+				vid, pid, ok := parseUSBID(boardID.USB)
+				if !ok {
+					continue
+				}
+				db.Boards = append(db.Boards, &BoardsDBEntry{
+					VID:             vid,
+					PID:             pid,
+					Name:            board.Name,
+					Platform:        id,
+					PlatformName:    inPlatformRelease.Name,
+					PlatformVersion: inPlatformRelease.Version.String(),
+					Maintainer:      maintainers[id],
+					version:         inPlatformRelease.Version,
+				})
+			}
+		}
+	}
+
+	sort.SliceStable(db.Boards, func(i, j int) bool {
+		a, b := db.Boards[i], db.Boards[j]
+		if a.Platform != b.Platform {
+			return a.Platform < b.Platform
+		}
+		return a.Name < b.Name
+	})
+}
+
+func (db *BoardsDB) platformVersion(platform string) (*semver.Version, bool) {
+	for _, entry := range db.Boards {
+		if entry.Platform == platform {
+			return entry.version, true
+		}
+	}
+	return nil, false
+}
+
+func (db *BoardsDB) removePlatform(platform string) {
+	boards := []*BoardsDBEntry{}
+	for _, entry := range db.Boards {
+		if entry.Platform != platform {
+			boards = append(boards, entry)
+		}
+	}
+	db.Boards = boards
+}
+
+// Lookup returns the boards matching the given USB VID and PID
+func (db *BoardsDB) Lookup(vid, pid string) []*BoardsDBEntry {
+	res := []*BoardsDBEntry{}
+	vid, pid = normalizeUSBID(vid), normalizeUSBID(pid)
+	for _, entry := range db.Boards {
+		if entry.VID == vid && entry.PID == pid {
+			res = append(res, entry)
+		}
+	}
+	return res
+}
+
+// LoadBoardsDB reads a BoardsDB from the given file
+func LoadBoardsDB(file *paths.Path) (*BoardsDB, error) {
+	data, err := file.ReadFile()
+	if err != nil {
+		return nil, fmt.Errorf(tr("reading boards database: %s"), err)
+	}
+	db := NewBoardsDB()
+	if err := json.Unmarshal(data, db); err != nil {
+		return nil, fmt.Errorf(tr("invalid boards database %[1]s: %[2]s"), file, err)
+	}
+	for _, entry := range db.Boards {
+		entry.version, _ = semver.Parse(entry.PlatformVersion)
+	}
+	return db, nil
+}
+
+// Save writes the BoardsDB in the given file
+func (db *BoardsDB) Save(file *paths.Path) error {
+	data, err := json.MarshalIndent(db, "", "  ")
+	if err != nil {
+		return fmt.Errorf(tr("encoding boards database: %s"), err)
+	}
+	if err := file.WriteFile(data); err != nil {
+		return fmt.Errorf(tr("writing boards database: %s"), err)
+	}
+	return nil
+}
+
+// parseUSBID splits an USB ID in the "0x2341:0x0043" format of the package
+// index into the normalized vid and pid
+func parseUSBID(usbID string) (string, string, bool) {
+	split := strings.Split(usbID, ":")
+	if len(split) != 2 {
+		return "", "", false
+	}
+	vid, pid := normalizeUSBID(split[0]), normalizeUSBID(split[1])
+	if vid == "0x" || pid == "0x" {
+		return "", "", false
+	}
+	return vid, pid, true
+}
+
+func normalizeUSBID(id string) string {
+	id = strings.ToLower(strings.TrimSpace(id))
+	return "0x" + strings.TrimPrefix(id, "0x")
+}
@@ -0,0 +1,85 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package packageindex
+
+import (
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBoardsDB(t *testing.T) {
+	index, err := LoadIndexNoSign(paths.New("testdata", "package_boardsdb_index.json"))
+	require.NoError(t, err)
+
+	db := NewBoardsDB()
+	db.AddIndex(index)
+	require.Len(t, db.Boards, 4)
+
+	// Only the latest release of the platforms is used
+	require.Empty(t, db.Lookup("0x1234", "0x0001"))
+
+	// The USB IDs are normalized
+	res := db.Lookup("0x1234", "0x00ab")
+	require.Len(t, res, 1)
+	require.Equal(t, &BoardsDBEntry{
+		VID:             "0x1234",
+		PID:             "0x00ab",
+		Name:            "Test Zero",
+		Platform:        "test:samd",
+		PlatformName:    "Test SAMD Boards",
+		PlatformVersion: "1.1.0",
+		Maintainer:      "Test",
+		version:         res[0].version,
+	}, res[0])
+	require.Len(t, db.Lookup("1234", "0x00AC"), 1)
+
+	res = db.Lookup("0x1234", "0x0002")
+	require.Len(t, res, 2)
+	require.Equal(t, "Other Uno", res[0].Name)
+	require.Equal(t, "other:avr", res[0].Platform)
+	require.Equal(t, "Test MKR", res[1].Name)
+	require.Equal(t, "test:samd", res[1].Platform)
+
+	// Adding the same index again doesn't duplicate the boards
+	db.AddIndex(index)
+	require.Len(t, db.Boards, 4)
+
+	// The database can be saved and loaded back
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	dbFile := tmp.Join(BoardsDBFileName)
+	require.NoError(t, db.Save(dbFile))
+	loaded, err := LoadBoardsDB(dbFile)
+	require.NoError(t, err)
+	require.Len(t, loaded.Boards, 4)
+	require.Equal(t, "1.1.0", loaded.Lookup("0x1234", "0x00ab")[0].version.String())
+
+	// An older release of a platform already in the database is ignored
+	older := &Index{Packages: []*indexPackage{index.Packages[0]}}
+	older.Packages[0].Platforms = older.Packages[0].Platforms[:1]
+	loaded.AddIndex(older)
+	require.Empty(t, loaded.Lookup("0x1234", "0x0001"))
+	require.Len(t, loaded.Lookup("0x1234", "0x00ab"), 1)
+
+	require.NoError(t, tmp.Join("invalid.json").WriteFile([]byte("{")))
+	_, err = LoadBoardsDB(tmp.Join("invalid.json"))
+	require.Error(t, err)
+	_, err = LoadBoardsDB(tmp.Join("missing.json"))
+	require.Error(t, err)
+}
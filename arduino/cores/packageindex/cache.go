@@ -0,0 +1,188 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package packageindex
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino/resources"
+	"github.com/arduino/go-paths-helper"
+)
+
+// CachedIndexesDirName is the name of the folder, in the data directory,
+// containing the package indexes downloaded from the network. Each index is
+// stored in its own folder, so indexes with the same file name published by
+// different sources don't overwrite each other.
+const CachedIndexesDirName = "package_indexes"
+
+// CachedIndexMetadataFileName is the name of the file, stored together with
+// a downloaded index, containing its CachedIndex metadata
+const CachedIndexMetadataFileName = "source.json"
+
+// CachedIndex is the metadata of a package index downloaded from the network
+type CachedIndex struct {
+	URL          string    `json:"url"`
+	ETag         string    `json:"etag,omitempty"`
+	LastModified string    `json:"last_modified,omitempty"`
+	FetchedAt    time.Time `json:"fetched_at"`
+
+	// Dir is the folder containing the index
+	Dir *paths.Path `json:"-"`
+}
+
+// CachedIndexDir returns the folder where the index downloaded from URL is
+// stored. The name of the folder is derived from the host and the path of
+// the URL.
+func CachedIndexDir(indexDir *paths.Path, URL *url.URL) *paths.Path {
+	host := strings.ToLower(URL.Hostname())
+	if host == "" {
+		host = "index"
+	}
+	hash := sha256.Sum256([]byte(strings.ToLower(URL.Host) + URL.Path))
+	return indexDir.Join(CachedIndexesDirName, fmt.Sprintf("%s-%x", host, hash[:6]))
+}
+
+// CachedIndexPath returns the path of the index downloaded from URL. The
+// compressed variants of an index are stored decompressed.
+func CachedIndexPath(indexDir *paths.Path, URL *url.URL) *paths.Path {
+	return CachedIndexDir(indexDir, URL).Join(resources.IndexFileName(URL))
+}
+
+// IndexPath returns the path of the cached index
+func (c *CachedIndex) IndexPath() (*paths.Path, error) {
+	URL, err := url.Parse(c.URL)
+	if err != nil {
+		return nil, err
+	}
+	return c.Dir.Join(resources.IndexFileName(URL)), nil
+}
+
+// Validators returns the HTTP validators of the cached index
+func (c *CachedIndex) Validators() *resources.IndexValidators {
+	return &resources.IndexValidators{ETag: c.ETag, LastModified: c.LastModified}
+}
+
+// LoadCachedIndex reads the metadata of the index stored in the given folder
+func LoadCachedIndex(dir *paths.Path) (*CachedIndex, error) {
+	file := dir.Join(CachedIndexMetadataFileName)
+	data, err := file.ReadFile()
+	if err != nil {
+		return nil, fmt.Errorf(tr("reading index metadata: %s"), err)
+	}
+	c := &CachedIndex{}
+	if err := json.Unmarshal(data, c); err != nil {
+		return nil, fmt.Errorf(tr("invalid index metadata %[1]s: %[2]s"), file, err)
+	}
+	c.Dir = dir
+	return c, nil
+}
+
+// LoadCachedIndexes reads the metadata of all the indexes downloaded in
+// indexDir, sorted by URL. The folders without metadata are skipped.
+func LoadCachedIndexes(indexDir *paths.Path) ([]*CachedIndex, error) {
+	res := []*CachedIndex{}
+	cacheDir := indexDir.Join(CachedIndexesDirName)
+	if cacheDir.NotExist() {
+		return res, nil
+	}
+	dirs, err := cacheDir.ReadDir()
+	if err != nil {
+		return nil, fmt.Errorf(tr("reading directory %[1]s: %[2]s"), cacheDir, err)
+	}
+	dirs.FilterDirs()
+	for _, dir := range dirs {
+		if c, err := LoadCachedIndex(dir); err == nil {
+			res = append(res, c)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].URL < res[j].URL })
+	return res, nil
+}
+
+// Save writes the metadata in the folder of the cached index
+func (c *CachedIndex) Save() error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf(tr("encoding index metadata: %s"), err)
+	}
+	if err := c.Dir.MkdirAll(); err != nil {
+		return fmt.Errorf(tr("creating directory %[1]s: %[2]s"), c.Dir, err)
+	}
+	if err := c.Dir.Join(CachedIndexMetadataFileName).WriteFile(data); err != nil {
+		return fmt.Errorf(tr("writing index metadata: %s"), err)
+	}
+	return nil
+}
+
+// MigrateLegacyIndexes moves the indexes downloaded from the given URLs, that
+// previous versions stored directly in indexDir, into their own folder. An
+// index is migrated only if its file name belongs to a single URL: otherwise
+// it's impossible to tell which source the file comes from, so it's left in
+// place and the index will be downloaded again on the next update.
+func MigrateLegacyIndexes(indexDir *paths.Path, urls []*url.URL) error {
+	byName := map[string][]*url.URL{}
+	for _, URL := range urls {
+		if URL.Scheme == "file" {
+			continue
+		}
+		name := path.Base(URL.Path)
+		byName[name] = append(byName[name], URL)
+	}
+
+	for name, sources := range byName {
+		legacyIndex := indexDir.Join(name)
+		if len(sources) != 1 || !legacyIndex.IsNotDir() {
+			continue
+		}
+		URL := sources[0]
+		indexPath := CachedIndexPath(indexDir, URL)
+		if indexPath.Exist() {
+			continue
+		}
+		info, err := legacyIndex.Stat()
+		if err != nil {
+			return fmt.Errorf(tr("migrating index %[1]s: %[2]s"), legacyIndex, err)
+		}
+		if err := indexPath.Parent().MkdirAll(); err != nil {
+			return fmt.Errorf(tr("migrating index %[1]s: %[2]s"), legacyIndex, err)
+		}
+		if err := legacyIndex.Rename(indexPath); err != nil {
+			return fmt.Errorf(tr("migrating index %[1]s: %[2]s"), legacyIndex, err)
+		}
+		legacySig := indexDir.Join(name + ".sig")
+		if legacySig.IsNotDir() {
+			if err := legacySig.Rename(indexPath.Parent().Join(name + ".sig")); err != nil {
+				return fmt.Errorf(tr("migrating index %[1]s: %[2]s"), legacySig, err)
+			}
+		}
+		c := &CachedIndex{
+			URL:       URL.String(),
+			FetchedAt: info.ModTime(),
+			Dir:       indexPath.Parent(),
+		}
+		if err := c.Save(); err != nil {
+			return fmt.Errorf(tr("migrating index %[1]s: %[2]s"), legacyIndex, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package packageindex
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCachedIndexPath(t *testing.T) {
+	indexDir := paths.New("data")
+	mustParse := func(u string) *url.URL {
+		URL, err := url.Parse(u)
+		require.NoError(t, err)
+		return URL
+	}
+
+	first := CachedIndexPath(indexDir, mustParse("https://example.com/first/package_index.json"))
+	second := CachedIndexPath(indexDir, mustParse("https://example.com/second/package_index.json"))
+	require.Equal(t, "package_index.json", first.Base())
+	require.Equal(t, "package_index.json", second.Base())
+	require.NotEqual(t, first, second)
+	require.Equal(t, indexDir.Join(CachedIndexesDirName), first.Parent().Parent())
+	require.Regexp(t, "^example.com-[0-9a-f]{12}$", first.Parent().Base())
+
+	// The scheme doesn't change the source of the index
+	require.Equal(t, first, CachedIndexPath(indexDir, mustParse("http://EXAMPLE.com/first/package_index.json")))
+	// The port does
+	require.NotEqual(t, first, CachedIndexPath(indexDir, mustParse("https://example.com:8080/first/package_index.json")))
+	// The compressed variants are stored decompressed
+	require.Equal(t, "package_index.json", CachedIndexPath(indexDir, mustParse("https://example.com/first/package_index.json.bz2")).Base())
+}
+
+func TestCachedIndexMetadata(t *testing.T) {
+	indexDir, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer indexDir.RemoveAll()
+
+	indexes, err := LoadCachedIndexes(indexDir)
+	require.NoError(t, err)
+	require.Empty(t, indexes)
+
+	URL, err := url.Parse("https://example.com/package_example_index.json")
+	require.NoError(t, err)
+	fetchedAt := time.Date(2021, 10, 4, 12, 0, 0, 0, time.UTC)
+	c := &CachedIndex{URL: URL.String(), ETag: `"1234"`, FetchedAt: fetchedAt, Dir: CachedIndexDir(indexDir, URL)}
+	require.NoError(t, c.Save())
+	// Folders without metadata are skipped
+	require.NoError(t, indexDir.Join(CachedIndexesDirName, "other").MkdirAll())
+
+	indexes, err = LoadCachedIndexes(indexDir)
+	require.NoError(t, err)
+	require.Len(t, indexes, 1)
+	require.Equal(t, URL.String(), indexes[0].URL)
+	require.Equal(t, `"1234"`, indexes[0].ETag)
+	require.True(t, fetchedAt.Equal(indexes[0].FetchedAt))
+	indexPath, err := indexes[0].IndexPath()
+	require.NoError(t, err)
+	require.Equal(t, CachedIndexPath(indexDir, URL), indexPath)
+}
+
+func TestMigrateLegacyIndexes(t *testing.T) {
+	indexDir, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer indexDir.RemoveAll()
+
+	var urls []*url.URL
+	for _, u := range []string{
+		"https://downloads.arduino.cc/packages/package_index.json",
+		"https://example.com/package_example_index.json",
+		"https://other.example.com/package_index.json",
+		"file:///tmp/package_local_index.json",
+	} {
+		URL, err := url.Parse(u)
+		require.NoError(t, err)
+		urls = append(urls, URL)
+	}
+	for _, name := range []string{"package_index.json", "package_example_index.json", "package_example_index.json.sig", "package_local_index.json"} {
+		require.NoError(t, indexDir.Join(name).WriteFile([]byte(name)))
+	}
+
+	require.NoError(t, MigrateLegacyIndexes(indexDir, urls))
+
+	// The index with a unique name is moved together with its signature
+	migrated := CachedIndexPath(indexDir, urls[1])
+	data, err := migrated.ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, "package_example_index.json", string(data))
+	require.True(t, migrated.Parent().Join("package_example_index.json.sig").Exist())
+	require.True(t, indexDir.Join("package_example_index.json").NotExist())
+	c, err := LoadCachedIndex(migrated.Parent())
+	require.NoError(t, err)
+	require.Equal(t, urls[1].String(), c.URL)
+
+	// The index with a name shared by more URLs, and the local one, are left in place
+	require.True(t, indexDir.Join("package_index.json").Exist())
+	require.True(t, CachedIndexPath(indexDir, urls[0]).NotExist())
+	require.True(t, CachedIndexPath(indexDir, urls[2]).NotExist())
+	require.True(t, indexDir.Join("package_local_index.json").Exist())
+
+	// Migrating again does nothing
+	require.NoError(t, MigrateLegacyIndexes(indexDir, urls))
+	indexes, err := LoadCachedIndexes(indexDir)
+	require.NoError(t, err)
+	require.Len(t, indexes, 1)
+}
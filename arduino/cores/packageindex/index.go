@@ -122,6 +122,63 @@ func (index Index) MergeIntoPackages(outPackages cores.Packages) {
 // which in turn contains a single indexPlatformRelease converted from the one
 // passed as argument
 func IndexFromPlatformRelease(pr *cores.PlatformRelease) Index {
+	packageTools := []*indexToolRelease{}
+	for name, tool := range pr.Platform.Package.Tools {
+		for _, toolRelease := range tool.Releases {
+			packageTools = append(packageTools, newIndexToolRelease(name, toolRelease, nil))
+		}
+	}
+
+	p := newIndexPackage(pr.Platform.Package)
+	p.Platforms = []*indexPlatformRelease{newIndexPlatformRelease(pr, pr.Resource.URL)}
+	p.Tools = packageTools
+	return Index{
+		IsTrusted: pr.IsTrusted,
+		Packages:  []*indexPackage{p},
+	}
+}
+
+// IndexFromReleases creates an Index that contains the given platform and tool
+// releases, grouped by package. The URLs of the archives are the ones returned by
+// resourceURL, the tool flavours for which it returns an empty string are omitted.
+func IndexFromReleases(platformReleases []*cores.PlatformRelease, toolReleases []*cores.ToolRelease, resourceURL func(*resources.DownloadResource) string) Index {
+	index := Index{Packages: []*indexPackage{}}
+	packages := map[*cores.Package]*indexPackage{}
+	getPackage := func(p *cores.Package) *indexPackage {
+		if res, ok := packages[p]; ok {
+			return res
+		}
+		res := newIndexPackage(p)
+		packages[p] = res
+		index.Packages = append(index.Packages, res)
+		return res
+	}
+
+	for _, pr := range platformReleases {
+		p := getPackage(pr.Platform.Package)
+		p.Platforms = append(p.Platforms, newIndexPlatformRelease(pr, resourceURL(pr.Resource)))
+	}
+	for _, toolRelease := range toolReleases {
+		p := getPackage(toolRelease.Tool.Package)
+		p.Tools = append(p.Tools, newIndexToolRelease(toolRelease.Tool.Name, toolRelease, resourceURL))
+	}
+	return index
+}
+
+func newIndexPackage(p *cores.Package) *indexPackage {
+	return &indexPackage{
+		Name:       p.Name,
+		Maintainer: p.Maintainer,
+		WebsiteURL: p.WebsiteURL,
+		URL:        p.URL,
+		Email:      p.Email,
+		Platforms:  []*indexPlatformRelease{},
+		Tools:      []*indexToolRelease{},
+		Help:       indexHelp{Online: p.Help.Online},
+	}
+}
+
+func newIndexPlatformRelease(pr *cores.PlatformRelease, URL string) *indexPlatformRelease {
 	boards := []indexBoard{}
 	for _, manifest := range pr.BoardsManifest {
 		board := indexBoard{
@@ -152,55 +209,46 @@ func IndexFromPlatformRelease(pr *cores.PlatformRelease) Index {
 		})
 	}
 
-	packageTools := []*indexToolRelease{}
-	for name, tool := range pr.Platform.Package.Tools {
-		for _, toolRelease := range tool.Releases {
-			flavours := []indexToolReleaseFlavour{}
-			for _, flavour := range toolRelease.Flavors {
-				flavours = append(flavours, indexToolReleaseFlavour{
-					OS:              flavour.OS,
-					URL:             flavour.Resource.URL,
-					ArchiveFileName: flavour.Resource.ArchiveFileName,
-					Size:            json.Number(fmt.Sprintf("%d", flavour.Resource.Size)),
-					Checksum:        flavour.Resource.Checksum,
-				})
+	return &indexPlatformRelease{
+		Name:                  pr.Platform.Name,
+		Architecture:          pr.Platform.Architecture,
+		Version:               pr.Version,
+		Deprecated:            pr.Platform.Deprecated,
+		Category:              pr.Platform.Category,
+		URL:                   URL,
+		ArchiveFileName:       pr.Resource.ArchiveFileName,
+		Checksum:              pr.Resource.Checksum,
+		Size:                  json.Number(fmt.Sprintf("%d", pr.Resource.Size)),
+		Boards:                boards,
+		Help:                  indexHelp{Online: pr.Help.Online},
+		ToolDependencies:      tools,
+		DiscoveryDependencies: discoveries,
+	}
+}
+
+// newIndexToolRelease converts the toolRelease of the tool name, if resourceURL
+// is not nil it's used to get the URLs of the archives
+func newIndexToolRelease(name string, toolRelease *cores.ToolRelease, resourceURL func(*resources.DownloadResource) string) *indexToolRelease {
+	systems := []indexToolReleaseFlavour{}
+	for _, flavour := range toolRelease.Flavors {
+		URL := flavour.Resource.URL
+		if resourceURL != nil {
+			if URL = resourceURL(flavour.Resource); URL == "" {
+				continue
 			}
-			packageTools = append(packageTools, &indexToolRelease{
-				Name:    name,
-				Version: toolRelease.Version,
-				Systems: flavours,
-			})
 		}
+		systems = append(systems, indexToolReleaseFlavour{
+			OS:              flavour.OS,
+			URL:             URL,
+			ArchiveFileName: flavour.Resource.ArchiveFileName,
+			Size:            json.Number(fmt.Sprintf("%d", flavour.Resource.Size)),
+			Checksum:        flavour.Resource.Checksum,
+		})
 	}
-
-	return Index{
-		IsTrusted: pr.IsTrusted,
-		Packages: []*indexPackage{
-			{
-				Name:       pr.Platform.Package.Name,
-				Maintainer: pr.Platform.Package.Maintainer,
-				WebsiteURL: pr.Platform.Package.WebsiteURL,
-				URL:        pr.Platform.Package.URL,
-				Email:      pr.Platform.Package.Email,
-				Platforms: []*indexPlatformRelease{{
-					Name:                  pr.Platform.Name,
-					Architecture:          pr.Platform.Architecture,
-					Version:               pr.Version,
-					Deprecated:            pr.Platform.Deprecated,
-					Category:              pr.Platform.Category,
-					URL:                   pr.Resource.URL,
-					ArchiveFileName:       pr.Resource.ArchiveFileName,
-					Checksum:              pr.Resource.Checksum,
-					Size:                  json.Number(fmt.Sprintf("%d", pr.Resource.Size)),
-					Boards:                boards,
-					Help:                  indexHelp{Online: pr.Help.Online},
-					ToolDependencies:      tools,
-					DiscoveryDependencies: discoveries,
-				}},
-				Tools: packageTools,
-				Help:  indexHelp{Online: pr.Platform.Package.Help.Online},
-			},
-		},
+	return &indexToolRelease{
+		Name:    name,
+		Version: toolRelease.Version,
+		Systems: systems,
 	}
 }
 
@@ -319,6 +367,13 @@ func (inToolRelease indexToolRelease) extractFlavours() []*cores.Flavor {
 
 // LoadIndex reads a package_index.json from a file and returns the corresponding Index structure.
 func LoadIndex(jsonIndexFile *paths.Path) (*Index, error) {
+	return LoadIndexWithKeys(jsonIndexFile, nil)
+}
+
+// LoadIndexWithKeys reads a package_index.json from a file and returns the corresponding Index
+// structure. The index is trusted if it's signed with the Arduino key or with one of the public
+// keys contained in the trustedKeys files.
+func LoadIndexWithKeys(jsonIndexFile *paths.Path, trustedKeys paths.PathList) (*Index, error) {
 	buff, err := jsonIndexFile.ReadFile()
 	if err != nil {
 		return nil, err
@@ -330,7 +385,7 @@ func LoadIndex(jsonIndexFile *paths.Path) (*Index, error) {
 	}
 
 	jsonSignatureFile := jsonIndexFile.Parent().Join(jsonIndexFile.Base() + ".sig")
-	trusted, _, err := security.VerifyArduinoDetachedSignature(jsonIndexFile, jsonSignatureFile)
+	trusted, _, err := security.VerifyDetachedSignatureWithKeys(jsonIndexFile, jsonSignatureFile, trustedKeys)
 	if err != nil {
 		logrus.
 			WithField("index", jsonIndexFile).
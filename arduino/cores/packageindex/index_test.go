@@ -16,6 +16,7 @@
 package packageindex
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/arduino/arduino-cli/arduino/cores"
@@ -555,3 +556,53 @@ func TestIndexFromPlatformRelease(t *testing.T) {
 		}
 	}
 }
+
+func TestIndexFromReleases(t *testing.T) {
+	index, err := LoadIndex(paths.New("testdata", "package_rfduino_index.json"))
+	require.NoError(t, err)
+	packages := cores.NewPackages()
+	index.MergeIntoPackages(packages)
+
+	platformRelease := packages["RFduino"].Platforms["RFduino"].Releases["2.3.1"]
+	require.NotNil(t, platformRelease)
+	toolRelease := packages["RFduino"].Tools["RFDLoader"].Releases["1.5"]
+	require.NotNil(t, toolRelease)
+	linuxFlavour := toolRelease.GetFlavourCompatibleWith("linux", "amd64")
+	require.NotNil(t, linuxFlavour)
+
+	// Only the linux flavour of the tool is kept, with the archives moved
+	resourceURL := func(r *resources.DownloadResource) string {
+		if r != platformRelease.Resource && r != linuxFlavour {
+			return ""
+		}
+		return "packages/" + r.ArchiveFileName
+	}
+	data, err := json.Marshal(IndexFromReleases([]*cores.PlatformRelease{platformRelease}, []*cores.ToolRelease{toolRelease}, resourceURL))
+	require.NoError(t, err)
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	require.NoError(t, tmp.Join("package_index.json").WriteFile(data))
+
+	index, err = LoadIndex(tmp.Join("package_index.json"))
+	require.NoError(t, err)
+	packages = cores.NewPackages()
+	index.MergeIntoPackages(packages)
+	require.Len(t, packages, 1)
+
+	platform := packages["RFduino"].Platforms["RFduino"]
+	require.Len(t, platform.Releases, 1)
+	release := platform.Releases["2.3.1"]
+	require.Equal(t, "packages/"+platformRelease.Resource.ArchiveFileName, release.Resource.URL)
+	require.Equal(t, platformRelease.Resource.Checksum, release.Resource.Checksum)
+	require.Equal(t, platformRelease.Resource.Size, release.Resource.Size)
+	require.Equal(t, platformRelease.ToolDependencies, release.ToolDependencies)
+
+	tools := packages["RFduino"].Tools
+	require.Len(t, tools, 1)
+	require.Len(t, tools["RFDLoader"].Releases, 1)
+	flavours := tools["RFDLoader"].Releases["1.5"].Flavors
+	require.Len(t, flavours, 1)
+	require.Equal(t, "packages/"+linuxFlavour.ArchiveFileName, flavours[0].Resource.URL)
+	require.Equal(t, linuxFlavour.Checksum, flavours[0].Resource.Checksum)
+}
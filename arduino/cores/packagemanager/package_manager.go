@@ -18,7 +18,6 @@ package packagemanager
 import (
 	"fmt"
 	"net/url"
-	"path"
 	"strings"
 
 	"github.com/arduino/arduino-cli/arduino/cores"
@@ -222,13 +221,18 @@ func (pm *PackageManager) ResolveFQBN(fqbn *cores.FQBN) (
 	return targetPackage, platformRelease, board, buildProperties, buildPlatformRelease, nil
 }
 
-// LoadPackageIndex loads a package index by looking up the local cached file from the specified URL
-func (pm *PackageManager) LoadPackageIndex(URL *url.URL) error {
-	indexPath := pm.IndexDir.Join(path.Base(URL.Path))
-	index, err := packageindex.LoadIndex(indexPath)
+// LoadPackageIndex loads a package index by looking up the local cached file from the specified URL.
+// The signature of the index is verified with the Arduino key and the given trusted keys, if
+// requireSignature is true the index is loaded only if the signature is valid.
+func (pm *PackageManager) LoadPackageIndex(URL *url.URL, trustedKeys paths.PathList, requireSignature bool) error {
+	indexPath := packageindex.CachedIndexPath(pm.IndexDir, URL)
+	index, err := packageindex.LoadIndexWithKeys(indexPath, trustedKeys)
 	if err != nil {
 		return fmt.Errorf(tr("loading json index file %[1]s: %[2]s"), indexPath, err)
 	}
+	if requireSignature && !index.IsTrusted {
+		return fmt.Errorf(tr("the signature of the json index file %s is missing or not trusted"), indexPath)
+	}
 
 	for _, p := range index.Packages {
 		p.URL = URL.String()
@@ -240,10 +244,20 @@ func (pm *PackageManager) LoadPackageIndex(URL *url.URL) error {
 
 // LoadPackageIndexFromFile load a package index from the specified file
 func (pm *PackageManager) LoadPackageIndexFromFile(indexPath *paths.Path) (*packageindex.Index, error) {
-	index, err := packageindex.LoadIndex(indexPath)
+	return pm.LoadPackageIndexFromFileWithKeys(indexPath, nil, false)
+}
+
+// LoadPackageIndexFromFileWithKeys load a package index from the specified file.
+// The signature of the index is verified with the Arduino key and the given trusted keys, if
+// requireSignature is true the index is loaded only if the signature is valid.
+func (pm *PackageManager) LoadPackageIndexFromFileWithKeys(indexPath *paths.Path, trustedKeys paths.PathList, requireSignature bool) (*packageindex.Index, error) {
+	index, err := packageindex.LoadIndexWithKeys(indexPath, trustedKeys)
 	if err != nil {
 		return nil, fmt.Errorf(tr("loading json index file %[1]s: %[2]s"), indexPath, err)
 	}
+	if requireSignature && !index.IsTrusted {
+		return nil, fmt.Errorf(tr("the signature of the json index file %s is missing or not trusted"), indexPath)
+	}
 
 	index.MergeIntoPackages(pm.Packages)
 	return index, nil
@@ -223,7 +223,9 @@ func TestFindToolsRequiredForBoard(t *testing.T) {
 	loadIndex := func(addr string) {
 		res, err := url.Parse(addr)
 		require.NoError(t, err)
-		require.NoError(t, pm.LoadPackageIndex(res))
+		// The indexes are not signed
+		require.Error(t, pm.LoadPackageIndex(res, nil, true))
+		require.NoError(t, pm.LoadPackageIndex(res, nil, false))
 	}
 	loadIndex("https://dl.espressif.com/dl/package_esp32_index.json")
 	loadIndex("http://arduino.esp8266.com/stable/package_esp8266com_index.json")
@@ -0,0 +1,187 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package gdbmi
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ErrClosed is returned by Client.Send when GDB terminates before answering
+var ErrClosed = errors.New("GDB session closed")
+
+// CommandError is an error reported by GDB in response to a command
+type CommandError struct {
+	Command string
+	Message string
+}
+
+func (e *CommandError) Error() string {
+	return e.Message
+}
+
+// Client sends commands to a GDB instance running with the MI interpreter and
+// dispatches the records it outputs
+type Client struct {
+	in        io.Writer
+	mux       sync.Mutex
+	nextToken int
+	pending   map[string]chan *Record
+	closed    chan struct{}
+	done      chan struct{}
+
+	eventsMux  sync.Mutex
+	eventsCond *sync.Cond
+	events     []func()
+	eventsDone bool
+}
+
+// NewClient returns a Client that writes the commands to in and reads the GDB
+// output from out. The asynchronous and stream records are passed to onRecord,
+// the lines that are not GDB/MI records, usually the output of the program
+// being debugged, are passed to onOutput. The callbacks are called in order
+// from a dedicated goroutine, so they may send commands to GDB.
+func NewClient(in io.Writer, out io.Reader, onRecord func(*Record), onOutput func(string)) *Client {
+	c := &Client{
+		in:      in,
+		pending: map[string]chan *Record{},
+		closed:  make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	c.eventsCond = sync.NewCond(&c.eventsMux)
+	go c.dispatchEvents()
+	go c.readOutput(out, onRecord, onOutput)
+	return c
+}
+
+// Done returns a channel that is closed when the GDB output ends and all the
+// records have been passed to the callbacks
+func (c *Client) Done() <-chan struct{} {
+	return c.done
+}
+
+// Send sends a command with the given arguments to GDB and waits for its
+// result. The arguments are quoted as needed. If GDB reports an error a
+// *CommandError is returned.
+func (c *Client) Send(command string, args ...string) (*Record, error) {
+	line := command
+	for _, arg := range args {
+		line += " " + Quote(arg)
+	}
+
+	c.mux.Lock()
+	select {
+	case <-c.closed:
+		c.mux.Unlock()
+		return nil, ErrClosed
+	default:
+	}
+	c.nextToken++
+	token := strconv.Itoa(c.nextToken)
+	result := make(chan *Record, 1)
+	c.pending[token] = result
+	_, err := fmt.Fprintf(c.in, "%s%s\n", token, line)
+	if err != nil {
+		delete(c.pending, token)
+	}
+	c.mux.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case rec := <-result:
+		if rec.Class == "error" {
+			return rec, &CommandError{Command: line, Message: rec.Results.String("msg")}
+		}
+		return rec, nil
+	case <-c.closed:
+		return nil, ErrClosed
+	}
+}
+
+func (c *Client) readOutput(out io.Reader, onRecord func(*Record), onOutput func(string)) {
+	scanner := bufio.NewScanner(out)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		rec, err := ParseRecord(line)
+		if err != nil {
+			if onOutput != nil {
+				output := strings.TrimRight(line, "\r") + "\n"
+				c.queueEvent(func() { onOutput(output) })
+			}
+			continue
+		}
+		if rec == nil {
+			// (gdb) prompt
+			continue
+		}
+		if rec.Type == ResultRecord {
+			c.mux.Lock()
+			result, ok := c.pending[rec.Token]
+			delete(c.pending, rec.Token)
+			c.mux.Unlock()
+			if ok {
+				result <- rec
+			}
+			continue
+		}
+		if onRecord != nil {
+			c.queueEvent(func() { onRecord(rec) })
+		}
+	}
+
+	c.mux.Lock()
+	close(c.closed)
+	c.mux.Unlock()
+	c.eventsMux.Lock()
+	c.eventsDone = true
+	c.eventsCond.Signal()
+	c.eventsMux.Unlock()
+}
+
+// queueEvent enqueues a callback without blocking, so that the output of GDB
+// is always consumed even if the callbacks are waiting for a command result
+func (c *Client) queueEvent(event func()) {
+	c.eventsMux.Lock()
+	c.events = append(c.events, event)
+	c.eventsCond.Signal()
+	c.eventsMux.Unlock()
+}
+
+func (c *Client) dispatchEvents() {
+	for {
+		c.eventsMux.Lock()
+		for len(c.events) == 0 && !c.eventsDone {
+			c.eventsCond.Wait()
+		}
+		if len(c.events) == 0 {
+			c.eventsMux.Unlock()
+			close(c.done)
+			return
+		}
+		event := c.events[0]
+		c.events = c.events[1:]
+		c.eventsMux.Unlock()
+		event()
+	}
+}
@@ -0,0 +1,158 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package gdbmi
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRecord(t *testing.T) {
+	rec, err := ParseRecord(`12^done,stack=[frame={level="0",addr="0x00001139",func="loop",file="Sketch.ino",line="7"},frame={level="1",func="main",args=[]}]`)
+	require.NoError(t, err)
+	require.Equal(t, "12", rec.Token)
+	require.Equal(t, ResultRecord, rec.Type)
+	require.Equal(t, "done", rec.Class)
+	frames := rec.Results.List("stack").Tuples()
+	require.Len(t, frames, 2)
+	require.Equal(t, "loop", frames[0].String("func"))
+	require.Equal(t, 7, frames[0].Int("line"))
+	require.Equal(t, 1, frames[1].Int("level"))
+	require.Equal(t, List{}, frames[1].List("args"))
+
+	rec, err = ParseRecord(`*stopped,reason="breakpoint-hit",disp="keep",bkptno="1",frame={func="setup",args=[{name="a",value="1"}]},thread-id="1",stopped-threads="all"`)
+	require.NoError(t, err)
+	require.Empty(t, rec.Token)
+	require.Equal(t, ExecAsyncRecord, rec.Type)
+	require.Equal(t, "stopped", rec.Class)
+	require.Equal(t, "breakpoint-hit", rec.Results.String("reason"))
+	require.Equal(t, "setup", rec.Results.Tuple("frame").String("func"))
+	require.Equal(t, "a", rec.Results.Tuple("frame").List("args").Tuples()[0].String("name"))
+
+	rec, err = ParseRecord(`=thread-group-added,id="i1"`)
+	require.NoError(t, err)
+	require.Equal(t, NotifyAsyncRecord, rec.Type)
+	require.Equal(t, "i1", rec.Results.String("id"))
+
+	rec, err = ParseRecord(`^running`)
+	require.NoError(t, err)
+	require.Equal(t, "running", rec.Class)
+	require.Empty(t, rec.Results)
+
+	rec, err = ParseRecord(`~"Breakpoint 1, \"quoted\"\tat \\path\n"`)
+	require.NoError(t, err)
+	require.True(t, rec.IsStream())
+	require.Equal(t, ConsoleStreamRecord, rec.Type)
+	require.Equal(t, "Breakpoint 1, \"quoted\"\tat \\path\n", rec.Stream)
+
+	rec, err = ParseRecord(`^done,value="0x0 <\303\250>",empty={}`)
+	require.NoError(t, err)
+	require.Equal(t, "0x0 <è>", rec.Results.String("value"))
+	require.Equal(t, List{}, rec.Results.List("empty"))
+	require.Nil(t, rec.Results.List("value"))
+
+	rec, err = ParseRecord("(gdb) \r")
+	require.NoError(t, err)
+	require.Nil(t, rec)
+
+	for _, invalid := range []string{
+		"",
+		"Hello from the program",
+		"12",
+		"^",
+		`^done,`,
+		`^done,value`,
+		`^done,value="unterminated`,
+		`^done,value={a="1"`,
+		`^done,value=[1]`,
+		`~"stream" trailing`,
+		`5~"stream"`,
+	} {
+		_, err := ParseRecord(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestQuote(t *testing.T) {
+	require.Equal(t, "main.cpp:12", Quote("main.cpp:12"))
+	require.Equal(t, "*0x20000000", Quote("*0x20000000"))
+	require.Equal(t, `""`, Quote(""))
+	require.Equal(t, `"a + b"`, Quote("a + b"))
+	require.Equal(t, `"C:\\Users\\My \"Sketch\".ino"`, Quote(`C:\Users\My "Sketch".ino`))
+	require.Equal(t, `"line\n"`, Quote("line\n"))
+}
+
+func TestClient(t *testing.T) {
+	cmdReader, cmdWriter := io.Pipe()
+	outReader, outWriter := io.Pipe()
+
+	records := make(chan *Record, 10)
+	output := make(chan string, 10)
+	client := NewClient(cmdWriter, outReader,
+		func(r *Record) { records <- r },
+		func(s string) { output <- s })
+
+	// Fake GDB
+	go func() {
+		commands := bufio.NewScanner(cmdReader)
+		for commands.Scan() {
+			cmd := commands.Text()
+			token := cmd[:strings.IndexAny(cmd, "-")]
+			switch cmd[len(token):] {
+			case `-exec-run`:
+				io.WriteString(outWriter, "=thread-group-started,id=\"i1\",pid=\"42\"\n")
+				io.WriteString(outWriter, token+"^running\n(gdb)\n")
+				io.WriteString(outWriter, "Hello from the program\n")
+				io.WriteString(outWriter, "*stopped,reason=\"exited-normally\"\n")
+			case `-data-evaluate-expression "a + b"`:
+				io.WriteString(outWriter, "~\"evaluating\\n\"\n")
+				io.WriteString(outWriter, token+"^done,value=\"3\"\n(gdb)\n")
+			default:
+				io.WriteString(outWriter, token+"^error,msg=\"Undefined command\"\n(gdb)\n")
+			}
+		}
+	}()
+
+	rec, err := client.Send("-data-evaluate-expression", "a + b")
+	require.NoError(t, err)
+	require.Equal(t, "3", rec.Results.String("value"))
+	require.Equal(t, "evaluating\n", (<-records).Stream)
+
+	_, err = client.Send("-unknown")
+	require.EqualError(t, err, "Undefined command")
+	require.IsType(t, &CommandError{}, err)
+
+	rec, err = client.Send("-exec-run")
+	require.NoError(t, err)
+	require.Equal(t, "running", rec.Class)
+	require.Equal(t, "thread-group-started", (<-records).Class)
+	require.Equal(t, "Hello from the program\n", <-output)
+	require.Equal(t, "exited-normally", (<-records).Results.String("reason"))
+
+	outWriter.Close()
+	select {
+	case <-client.Done():
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "client not closed")
+	}
+	_, err = client.Send("-gdb-exit")
+	require.Equal(t, ErrClosed, err)
+}
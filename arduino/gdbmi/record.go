@@ -0,0 +1,349 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package gdbmi
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/arduino/arduino-cli/i18n"
+)
+
+var tr = i18n.Tr
+
+// RecordType is the type of a GDB/MI output record, identified by its
+// prefix character
+type RecordType byte
+
+const (
+	// ResultRecord is the result of a command (^done, ^running, ^error...)
+	ResultRecord RecordType = '^'
+	// ExecAsyncRecord is a change of the execution state of the target (*stopped, *running)
+	ExecAsyncRecord RecordType = '*'
+	// StatusAsyncRecord is a progress information of a slow operation
+	StatusAsyncRecord RecordType = '+'
+	// NotifyAsyncRecord is a notification of GDB (=breakpoint-modified, =thread-created...)
+	NotifyAsyncRecord RecordType = '='
+	// ConsoleStreamRecord is the output of a CLI command
+	ConsoleStreamRecord RecordType = '~'
+	// TargetStreamRecord is the output of the program being debugged
+	TargetStreamRecord RecordType = '@'
+	// LogStreamRecord is an internal message of GDB
+	LogStreamRecord RecordType = '&'
+)
+
+// Record is a line of GDB/MI output. The result and the async records have a
+// Class and a set of Results, the stream records have only the Stream text.
+type Record struct {
+	Token   string
+	Type    RecordType
+	Class   string
+	Results Tuple
+	Stream  string
+}
+
+// IsStream returns true if the record is a stream record
+func (r *Record) IsStream() bool {
+	return r.Type == ConsoleStreamRecord || r.Type == TargetStreamRecord || r.Type == LogStreamRecord
+}
+
+// Tuple is a set of named values. A value can be a string, a Tuple or a List.
+type Tuple map[string]interface{}
+
+// List is a list of values. A value can be a string, a Tuple or a List. The
+// names of the elements of the lists of results, like the "frame" in
+// `stack=[frame={...},frame={...}]`, are discarded.
+type List []interface{}
+
+// String returns the string value with the given name, or an empty string
+func (t Tuple) String(name string) string {
+	s, _ := t[name].(string)
+	return s
+}
+
+// Int returns the integer value with the given name, or 0
+func (t Tuple) Int(name string) int {
+	n, _ := strconv.Atoi(t.String(name))
+	return n
+}
+
+// Tuple returns the Tuple value with the given name, or nil
+func (t Tuple) Tuple(name string) Tuple {
+	res, _ := t[name].(Tuple)
+	return res
+}
+
+// List returns the List value with the given name, or nil. An empty Tuple is
+// returned as an empty List since GDB uses `{}` and `[]` interchangeably for
+// empty values.
+func (t Tuple) List(name string) List {
+	switch v := t[name].(type) {
+	case List:
+		return v
+	case Tuple:
+		if len(v) == 0 {
+			return List{}
+		}
+	}
+	return nil
+}
+
+// Tuples returns the elements of the List that are Tuples
+func (l List) Tuples() []Tuple {
+	res := []Tuple{}
+	for _, v := range l {
+		if t, ok := v.(Tuple); ok {
+			res = append(res, t)
+		}
+	}
+	return res
+}
+
+// ParseRecord parses a line of GDB/MI output. It returns nil, without error,
+// for the `(gdb)` prompt that ends a group of records.
+func ParseRecord(line string) (*Record, error) {
+	line = strings.TrimRight(line, "\r\n")
+	if strings.TrimSpace(line) == "(gdb)" {
+		return nil, nil
+	}
+	p := &parser{s: line}
+
+	start := p.pos
+	for p.pos < len(p.s) && p.s[p.pos] >= '0' && p.s[p.pos] <= '9' {
+		p.pos++
+	}
+	token := p.s[start:p.pos]
+	if p.pos >= len(p.s) {
+		return nil, p.errorf(tr("missing record type"))
+	}
+
+	rec := &Record{Token: token, Type: RecordType(p.s[p.pos])}
+	p.pos++
+	switch rec.Type {
+	case ConsoleStreamRecord, TargetStreamRecord, LogStreamRecord:
+		if token != "" {
+			return nil, p.errorf(tr("unexpected token in stream record"))
+		}
+		s, err := p.parseCString()
+		if err != nil {
+			return nil, err
+		}
+		rec.Stream = s
+	case ResultRecord, ExecAsyncRecord, StatusAsyncRecord, NotifyAsyncRecord:
+		rec.Class = p.parseName()
+		if rec.Class == "" {
+			return nil, p.errorf(tr("missing record class"))
+		}
+		rec.Results = Tuple{}
+		for p.pos < len(p.s) {
+			if err := p.expect(','); err != nil {
+				return nil, err
+			}
+			name, value, err := p.parseResult()
+			if err != nil {
+				return nil, err
+			}
+			rec.Results[name] = value
+		}
+	default:
+		return nil, p.errorf(tr("invalid record type '%c'", rec.Type))
+	}
+	if p.pos != len(p.s) {
+		return nil, p.errorf(tr("unexpected characters at the end of the record"))
+	}
+	return rec, nil
+}
+
+type parser struct {
+	s   string
+	pos int
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("%s: %s", tr("invalid GDB/MI record at column %d", p.pos+1), fmt.Sprintf(format, args...))
+}
+
+func (p *parser) expect(c byte) error {
+	if p.pos >= len(p.s) || p.s[p.pos] != c {
+		return p.errorf(tr("expected '%c'", c))
+	}
+	p.pos++
+	return nil
+}
+
+func (p *parser) peek() byte {
+	if p.pos >= len(p.s) {
+		return 0
+	}
+	return p.s[p.pos]
+}
+
+func (p *parser) parseName() string {
+	start := p.pos
+	for p.pos < len(p.s) {
+		c := p.s[p.pos]
+		if c == '=' || c == ',' || c == '{' || c == '}' || c == '[' || c == ']' || c == '"' {
+			break
+		}
+		p.pos++
+	}
+	return p.s[start:p.pos]
+}
+
+func (p *parser) parseResult() (string, interface{}, error) {
+	name := p.parseName()
+	if name == "" {
+		return "", nil, p.errorf(tr("missing result name"))
+	}
+	if err := p.expect('='); err != nil {
+		return "", nil, err
+	}
+	value, err := p.parseValue()
+	return name, value, err
+}
+
+func (p *parser) parseValue() (interface{}, error) {
+	switch p.peek() {
+	case '"':
+		return p.parseCString()
+	case '{':
+		p.pos++
+		res := Tuple{}
+		if p.peek() == '}' {
+			p.pos++
+			return res, nil
+		}
+		for {
+			name, value, err := p.parseResult()
+			if err != nil {
+				return nil, err
+			}
+			res[name] = value
+			if p.peek() != ',' {
+				break
+			}
+			p.pos++
+		}
+		return res, p.expect('}')
+	case '[':
+		p.pos++
+		res := List{}
+		if p.peek() == ']' {
+			p.pos++
+			return res, nil
+		}
+		for {
+			if c := p.peek(); c != '"' && c != '{' && c != '[' {
+				// List of results: only the values are kept
+				p.parseName()
+				if err := p.expect('='); err != nil {
+					return nil, err
+				}
+			}
+			value, err := p.parseValue()
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, value)
+			if p.peek() != ',' {
+				break
+			}
+			p.pos++
+		}
+		return res, p.expect(']')
+	default:
+		return nil, p.errorf(tr("expected a value"))
+	}
+}
+
+func (p *parser) parseCString() (string, error) {
+	if err := p.expect('"'); err != nil {
+		return "", err
+	}
+	var res strings.Builder
+	for p.pos < len(p.s) {
+		c := p.s[p.pos]
+		p.pos++
+		switch c {
+		case '"':
+			return res.String(), nil
+		case '\\':
+			if p.pos >= len(p.s) {
+				return "", p.errorf(tr("unterminated string"))
+			}
+			c = p.s[p.pos]
+			p.pos++
+			switch c {
+			case 'n':
+				res.WriteByte('\n')
+			case 't':
+				res.WriteByte('\t')
+			case 'r':
+				res.WriteByte('\r')
+			case 'e':
+				res.WriteByte(0x1b)
+			case '0', '1', '2', '3', '4', '5', '6', '7':
+				// Octal escape of up to 3 digits
+				n := int(c - '0')
+				for i := 0; i < 2 && p.pos < len(p.s) && p.s[p.pos] >= '0' && p.s[p.pos] <= '7'; i++ {
+					n = n*8 + int(p.s[p.pos]-'0')
+					p.pos++
+				}
+				res.WriteByte(byte(n))
+			default:
+				res.WriteByte(c)
+			}
+		default:
+			res.WriteByte(c)
+		}
+	}
+	return "", p.errorf(tr("unterminated string"))
+}
+
+// Quote returns the argument of a command as a C string, if it contains
+// characters that would be misinterpreted by GDB
+func Quote(arg string) string {
+	safe := arg != ""
+	for _, c := range arg {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || strings.ContainsRune("_-+.:/*&@$", c)) {
+			safe = false
+			break
+		}
+	}
+	if safe {
+		return arg
+	}
+	var res strings.Builder
+	res.WriteByte('"')
+	for _, c := range arg {
+		switch c {
+		case '"', '\\':
+			res.WriteByte('\\')
+			res.WriteRune(c)
+		case '\n':
+			res.WriteString(`\n`)
+		case '\t':
+			res.WriteString(`\t`)
+		case '\r':
+			res.WriteString(`\r`)
+		default:
+			res.WriteRune(c)
+		}
+	}
+	res.WriteByte('"')
+	return res.String()
+}
@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/resources"
 	"github.com/arduino/go-paths-helper"
 	"github.com/stretchr/testify/require"
 	semver "go.bug.st/relaxed-semver"
@@ -113,3 +114,42 @@ func TestIndexer(t *testing.T) {
 	require.Contains(t, resolve2, bear130)
 	require.Contains(t, resolve2, http040)
 }
+
+func TestWriteIndex(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	require.NoError(t, tmp.Join("library_index.json").WriteFile([]byte(`{"libraries": [
+		{"name": "Arduino Low Power", "version": "1.2.1", "author": "Arduino", "architectures": ["samd"],
+		 "url": "https://downloads.arduino.cc/libraries/ArduinoLowPower-1.2.1.zip",
+		 "archiveFileName": "ArduinoLowPower-1.2.1.zip", "size": 12345, "checksum": "SHA-256:1234",
+		 "dependencies": [{"name": "RTCZero"}, {"name": "FlashStorage", "version": ">=1.0.0"}]},
+		{"name": "RTCZero", "version": "1.6.0", "url": "https://downloads.arduino.cc/libraries/RTCZero-1.6.0.zip",
+		 "archiveFileName": "RTCZero-1.6.0.zip", "size": 123, "checksum": "SHA-256:5678"}
+	]}`)))
+	index, err := LoadIndex(tmp.Join("library_index.json"))
+	require.NoError(t, err)
+
+	alp := index.Libraries["Arduino Low Power"].Latest
+	resourceURL := func(r *resources.DownloadResource) string {
+		return "libraries/" + r.ArchiveFileName
+	}
+	require.NoError(t, WriteIndex(tmp.Join("library_index_written.json"), []*Release{alp}, resourceURL))
+
+	written, err := LoadIndex(tmp.Join("library_index_written.json"))
+	require.NoError(t, err)
+	require.Len(t, written.Libraries, 1)
+	release := written.Libraries["Arduino Low Power"].Latest
+	require.Equal(t, "Arduino Low Power@1.2.1", release.String())
+	require.Equal(t, alp.Author, release.Author)
+	require.Equal(t, alp.Architectures, release.Architectures)
+	require.Equal(t, "libraries/ArduinoLowPower-1.2.1.zip", release.Resource.URL)
+	require.Equal(t, alp.Resource.ArchiveFileName, release.Resource.ArchiveFileName)
+	require.Equal(t, alp.Resource.Size, release.Resource.Size)
+	require.Equal(t, alp.Resource.Checksum, release.Resource.Checksum)
+	require.Len(t, release.Dependencies, 2)
+	require.Equal(t, "RTCZero", release.Dependencies[0].GetName())
+	require.Equal(t, "", release.Dependencies[0].GetConstraint().String())
+	require.Equal(t, "FlashStorage", release.Dependencies[1].GetName())
+	require.Equal(t, ">=1.0.0", release.Dependencies[1].GetConstraint().String())
+}
@@ -82,6 +82,50 @@ func (i indexJSON) extractIndex() (*Index, error) {
 	return index, nil
 }
 
+// WriteIndex writes in indexFile a library_index.json containing the given
+// releases. The URLs of the archives are the ones returned by resourceURL.
+func WriteIndex(indexFile *paths.Path, releases []*Release, resourceURL func(*resources.DownloadResource) string) error {
+	i := indexJSON{Libraries: []indexRelease{}}
+	for _, release := range releases {
+		dependencies := []*indexDependency{}
+		for _, dep := range release.Dependencies {
+			indexDep := &indexDependency{Name: dep.GetName()}
+			if constraint := dep.GetConstraint(); constraint != nil {
+				indexDep.Version = constraint.String()
+			}
+			dependencies = append(dependencies, indexDep)
+		}
+		i.Libraries = append(i.Libraries, indexRelease{
+			Name:             release.Library.Name,
+			Version:          release.Version,
+			Author:           release.Author,
+			Maintainer:       release.Maintainer,
+			Sentence:         release.Sentence,
+			Paragraph:        release.Paragraph,
+			Website:          release.Website,
+			Category:         release.Category,
+			Architectures:    release.Architectures,
+			Types:            release.Types,
+			URL:              resourceURL(release.Resource),
+			ArchiveFileName:  release.Resource.ArchiveFileName,
+			Size:             release.Resource.Size,
+			Checksum:         release.Resource.Checksum,
+			Dependencies:     dependencies,
+			License:          release.License,
+			ProvidesIncludes: release.ProvidesIncludes,
+		})
+	}
+
+	data, err := json.MarshalIndent(i, "", "  ")
+	if err != nil {
+		return fmt.Errorf(tr("encoding library_index.json: %s"), err)
+	}
+	if err := indexFile.WriteFile(data); err != nil {
+		return fmt.Errorf(tr("writing library_index.json: %s"), err)
+	}
+	return nil
+}
+
 func (indexLib *indexRelease) extractLibraryIn(index *Index) {
 	library, exist := index.Libraries[indexLib.Name]
 	if !exist {
@@ -38,10 +38,11 @@ type LibrariesManager struct {
 	LibrariesDir []*LibrariesDir
 	Libraries    map[string]*LibraryAlternatives `json:"libraries"`
 
-	Index              *librariesindex.Index
-	IndexFile          *paths.Path
-	IndexFileSignature *paths.Path
-	DownloadsDir       *paths.Path
+	Index               *librariesindex.Index
+	IndexFile           *paths.Path
+	IndexFileSignature  *paths.Path
+	IndexFileValidators *paths.Path
+	DownloadsDir        *paths.Path
 }
 
 // LibrariesDir is a directory containing libraries
@@ -101,17 +102,19 @@ func (lm LibrariesManager) Names() []string {
 
 // NewLibraryManager creates a new library manager
 func NewLibraryManager(indexDir *paths.Path, downloadsDir *paths.Path) *LibrariesManager {
-	var indexFile, indexFileSignature *paths.Path
+	var indexFile, indexFileSignature, indexFileValidators *paths.Path
 	if indexDir != nil {
 		indexFile = indexDir.Join("library_index.json")
 		indexFileSignature = indexDir.Join("library_index.json.sig")
+		indexFileValidators = indexDir.Join("library_index_validators.json")
 	}
 	return &LibrariesManager{
-		Libraries:          map[string]*LibraryAlternatives{},
-		IndexFile:          indexFile,
-		IndexFileSignature: indexFileSignature,
-		DownloadsDir:       downloadsDir,
-		Index:              librariesindex.EmptyIndex,
+		Libraries:           map[string]*LibraryAlternatives{},
+		IndexFile:           indexFile,
+		IndexFileSignature:  indexFileSignature,
+		IndexFileValidators: indexFileValidators,
+		DownloadsDir:        downloadsDir,
+		Index:               librariesindex.EmptyIndex,
 	}
 }
 
@@ -30,7 +30,8 @@ import (
 
 // Cpp finds libraries made for the C++ language
 type Cpp struct {
-	headers map[string]libraries.List
+	headers   map[string]libraries.List
+	overrides map[string]*Override
 }
 
 var tr = i18n.Tr
@@ -38,7 +39,8 @@ var tr = i18n.Tr
 // NewCppResolver creates a new Cpp resolver
 func NewCppResolver() *Cpp {
 	return &Cpp{
-		headers: map[string]libraries.List{},
+		headers:   map[string]libraries.List{},
+		overrides: map[string]*Override{},
 	}
 }
 
@@ -75,6 +77,11 @@ func (resolver *Cpp) AlternativesFor(header string) libraries.List {
 // ResolveFor finds the most suitable library for the specified combination of
 // header and architecture. If no libraries provides the requested header, nil is returned
 func (resolver *Cpp) ResolveFor(header, architecture string) *libraries.Library {
+	lib, _ := resolver.resolve(header, architecture)
+	return lib
+}
+
+func (resolver *Cpp) resolve(header, architecture string) (*libraries.Library, ResolutionReason) {
 	logrus.Infof("Resolving include %s for arch %s", header, architecture)
 	var found libraries.List
 	var foundPriority int
@@ -96,22 +103,25 @@ func (resolver *Cpp) ResolveFor(header, architecture string) *libraries.Library
 			Infof(msg)
 	}
 	if found == nil {
-		return nil
+		return nil, ""
 	}
 	if len(found) == 1 {
-		return found[0]
+		if len(resolver.headers[header]) == 1 {
+			return found[0], OnlyCandidate
+		}
+		return found[0], HighestPriority
 	}
 
 	// If more than one library qualifies use the "closestmatch" algorithm to
 	// find the best matching one (instead of choosing it randomly)
 	if best := findLibraryWithNameBestDistance(header, found); best != nil {
 		logrus.WithField("lib", best.Name).Info("  library with the best matching name")
-		return best
+		return best, BestNameMatch
 	}
 
 	found.SortByName()
 	logrus.WithField("lib", found[0].Name).Info("  first library in alphabetic order")
-	return found[0]
+	return found[0], AlphabeticalOrder
 }
 
 func simplify(name string) string {
@@ -121,51 +131,55 @@ func simplify(name string) string {
 }
 
 func computePriority(lib *libraries.Library, header, arch string) int {
+	return computePriorityDetails(lib, header, arch).Total()
+}
+
+func computePriorityDetails(lib *libraries.Library, header, arch string) *Priority {
 	header = strings.TrimSuffix(header, filepath.Ext(header))
 	header = simplify(header)
 	name := simplify(lib.Name)
 	realName := simplify(lib.RealName)
 
-	priority := 0
+	priority := &Priority{}
 
 	// Bonus for core-optimized libraries
 	if lib.IsOptimizedForArchitecture(arch) {
 		// give a slightly better bonus for libraries that have specific optimization
 		// (it is more important than Location but less important than Name)
-		priority += 1010
+		priority.Architecture = 1010
 	} else if lib.IsArchitectureIndependent() {
 		// standard bonus for architecture independent (vanilla) libraries
-		priority += 1000
+		priority.Architecture = 1000
 	} else {
 		// the library is not architecture compatible
-		priority += 0
+		priority.Architecture = 0
 	}
 
 	if realName == header && name == header {
-		priority += 600
+		priority.Name = 600
 	} else if realName == header || name == header {
-		priority += 500
+		priority.Name = 500
 	} else if realName == header+"-master" || name == header+"-master" {
-		priority += 400
+		priority.Name = 400
 	} else if strings.HasPrefix(realName, header) || strings.HasPrefix(name, header) {
-		priority += 300
+		priority.Name = 300
 	} else if strings.HasSuffix(realName, header) || strings.HasSuffix(name, header) {
-		priority += 200
+		priority.Name = 200
 	} else if strings.Contains(realName, header) || strings.Contains(name, header) {
-		priority += 100
+		priority.Name = 100
 	}
 
 	switch lib.Location {
 	case libraries.IDEBuiltIn:
-		priority += 0
+		priority.Location = 0
 	case libraries.ReferencedPlatformBuiltIn:
-		priority++
+		priority.Location = 1
 	case libraries.PlatformBuiltIn:
-		priority += 2
+		priority.Location = 2
 	case libraries.User:
-		priority += 3
+		priority.Location = 3
 	case libraries.Unmanaged:
-		priority += 4
+		priority.Location = 4
 	default:
 		panic(fmt.Sprintf("Invalid library location: %d", lib.Location))
 	}
@@ -0,0 +1,128 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package librariesresolver
+
+import (
+	"sort"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+)
+
+// Priority is the priority of a library as provider of a header, broken down
+// by the criteria used to compute it. The libraries optimized for the target
+// architecture or architecture independent get the highest bonus, then come
+// the libraries whose name matches the header and finally the location where
+// the library is installed.
+type Priority struct {
+	Architecture int
+	Name         int
+	Location     int
+}
+
+// Total returns the overall priority used to compare the libraries
+func (p *Priority) Total() int {
+	return p.Architecture + p.Name + p.Location
+}
+
+// ResolutionReason is the reason why a library has been selected among the
+// ones providing a header
+type ResolutionReason string
+
+const (
+	// Pinned means that the library has been selected by an Override
+	Pinned ResolutionReason = "pinned"
+	// OnlyCandidate means that no other library provides the header
+	OnlyCandidate ResolutionReason = "only_candidate"
+	// HighestPriority means that the library has the highest priority
+	HighestPriority ResolutionReason = "highest_priority"
+	// BestNameMatch means that more libraries have the highest priority and
+	// the one whose name is the closest to the header has been selected
+	BestNameMatch ResolutionReason = "best_name_match"
+	// AlphabeticalOrder means that more libraries have the highest priority
+	// and the first one in alphabetical order has been selected
+	AlphabeticalOrder ResolutionReason = "alphabetical_order"
+)
+
+// Candidate is a library that provides a header, with its priority
+type Candidate struct {
+	Library  *libraries.Library
+	Priority *Priority
+}
+
+// Resolution explains how the library that provides a header is selected
+type Resolution struct {
+	Header     string
+	Selected   *libraries.Library
+	Reason     ResolutionReason
+	Candidates []*Candidate
+}
+
+// Explain returns the Resolution of the specified header for the given
+// architecture: the candidates are sorted by priority, the selected one is the
+// library that would be returned by ResolveOverride or ResolveFor. If the
+// header has been pinned to a library that is not available the
+// OverrideNotSatisfiedError is returned.
+func (resolver *Cpp) Explain(header, architecture string) (*Resolution, error) {
+	res := &Resolution{Header: header, Candidates: []*Candidate{}}
+	for _, lib := range resolver.headers[header] {
+		res.Candidates = append(res.Candidates, &Candidate{
+			Library:  lib,
+			Priority: computePriorityDetails(lib, header, architecture),
+		})
+	}
+	sort.SliceStable(res.Candidates, func(i, j int) bool {
+		return res.Candidates[i].Priority.Total() > res.Candidates[j].Priority.Total()
+	})
+
+	pinned, err := resolver.ResolveOverride(header, architecture)
+	if err != nil {
+		return nil, err
+	}
+	if pinned != nil {
+		res.Selected = pinned
+		res.Reason = Pinned
+	} else {
+		res.Selected, res.Reason = resolver.resolve(header, architecture)
+	}
+	return res, nil
+}
+
+// ToRPC converts the Resolution into a *rpc.LibraryResolution
+func (r *Resolution) ToRPC() *rpc.LibraryResolution {
+	res := &rpc.LibraryResolution{
+		Header:     r.Header,
+		Reason:     string(r.Reason),
+		Candidates: []*rpc.LibraryResolutionCandidate{},
+	}
+	for _, c := range r.Candidates {
+		candidate := &rpc.LibraryResolutionCandidate{
+			Name:                 c.Library.Name,
+			Version:              c.Library.Version.String(),
+			Location:             c.Library.Location.ToRPCLibraryLocation(),
+			ArchitecturePriority: int32(c.Priority.Architecture),
+			NamePriority:         int32(c.Priority.Name),
+			LocationPriority:     int32(c.Priority.Location),
+			Priority:             int32(c.Priority.Total()),
+			Selected:             c.Library == r.Selected,
+		}
+		if c.Library.InstallDir != nil {
+			candidate.InstallDir = c.Library.InstallDir.String()
+		}
+		res.Candidates = append(res.Candidates, candidate)
+	}
+	return res
+}
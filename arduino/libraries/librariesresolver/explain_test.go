@@ -0,0 +1,89 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package librariesresolver
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExplain(t *testing.T) {
+	userServo := &libraries.Library{Name: "Servo", Location: libraries.User, Architectures: []string{"avr"}}
+	anotherServo := &libraries.Library{Name: "AnotherServo", Location: libraries.Unmanaged, Architectures: []string{"*"}}
+	resolver := NewCppResolver()
+	resolver.headers["Servo.h"] = libraries.List{bundleServo, anotherServo, userServo}
+	resolver.headers["calculus_lib.h"] = libraries.List{l1}
+	resolver.headers["XYZ.h"] = libraries.List{l5, l6, l7}
+
+	res, err := resolver.Explain("Servo.h", "avr")
+	require.NoError(t, err)
+	require.Equal(t, "Servo.h", res.Header)
+	require.Equal(t, userServo, res.Selected)
+	require.Equal(t, HighestPriority, res.Reason)
+	require.Len(t, res.Candidates, 3)
+	// Candidates are sorted by priority
+	require.Equal(t, userServo, res.Candidates[0].Library)
+	require.Equal(t, &Priority{Architecture: 1010, Name: 500, Location: 3}, res.Candidates[0].Priority)
+	require.Equal(t, bundleServo, res.Candidates[1].Library)
+	require.Equal(t, &Priority{Architecture: 1010, Name: 500, Location: 0}, res.Candidates[1].Priority)
+	require.Equal(t, anotherServo, res.Candidates[2].Library)
+	require.Equal(t, &Priority{Architecture: 1000, Name: 200, Location: 4}, res.Candidates[2].Priority)
+	require.Equal(t, 1204, res.Candidates[2].Priority.Total())
+
+	rpcResolution := res.ToRPC()
+	require.Equal(t, "highest_priority", rpcResolution.GetReason())
+	require.Len(t, rpcResolution.GetCandidates(), 3)
+	require.True(t, rpcResolution.GetCandidates()[0].GetSelected())
+	require.False(t, rpcResolution.GetCandidates()[1].GetSelected())
+	require.Equal(t, int32(1513), rpcResolution.GetCandidates()[0].GetPriority())
+	require.Equal(t, int32(200), rpcResolution.GetCandidates()[2].GetNamePriority())
+
+	res, err = resolver.Explain("calculus_lib.h", "avr")
+	require.NoError(t, err)
+	require.Equal(t, l1, res.Selected)
+	require.Equal(t, OnlyCandidate, res.Reason)
+
+	res, err = resolver.Explain("XYZ.h", "avr")
+	require.NoError(t, err)
+	require.Equal(t, l7, res.Selected)
+	require.Equal(t, AlphabeticalOrder, res.Reason)
+
+	sameNameResolver := NewCppResolver()
+	sameNameResolver.headers["calculus_lib.h"] = libraries.List{l7, l6}
+	res, err = sameNameResolver.Explain("calculus_lib.h", "avr")
+	require.NoError(t, err)
+	require.Equal(t, l6, res.Selected)
+	require.Equal(t, BestNameMatch, res.Reason)
+
+	res, err = resolver.Explain("missing.h", "avr")
+	require.NoError(t, err)
+	require.Nil(t, res.Selected)
+	require.Empty(t, res.Candidates)
+
+	// The pinned library is selected even if it has a lower priority
+	resolver.AddOverride(&Override{Header: "Servo.h", Library: "AnotherServo"})
+	res, err = resolver.Explain("Servo.h", "avr")
+	require.NoError(t, err)
+	require.Equal(t, anotherServo, res.Selected)
+	require.Equal(t, Pinned, res.Reason)
+	require.Len(t, res.Candidates, 3)
+
+	resolver.AddOverride(&Override{Header: "Servo.h", Library: "NotInstalled"})
+	_, err = resolver.Explain("Servo.h", "avr")
+	require.IsType(t, &OverrideNotSatisfiedError{}, err)
+}
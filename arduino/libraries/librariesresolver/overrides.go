@@ -0,0 +1,125 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package librariesresolver
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+	semver "go.bug.st/relaxed-semver"
+)
+
+// Override pins the library that provides a header, bypassing the selection
+// among the alternatives made by ResolveFor. If Path or Version are set the
+// library must also be installed in that folder or have that version.
+type Override struct {
+	Header  string
+	Library string
+	Path    *paths.Path
+	Version *semver.Version
+}
+
+// LibraryString returns a description of the pinned library
+func (o *Override) LibraryString() string {
+	res := o.Library
+	if o.Version != nil {
+		res += "@" + o.Version.String()
+	}
+	if o.Path != nil {
+		res += " (" + o.Path.String() + ")"
+	}
+	return res
+}
+
+func (o *Override) matches(lib *libraries.Library) bool {
+	// The name of the library can be the folder name or the one declared
+	// in library.properties
+	if lib.Name != o.Library && lib.RealName != o.Library {
+		return false
+	}
+	if o.Path != nil && (lib.InstallDir == nil || !lib.InstallDir.EquivalentTo(o.Path)) {
+		return false
+	}
+	if o.Version != nil && (lib.Version == nil || !lib.Version.Equal(o.Version)) {
+		return false
+	}
+	return true
+}
+
+// OverrideNotSatisfiedError is returned when none of the libraries providing
+// a header matches the Override for that header
+type OverrideNotSatisfiedError struct {
+	Override   *Override
+	Candidates libraries.List
+}
+
+func (e *OverrideNotSatisfiedError) Error() string {
+	candidates := []string{}
+	for _, lib := range e.Candidates {
+		candidates = append(candidates, fmt.Sprintf("%s (%s)", lib, lib.InstallDir))
+	}
+	if len(candidates) == 0 {
+		return fmt.Sprintf(tr("library %[1]s, required to provide %[2]s, not found: no installed library provides %[2]s"),
+			e.Override.LibraryString(), e.Override.Header)
+	}
+	return fmt.Sprintf(tr("library %[1]s, required to provide %[2]s, not found: %[2]s is provided by %[3]s"),
+		e.Override.LibraryString(), e.Override.Header, strings.Join(candidates, ", "))
+}
+
+// AddOverride pins the library that provides a header, replacing the Override
+// previously added for the same header
+func (resolver *Cpp) AddOverride(override *Override) {
+	resolver.overrides[override.Header] = override
+}
+
+// OverrideFor returns the Override for the specified header, or nil if the
+// header has not been pinned to a library
+func (resolver *Cpp) OverrideFor(header string) *Override {
+	return resolver.overrides[header]
+}
+
+// ResolveOverride returns the library pinned to the specified header. If the
+// header has not been pinned nil is returned, if none of the libraries
+// providing the header matches the Override an OverrideNotSatisfiedError is
+// returned.
+func (resolver *Cpp) ResolveOverride(header, architecture string) (*libraries.Library, error) {
+	override := resolver.overrides[header]
+	if override == nil {
+		return nil, nil
+	}
+
+	var found *libraries.Library
+	var foundPriority int
+	for _, lib := range resolver.headers[header] {
+		if !override.matches(lib) {
+			continue
+		}
+		// If more libraries match, e.g. the same library installed in
+		// different locations, choose the one with the higher priority
+		if libPriority := computePriority(lib, header, architecture); found == nil || foundPriority < libPriority {
+			found = lib
+			foundPriority = libPriority
+		}
+	}
+	if found == nil {
+		return nil, &OverrideNotSatisfiedError{Override: override, Candidates: resolver.headers[header]}
+	}
+	logrus.WithField("lib", found.Name).Infof("Resolving include %s with overridden library", header)
+	return found, nil
+}
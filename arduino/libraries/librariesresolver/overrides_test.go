@@ -0,0 +1,78 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package librariesresolver
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+	semver "go.bug.st/relaxed-semver"
+)
+
+func TestResolveOverride(t *testing.T) {
+	teamA := &libraries.Library{Name: "Sensor", Location: libraries.User, InstallDir: paths.New("/team-a/Sensor"), Version: semver.MustParse("1.0.0")}
+	teamB := &libraries.Library{Name: "Sensor", Location: libraries.Unmanaged, InstallDir: paths.New("/team-b/Sensor"), Version: semver.MustParse("2.0.0")}
+	other := &libraries.Library{Name: "SensorFork", RealName: "Sensor Fork", Location: libraries.Unmanaged, InstallDir: paths.New("/SensorFork")}
+	resolver := NewCppResolver()
+	resolver.headers["Sensor.h"] = libraries.List{teamA, teamB, other}
+
+	// Without overrides the usual heuristic applies
+	lib, err := resolver.ResolveOverride("Sensor.h", "avr")
+	require.NoError(t, err)
+	require.Nil(t, lib)
+	require.Equal(t, teamB, resolver.ResolveFor("Sensor.h", "avr"))
+
+	resolver.AddOverride(&Override{Header: "Sensor.h", Library: "Sensor", Path: paths.New("/team-a/Sensor")})
+	lib, err = resolver.ResolveOverride("Sensor.h", "avr")
+	require.NoError(t, err)
+	require.Equal(t, teamA, lib)
+
+	resolver.AddOverride(&Override{Header: "Sensor.h", Library: "Sensor", Version: semver.MustParse("1.0.0")})
+	lib, err = resolver.ResolveOverride("Sensor.h", "avr")
+	require.NoError(t, err)
+	require.Equal(t, teamA, lib)
+
+	resolver.AddOverride(&Override{Header: "Sensor.h", Library: "SensorFork"})
+	lib, err = resolver.ResolveOverride("Sensor.h", "avr")
+	require.NoError(t, err)
+	require.Equal(t, other, lib)
+
+	// The name declared in library.properties can be used as well
+	resolver.AddOverride(&Override{Header: "Sensor.h", Library: "Sensor Fork"})
+	lib, err = resolver.ResolveOverride("Sensor.h", "avr")
+	require.NoError(t, err)
+	require.Equal(t, other, lib)
+
+	// If more libraries match the one with the higher priority is chosen
+	resolver.AddOverride(&Override{Header: "Sensor.h", Library: "Sensor"})
+	lib, err = resolver.ResolveOverride("Sensor.h", "avr")
+	require.NoError(t, err)
+	require.Equal(t, teamB, lib)
+
+	// A missing library is an error, not a fallback to another alternative
+	resolver.AddOverride(&Override{Header: "Sensor.h", Library: "Sensor", Version: semver.MustParse("3.0.0")})
+	lib, err = resolver.ResolveOverride("Sensor.h", "avr")
+	require.Nil(t, lib)
+	require.IsType(t, &OverrideNotSatisfiedError{}, err)
+	require.Contains(t, err.Error(), "Sensor@3.0.0")
+
+	resolver.AddOverride(&Override{Header: "Missing.h", Library: "Missing"})
+	_, err = resolver.ResolveOverride("Missing.h", "avr")
+	require.Error(t, err)
+	require.Equal(t, "Missing", resolver.OverrideFor("Missing.h").Library)
+}
@@ -15,31 +15,190 @@
 
 package libraries
 
-// Lint produce warnings about the formal correctness of a Library
-func (l *Library) Lint() ([]string, error) {
-
-	// TODO: check for spurious dirs
-	// subDirs, err := ioutil.ReadDir(libraryDir)
-	// if err != nil {
-	// 	return nil, fmt.Errorf("reading dir %s: %s", libraryDir, err)
-	// }
-	// 	for _, subDir := range subDirs {
-	// 		if utils.IsSCCSOrHiddenFile(subDir) {
-	// 			if !utils.IsSCCSFile(subDir) && utils.IsHiddenFile(subDir) {
-	// 				logger.Fprintln(os.Stdout, "warn",
-	// 					"WARNING: Spurious {0} directory in '{1}' library",
-	// 					filepath.Base(subDir.Name()), libProperties["name"])
-	// 			}
-	// 		}
-	// 	}
-
-	// TODO: check for invalid category in library.properties
-	// if !ValidCategories[libProperties["category"]] {
-	//  logger.Fprintln(os.Stdout, "warn",
-	// 	"WARNING: Category '{0}' in library {1} is not valid. Setting to '{2}'",
-	// 	libProperties["category"], libProperties["name"], "Uncategorized")
-	// 	libProperties["category"] = "Uncategorized"
-	// }
-
-	return []string{}, nil
+import (
+	"fmt"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/utils"
+	properties "github.com/arduino/go-properties-orderedmap"
+)
+
+// LintSeverity is the severity of a LintIssue
+type LintSeverity string
+
+const (
+	// LintWarning is used for issues that doesn't prevent the library from being used
+	LintWarning LintSeverity = "warning"
+	// LintError is used for issues that may break the build of the sketches using the library
+	LintError LintSeverity = "error"
+)
+
+// LintIssue is a problem found by the linter in a library
+type LintIssue struct {
+	Rule     string       `json:"rule"`
+	Severity LintSeverity `json:"severity"`
+	Message  string       `json:"message"`
+}
+
+func (issue *LintIssue) String() string {
+	return strings.ToUpper(string(issue.Severity)) + ": " + issue.Message
+}
+
+// sourceControlFolders are the directories created by the most common SCCS and IDEs
+var sourceControlFolders = map[string]bool{
+	"CVS": true, "RCS": true, ".git": true, ".github": true, ".svn": true, ".hg": true, ".bzr": true,
+	".vscode": true, ".settings": true, ".pioenvs": true, ".piolibdeps": true,
+}
+
+// lintContext contains the data available to the lint rules
+type lintContext struct {
+	library *Library
+	// properties is the content of library.properties as found in the library
+	// folder (the loaded Library has defaults applied), it's nil for legacy libraries.
+	properties *properties.Map
+	// knownArchitectures is the set of the architectures of the installed platforms,
+	// if nil the architectures are not checked.
+	knownArchitectures map[string]bool
+}
+
+// lintRule is a single check performed by the linter, the check function returns
+// a message for each violation found.
+type lintRule struct {
+	id       string
+	severity LintSeverity
+	check    func(ctx *lintContext) ([]string, error)
+}
+
+var lintRules = []*lintRule{
+	{id: "spurious-directory", severity: LintWarning, check: lintSpuriousDirectories},
+	{id: "missing-property", severity: LintError, check: lintMandatoryProperties},
+	{id: "invalid-category", severity: LintWarning, check: lintCategory},
+	{id: "name-mismatch", severity: LintWarning, check: lintNameMatchesFolder},
+	{id: "unknown-architecture", severity: LintWarning, check: lintArchitectures},
+	{id: "missing-include", severity: LintError, check: lintDeclaredIncludes},
+}
+
+// Lint produce warnings about the formal correctness of a Library.
+// knownArchitectures is the set of the architectures provided by the installed
+// platforms, if nil the `architectures` field of library.properties is not checked.
+func (l *Library) Lint(knownArchitectures map[string]bool) ([]*LintIssue, error) {
+	ctx := &lintContext{
+		library:            l,
+		knownArchitectures: knownArchitectures,
+	}
+	if !l.IsLegacy {
+		libProperties, err := properties.Load(l.InstallDir.Join("library.properties").String())
+		if err != nil {
+			return nil, fmt.Errorf(tr("loading library.properties: %s"), err)
+		}
+		if libProperties.Get("maintainer") == "" && libProperties.Get("email") != "" {
+			libProperties.Set("maintainer", libProperties.Get("email"))
+		}
+		ctx.properties = libProperties
+	}
+
+	issues := []*LintIssue{}
+	for _, rule := range lintRules {
+		messages, err := rule.check(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, message := range messages {
+			issues = append(issues, &LintIssue{
+				Rule:     rule.id,
+				Severity: rule.severity,
+				Message:  message,
+			})
+		}
+	}
+	return issues, nil
+}
+
+func lintSpuriousDirectories(ctx *lintContext) ([]string, error) {
+	subDirs, err := ctx.library.InstallDir.ReadDir()
+	if err != nil {
+		return nil, fmt.Errorf(tr("reading dir %[1]s: %[2]s"), ctx.library.InstallDir, err)
+	}
+	subDirs.FilterDirs()
+	res := []string{}
+	for _, subDir := range subDirs {
+		// Source control folders are expected and always skipped by the builder
+		name := subDir.Base()
+		if strings.HasPrefix(name, ".") && !sourceControlFolders[name] {
+			res = append(res, tr("Spurious %[1]s directory in '%[2]s' library", name, ctx.library.Name))
+		}
+	}
+	return res, nil
+}
+
+func lintMandatoryProperties(ctx *lintContext) ([]string, error) {
+	if ctx.properties == nil {
+		return nil, nil
+	}
+	res := []string{}
+	for _, propName := range MandatoryProperties {
+		if strings.TrimSpace(ctx.properties.Get(propName)) == "" {
+			res = append(res, tr("Missing '%[1]s' from library in %[2]s", propName, ctx.library.InstallDir))
+		}
+	}
+	return res, nil
+}
+
+func lintCategory(ctx *lintContext) ([]string, error) {
+	if ctx.properties == nil {
+		return nil, nil
+	}
+	category := strings.TrimSpace(ctx.properties.Get("category"))
+	if category == "" || ValidCategories[category] {
+		return nil, nil
+	}
+	return []string{
+		tr("Category '%[1]s' in library %[2]s is not valid", category, ctx.library.Name),
+	}, nil
+}
+
+func lintNameMatchesFolder(ctx *lintContext) ([]string, error) {
+	if ctx.properties == nil {
+		return nil, nil
+	}
+	name := strings.TrimSpace(ctx.properties.Get("name"))
+	if name == "" {
+		// Already reported as missing property
+		return nil, nil
+	}
+	folder := ctx.library.InstallDir.Base()
+	if folder == name || folder == utils.SanitizeName(name) {
+		return nil, nil
+	}
+	return []string{
+		tr("Library name '%[1]s' doesn't match the library folder name '%[2]s'", name, folder),
+	}, nil
+}
+
+func lintArchitectures(ctx *lintContext) ([]string, error) {
+	if ctx.properties == nil || ctx.knownArchitectures == nil {
+		return nil, nil
+	}
+	res := []string{}
+	for _, arch := range strings.Split(ctx.properties.Get("architectures"), ",") {
+		arch = strings.TrimSpace(arch)
+		if arch == "" || arch == "*" || ctx.knownArchitectures[arch] {
+			continue
+		}
+		res = append(res, tr("Architecture '%[1]s' of library %[2]s doesn't match any installed platform", arch, ctx.library.Name))
+	}
+	return res, nil
+}
+
+func lintDeclaredIncludes(ctx *lintContext) ([]string, error) {
+	res := []string{}
+	for _, header := range ctx.library.DeclaredHeaders() {
+		if header == "" {
+			continue
+		}
+		if !ctx.library.SourceDir.Join(header).Exist() {
+			res = append(res, tr("Header %[1]s declared in 'includes' not found in library %[2]s", header, ctx.library.Name))
+		}
+	}
+	return res, nil
 }
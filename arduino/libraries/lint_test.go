@@ -0,0 +1,60 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package libraries
+
+import (
+	"testing"
+
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLint(t *testing.T) {
+	knownArchitectures := map[string]bool{"avr": true}
+
+	good, err := Load(paths.New("testdata", "lint", "Good"), User)
+	require.NoError(t, err)
+	issues, err := good.Lint(knownArchitectures)
+	require.NoError(t, err)
+	require.Empty(t, issues)
+
+	bad, err := Load(paths.New("testdata", "lint", "Bad"), User)
+	require.NoError(t, err)
+	issues, err = bad.Lint(knownArchitectures)
+	require.NoError(t, err)
+	rules := map[string]LintSeverity{}
+	for _, issue := range issues {
+		rules[issue.Rule] = issue.Severity
+	}
+	require.Equal(t, map[string]LintSeverity{
+		"spurious-directory":   LintWarning,
+		"missing-property":     LintError,
+		"invalid-category":     LintWarning,
+		"name-mismatch":        LintWarning,
+		"unknown-architecture": LintWarning,
+		"missing-include":      LintError,
+	}, rules)
+	require.Len(t, issues, 6)
+	require.Contains(t, issues[0].Message, ".hidden")
+	require.Contains(t, issues[1].Message, "'author'")
+	require.Contains(t, issues[4].Message, "'unknown'")
+	require.Contains(t, issues[5].Message, "Missing.h")
+
+	// Architectures are not checked without a list of known architectures
+	issues, err = bad.Lint(nil)
+	require.NoError(t, err)
+	require.Len(t, issues, 5)
+}
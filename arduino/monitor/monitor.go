@@ -0,0 +1,399 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package monitor
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/arduino/arduino-cli/cli/globals"
+	"github.com/arduino/arduino-cli/executils"
+	"github.com/arduino/arduino-cli/i18n"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// To work correctly a Pluggable Monitor must respect the state machine specifed on the documentation:
+// https://arduino.github.io/arduino-cli/latest/pluggable-monitor-specification/#state-machine
+// States a PluggableMonitor can be in
+const (
+	Alive int = iota
+	Idling
+	Opened
+	Dead
+)
+
+// PluggableMonitor is a tool that communicates with a board through a
+// communication port.
+type PluggableMonitor struct {
+	id                   string
+	process              *executils.Process
+	outgoingCommandsPipe io.Writer
+	incomingMessagesChan <-chan *monitorMessage
+
+	// All the following fields are guarded by statusMutex
+	statusMutex           sync.Mutex
+	incomingMessagesError error
+	state                 int
+	openedConn            net.Conn
+}
+
+type monitorMessage struct {
+	EventType       string          `json:"eventType"`
+	Message         string          `json:"message"`
+	Error           bool            `json:"error"`
+	ProtocolVersion int             `json:"protocolVersion"`  // Used in HELLO command
+	PortDescription *PortDescriptor `json:"port_description"` // Used in DESCRIBE command
+}
+
+func (msg monitorMessage) String() string {
+	s := fmt.Sprintf("type: %s", msg.EventType)
+	if msg.Message != "" {
+		s = tr("%[1]s, message: %[2]s", s, msg.Message)
+	}
+	if msg.ProtocolVersion != 0 {
+		s = tr("%[1]s, protocol version: %[2]d", s, msg.ProtocolVersion)
+	}
+	if msg.PortDescription != nil {
+		s = tr("%[1]s, port description: %[2]s", s, msg.PortDescription)
+	}
+	return s
+}
+
+// PortDescriptor is a set of information about the port and the
+// configuration parameters supported by the monitor.
+type PortDescriptor struct {
+	Protocol                string                              `json:"protocol"`
+	ConfigurationParameters map[string]*PortParameterDescriptor `json:"configuration_parameters"`
+}
+
+func (desc *PortDescriptor) String() string {
+	if desc == nil {
+		return "none"
+	}
+	return desc.Protocol
+}
+
+// ToRPC converts the configuration parameters of the PortDescriptor into
+// a list of rpc.MonitorPortSettingDescriptor sorted by setting id
+func (desc *PortDescriptor) ToRPC() []*rpc.MonitorPortSettingDescriptor {
+	res := []*rpc.MonitorPortSettingDescriptor{}
+	for id, param := range desc.ConfigurationParameters {
+		res = append(res, &rpc.MonitorPortSettingDescriptor{
+			SettingId:  id,
+			Label:      param.Label,
+			Type:       param.Type,
+			EnumValues: param.Values,
+			Value:      param.Selected,
+		})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].SettingId < res[j].SettingId })
+	return res
+}
+
+// PortParameterDescriptor contains the metadata of a single configuration
+// parameter supported by the monitor.
+type PortParameterDescriptor struct {
+	Label    string   `json:"label"`
+	Type     string   `json:"type"`
+	Values   []string `json:"values"`
+	Selected string   `json:"selected"`
+}
+
+var tr = i18n.Tr
+
+// New create and connect to the given pluggable monitor
+func New(id string, args ...string) (*PluggableMonitor, error) {
+	proc, err := executils.NewProcess(args...)
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := proc.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdin, err := proc.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	messageChan := make(chan *monitorMessage)
+	mon := &PluggableMonitor{
+		id:                   id,
+		process:              proc,
+		incomingMessagesChan: messageChan,
+		outgoingCommandsPipe: stdin,
+		state:                Dead,
+	}
+	go mon.jsonDecodeLoop(stdout, messageChan)
+	return mon, nil
+}
+
+// GetID returns the identifier for this monitor
+func (mon *PluggableMonitor) GetID() string {
+	return mon.id
+}
+
+func (mon *PluggableMonitor) String() string {
+	return mon.id
+}
+
+func (mon *PluggableMonitor) jsonDecodeLoop(in io.Reader, outChan chan<- *monitorMessage) {
+	decoder := json.NewDecoder(in)
+	closeAndReportError := func(err error) {
+		mon.statusMutex.Lock()
+		mon.state = Dead
+		mon.incomingMessagesError = err
+		mon.statusMutex.Unlock()
+		close(outChan)
+		logrus.Errorf("stopped monitor %s decode loop", mon.id)
+	}
+
+	for {
+		var msg monitorMessage
+		if err := decoder.Decode(&msg); err != nil {
+			closeAndReportError(err)
+			return
+		}
+		logrus.Infof("from monitor %s received message %s", mon.id, msg)
+		if msg.EventType == "port_closed" {
+			// The port has been closed on the monitor side (for example because
+			// the board has been disconnected), this is an asynchronous event
+			// so it must not be delivered as a command response.
+			mon.statusMutex.Lock()
+			if mon.openedConn != nil {
+				mon.openedConn.Close()
+				mon.openedConn = nil
+			}
+			if mon.state == Opened {
+				mon.state = Idling
+			}
+			mon.statusMutex.Unlock()
+		} else {
+			outChan <- &msg
+		}
+	}
+}
+
+// State returns the current state of this PluggableMonitor
+func (mon *PluggableMonitor) State() int {
+	mon.statusMutex.Lock()
+	defer mon.statusMutex.Unlock()
+	return mon.state
+}
+
+func (mon *PluggableMonitor) waitMessage(timeout time.Duration, expectedEvt string) (*monitorMessage, error) {
+	var msg *monitorMessage
+	select {
+	case msg = <-mon.incomingMessagesChan:
+		if msg == nil {
+			// channel has been closed
+			mon.statusMutex.Lock()
+			defer mon.statusMutex.Unlock()
+			return nil, mon.incomingMessagesError
+		}
+	case <-time.After(timeout):
+		return nil, fmt.Errorf(tr("timeout waiting for message from %s"), mon.id)
+	}
+	if expectedEvt == "" {
+		// No message processing required for this call
+		return msg, nil
+	}
+	if msg.EventType != expectedEvt {
+		return msg, errors.Errorf(tr("communication out of sync, expected '%[1]s', received '%[2]s'"), expectedEvt, msg.EventType)
+	}
+	if msg.Error {
+		return msg, errors.Errorf(tr("command '%[1]s' failed: %[2]s"), expectedEvt, msg.Message)
+	}
+	if strings.ToUpper(msg.Message) != "OK" {
+		return msg, errors.Errorf(tr("communication out of sync, expected '%[1]s', received '%[2]s'"), "OK", msg.Message)
+	}
+	return msg, nil
+}
+
+func (mon *PluggableMonitor) sendCommand(command string) error {
+	logrus.Infof("sending command %s to monitor %s", strings.TrimSpace(command), mon)
+	data := []byte(command)
+	for {
+		n, err := mon.outgoingCommandsPipe.Write(data)
+		if err != nil {
+			return err
+		}
+		if n == len(data) {
+			return nil
+		}
+		data = data[n:]
+	}
+}
+
+func (mon *PluggableMonitor) runProcess() error {
+	logrus.Infof("starting monitor %s process", mon.id)
+	if err := mon.process.Start(); err != nil {
+		return err
+	}
+	mon.statusMutex.Lock()
+	defer mon.statusMutex.Unlock()
+	mon.state = Alive
+	logrus.Infof("started monitor %s process", mon.id)
+	return nil
+}
+
+func (mon *PluggableMonitor) killProcess() error {
+	logrus.Infof("killing monitor %s process", mon.id)
+	if err := mon.process.Kill(); err != nil {
+		return err
+	}
+	mon.statusMutex.Lock()
+	defer mon.statusMutex.Unlock()
+	mon.state = Dead
+	logrus.Infof("killed monitor %s process", mon.id)
+	return nil
+}
+
+// Run starts the monitor executable process and sends the HELLO command to the monitor to agree on the
+// pluggable monitor protocol. This must be the first command to run in the communication with the monitor.
+// If the process is started but the HELLO command fails the process is killed.
+func (mon *PluggableMonitor) Run() (err error) {
+	if err = mon.runProcess(); err != nil {
+		return err
+	}
+
+	defer func() {
+		// If the monitor process is started successfully but the HELLO handshake
+		// fails the monitor is an unusable state, we kill the process to avoid
+		// further issues down the line.
+		if err == nil {
+			return
+		}
+		if err := mon.killProcess(); err != nil {
+			// Log failure to kill the process, ideally that should never happen
+			// but it's best to know it if it does
+			logrus.Errorf("Killing monitor %s after unsuccessful start: %s", mon.id, err)
+		}
+	}()
+
+	if err = mon.sendCommand("HELLO 1 \"arduino-cli " + globals.VersionInfo.VersionString + "\"\n"); err != nil {
+		return err
+	}
+	if msg, err := mon.waitMessage(time.Second*10, "hello"); err != nil {
+		return fmt.Errorf(tr("calling %[1]s: %[2]w"), "HELLO", err)
+	} else if msg.ProtocolVersion > 1 {
+		return errors.Errorf(tr("protocol version not supported: requested 1, got %d"), msg.ProtocolVersion)
+	}
+	mon.statusMutex.Lock()
+	defer mon.statusMutex.Unlock()
+	mon.state = Idling
+	return nil
+}
+
+// Describe returns a description of the Port and the configuration parameters.
+func (mon *PluggableMonitor) Describe() (*PortDescriptor, error) {
+	if err := mon.sendCommand("DESCRIBE\n"); err != nil {
+		return nil, err
+	}
+	msg, err := mon.waitMessage(time.Second*10, "describe")
+	if err != nil {
+		return nil, fmt.Errorf(tr("calling %[1]s: %[2]w"), "DESCRIBE", err)
+	}
+	if msg.PortDescription == nil {
+		return nil, errors.New(tr("invalid 'describe' message: missing port description"))
+	}
+	return msg.PortDescription, nil
+}
+
+// Configure sets a port configuration parameter.
+func (mon *PluggableMonitor) Configure(param, value string) error {
+	if err := mon.sendCommand(fmt.Sprintf("CONFIGURE %s %s\n", param, value)); err != nil {
+		return err
+	}
+	if _, err := mon.waitMessage(time.Second*10, "configure"); err != nil {
+		return fmt.Errorf(tr("calling %[1]s: %[2]w"), "CONFIGURE", err)
+	}
+	return nil
+}
+
+// Open connects to the given Port. A communication channel is opened
+// between the monitor and the client through a local TCP socket, the
+// returned io.ReadWriter can be used to exchange data with the port.
+func (mon *PluggableMonitor) Open(portAddress, portProtocol string) (io.ReadWriter, error) {
+	tcpListener, err := net.Listen("tcp", "127.0.0.1:")
+	if err != nil {
+		return nil, err
+	}
+	defer tcpListener.Close()
+	tcpListenerPort := tcpListener.Addr().(*net.TCPAddr).Port
+
+	if err := mon.sendCommand(fmt.Sprintf("OPEN 127.0.0.1:%d %s\n", tcpListenerPort, portAddress)); err != nil {
+		return nil, err
+	}
+	if _, err := mon.waitMessage(time.Second*10, "open"); err != nil {
+		return nil, fmt.Errorf(tr("calling %[1]s: %[2]w"), "OPEN", err)
+	}
+
+	conn, err := tcpListener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	logrus.Infof("monitor %s connected to %s port %s", mon.id, portProtocol, portAddress)
+
+	mon.statusMutex.Lock()
+	defer mon.statusMutex.Unlock()
+	mon.openedConn = conn
+	mon.state = Opened
+	return conn, nil
+}
+
+// Close the communication port with the board.
+func (mon *PluggableMonitor) Close() error {
+	if err := mon.sendCommand("CLOSE\n"); err != nil {
+		return err
+	}
+	if _, err := mon.waitMessage(time.Second*10, "close"); err != nil {
+		return fmt.Errorf(tr("calling %[1]s: %[2]w"), "CLOSE", err)
+	}
+	mon.statusMutex.Lock()
+	defer mon.statusMutex.Unlock()
+	if mon.openedConn != nil {
+		mon.openedConn.Close()
+		mon.openedConn = nil
+	}
+	mon.state = Idling
+	return nil
+}
+
+// Quit terminates the monitor. No more commands can be accepted by the monitor.
+func (mon *PluggableMonitor) Quit() error {
+	if err := mon.sendCommand("QUIT\n"); err != nil {
+		return err
+	}
+	if _, err := mon.waitMessage(time.Second*10, "quit"); err != nil {
+		return fmt.Errorf(tr("calling %[1]s: %[2]w"), "QUIT", err)
+	}
+	mon.statusMutex.Lock()
+	defer mon.statusMutex.Unlock()
+	if mon.openedConn != nil {
+		mon.openedConn.Close()
+		mon.openedConn = nil
+	}
+	mon.state = Dead
+	return nil
+}
@@ -0,0 +1,66 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package monitor
+
+import (
+	"io"
+	"testing"
+
+	"github.com/arduino/arduino-cli/executils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPluggableMonitorLifecycle(t *testing.T) {
+	// Build `dummy-monitor` helper inside testdata/dummy-monitor
+	builder, err := executils.NewProcess("go", "build")
+	require.NoError(t, err)
+	builder.SetDir("testdata/dummy-monitor")
+	require.NoError(t, builder.Run())
+
+	mon, err := New("test", "testdata/dummy-monitor/dummy-monitor")
+	require.NoError(t, err)
+	require.Equal(t, Dead, mon.State())
+
+	require.NoError(t, mon.Run())
+	require.Equal(t, Idling, mon.State())
+
+	desc, err := mon.Describe()
+	require.NoError(t, err)
+	require.Equal(t, "test", desc.Protocol)
+	require.Contains(t, desc.ConfigurationParameters, "echo")
+	require.Equal(t, "on", desc.ConfigurationParameters["echo"].Selected)
+	require.Equal(t, []string{"on", "off"}, desc.ConfigurationParameters["echo"].Values)
+
+	require.Error(t, mon.Configure("unknown", "value"))
+	require.NoError(t, mon.Configure("echo", "on"))
+
+	rw, err := mon.Open("dummy-port", "test")
+	require.NoError(t, err)
+	require.Equal(t, Opened, mon.State())
+
+	_, err = rw.Write([]byte("hello"))
+	require.NoError(t, err)
+	buff := make([]byte, 5)
+	_, err = io.ReadFull(rw, buff)
+	require.NoError(t, err)
+	require.Equal(t, "hello", string(buff))
+
+	require.NoError(t, mon.Close())
+	require.Equal(t, Idling, mon.State())
+
+	require.NoError(t, mon.Quit())
+	require.Equal(t, Dead, mon.State())
+}
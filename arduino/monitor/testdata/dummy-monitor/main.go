@@ -0,0 +1,107 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// dummy-monitor is a minimal pluggable monitor used for testing: every
+// byte received from the client is echoed back.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+)
+
+type message struct {
+	EventType       string      `json:"eventType"`
+	Message         string      `json:"message"`
+	Error           bool        `json:"error,omitempty"`
+	ProtocolVersion int         `json:"protocolVersion,omitempty"`
+	PortDescription interface{} `json:"port_description,omitempty"`
+}
+
+var settings = map[string]string{"echo": "on"}
+
+func reply(msg *message) {
+	data, _ := json.Marshal(msg)
+	fmt.Println(string(data))
+}
+
+func main() {
+	var conn net.Conn
+	in := bufio.NewScanner(os.Stdin)
+	for in.Scan() {
+		fields := strings.Fields(in.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		switch cmd := strings.ToUpper(fields[0]); cmd {
+		case "HELLO":
+			reply(&message{EventType: "hello", Message: "OK", ProtocolVersion: 1})
+		case "DESCRIBE":
+			reply(&message{EventType: "describe", Message: "OK", PortDescription: map[string]interface{}{
+				"protocol": "test",
+				"configuration_parameters": map[string]interface{}{
+					"echo": map[string]interface{}{
+						"label":    "Echo",
+						"type":     "enum",
+						"values":   []string{"on", "off"},
+						"selected": settings["echo"],
+					},
+				},
+			}})
+		case "CONFIGURE":
+			if len(fields) != 3 || settings[fields[1]] == "" {
+				reply(&message{EventType: "configure", Message: "invalid parameter", Error: true})
+				continue
+			}
+			settings[fields[1]] = fields[2]
+			reply(&message{EventType: "configure", Message: "OK"})
+		case "OPEN":
+			c, err := net.Dial("tcp", fields[1])
+			if err != nil {
+				reply(&message{EventType: "open", Message: err.Error(), Error: true})
+				continue
+			}
+			conn = c
+			reply(&message{EventType: "open", Message: "OK"})
+			go func() {
+				buff := make([]byte, 1024)
+				for {
+					n, err := c.Read(buff)
+					if err != nil {
+						return
+					}
+					if settings["echo"] == "on" {
+						c.Write(buff[:n])
+					}
+				}
+			}()
+		case "CLOSE":
+			if conn != nil {
+				conn.Close()
+				conn = nil
+			}
+			reply(&message{EventType: "close", Message: "OK"})
+		case "QUIT":
+			reply(&message{EventType: "quit", Message: "OK"})
+			os.Exit(0)
+		default:
+			reply(&message{EventType: "command_error", Message: "Unknown command " + cmd, Error: true})
+		}
+	}
+}
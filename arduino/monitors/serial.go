@@ -16,6 +16,10 @@
 package monitors
 
 import (
+	"strconv"
+	"sync"
+
+	"github.com/arduino/arduino-cli/arduino/monitor"
 	"github.com/arduino/arduino-cli/i18n"
 	"github.com/pkg/errors"
 	"go.bug.st/serial"
@@ -27,26 +31,260 @@ const (
 
 var tr = i18n.Tr
 
+// Identifiers of the settings supported by the SerialMonitor
+const (
+	SerialSettingBaudRate = "baudrate"
+	SerialSettingDataBits = "bits"
+	SerialSettingParity   = "parity"
+	SerialSettingStopBits = "stop_bits"
+	SerialSettingDTR      = "dtr"
+	SerialSettingRTS      = "rts"
+)
+
+var (
+	serialBaudRates = []string{
+		"300", "600", "750", "1200", "2400", "4800", "9600", "19200", "31250", "38400",
+		"57600", "74880", "115200", "230400", "250000", "460800", "500000", "921600",
+		"1000000", "2000000",
+	}
+	serialDataBits = []string{"5", "6", "7", "8"}
+	serialParities = map[string]serial.Parity{
+		"none":  serial.NoParity,
+		"even":  serial.EvenParity,
+		"odd":   serial.OddParity,
+		"mark":  serial.MarkParity,
+		"space": serial.SpaceParity,
+	}
+	serialParityValues = []string{"none", "even", "odd", "mark", "space"}
+	serialStopBits     = map[string]serial.StopBits{
+		"1":   serial.OneStopBit,
+		"1.5": serial.OnePointFiveStopBits,
+		"2":   serial.TwoStopBits,
+	}
+	serialStopBitsValues = []string{"1", "1.5", "2"}
+	serialOnOffValues    = []string{"on", "off"}
+)
+
+// SerialMonitorSettings contains the configuration of a serial port
+type SerialMonitorSettings struct {
+	BaudRate int
+	DataBits int
+	Parity   serial.Parity
+	StopBits serial.StopBits
+	DTR      bool
+	RTS      bool
+}
+
+// NewSerialMonitorSettings returns the default serial port settings (9600 8N1
+// with DTR and RTS asserted)
+func NewSerialMonitorSettings() *SerialMonitorSettings {
+	return &SerialMonitorSettings{
+		BaudRate: defaultBaudRate,
+		DataBits: 8,
+		Parity:   serial.NoParity,
+		StopBits: serial.OneStopBit,
+		DTR:      true,
+		RTS:      true,
+	}
+}
+
+// Set changes the setting with the given identifier, the value is parsed
+// and validated accordingly to the setting type.
+func (s *SerialMonitorSettings) Set(setting, value string) error {
+	invalid := func() error {
+		return errors.Errorf(tr("invalid value for setting %[1]s: %[2]s"), setting, value)
+	}
+	switch setting {
+	case SerialSettingBaudRate:
+		baudRate, err := strconv.Atoi(value)
+		if err != nil || baudRate <= 0 {
+			return invalid()
+		}
+		s.BaudRate = baudRate
+	case SerialSettingDataBits:
+		dataBits, err := strconv.Atoi(value)
+		if err != nil || dataBits < 5 || dataBits > 8 {
+			return invalid()
+		}
+		s.DataBits = dataBits
+	case SerialSettingParity:
+		parity, ok := serialParities[value]
+		if !ok {
+			return invalid()
+		}
+		s.Parity = parity
+	case SerialSettingStopBits:
+		stopBits, ok := serialStopBits[value]
+		if !ok {
+			return invalid()
+		}
+		s.StopBits = stopBits
+	case SerialSettingDTR, SerialSettingRTS:
+		var enabled bool
+		switch value {
+		case "on":
+			enabled = true
+		case "off":
+			enabled = false
+		default:
+			return invalid()
+		}
+		if setting == SerialSettingDTR {
+			s.DTR = enabled
+		} else {
+			s.RTS = enabled
+		}
+	default:
+		return errors.Errorf(tr("invalid setting: %s"), setting)
+	}
+	return nil
+}
+
+// Describe returns a description of the settings with their current values
+func (s *SerialMonitorSettings) Describe() *monitor.PortDescriptor {
+	onOff := func(b bool) string {
+		if b {
+			return "on"
+		}
+		return "off"
+	}
+	parity := ""
+	for k, v := range serialParities {
+		if v == s.Parity {
+			parity = k
+		}
+	}
+	stopBits := ""
+	for k, v := range serialStopBits {
+		if v == s.StopBits {
+			stopBits = k
+		}
+	}
+	return &monitor.PortDescriptor{
+		Protocol: "serial",
+		ConfigurationParameters: map[string]*monitor.PortParameterDescriptor{
+			SerialSettingBaudRate: {
+				Label:    tr("Baudrate"),
+				Type:     "enum",
+				Values:   serialBaudRates,
+				Selected: strconv.Itoa(s.BaudRate),
+			},
+			SerialSettingDataBits: {
+				Label:    tr("Data bits"),
+				Type:     "enum",
+				Values:   serialDataBits,
+				Selected: strconv.Itoa(s.DataBits),
+			},
+			SerialSettingParity: {
+				Label:    tr("Parity"),
+				Type:     "enum",
+				Values:   serialParityValues,
+				Selected: parity,
+			},
+			SerialSettingStopBits: {
+				Label:    tr("Stop bits"),
+				Type:     "enum",
+				Values:   serialStopBitsValues,
+				Selected: stopBits,
+			},
+			SerialSettingDTR: {
+				Label:    tr("DTR"),
+				Type:     "enum",
+				Values:   serialOnOffValues,
+				Selected: onOff(s.DTR),
+			},
+			SerialSettingRTS: {
+				Label:    tr("RTS"),
+				Type:     "enum",
+				Values:   serialOnOffValues,
+				Selected: onOff(s.RTS),
+			},
+		},
+	}
+}
+
+func (s *SerialMonitorSettings) mode() *serial.Mode {
+	return &serial.Mode{
+		BaudRate: s.BaudRate,
+		DataBits: s.DataBits,
+		Parity:   s.Parity,
+		StopBits: s.StopBits,
+	}
+}
+
 // SerialMonitor is a monitor for serial ports
 type SerialMonitor struct {
 	port serial.Port
+	// mutex protects settings, that may be changed by Configure while the
+	// port is being read and written
+	mutex    sync.Mutex
+	settings SerialMonitorSettings
 }
 
-// OpenSerialMonitor creates a monitor instance for a serial port
-func OpenSerialMonitor(portName string, baudRate int) (*SerialMonitor, error) {
-	// use default baud rate if not provided
-	if baudRate == 0 {
-		baudRate = defaultBaudRate
+// OpenSerialMonitor opens the given serial port using the specified settings,
+// if settings is nil the default settings are used.
+func OpenSerialMonitor(portName string, settings *SerialMonitorSettings) (*SerialMonitor, error) {
+	if settings == nil {
+		settings = NewSerialMonitorSettings()
 	}
 
-	port, err := serial.Open(portName, &serial.Mode{BaudRate: baudRate})
+	port, err := serial.Open(portName, settings.mode())
 	if err != nil {
 		return nil, errors.Wrap(err, tr("error opening serial monitor"))
 	}
+	mon := &SerialMonitor{
+		port:     port,
+		settings: *settings,
+	}
+	if err := mon.applyControlSignals(); err != nil {
+		port.Close()
+		return nil, err
+	}
+	return mon, nil
+}
+
+func (mon *SerialMonitor) applyControlSignals() error {
+	if err := mon.port.SetDTR(mon.settings.DTR); err != nil {
+		return errors.Wrap(err, tr("setting DTR"))
+	}
+	if err := mon.port.SetRTS(mon.settings.RTS); err != nil {
+		return errors.Wrap(err, tr("setting RTS"))
+	}
+	return nil
+}
 
-	return &SerialMonitor{
-		port: port,
-	}, nil
+// Settings returns a copy of the settings currently in use
+func (mon *SerialMonitor) Settings() *SerialMonitorSettings {
+	mon.mutex.Lock()
+	defer mon.mutex.Unlock()
+	settings := mon.settings
+	return &settings
+}
+
+// Configure changes a setting of the opened port
+func (mon *SerialMonitor) Configure(setting, value string) error {
+	mon.mutex.Lock()
+	defer mon.mutex.Unlock()
+	settings := mon.settings
+	if err := settings.Set(setting, value); err != nil {
+		return err
+	}
+	switch setting {
+	case SerialSettingDTR:
+		if err := mon.port.SetDTR(settings.DTR); err != nil {
+			return errors.Wrap(err, tr("setting DTR"))
+		}
+	case SerialSettingRTS:
+		if err := mon.port.SetRTS(settings.RTS); err != nil {
+			return errors.Wrap(err, tr("setting RTS"))
+		}
+	default:
+		if err := mon.port.SetMode(settings.mode()); err != nil {
+			return errors.Wrap(err, tr("changing serial port settings"))
+		}
+	}
+	mon.settings = settings
+	return nil
 }
 
 // Close the connection
@@ -0,0 +1,67 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package monitors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.bug.st/serial"
+)
+
+func TestSerialMonitorSettings(t *testing.T) {
+	settings := NewSerialMonitorSettings()
+	require.Equal(t, 9600, settings.BaudRate)
+	require.Equal(t, 8, settings.DataBits)
+	require.Equal(t, serial.NoParity, settings.Parity)
+	require.Equal(t, serial.OneStopBit, settings.StopBits)
+	require.True(t, settings.DTR)
+	require.True(t, settings.RTS)
+
+	require.NoError(t, settings.Set("baudrate", "115200"))
+	require.NoError(t, settings.Set("bits", "7"))
+	require.NoError(t, settings.Set("parity", "even"))
+	require.NoError(t, settings.Set("stop_bits", "1.5"))
+	require.NoError(t, settings.Set("dtr", "off"))
+	require.Equal(t, 115200, settings.BaudRate)
+	require.Equal(t, 7, settings.DataBits)
+	require.Equal(t, serial.EvenParity, settings.Parity)
+	require.Equal(t, serial.OnePointFiveStopBits, settings.StopBits)
+	require.False(t, settings.DTR)
+	require.True(t, settings.RTS)
+
+	require.Error(t, settings.Set("baudrate", "fast"))
+	require.Error(t, settings.Set("bits", "9"))
+	require.Error(t, settings.Set("parity", "maybe"))
+	require.Error(t, settings.Set("stop_bits", "3"))
+	require.Error(t, settings.Set("rts", "yes"))
+	require.Error(t, settings.Set("flow_control", "none"))
+	require.Equal(t, 115200, settings.BaudRate)
+
+	desc := settings.Describe()
+	require.Equal(t, "serial", desc.Protocol)
+	require.Equal(t, "115200", desc.ConfigurationParameters["baudrate"].Selected)
+	require.Equal(t, "7", desc.ConfigurationParameters["bits"].Selected)
+	require.Equal(t, "even", desc.ConfigurationParameters["parity"].Selected)
+	require.Equal(t, "1.5", desc.ConfigurationParameters["stop_bits"].Selected)
+	require.Equal(t, "off", desc.ConfigurationParameters["dtr"].Selected)
+	require.Equal(t, "on", desc.ConfigurationParameters["rts"].Selected)
+
+	settingsRPC := desc.ToRPC()
+	require.Len(t, settingsRPC, 6)
+	require.Equal(t, "baudrate", settingsRPC[0].SettingId)
+	require.Equal(t, "stop_bits", settingsRPC[5].SettingId)
+}
@@ -17,6 +17,7 @@ package resources
 
 import (
 	"fmt"
+	"net/http"
 	"os"
 
 	"github.com/arduino/go-paths-helper"
@@ -42,16 +43,22 @@ func (r *DownloadResource) IsCached(downloadDir *paths.Path) (bool, error) {
 	return archivePath.Exist(), nil
 }
 
-// Download a DownloadResource.
+// Download a DownloadResource. If a previous download of the resource was
+// interrupted the download is resumed from where it stopped, if the server
+// supports it.
 func (r *DownloadResource) Download(downloadDir *paths.Path, config *downloader.Config) (*downloader.Downloader, error) {
 	path, err := r.ArchivePath(downloadDir)
 	if err != nil {
 		return nil, fmt.Errorf(tr("getting archive path: %s"), err)
 	}
 
-	if _, err := path.Stat(); os.IsNotExist(err) {
+	if info, err := path.Stat(); os.IsNotExist(err) {
 		// normal download
-	} else if err == nil {
+	} else if err != nil {
+		return nil, fmt.Errorf(tr("getting archive file info: %s"), err)
+	} else if info.Size() < r.Size {
+		// resume download
+	} else {
 		// check local file integrity
 		ok, err := r.TestLocalArchiveIntegrity(downloadDir)
 		if err != nil || !ok {
@@ -62,11 +69,50 @@ func (r *DownloadResource) Download(downloadDir *paths.Path, config *downloader.
 			// File is cached, nothing to do here
 			return nil, nil
 		}
-	} else if err == nil {
-		// resume download
-	} else {
-		return nil, fmt.Errorf(tr("getting archive file info: %s"), err)
 	}
 
-	return downloader.DownloadWithConfig(path.String(), r.URL, *config)
+	d, err := downloader.DownloadWithConfig(path.String(), r.URL, *config)
+	if err != nil {
+		return nil, err
+	}
+	resumed := d.Completed() > 0
+	if resumed && d.Resp.StatusCode == http.StatusOK {
+		// The server can't resume the download, start again from the beginning
+		if err := d.Close(); err != nil {
+			return nil, err
+		}
+		d, err = downloader.DownloadWithConfig(path.String(), r.URL, *config, downloader.NoResume)
+		if err != nil {
+			return nil, err
+		}
+		resumed = false
+	}
+
+	// The body of the error responses must not end up in the archive: the
+	// download is closed before writing anything, the partial archive is
+	// kept to resume it later
+	expectedStatus := http.StatusOK
+	if resumed {
+		expectedStatus = http.StatusPartialContent
+	}
+	if d.Resp.StatusCode != expectedStatus {
+		d.Close()
+		if info, err := path.Stat(); err == nil && info.Size() == 0 {
+			path.Remove()
+		}
+		return nil, &DownloadStatusError{URL: r.URL, Status: d.Resp.Status, StatusCode: d.Resp.StatusCode}
+	}
+	return d, nil
+}
+
+// DownloadStatusError is returned when the server answers a download request
+// with an unexpected status
+type DownloadStatusError struct {
+	URL        string
+	Status     string
+	StatusCode int
+}
+
+func (e *DownloadStatusError) Error() string {
+	return fmt.Sprintf(tr("downloading %[1]s: server responded with: %[2]s"), e.URL, e.Status)
 }
@@ -16,12 +16,16 @@
 package resources
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/arduino/arduino-cli/httpclient"
 	"github.com/arduino/go-paths-helper"
@@ -82,3 +86,89 @@ func TestDownloadApplyUserAgentHeaderUsingConfig(t *testing.T) {
 	require.Equal(t, goldUserAgentString, userAgentHeaderString)
 
 }
+
+func TestDownloadResume(t *testing.T) {
+	content := []byte(strings.Repeat("0123456789", 100))
+	supportsRange := true
+	failing := false
+	ranges := []string{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ranges = append(ranges, r.Header.Get("Range"))
+		if failing {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte("error page"))
+		} else if supportsRange {
+			http.ServeContent(w, r, "archive.zip", time.Time{}, bytes.NewReader(content))
+		} else {
+			w.Write(content)
+		}
+	}))
+	defer srv.Close()
+
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	sum := sha256.Sum256(content)
+	r := &DownloadResource{
+		ArchiveFileName: "archive.zip",
+		CachePath:       "cache",
+		Checksum:        "SHA-256:" + hex.EncodeToString(sum[:]),
+		Size:            int64(len(content)),
+		URL:             srv.URL + "/archive.zip",
+	}
+	archive := tmp.Join("cache", "archive.zip")
+	download := func() {
+		d, err := r.Download(tmp, &downloader.Config{HttpClient: *http.DefaultClient})
+		require.NoError(t, err)
+		require.NoError(t, d.Run())
+		data, err := archive.ReadFile()
+		require.NoError(t, err)
+		require.Equal(t, content, data)
+	}
+
+	// The partial download is resumed
+	require.NoError(t, archive.Parent().MkdirAll())
+	require.NoError(t, archive.WriteFile(content[:300]))
+	download()
+	require.Equal(t, []string{"bytes=300-"}, ranges)
+
+	// The download starts again if the server doesn't support resuming
+	ranges = []string{}
+	supportsRange = false
+	require.NoError(t, archive.WriteFile(content[:300]))
+	download()
+	require.Equal(t, []string{"bytes=300-", ""}, ranges)
+
+	// A complete archive with a wrong content is downloaded again
+	ranges = []string{}
+	supportsRange = true
+	require.NoError(t, archive.WriteFile(bytes.Repeat([]byte("x"), len(content))))
+	download()
+	require.Equal(t, []string{""}, ranges)
+
+	// The error responses are not written in the partial archive
+	ranges = []string{}
+	failing = true
+	require.NoError(t, archive.WriteFile(content[:300]))
+	_, err = r.Download(tmp, &downloader.Config{HttpClient: *http.DefaultClient})
+	var statusErr *DownloadStatusError
+	require.ErrorAs(t, err, &statusErr)
+	require.Equal(t, http.StatusServiceUnavailable, statusErr.StatusCode)
+	require.Equal(t, []string{"bytes=300-"}, ranges)
+	data, err := archive.ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, content[:300], data)
+
+	// nor in a new one
+	require.NoError(t, archive.Remove())
+	_, err = r.Download(tmp, &downloader.Config{HttpClient: *http.DefaultClient})
+	require.ErrorAs(t, err, &statusErr)
+	require.True(t, archive.NotExist())
+
+	// The partial archive is resumed when the server is back
+	ranges = []string{}
+	failing = false
+	require.NoError(t, archive.WriteFile(content[:300]))
+	download()
+	require.Equal(t, []string{"bytes=300-"}, ranges)
+}
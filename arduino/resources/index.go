@@ -0,0 +1,176 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package resources
+
+import (
+	"compress/bzip2"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/arduino/go-paths-helper"
+	"go.bug.st/downloader/v2"
+)
+
+// IndexValidators are the HTTP validators returned by the server together with
+// an index. They are sent back when the index is downloaded again, so the server
+// can respond 304 Not Modified, without sending the index, if it didn't change.
+type IndexValidators struct {
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"last_modified,omitempty"`
+}
+
+// IndexValidatorsFromResponse returns the validators sent by the server in resp
+func IndexValidatorsFromResponse(resp *http.Response) *IndexValidators {
+	return &IndexValidators{
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+	}
+}
+
+// LoadIndexValidators reads the validators saved in file
+func LoadIndexValidators(file *paths.Path) (*IndexValidators, error) {
+	data, err := file.ReadFile()
+	if err != nil {
+		return nil, fmt.Errorf(tr("reading index validators: %s"), err)
+	}
+	v := &IndexValidators{}
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, fmt.Errorf(tr("invalid index validators %[1]s: %[2]s"), file, err)
+	}
+	return v, nil
+}
+
+// Save writes the validators in file
+func (v *IndexValidators) Save(file *paths.Path) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf(tr("encoding index validators: %s"), err)
+	}
+	if err := file.WriteFile(data); err != nil {
+		return fmt.Errorf(tr("writing index validators: %s"), err)
+	}
+	return nil
+}
+
+// IndexFileName returns the name of the file where the index downloaded from
+// URL is stored, that is the name of the file in the URL without the
+// extension of the compressed variants of the index.
+func IndexFileName(URL *url.URL) string {
+	name := path.Base(URL.Path)
+	for _, ext := range []string{".gz", ".bz2"} {
+		if strings.HasSuffix(name, ext) && len(name) > len(ext) {
+			return strings.TrimSuffix(name, ext)
+		}
+	}
+	return name
+}
+
+// DownloadIndex returns a downloader for the index at URL, saving it in file.
+// If the validators of a previous download are given the index is downloaded
+// only if it changed on the server, see IndexNotModified. The server is allowed
+// to send the index gzip compressed, DecompressIndex must be used to get the
+// downloaded index.
+func DownloadIndex(file *paths.Path, URL *url.URL, validators *IndexValidators, config *downloader.Config) (*downloader.Downloader, error) {
+	httpClient := config.HttpClient
+	httpClient.Transport = &indexRoundTripper{
+		transport:  httpClient.Transport,
+		validators: validators,
+	}
+	return downloader.DownloadWithConfig(file.String(), URL.String(), downloader.Config{HttpClient: httpClient}, downloader.NoResume)
+}
+
+// IndexNotModified returns true if the server responded that the index didn't
+// change since the download the validators were sent for. In this case there's
+// nothing to download and the downloader is closed.
+func IndexNotModified(d *downloader.Downloader) bool {
+	if d.Resp.StatusCode != http.StatusNotModified {
+		return false
+	}
+	d.Close()
+	return true
+}
+
+// DecompressIndex writes in dest the index downloaded by d in file, removing the
+// gzip encoding applied by the server and decompressing the gzip or bzip2
+// compressed variants of the index.
+func DecompressIndex(d *downloader.Downloader, file *paths.Path, dest *paths.Path) error {
+	in, err := file.Open()
+	if err != nil {
+		return fmt.Errorf(tr("opening downloaded index: %s"), err)
+	}
+	defer in.Close()
+
+	var r io.Reader = in
+	if strings.EqualFold(d.Resp.Header.Get("Content-Encoding"), "gzip") {
+		if r, err = gzip.NewReader(r); err != nil {
+			return fmt.Errorf(tr("decompressing downloaded index: %s"), err)
+		}
+	}
+	if URL, err := url.Parse(d.URL); err == nil {
+		switch path.Ext(URL.Path) {
+		case ".gz":
+			if r, err = gzip.NewReader(r); err != nil {
+				return fmt.Errorf(tr("decompressing downloaded index: %s"), err)
+			}
+		case ".bz2":
+			r = bzip2.NewReader(r)
+		}
+	}
+
+	out, err := dest.Create()
+	if err != nil {
+		return fmt.Errorf(tr("creating index file: %s"), err)
+	}
+	if _, err := io.Copy(out, r); err != nil {
+		out.Close()
+		return fmt.Errorf(tr("decompressing downloaded index: %s"), err)
+	}
+	if err := out.Close(); err != nil {
+		return fmt.Errorf(tr("creating index file: %s"), err)
+	}
+	return nil
+}
+
+// indexRoundTripper adds to the index requests the conditional headers and the
+// support for the gzip encoding
+type indexRoundTripper struct {
+	transport  http.RoundTripper
+	validators *IndexValidators
+}
+
+func (t *indexRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("Accept-Encoding", "gzip")
+	if t.validators != nil {
+		if t.validators.ETag != "" {
+			req.Header.Set("If-None-Match", t.validators.ETag)
+		}
+		if t.validators.LastModified != "" {
+			req.Header.Set("If-Modified-Since", t.validators.LastModified)
+		}
+	}
+	transport := t.transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return transport.RoundTrip(req)
+}
@@ -0,0 +1,150 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package resources
+
+import (
+	"bytes"
+	"compress/gzip"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+	"go.bug.st/downloader/v2"
+)
+
+func TestIndexFileName(t *testing.T) {
+	for u, name := range map[string]string{
+		"https://example.com/package_index.json":         "package_index.json",
+		"https://example.com/package_index.json.gz":      "package_index.json",
+		"https://example.com/package_index.json.bz2":     "package_index.json",
+		"https://example.com/boards/package_index.json?": "package_index.json",
+		"https://example.com/.gz":                        ".gz",
+	} {
+		URL, err := url.Parse(u)
+		require.NoError(t, err)
+		require.Equal(t, name, IndexFileName(URL), u)
+	}
+}
+
+func TestDownloadIndexConditional(t *testing.T) {
+	index, err := paths.New("testdata", "package_test_index.json").ReadFile()
+	require.NoError(t, err)
+	lastModified := time.Date(2021, 10, 4, 12, 0, 0, 0, time.UTC)
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("ETag", `"v1"`)
+		http.ServeContent(w, r, "package_test_index.json", lastModified, bytes.NewReader(index))
+	}))
+	defer srv.Close()
+
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	URL, err := url.Parse(srv.URL + "/package_test_index.json")
+	require.NoError(t, err)
+	config := &downloader.Config{HttpClient: *http.DefaultClient}
+	download := func(validators *IndexValidators) *downloader.Downloader {
+		d, err := DownloadIndex(tmp.Join("download"), URL, validators, config)
+		require.NoError(t, err)
+		return d
+	}
+
+	// The first download gets the index and its validators
+	d := download(nil)
+	require.False(t, IndexNotModified(d))
+	require.NoError(t, d.Run())
+	require.NoError(t, DecompressIndex(d, tmp.Join("download"), tmp.Join("package_test_index.json")))
+	data, err := tmp.Join("package_test_index.json").ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, index, data)
+	validators := IndexValidatorsFromResponse(d.Resp)
+	require.Equal(t, `"v1"`, validators.ETag)
+	require.Equal(t, lastModified.Format(http.TimeFormat), validators.LastModified)
+
+	// The validators are saved and loaded back
+	require.NoError(t, validators.Save(tmp.Join("validators.json")))
+	validators, err = LoadIndexValidators(tmp.Join("validators.json"))
+	require.NoError(t, err)
+
+	// Unchanged index, with both validators or only one of them
+	require.True(t, IndexNotModified(download(validators)))
+	require.True(t, IndexNotModified(download(&IndexValidators{ETag: validators.ETag})))
+	require.True(t, IndexNotModified(download(&IndexValidators{LastModified: validators.LastModified})))
+
+	// Changed index
+	require.False(t, IndexNotModified(download(&IndexValidators{ETag: `"v0"`})))
+	require.False(t, IndexNotModified(download(&IndexValidators{LastModified: lastModified.Add(-time.Hour).Format(http.TimeFormat)})))
+	require.Equal(t, 6, requests)
+}
+
+func TestDownloadIndexCompressed(t *testing.T) {
+	index, err := paths.New("testdata", "package_test_index.json").ReadFile()
+	require.NoError(t, err)
+	indexBz2, err := paths.New("testdata", "package_test_index.json.bz2").ReadFile()
+	require.NoError(t, err)
+	gzipped := func(data []byte) []byte {
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		_, err := w.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		return buf.Bytes()
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/package_test_index.json":
+			// The index is sent gzip encoded only if the client supports it
+			if strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
+				w.Header().Set("Content-Encoding", "gzip")
+				w.Write(gzipped(index))
+			} else {
+				w.Write(index)
+			}
+		case "/package_test_index.json.gz":
+			w.Write(gzipped(index))
+		case "/package_test_index.json.bz2":
+			w.Write(indexBz2)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	config := &downloader.Config{HttpClient: *http.DefaultClient}
+
+	for _, name := range []string{"package_test_index.json", "package_test_index.json.gz", "package_test_index.json.bz2"} {
+		URL, err := url.Parse(srv.URL + "/" + name)
+		require.NoError(t, err)
+		d, err := DownloadIndex(tmp.Join(name), URL, nil, config)
+		require.NoError(t, err)
+		require.NoError(t, d.Run())
+		dest := tmp.Join(IndexFileName(URL) + ".decompressed")
+		require.NoError(t, DecompressIndex(d, tmp.Join(name), dest))
+		data, err := dest.ReadFile()
+		require.NoError(t, err)
+		require.Equal(t, index, data, name)
+	}
+}
@@ -16,11 +16,14 @@
 package security
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/arduino/go-paths-helper"
 	rice "github.com/cmaglie/go.rice"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/armor"
 )
 
 var (
@@ -78,3 +81,47 @@ func TestVerifySignature(t *testing.T) {
 	require.Nil(t, signer)
 	require.Error(t, err)
 }
+
+func TestVerifyDetachedSignatureWithKeys(t *testing.T) {
+	// The bundled Arduino keys are always trusted
+	res, signer, err := VerifyDetachedSignatureWithKeys(PackageIndexPath, PackageSignaturePath, nil)
+	require.NoError(t, err)
+	require.True(t, res)
+	require.Equal(t, uint64(0x7baf404c2dfab4ae), signer.PrimaryKey.KeyId)
+
+	res, _, err = VerifyDetachedSignatureWithKeys(ModuleFWIndexPath, ModuleFWSignaturePath, nil)
+	require.Error(t, err)
+	require.False(t, res)
+
+	res, signer, err = VerifyDetachedSignatureWithKeys(ModuleFWIndexPath, ModuleFWSignaturePath, paths.NewPathList(ModuleFWIndexKey.String()))
+	require.NoError(t, err)
+	require.True(t, res)
+	require.Equal(t, uint64(0x82f2d7c7c5a22a73), signer.PrimaryKey.KeyId)
+
+	res, _, err = VerifyDetachedSignatureWithKeys(ModuleFWIndexPath, ModuleFWSignaturePath, paths.NewPathList("testdata/missing.gpg.key"))
+	require.Error(t, err)
+	require.False(t, res)
+}
+
+func TestLoadKeyRing(t *testing.T) {
+	keyRing, err := LoadKeyRing(ModuleFWIndexKey)
+	require.NoError(t, err)
+	require.Len(t, keyRing, 1)
+	require.Equal(t, uint64(0x82f2d7c7c5a22a73), keyRing[0].PrimaryKey.KeyId)
+
+	// The same key in the armored format
+	armored := new(bytes.Buffer)
+	w, err := armor.Encode(armored, openpgp.PublicKeyType, nil)
+	require.NoError(t, err)
+	require.NoError(t, keyRing[0].Serialize(w))
+	require.NoError(t, w.Close())
+	armoredKey := paths.New(t.TempDir(), "key.asc")
+	require.NoError(t, armoredKey.WriteFile(armored.Bytes()))
+	keyRing, err = LoadKeyRing(armoredKey)
+	require.NoError(t, err)
+	require.Len(t, keyRing, 1)
+	require.Equal(t, uint64(0x82f2d7c7c5a22a73), keyRing[0].PrimaryKey.KeyId)
+
+	_, err = LoadKeyRing(PackageIndexPath)
+	require.Error(t, err)
+}
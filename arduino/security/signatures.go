@@ -16,6 +16,7 @@
 package security
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 	"os"
@@ -71,6 +72,59 @@ func VerifySignature(targetPath *paths.Path, signaturePath *paths.Path, arduinoK
 	if err != nil {
 		return false, nil, fmt.Errorf(tr("retrieving Arduino public keys: %s"), err)
 	}
+	return checkDetachedSignature(targetPath, signaturePath, keyRing)
+}
+
+// VerifyDetachedSignatureWithKeys checks that the detached GPG signature (in the
+// signaturePath file) matches the given targetPath file and is an authentic
+// signature from the bundled trusted keychain or from one of the public keys
+// contained in the keyPaths files, armored or binary.
+// If any of the above conditions fails this function returns false.
+// The PGP entity that produced the signature is returned too.
+func VerifyDetachedSignatureWithKeys(targetPath *paths.Path, signaturePath *paths.Path, keyPaths paths.PathList) (bool, *openpgp.Entity, error) {
+	keysBox, err := rice.FindBox("keys")
+	if err != nil {
+		panic("could not find bundled signature keys")
+	}
+	arduinoKeyringFile, err := keysBox.Open("arduino_public.gpg.key")
+	if err != nil {
+		panic("could not find bundled signature keys")
+	}
+	defer arduinoKeyringFile.Close()
+	keyRing, err := openpgp.ReadKeyRing(arduinoKeyringFile)
+	if err != nil {
+		return false, nil, fmt.Errorf(tr("retrieving Arduino public keys: %s"), err)
+	}
+	for _, keyPath := range keyPaths {
+		keys, err := LoadKeyRing(keyPath)
+		if err != nil {
+			return false, nil, err
+		}
+		keyRing = append(keyRing, keys...)
+	}
+	return checkDetachedSignature(targetPath, signaturePath, keyRing)
+}
+
+// LoadKeyRing reads the OpenPGP public keys contained in the given file, that
+// may be armored or binary
+func LoadKeyRing(keyPath *paths.Path) (openpgp.EntityList, error) {
+	data, err := keyPath.ReadFile()
+	if err != nil {
+		return nil, fmt.Errorf(tr("reading public key %[1]s: %[2]s"), keyPath, err)
+	}
+	var keyRing openpgp.EntityList
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("-----BEGIN")) {
+		keyRing, err = openpgp.ReadArmoredKeyRing(bytes.NewReader(data))
+	} else {
+		keyRing, err = openpgp.ReadKeyRing(bytes.NewReader(data))
+	}
+	if err != nil {
+		return nil, fmt.Errorf(tr("reading public key %[1]s: %[2]s"), keyPath, err)
+	}
+	return keyRing, nil
+}
+
+func checkDetachedSignature(targetPath *paths.Path, signaturePath *paths.Path, keyRing openpgp.KeyRing) (bool, *openpgp.Entity, error) {
 	target, err := targetPath.Open()
 	if err != nil {
 		return false, nil, fmt.Errorf(tr("opening target file: %s"), err)
@@ -0,0 +1,225 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sizereport
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+)
+
+// Comparison contains the differences of memory usage between a baseline
+// Report and the current one
+type Comparison struct {
+	BaselineFlashSize int64
+	FlashSize         int64
+	BaselineRAMSize   int64
+	RAMSize           int64
+	Libraries         []*Delta
+	Symbols           []*Delta
+}
+
+// Delta is the change of memory usage of a library or a symbol. For the
+// symbols the Library field contains the library they belong to.
+type Delta struct {
+	Name          string
+	Library       string
+	BaselineFlash int64
+	Flash         int64
+	BaselineRAM   int64
+	RAM           int64
+}
+
+// FlashDelta returns the growth of the flash usage
+func (d *Delta) FlashDelta() int64 {
+	return d.Flash - d.BaselineFlash
+}
+
+// RAMDelta returns the growth of the RAM usage
+func (d *Delta) RAMDelta() int64 {
+	return d.RAM - d.BaselineRAM
+}
+
+// Compare returns the differences between the baseline and the current
+// report. Only the libraries and the symbols whose usage changed are reported,
+// sorted by the size of the change. The symbols are identified by name and
+// library, so the comparison is accurate only if both reports contain all the
+// symbols.
+func Compare(baseline, current *Report) *Comparison {
+	res := &Comparison{
+		BaselineFlashSize: baseline.FlashSize,
+		FlashSize:         current.FlashSize,
+		BaselineRAMSize:   baseline.RAMSize,
+		RAMSize:           current.RAMSize,
+	}
+
+	libraries := newDeltas()
+	for _, l := range baseline.Libraries {
+		d := libraries.get(l.Name, "")
+		d.BaselineFlash += l.Flash
+		d.BaselineRAM += l.RAM
+	}
+	for _, l := range current.Libraries {
+		d := libraries.get(l.Name, "")
+		d.Flash += l.Flash
+		d.RAM += l.RAM
+	}
+	res.Libraries = libraries.changed()
+
+	symbols := newDeltas()
+	for _, s := range baseline.Symbols {
+		d := symbols.get(s.Name, s.Library)
+		d.BaselineFlash += s.Flash
+		d.BaselineRAM += s.RAM
+	}
+	for _, s := range current.Symbols {
+		d := symbols.get(s.Name, s.Library)
+		d.Flash += s.Flash
+		d.RAM += s.RAM
+	}
+	res.Symbols = symbols.changed()
+	return res
+}
+
+type deltas struct {
+	byKey map[string]*Delta
+	list  []*Delta
+}
+
+func newDeltas() *deltas {
+	return &deltas{byKey: map[string]*Delta{}}
+}
+
+func (ds *deltas) get(name, library string) *Delta {
+	key := library + "\x00" + name
+	d, ok := ds.byKey[key]
+	if !ok {
+		d = &Delta{Name: name, Library: library}
+		ds.byKey[key] = d
+		ds.list = append(ds.list, d)
+	}
+	return d
+}
+
+func (ds *deltas) changed() []*Delta {
+	res := []*Delta{}
+	for _, d := range ds.list {
+		if d.FlashDelta() != 0 || d.RAMDelta() != 0 {
+			res = append(res, d)
+		}
+	}
+	abs := func(n int64) int64 {
+		if n < 0 {
+			return -n
+		}
+		return n
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		a := abs(res[i].FlashDelta()) + abs(res[i].RAMDelta())
+		b := abs(res[j].FlashDelta()) + abs(res[j].RAMDelta())
+		if a != b {
+			return a > b
+		}
+		if res[i].Library != res[j].Library {
+			return res[i].Library < res[j].Library
+		}
+		return res[i].Name < res[j].Name
+	})
+	return res
+}
+
+// Threshold is the maximum growth of memory usage allowed, expressed in bytes
+// or as a percentage of the baseline usage
+type Threshold struct {
+	Bytes   int64
+	Percent float64
+}
+
+// ParseThreshold parses a threshold expressed in bytes (e.g. "512") or as a
+// percentage (e.g. "1.5%")
+func ParseThreshold(threshold string) (*Threshold, error) {
+	s := strings.TrimSpace(threshold)
+	if strings.HasSuffix(s, "%") {
+		percent, err := strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(s, "%")), 64)
+		if err != nil || percent < 0 {
+			return nil, fmt.Errorf(tr("invalid threshold: %s"), threshold)
+		}
+		return &Threshold{Percent: percent}, nil
+	}
+	bytes, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || bytes < 0 {
+		return nil, fmt.Errorf(tr("invalid threshold: %s"), threshold)
+	}
+	return &Threshold{Bytes: bytes}, nil
+}
+
+func (t *Threshold) String() string {
+	if t.Bytes == 0 && t.Percent != 0 {
+		return strconv.FormatFloat(t.Percent, 'f', -1, 64) + "%"
+	}
+	return strconv.FormatInt(t.Bytes, 10)
+}
+
+// IsExceededBy returns true if the growth from baseline to current exceeds the threshold
+func (t *Threshold) IsExceededBy(baseline, current int64) bool {
+	growth := current - baseline
+	if t.Percent != 0 && baseline > 0 {
+		return float64(growth)*100/float64(baseline) > t.Percent
+	}
+	return growth > t.Bytes
+}
+
+// ExceededBy returns the names of the memories, "flash" or "RAM", whose growth
+// exceeds the threshold
+func (c *Comparison) ExceededBy(t *Threshold) []string {
+	res := []string{}
+	if t.IsExceededBy(c.BaselineFlashSize, c.FlashSize) {
+		res = append(res, "flash")
+	}
+	if t.IsExceededBy(c.BaselineRAMSize, c.RAMSize) {
+		res = append(res, "RAM")
+	}
+	return res
+}
+
+// ToRPC converts the Comparison into a *rpc.SizeComparison
+func (c *Comparison) ToRPC() *rpc.SizeComparison {
+	toRPCDeltas := func(deltas []*Delta) []*rpc.SizeDelta {
+		res := []*rpc.SizeDelta{}
+		for _, d := range deltas {
+			res = append(res, &rpc.SizeDelta{
+				Name:          d.Name,
+				Library:       d.Library,
+				BaselineFlash: d.BaselineFlash,
+				Flash:         d.Flash,
+				BaselineRam:   d.BaselineRAM,
+				Ram:           d.RAM,
+			})
+		}
+		return res
+	}
+	return &rpc.SizeComparison{
+		BaselineFlashSize: c.BaselineFlashSize,
+		FlashSize:         c.FlashSize,
+		BaselineRamSize:   c.BaselineRAMSize,
+		RamSize:           c.RAMSize,
+		Libraries:         toRPCDeltas(c.Libraries),
+		Symbols:           toRPCDeltas(c.Symbols),
+	}
+}
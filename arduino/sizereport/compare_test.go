@@ -0,0 +1,120 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sizereport
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompare(t *testing.T) {
+	baseline := &Report{
+		FlashSize: 1000,
+		RAMSize:   100,
+		Libraries: []*Usage{
+			{Name: "sketch", Flash: 300, RAM: 50},
+			{Name: "core", Flash: 600, RAM: 40},
+			{Name: "Servo", Flash: 100, RAM: 10},
+		},
+		Symbols: []*Symbol{
+			{Name: "setup", Library: "sketch", Flash: 200},
+			{Name: "buffer", Library: "sketch", RAM: 50},
+			{Name: "loop", Library: "sketch", Flash: 100},
+			{Name: "init", Library: "core", Flash: 600, RAM: 40},
+			{Name: "attach", Library: "Servo", Flash: 100, RAM: 10},
+		},
+	}
+	current := &Report{
+		FlashSize: 1150,
+		RAMSize:   90,
+		Libraries: []*Usage{
+			{Name: "sketch", Flash: 450, RAM: 50},
+			{Name: "core", Flash: 600, RAM: 40},
+			{Name: "Wire", Flash: 100},
+		},
+		Symbols: []*Symbol{
+			{Name: "setup", Library: "sketch", Flash: 350},
+			{Name: "buffer", Library: "sketch", RAM: 50},
+			{Name: "loop", Library: "sketch", Flash: 100},
+			{Name: "init", Library: "core", Flash: 600, RAM: 40},
+			// Symbols with the same name in different libraries are not mixed up
+			{Name: "attach", Library: "Wire", Flash: 100},
+		},
+	}
+
+	c := Compare(baseline, current)
+	require.Equal(t, int64(1000), c.BaselineFlashSize)
+	require.Equal(t, int64(1150), c.FlashSize)
+	require.Equal(t, int64(100), c.BaselineRAMSize)
+	require.Equal(t, int64(90), c.RAMSize)
+	require.Equal(t, []*Delta{
+		{Name: "sketch", BaselineFlash: 300, Flash: 450, BaselineRAM: 50, RAM: 50},
+		{Name: "Servo", BaselineFlash: 100, BaselineRAM: 10},
+		{Name: "Wire", Flash: 100},
+	}, c.Libraries)
+	require.Equal(t, []*Delta{
+		{Name: "setup", Library: "sketch", BaselineFlash: 200, Flash: 350},
+		{Name: "attach", Library: "Servo", BaselineFlash: 100, BaselineRAM: 10},
+		{Name: "attach", Library: "Wire", Flash: 100},
+	}, c.Symbols)
+	require.Equal(t, int64(150), c.Symbols[0].FlashDelta())
+	require.Equal(t, int64(-10), c.Symbols[1].RAMDelta())
+
+	require.Empty(t, c.ExceededBy(&Threshold{Bytes: 150}))
+	require.Equal(t, []string{"flash"}, c.ExceededBy(&Threshold{Bytes: 149}))
+	require.Equal(t, []string{"flash"}, c.ExceededBy(&Threshold{Percent: 10}))
+	require.Empty(t, c.ExceededBy(&Threshold{Percent: 15}))
+
+	rpcComparison := c.ToRPC()
+	require.Equal(t, int64(1150), rpcComparison.GetFlashSize())
+	require.Len(t, rpcComparison.GetLibraries(), 3)
+	require.Equal(t, "Servo", rpcComparison.GetSymbols()[1].GetLibrary())
+
+	// Comparing a report with itself gives no differences
+	c = Compare(FromRPC(current.ToRPC()), current)
+	require.Empty(t, c.Libraries)
+	require.Empty(t, c.Symbols)
+	require.Empty(t, c.ExceededBy(&Threshold{}))
+}
+
+func TestParseThreshold(t *testing.T) {
+	threshold, err := ParseThreshold("512")
+	require.NoError(t, err)
+	require.Equal(t, &Threshold{Bytes: 512}, threshold)
+	require.Equal(t, "512", threshold.String())
+	require.False(t, threshold.IsExceededBy(1000, 1512))
+	require.True(t, threshold.IsExceededBy(1000, 1513))
+
+	threshold, err = ParseThreshold(" 1.5% ")
+	require.NoError(t, err)
+	require.Equal(t, &Threshold{Percent: 1.5}, threshold)
+	require.Equal(t, "1.5%", threshold.String())
+	require.False(t, threshold.IsExceededBy(1000, 1015))
+	require.True(t, threshold.IsExceededBy(1000, 1016))
+	// Any growth from an empty baseline exceeds the threshold
+	require.True(t, threshold.IsExceededBy(0, 1))
+
+	threshold, err = ParseThreshold("0")
+	require.NoError(t, err)
+	require.False(t, threshold.IsExceededBy(1000, 1000))
+	require.True(t, threshold.IsExceededBy(1000, 1001))
+
+	for _, invalid := range []string{"", "abc", "-1", "-5%", "%", "10KB"} {
+		_, err := ParseThreshold(invalid)
+		require.Error(t, err, invalid)
+	}
+}
@@ -0,0 +1,151 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sizereport
+
+import (
+	"debug/dwarf"
+	"debug/elf"
+	"path/filepath"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+)
+
+type sourceFile struct {
+	name    string
+	library string
+}
+
+type debugEntry struct {
+	file   string
+	origin dwarf.Offset
+}
+
+// loadSourceFiles uses the debug information of the executable to find the
+// source files declaring the functions and the variables, the result maps their
+// addresses to the source files. The source files are named relative to the
+// source folder containing them.
+func loadSourceFiles(f *elf.File, sourceDirs []*SourceDir) map[uint64]*sourceFile {
+	res := map[uint64]*sourceFile{}
+	data, err := f.DWARF()
+	if err != nil {
+		logrus.WithError(err).Info("No debug information available to attribute symbols")
+		return res
+	}
+
+	// The definitions often refer to the declaration, through DW_AT_specification,
+	// or to the abstract instance, through DW_AT_abstract_origin, that contain the
+	// source file: the entries are collected first and resolved later.
+	entries := map[dwarf.Offset]*debugEntry{}
+	addresses := map[uint64]dwarf.Offset{}
+	reader := data.Reader()
+	var files []*dwarf.LineFile
+	for {
+		entry, err := reader.Next()
+		if err != nil {
+			logrus.WithError(err).Warn("Error reading debug information")
+			break
+		}
+		if entry == nil {
+			break
+		}
+		switch entry.Tag {
+		case dwarf.TagCompileUnit, dwarf.TagPartialUnit:
+			files = nil
+			if lineReader, err := data.LineReader(entry); err == nil && lineReader != nil {
+				files = lineReader.Files()
+			}
+			continue
+		case dwarf.TagSubprogram, dwarf.TagVariable:
+		default:
+			continue
+		}
+
+		debugEntry := &debugEntry{}
+		if index, ok := entry.Val(dwarf.AttrDeclFile).(int64); ok && index >= 0 && int(index) < len(files) && files[index] != nil {
+			debugEntry.file = files[index].Name
+		}
+		if origin, ok := entry.Val(dwarf.AttrAbstractOrigin).(dwarf.Offset); ok {
+			debugEntry.origin = origin
+		} else if origin, ok := entry.Val(dwarf.AttrSpecification).(dwarf.Offset); ok {
+			debugEntry.origin = origin
+		}
+		entries[entry.Offset] = debugEntry
+
+		if entry.Tag == dwarf.TagSubprogram {
+			if address, ok := entry.Val(dwarf.AttrLowpc).(uint64); ok {
+				addresses[address] = entry.Offset
+			}
+		} else if location, ok := entry.Val(dwarf.AttrLocation).([]byte); ok {
+			if address, ok := staticAddress(location, f); ok {
+				addresses[address] = entry.Offset
+			}
+		}
+		if entry.Tag == dwarf.TagSubprogram && entry.Children {
+			// The local variables are not interesting
+			reader.SkipChildren()
+		}
+	}
+
+	for address, offset := range addresses {
+		file := ""
+		// Follow the chain of references, with a limit to avoid loops
+		for i := 0; i < 8 && file == "" && offset != 0; i++ {
+			entry, ok := entries[offset]
+			if !ok {
+				break
+			}
+			file, offset = entry.file, entry.origin
+		}
+		if file == "" {
+			continue
+		}
+		res[address] = toSourceFile(file, sourceDirs)
+	}
+	return res
+}
+
+// staticAddress returns the address of a variable allocated statically, its
+// location is a single DW_OP_addr operation
+func staticAddress(location []byte, f *elf.File) (uint64, bool) {
+	const opAddr = 0x03
+	if len(location) == 0 || location[0] != opAddr {
+		return 0, false
+	}
+	switch len(location) {
+	case 5:
+		return uint64(f.ByteOrder.Uint32(location[1:])), true
+	case 9:
+		return f.ByteOrder.Uint64(location[1:]), true
+	case 3:
+		return uint64(f.ByteOrder.Uint16(location[1:])), true
+	}
+	return 0, false
+}
+
+func toSourceFile(file string, sourceDirs []*SourceDir) *sourceFile {
+	path := paths.New(filepath.FromSlash(file))
+	for _, dir := range sourceDirs {
+		if inside, err := path.IsInsideDir(dir.Path); err != nil || !inside {
+			continue
+		}
+		if rel, err := dir.Path.RelTo(path); err == nil {
+			// Use the slash as separator to obtain the same report on every OS
+			return &sourceFile{name: filepath.ToSlash(rel.String()), library: dir.Library}
+		}
+	}
+	return &sourceFile{name: filepath.ToSlash(file)}
+}
@@ -0,0 +1,173 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sizereport
+
+import (
+	"bytes"
+	"debug/elf"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+type definition struct {
+	object  string
+	library string
+	size    uint64
+	bind    elf.SymBind
+}
+
+// definitions maps the names of the symbols to the object files defining them
+type definitions map[string][]*definition
+
+// loadDefinitions reads the symbols defined in the given object files. Errors
+// are logged and the unreadable files are skipped, their symbols will be
+// attributed through the debug information if possible.
+func loadDefinitions(objects []*ObjectFile) definitions {
+	res := definitions{}
+	for _, object := range objects {
+		data, err := object.Path.ReadFile()
+		if err != nil {
+			logrus.WithError(err).Warnf("Error reading object file %s", object.Path)
+			continue
+		}
+		if !bytes.HasPrefix(data, []byte(arMagic)) {
+			res.add(data, object.Name, object.Library)
+			continue
+		}
+		members, err := readArchive(data)
+		if err != nil {
+			logrus.WithError(err).Warnf("Error reading archive %s", object.Path)
+			continue
+		}
+		for _, member := range members {
+			res.add(member.data, object.Name+"("+member.name+")", object.Library)
+		}
+	}
+	return res
+}
+
+func (defs definitions) add(data []byte, object, library string) {
+	f, err := elf.NewFile(bytes.NewReader(data))
+	if err != nil {
+		// Not an ELF object file
+		return
+	}
+	defer f.Close()
+	symbols, err := f.Symbols()
+	if err != nil {
+		return
+	}
+	for _, s := range symbols {
+		typ := elf.ST_TYPE(s.Info)
+		if s.Section == elf.SHN_UNDEF || (typ != elf.STT_FUNC && typ != elf.STT_OBJECT) {
+			continue
+		}
+		defs[s.Name] = append(defs[s.Name], &definition{
+			object:  object,
+			library: library,
+			size:    s.Size,
+			bind:    elf.ST_BIND(s.Info),
+		})
+	}
+}
+
+// find returns the definition of the given symbol of the executable. If the
+// symbol is defined in many object files, like the static functions with the
+// same name, the one with the same size and binding is preferred.
+func (defs definitions) find(s elf.Symbol) *definition {
+	candidates := defs[s.Name]
+	if len(candidates) == 0 {
+		return nil
+	}
+	for _, c := range candidates {
+		if c.size == s.Size && c.bind == elf.ST_BIND(s.Info) {
+			return c
+		}
+	}
+	for _, c := range candidates {
+		if c.bind == elf.ST_BIND(s.Info) {
+			return c
+		}
+	}
+	return candidates[0]
+}
+
+const arMagic = "!<arch>\n"
+
+type archiveMember struct {
+	name string
+	data []byte
+}
+
+// readArchive returns the members of an ar archive, the GNU and BSD variants
+// of the long file names are supported.
+func readArchive(data []byte) ([]*archiveMember, error) {
+	res := []*archiveMember{}
+	var longNames []byte
+	data = data[len(arMagic):]
+	for len(data) > 0 {
+		if len(data) < 60 {
+			return nil, errors.New("truncated archive header")
+		}
+		header := data[:60]
+		data = data[60:]
+		name := strings.TrimRight(string(header[0:16]), " ")
+		size, err := strconv.Atoi(strings.TrimSpace(string(header[48:58])))
+		if err != nil || size < 0 || size > len(data) {
+			return nil, errors.New("invalid archive member size")
+		}
+		content := data[:size]
+		// Members are aligned to even offsets
+		if size%2 == 1 && size < len(data) {
+			size++
+		}
+		data = data[size:]
+
+		switch {
+		case name == "/" || name == "/SYM64/" || strings.HasPrefix(name, "__.SYMDEF"):
+			// Symbols table
+			continue
+		case name == "//":
+			longNames = content
+			continue
+		case strings.HasPrefix(name, "#1/"):
+			// BSD: the name is at the beginning of the content
+			n, err := strconv.Atoi(name[3:])
+			if err != nil || n > len(content) {
+				return nil, errors.New("invalid archive member name")
+			}
+			name = strings.TrimRight(string(content[:n]), "\x00")
+			content = content[n:]
+		case strings.HasPrefix(name, "/"):
+			// GNU: the name is in the long names table
+			offset, err := strconv.Atoi(name[1:])
+			if err != nil || offset > len(longNames) {
+				return nil, errors.New("invalid archive member name")
+			}
+			name = string(longNames[offset:])
+			if end := strings.Index(name, "/\n"); end != -1 {
+				name = name[:end]
+			}
+		default:
+			name = strings.TrimSuffix(name, "/")
+		}
+		res = append(res, &archiveMember{name: name, data: content})
+	}
+	return res, nil
+}
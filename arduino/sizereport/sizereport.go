@@ -0,0 +1,306 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sizereport
+
+import (
+	"debug/elf"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/i18n"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+)
+
+var tr = i18n.Tr
+
+// Report is the detailed memory usage of an executable. The flash and RAM
+// usage is estimated from the flags of the ELF sections: read-only sections are
+// stored in flash, writable sections with initial values are stored both in
+// flash and in RAM, zero-initialized sections are allocated in RAM only.
+type Report struct {
+	FlashSize    int64      `json:"flash_size"`
+	RAMSize      int64      `json:"ram_size"`
+	MaxFlashSize int64      `json:"max_flash_size,omitempty"`
+	MaxRAMSize   int64      `json:"max_ram_size,omitempty"`
+	Sections     []*Section `json:"sections"`
+	Libraries    []*Usage   `json:"libraries"`
+	Objects      []*Usage   `json:"objects"`
+	Symbols      []*Symbol  `json:"symbols"`
+}
+
+// Section is the memory usage of a section of the executable
+type Section struct {
+	Name    string `json:"name"`
+	Address uint64 `json:"address"`
+	Size    int64  `json:"size"`
+	Flash   int64  `json:"flash"`
+	RAM     int64  `json:"ram"`
+}
+
+// Usage is the memory used by the symbols of a library or an object file.
+// For the object files the Library field contains the library they belong to.
+type Usage struct {
+	Name    string `json:"name"`
+	Library string `json:"library,omitempty"`
+	Flash   int64  `json:"flash"`
+	RAM     int64  `json:"ram"`
+}
+
+// Symbol is the memory used by a function or a variable
+type Symbol struct {
+	Name    string `json:"name"`
+	Section string `json:"section"`
+	Object  string `json:"object,omitempty"`
+	Library string `json:"library,omitempty"`
+	Flash   int64  `json:"flash"`
+	RAM     int64  `json:"ram"`
+}
+
+// ObjectFile is an object file, or an archive of object files, linked in the
+// executable. The symbols defined in the object file are attributed to it.
+type ObjectFile struct {
+	Path    *paths.Path
+	Name    string
+	Library string
+}
+
+// SourceDir is a folder containing source files of a library. It's used to
+// attribute the symbols through the debug information when the object files
+// don't contain them, like in the builds with link time optimization.
+type SourceDir struct {
+	Path    *paths.Path
+	Library string
+}
+
+// Analyze computes the Report of the given ELF executable. The maxSymbols
+// biggest symbols are reported, all of them if maxSymbols is 0.
+func Analyze(elfFile *paths.Path, objects []*ObjectFile, sourceDirs []*SourceDir, maxSymbols int) (*Report, error) {
+	f, err := elf.Open(elfFile.String())
+	if err != nil {
+		return nil, fmt.Errorf(tr("reading ELF file %[1]s: %[2]s"), elfFile, err)
+	}
+	defer f.Close()
+
+	report := &Report{
+		Sections:  []*Section{},
+		Libraries: []*Usage{},
+		Objects:   []*Usage{},
+		Symbols:   []*Symbol{},
+	}
+	sectionsByIndex := map[elf.SectionIndex]*Section{}
+	for i, s := range f.Sections {
+		if s.Flags&elf.SHF_ALLOC == 0 || s.Size == 0 {
+			continue
+		}
+		section := &Section{Name: s.Name, Address: s.Addr, Size: int64(s.Size)}
+		switch {
+		case strings.HasPrefix(s.Name, ".eeprom"):
+			// The EEPROM contents are not part of the flash nor of the RAM
+		case s.Type == elf.SHT_NOBITS:
+			section.RAM = section.Size
+		case s.Flags&elf.SHF_WRITE != 0:
+			section.Flash = section.Size
+			section.RAM = section.Size
+		default:
+			section.Flash = section.Size
+		}
+		report.FlashSize += section.Flash
+		report.RAMSize += section.RAM
+		report.Sections = append(report.Sections, section)
+		sectionsByIndex[elf.SectionIndex(i)] = section
+	}
+	sort.SliceStable(report.Sections, func(i, j int) bool {
+		return report.Sections[i].Address < report.Sections[j].Address
+	})
+
+	symbols, err := f.Symbols()
+	if err != nil && err != elf.ErrNoSymbols {
+		return nil, fmt.Errorf(tr("reading symbols of %[1]s: %[2]s"), elfFile, err)
+	}
+
+	definitions := loadDefinitions(objects)
+	var sources map[uint64]*sourceFile
+	for _, s := range symbols {
+		typ := elf.ST_TYPE(s.Info)
+		if s.Size == 0 || (typ != elf.STT_FUNC && typ != elf.STT_OBJECT) {
+			continue
+		}
+		section, ok := sectionsByIndex[s.Section]
+		if !ok {
+			continue
+		}
+		symbol := &Symbol{Name: s.Name, Section: section.Name}
+		if section.Flash > 0 {
+			symbol.Flash = int64(s.Size)
+		}
+		if section.RAM > 0 {
+			symbol.RAM = int64(s.Size)
+		}
+		if def := definitions.find(s); def != nil {
+			symbol.Object = def.object
+			symbol.Library = def.library
+		} else {
+			// Fallback to the debug information, loaded only if needed
+			if sources == nil {
+				sources = loadSourceFiles(f, sourceDirs)
+			}
+			address := s.Value
+			if typ == elf.STT_FUNC && f.Machine == elf.EM_ARM {
+				// Remove the Thumb bit
+				address &^= 1
+			}
+			if source, ok := sources[address]; ok {
+				symbol.Object = source.name
+				symbol.Library = source.library
+			}
+		}
+		report.Symbols = append(report.Symbols, symbol)
+	}
+
+	libraries := map[string]*Usage{}
+	objectsUsage := map[string]*Usage{}
+	for _, symbol := range report.Symbols {
+		if symbol.Object == "" {
+			continue
+		}
+		object, ok := objectsUsage[symbol.Object]
+		if !ok {
+			object = &Usage{Name: symbol.Object, Library: symbol.Library}
+			objectsUsage[symbol.Object] = object
+			report.Objects = append(report.Objects, object)
+		}
+		object.Flash += symbol.Flash
+		object.RAM += symbol.RAM
+
+		if symbol.Library == "" {
+			continue
+		}
+		library, ok := libraries[symbol.Library]
+		if !ok {
+			library = &Usage{Name: symbol.Library}
+			libraries[symbol.Library] = library
+			report.Libraries = append(report.Libraries, library)
+		}
+		library.Flash += symbol.Flash
+		library.RAM += symbol.RAM
+	}
+
+	sortUsages(report.Libraries)
+	sortUsages(report.Objects)
+	sort.SliceStable(report.Symbols, func(i, j int) bool {
+		a, b := report.Symbols[i], report.Symbols[j]
+		if a.Flash+a.RAM != b.Flash+b.RAM {
+			return a.Flash+a.RAM > b.Flash+b.RAM
+		}
+		return a.Name < b.Name
+	})
+	if maxSymbols > 0 && len(report.Symbols) > maxSymbols {
+		report.Symbols = report.Symbols[:maxSymbols]
+	}
+	return report, nil
+}
+
+func sortUsages(usages []*Usage) {
+	sort.SliceStable(usages, func(i, j int) bool {
+		a, b := usages[i], usages[j]
+		if a.Flash+a.RAM != b.Flash+b.RAM {
+			return a.Flash+a.RAM > b.Flash+b.RAM
+		}
+		return a.Name < b.Name
+	})
+}
+
+// ToRPC converts the Report into a *rpc.SizeReport
+func (r *Report) ToRPC() *rpc.SizeReport {
+	toRPCUsages := func(usages []*Usage) []*rpc.SizeReportUsage {
+		res := []*rpc.SizeReportUsage{}
+		for _, u := range usages {
+			res = append(res, &rpc.SizeReportUsage{Name: u.Name, Library: u.Library, Flash: u.Flash, Ram: u.RAM})
+		}
+		return res
+	}
+	res := &rpc.SizeReport{
+		FlashSize:    r.FlashSize,
+		RamSize:      r.RAMSize,
+		MaxFlashSize: r.MaxFlashSize,
+		MaxRamSize:   r.MaxRAMSize,
+		Libraries:    toRPCUsages(r.Libraries),
+		Objects:      toRPCUsages(r.Objects),
+	}
+	for _, s := range r.Sections {
+		res.Sections = append(res.Sections, &rpc.SizeReportSection{
+			Name:    s.Name,
+			Address: s.Address,
+			Size:    s.Size,
+			Flash:   s.Flash,
+			Ram:     s.RAM,
+		})
+	}
+	for _, s := range r.Symbols {
+		res.Symbols = append(res.Symbols, &rpc.SizeReportSymbol{
+			Name:    s.Name,
+			Section: s.Section,
+			Object:  s.Object,
+			Library: s.Library,
+			Flash:   s.Flash,
+			Ram:     s.RAM,
+		})
+	}
+	return res
+}
+
+// FromRPC converts a *rpc.SizeReport into a Report
+func FromRPC(r *rpc.SizeReport) *Report {
+	fromRPCUsages := func(usages []*rpc.SizeReportUsage) []*Usage {
+		res := []*Usage{}
+		for _, u := range usages {
+			res = append(res, &Usage{Name: u.GetName(), Library: u.GetLibrary(), Flash: u.GetFlash(), RAM: u.GetRam()})
+		}
+		return res
+	}
+	res := &Report{
+		FlashSize:    r.GetFlashSize(),
+		RAMSize:      r.GetRamSize(),
+		MaxFlashSize: r.GetMaxFlashSize(),
+		MaxRAMSize:   r.GetMaxRamSize(),
+		Sections:     []*Section{},
+		Libraries:    fromRPCUsages(r.GetLibraries()),
+		Objects:      fromRPCUsages(r.GetObjects()),
+		Symbols:      []*Symbol{},
+	}
+	for _, s := range r.GetSections() {
+		res.Sections = append(res.Sections, &Section{
+			Name:    s.GetName(),
+			Address: s.GetAddress(),
+			Size:    s.GetSize(),
+			Flash:   s.GetFlash(),
+			RAM:     s.GetRam(),
+		})
+	}
+	for _, s := range r.GetSymbols() {
+		res.Symbols = append(res.Symbols, &Symbol{
+			Name:    s.GetName(),
+			Section: s.GetSection(),
+			Object:  s.GetObject(),
+			Library: s.GetLibrary(),
+			Flash:   s.GetFlash(),
+			RAM:     s.GetRam(),
+		})
+	}
+	return res
+}
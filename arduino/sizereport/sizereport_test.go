@@ -0,0 +1,145 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sizereport
+
+import (
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func findSymbol(t *testing.T, report *Report, name string) *Symbol {
+	for _, s := range report.Symbols {
+		if s.Name == name {
+			return s
+		}
+	}
+	require.FailNow(t, "symbol not found", name)
+	return nil
+}
+
+func TestAnalyzeWithObjectFiles(t *testing.T) {
+	testdata := paths.New("testdata")
+	objects := []*ObjectFile{
+		{Path: testdata.Join("main.o"), Name: "sketch/main.o", Library: "sketch"},
+		{Path: testdata.Join("libcounter.a"), Name: "libcounter.a", Library: "Counter"},
+	}
+	report, err := Analyze(testdata.Join("sketch.elf"), objects, nil, 0)
+	require.NoError(t, err)
+
+	var flash, ram int64
+	for _, s := range report.Sections {
+		flash += s.Flash
+		ram += s.RAM
+		switch s.Name {
+		case ".text":
+			require.Equal(t, s.Size, s.Flash)
+			require.Zero(t, s.RAM)
+		case ".data":
+			require.Equal(t, s.Size, s.Flash)
+			require.Equal(t, s.Size, s.RAM)
+		case ".bss":
+			require.Zero(t, s.Flash)
+			require.Equal(t, s.Size, s.RAM)
+		}
+	}
+	require.Equal(t, flash, report.FlashSize)
+	require.Equal(t, ram, report.RAMSize)
+
+	require.Equal(t, &Symbol{Name: "main", Section: ".text", Object: "sketch/main.o", Library: "sketch", Flash: 27}, findSymbol(t, report, "main"))
+	require.Equal(t, &Symbol{Name: "message", Section: ".rodata", Object: "sketch/main.o", Library: "sketch", Flash: 12}, findSymbol(t, report, "message"))
+	require.Equal(t, &Symbol{Name: "buffer", Section: ".bss", Object: "sketch/main.o", Library: "sketch", RAM: 64}, findSymbol(t, report, "buffer"))
+	require.Equal(t, &Symbol{Name: "increment", Section: ".text", Object: "libcounter.a(counter.o)", Library: "Counter", Flash: 16}, findSymbol(t, report, "increment"))
+	require.Equal(t, &Symbol{Name: "counter", Section: ".data", Object: "libcounter.a(counter.o)", Library: "Counter", Flash: 4, RAM: 4}, findSymbol(t, report, "counter"))
+	require.Equal(t, "_start", findSymbol(t, report, "_start").Name)
+	require.Empty(t, findSymbol(t, report, "_start").Library)
+
+	require.Equal(t, []*Usage{
+		{Name: "sketch", Flash: 39, RAM: 64},
+		{Name: "Counter", Flash: 20, RAM: 4},
+	}, report.Libraries)
+	require.Equal(t, []*Usage{
+		{Name: "sketch/main.o", Library: "sketch", Flash: 39, RAM: 64},
+		{Name: "libcounter.a(counter.o)", Library: "Counter", Flash: 20, RAM: 4},
+	}, report.Objects)
+
+	// Symbols are sorted by size
+	for i := 1; i < len(report.Symbols); i++ {
+		a, b := report.Symbols[i-1], report.Symbols[i]
+		require.GreaterOrEqual(t, a.Flash+a.RAM, b.Flash+b.RAM)
+	}
+
+	limited, err := Analyze(testdata.Join("sketch.elf"), objects, nil, 2)
+	require.NoError(t, err)
+	require.Equal(t, report.Symbols[:2], limited.Symbols)
+	require.Equal(t, report.Libraries, limited.Libraries)
+}
+
+func TestAnalyzeWithDebugInformation(t *testing.T) {
+	// The executable has been compiled with -fdebug-prefix-map to store the
+	// sources in the /sketch folder
+	sourceDirs := []*SourceDir{{Path: paths.New("/sketch"), Library: "sketch"}}
+	report, err := Analyze(paths.New("testdata", "sketch.elf"), nil, sourceDirs, 0)
+	require.NoError(t, err)
+
+	require.Equal(t, &Symbol{Name: "main", Section: ".text", Object: "main.c", Library: "sketch", Flash: 27}, findSymbol(t, report, "main"))
+	require.Equal(t, &Symbol{Name: "buffer", Section: ".bss", Object: "main.c", Library: "sketch", RAM: 64}, findSymbol(t, report, "buffer"))
+	require.Equal(t, &Symbol{Name: "counter", Section: ".data", Object: "counter.c", Library: "sketch", Flash: 4, RAM: 4}, findSymbol(t, report, "counter"))
+	require.Equal(t, []*Usage{{Name: "sketch", Flash: 59, RAM: 68}}, report.Libraries)
+
+	// The source files outside the source folders are not attributed to libraries
+	report, err = Analyze(paths.New("testdata", "sketch.elf"), nil, nil, 0)
+	require.NoError(t, err)
+	require.Equal(t, &Symbol{Name: "counter", Section: ".data", Object: "/sketch/counter.c", Flash: 4, RAM: 4}, findSymbol(t, report, "counter"))
+	require.Empty(t, report.Libraries)
+}
+
+func TestAnalyzeStrippedExecutable(t *testing.T) {
+	report, err := Analyze(paths.New("testdata", "sketch-stripped.elf"), nil, nil, 0)
+	require.NoError(t, err)
+	require.NotEmpty(t, report.Sections)
+	require.NotZero(t, report.FlashSize)
+	require.Empty(t, report.Symbols)
+
+	_, err = Analyze(paths.New("testdata", "main.c"), nil, nil, 0)
+	require.Error(t, err)
+}
+
+func TestReadArchive(t *testing.T) {
+	longName := "a_very_long_object_file_name.o"
+	data := []byte(arMagic +
+		"//                                              32        `\n" +
+		longName + "/\n" +
+		"/0              0           0     0     644     3         `\n" +
+		"abc\n" +
+		"short.o/        0           0     0     644     2         `\n" +
+		"de" +
+		"#1/8            0           0     0     644     10        `\n" +
+		"bsd.o\x00\x00\x00fg")
+	members, err := readArchive(data)
+	require.NoError(t, err)
+	require.Len(t, members, 3)
+	require.Equal(t, longName, members[0].name)
+	require.Equal(t, []byte("abc"), members[0].data)
+	require.Equal(t, "short.o", members[1].name)
+	require.Equal(t, []byte("de"), members[1].data)
+	require.Equal(t, "bsd.o", members[2].name)
+	require.Equal(t, []byte("fg"), members[2].data)
+
+	_, err = readArchive([]byte(arMagic + "truncated"))
+	require.Error(t, err)
+}
@@ -0,0 +1,5 @@
+int counter = 5;
+
+int increment(void) {
+  return counter++;
+}
@@ -0,0 +1,15 @@
+// Build with:
+// gcc -g -Os -fdebug-prefix-map=$PWD=/sketch -c main.c -o main.o
+// gcc -g -Os -fdebug-prefix-map=$PWD=/sketch -c counter.c -o counter.o
+// ar rcs libcounter.a counter.o
+// gcc -g -Os -fdebug-prefix-map=$PWD=/sketch main.o libcounter.a -o sketch.elf
+// strip -o sketch-stripped.elf sketch.elf
+int increment(void);
+
+char buffer[64];
+static const char message[] = "hello world";
+
+int main(void) {
+  buffer[0] = message[increment()];
+  return buffer[0];
+}
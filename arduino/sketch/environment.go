@@ -0,0 +1,40 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import "github.com/arduino/go-paths-helper"
+
+// EnvironmentDirName is the name of the folder, in the sketch root folder, that
+// contains the platforms and libraries of the sketch environment
+const EnvironmentDirName = ".arduino-env"
+
+// EnvironmentDir returns the path to the sketch environment folder
+func (s *Sketch) EnvironmentDir() *paths.Path {
+	return s.FullPath.Join(EnvironmentDirName)
+}
+
+// EnvironmentPackagesDir returns the path to the folder containing the platforms
+// and tools of the sketch environment, it has the same layout of the `packages`
+// folder in the Arduino data directory.
+func (s *Sketch) EnvironmentPackagesDir() *paths.Path {
+	return s.EnvironmentDir().Join("packages")
+}
+
+// EnvironmentLibrariesDir returns the path to the folder containing the libraries
+// of the sketch environment
+func (s *Sketch) EnvironmentLibrariesDir() *paths.Path {
+	return s.EnvironmentDir().Join("libraries")
+}
@@ -0,0 +1,42 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import (
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSketchWithEnvironment(t *testing.T) {
+	sketchPath := paths.New("testdata", "SketchWithEnvironment")
+	mainFilePath := sketchPath.Join("SketchWithEnvironment.ino")
+
+	sketch, err := New(sketchPath)
+	require.NoError(t, err)
+	require.NotNil(t, sketch)
+	require.True(t, mainFilePath.EquivalentTo(sketch.MainFile))
+	// The files of the environment are not part of the sketch
+	require.Equal(t, 0, sketch.OtherSketchFiles.Len())
+	require.Equal(t, 0, sketch.AdditionalFiles.Len())
+	require.Equal(t, 0, sketch.RootFolderFiles.Len())
+
+	require.True(t, sketchPath.Join(".arduino-env").EquivalentTo(sketch.EnvironmentDir()))
+	require.True(t, sketchPath.Join(".arduino-env", "packages").EquivalentTo(sketch.EnvironmentPackagesDir()))
+	require.True(t, sketchPath.Join(".arduino-env", "libraries").EquivalentTo(sketch.EnvironmentLibrariesDir()))
+	require.True(t, sketch.EnvironmentLibrariesDir().Join("EnvLib").IsDir())
+}
@@ -0,0 +1,97 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/arduino/go-paths-helper"
+	"gopkg.in/yaml.v2"
+)
+
+// BuildMatrix lists the boards and the build properties a sketch is built
+// with. The sketch is built for each board with each set of board options and
+// with each set of build properties.
+type BuildMatrix struct {
+	Boards          []*BuildMatrixBoard `yaml:"boards"`
+	BuildProperties [][]string          `yaml:"build_properties,omitempty"`
+}
+
+// BuildMatrixBoard is a board of a BuildMatrix. Each entry of Options is a set
+// of board options in the KEY=VALUE[,KEY=VALUE...] format, if there are no
+// Options the board is built with its default options.
+type BuildMatrixBoard struct {
+	Fqbn    string   `yaml:"fqbn"`
+	Options []string `yaml:"options,omitempty"`
+}
+
+// BuildMatrixTarget is a single build of a BuildMatrix
+type BuildMatrixTarget struct {
+	Fqbn            string
+	BuildProperties []string
+}
+
+// LoadBuildMatrix reads a BuildMatrix from the given file
+func LoadBuildMatrix(file *paths.Path) (*BuildMatrix, error) {
+	content, err := file.ReadFile()
+	if err != nil {
+		return nil, fmt.Errorf(tr("reading build matrix %[1]s: %[2]s"), file, err)
+	}
+	matrix := &BuildMatrix{}
+	if err := yaml.UnmarshalStrict(content, matrix); err != nil {
+		return nil, fmt.Errorf(tr("decoding build matrix %[1]s: %[2]s"), file, err)
+	}
+	if len(matrix.Boards) == 0 {
+		return nil, fmt.Errorf(tr("decoding build matrix %[1]s: %[2]s"), file, tr("no boards"))
+	}
+	for _, board := range matrix.Boards {
+		if board.Fqbn == "" {
+			return nil, fmt.Errorf(tr("decoding build matrix %[1]s: %[2]s"), file, tr("missing board FQBN"))
+		}
+	}
+	return matrix, nil
+}
+
+// Targets returns the builds of the matrix: the boards, with the board options
+// added to the FQBN, combined with the sets of build properties
+func (m *BuildMatrix) Targets() []*BuildMatrixTarget {
+	buildProperties := m.BuildProperties
+	if len(buildProperties) == 0 {
+		buildProperties = [][]string{{}}
+	}
+	res := []*BuildMatrixTarget{}
+	for _, board := range m.Boards {
+		fqbns := []string{}
+		for _, options := range board.Options {
+			if strings.Count(board.Fqbn, ":") > 2 {
+				// The FQBN already has some options
+				fqbns = append(fqbns, board.Fqbn+","+options)
+			} else {
+				fqbns = append(fqbns, board.Fqbn+":"+options)
+			}
+		}
+		if len(fqbns) == 0 {
+			fqbns = append(fqbns, board.Fqbn)
+		}
+		for _, fqbn := range fqbns {
+			for _, properties := range buildProperties {
+				res = append(res, &BuildMatrixTarget{Fqbn: fqbn, BuildProperties: properties})
+			}
+		}
+	}
+	return res
+}
@@ -0,0 +1,52 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import (
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildMatrix(t *testing.T) {
+	matrix, err := LoadBuildMatrix(paths.New("testdata", "matrix", "matrix.yaml"))
+	require.NoError(t, err)
+	require.Len(t, matrix.Boards, 3)
+
+	debug := []string{"build.extra_flags=-DDEBUG", "compiler.optimization_flags=-Og"}
+	require.Equal(t, []*BuildMatrixTarget{
+		{Fqbn: "arduino:avr:nano:cpu=atmega328", BuildProperties: []string{}},
+		{Fqbn: "arduino:avr:nano:cpu=atmega328", BuildProperties: debug},
+		{Fqbn: "arduino:avr:nano:cpu=atmega328old", BuildProperties: []string{}},
+		{Fqbn: "arduino:avr:nano:cpu=atmega328old", BuildProperties: debug},
+		{Fqbn: "arduino:samd:mkr1000", BuildProperties: []string{}},
+		{Fqbn: "arduino:samd:mkr1000", BuildProperties: debug},
+		{Fqbn: "esp32:esp32:esp32:PSRAM=enabled,FlashMode=dio", BuildProperties: []string{}},
+		{Fqbn: "esp32:esp32:esp32:PSRAM=enabled,FlashMode=dio", BuildProperties: debug},
+	}, matrix.Targets())
+
+	// Without build properties each board is built once
+	matrix.BuildProperties = nil
+	require.Len(t, matrix.Targets(), 4)
+
+	_, err = LoadBuildMatrix(paths.New("testdata", "matrix", "missing_fqbn.yaml"))
+	require.Error(t, err)
+	_, err = LoadBuildMatrix(paths.New("testdata", "matrix", "unknown_field.yaml"))
+	require.Error(t, err)
+	_, err = LoadBuildMatrix(paths.New("testdata", "matrix", "not_existing.yaml"))
+	require.Error(t, err)
+}
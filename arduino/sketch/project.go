@@ -0,0 +1,223 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/arduino/go-paths-helper"
+	"gopkg.in/yaml.v2"
+)
+
+// ProjectFileName is the name of the file, in the sketch root folder, that declares
+// the dependencies of the sketch
+const ProjectFileName = "sketch.yaml"
+
+// LockFileName is the name of the file, in the sketch root folder, that contains
+// the exact versions of the dependencies resolved from the project file
+const LockFileName = "sketch.lock"
+
+// Project declares the target board and the platforms and libraries needed to build a sketch
+type Project struct {
+	Fqbn      string                `yaml:"fqbn,omitempty"`
+	Platforms []*ProjectPlatformRef `yaml:"platforms,omitempty"`
+	Libraries []*ProjectLibraryRef  `yaml:"libraries,omitempty"`
+}
+
+// ProjectPlatformRef is a reference to a platform release required by the sketch.
+// If Version is empty the latest available release is used.
+type ProjectPlatformRef struct {
+	Platform string `yaml:"platform"`
+	Version  string `yaml:"version,omitempty"`
+	IndexURL string `yaml:"index_url,omitempty"`
+}
+
+// ProjectLibraryRef is a reference to a library release required by the sketch.
+// If Version is empty the latest available release is used.
+type ProjectLibraryRef struct {
+	Name    string `yaml:"name"`
+	Version string `yaml:"version,omitempty"`
+}
+
+// PlatformID returns the package and the architecture of the referenced platform
+func (p *ProjectPlatformRef) PlatformID() (string, string, error) {
+	split := strings.Split(p.Platform, ":")
+	if len(split) != 2 || split[0] == "" || split[1] == "" {
+		return "", "", fmt.Errorf(tr("invalid platform identifier: %s"), p.Platform)
+	}
+	return split[0], split[1], nil
+}
+
+func (p *ProjectPlatformRef) String() string {
+	if p.Version == "" {
+		return p.Platform
+	}
+	return p.Platform + "@" + p.Version
+}
+
+func (l *ProjectLibraryRef) String() string {
+	if l.Version == "" {
+		return l.Name
+	}
+	return l.Name + "@" + l.Version
+}
+
+// LoadProject reads the project file from the given sketch folder. A nil Project
+// without errors is returned if the sketch has no project file. Unknown keys and
+// invalid dependencies are reported as errors.
+func LoadProject(sketchPath *paths.Path) (*Project, error) {
+	return loadProject(sketchPath, true)
+}
+
+// loadProject reads the project file from the given sketch folder. If strict is
+// false the unknown keys, that may be added by other tools, are ignored and the
+// dependencies are not checked.
+func loadProject(sketchPath *paths.Path, strict bool) (*Project, error) {
+	projectFile := sketchPath.Join(ProjectFileName)
+	if projectFile.NotExist() {
+		return nil, nil
+	}
+	content, err := projectFile.ReadFile()
+	if err != nil {
+		return nil, fmt.Errorf(tr("reading sketch project file %[1]s: %[2]s"), projectFile, err)
+	}
+	project := &Project{}
+	if !strict {
+		if err := yaml.Unmarshal(content, project); err != nil {
+			return nil, fmt.Errorf(tr("decoding sketch project file %[1]s: %[2]s"), projectFile, err)
+		}
+		return project, nil
+	}
+	if err := yaml.UnmarshalStrict(content, project); err != nil {
+		return nil, fmt.Errorf(tr("decoding sketch project file %[1]s: %[2]s"), projectFile, err)
+	}
+	for _, platform := range project.Platforms {
+		if _, _, err := platform.PlatformID(); err != nil {
+			return nil, fmt.Errorf(tr("decoding sketch project file %[1]s: %[2]s"), projectFile, err)
+		}
+	}
+	for _, library := range project.Libraries {
+		if library.Name == "" {
+			return nil, fmt.Errorf(tr("decoding sketch project file %[1]s: %[2]s"), projectFile, tr("missing library name"))
+		}
+	}
+	return project, nil
+}
+
+// Lockfile contains the releases, resolved from a Project, that are installed by
+// `sketch install-deps`. Tools checksums are not recorded since they depend on
+// the host where the tools are installed.
+type Lockfile struct {
+	Platforms []*LockedPlatform `yaml:"platforms,omitempty"`
+	Libraries []*LockedLibrary  `yaml:"libraries,omitempty"`
+}
+
+// LockedPlatform is a platform release resolved from a ProjectPlatformRef
+type LockedPlatform struct {
+	Platform string        `yaml:"platform"`
+	Version  string        `yaml:"version"`
+	IndexURL string        `yaml:"index_url,omitempty"`
+	Checksum string        `yaml:"checksum,omitempty"`
+	Tools    []*LockedTool `yaml:"tools,omitempty"`
+}
+
+// LockedTool is a tool release required by a LockedPlatform
+type LockedTool struct {
+	Tool    string `yaml:"tool"`
+	Version string `yaml:"version"`
+}
+
+// LockedLibrary is a library release resolved from a ProjectLibraryRef or
+// from the dependencies of another library
+type LockedLibrary struct {
+	Name     string `yaml:"name"`
+	Version  string `yaml:"version"`
+	Checksum string `yaml:"checksum,omitempty"`
+}
+
+// LoadLockfile reads the lock file from the given sketch folder. A nil Lockfile
+// without errors is returned if the sketch has no lock file.
+func LoadLockfile(sketchPath *paths.Path) (*Lockfile, error) {
+	lockFile := sketchPath.Join(LockFileName)
+	if lockFile.NotExist() {
+		return nil, nil
+	}
+	content, err := lockFile.ReadFile()
+	if err != nil {
+		return nil, fmt.Errorf(tr("reading sketch lock file %[1]s: %[2]s"), lockFile, err)
+	}
+	lock := &Lockfile{}
+	if err := yaml.UnmarshalStrict(content, lock); err != nil {
+		return nil, fmt.Errorf(tr("decoding sketch lock file %[1]s: %[2]s"), lockFile, err)
+	}
+	return lock, nil
+}
+
+// Save writes the lock file in the given sketch folder
+func (l *Lockfile) Save(sketchPath *paths.Path) error {
+	d, err := yaml.Marshal(l)
+	if err != nil {
+		return fmt.Errorf(tr("encoding sketch lock file: %s"), err)
+	}
+	lockFile := sketchPath.Join(LockFileName)
+	if err := lockFile.WriteFile(d); err != nil {
+		return fmt.Errorf(tr("writing sketch lock file %[1]s: %[2]s"), lockFile, err)
+	}
+	return nil
+}
+
+// Satisfies returns true if the lock file contains a release for every platform
+// and library required by the project, matching the required version if any.
+func (l *Lockfile) Satisfies(project *Project) bool {
+	for _, required := range project.Platforms {
+		if locked := l.FindPlatform(required.Platform); locked == nil {
+			return false
+		} else if required.Version != "" && required.Version != locked.Version {
+			return false
+		} else if required.IndexURL != locked.IndexURL {
+			return false
+		}
+	}
+	for _, required := range project.Libraries {
+		if locked := l.FindLibrary(required.Name); locked == nil {
+			return false
+		} else if required.Version != "" && required.Version != locked.Version {
+			return false
+		}
+	}
+	return true
+}
+
+// FindPlatform returns the locked release of the given platform or nil if not found
+func (l *Lockfile) FindPlatform(platform string) *LockedPlatform {
+	for _, locked := range l.Platforms {
+		if locked.Platform == platform {
+			return locked
+		}
+	}
+	return nil
+}
+
+// FindLibrary returns the locked release of the given library or nil if not found
+func (l *Lockfile) FindLibrary(name string) *LockedLibrary {
+	for _, locked := range l.Libraries {
+		if locked.Name == name {
+			return locked
+		}
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import (
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadProject(t *testing.T) {
+	sketch, err := New(paths.New("testdata", "SketchWithProject"))
+	require.NoError(t, err)
+	project := sketch.Project
+	require.NotNil(t, project)
+	require.Equal(t, "arduino:avr:uno", project.Fqbn)
+	require.Len(t, project.Platforms, 2)
+	require.Equal(t, "arduino:avr@1.8.3", project.Platforms[0].String())
+	require.Equal(t, "esp32:esp32", project.Platforms[1].String())
+	require.Equal(t, "https://raw.githubusercontent.com/espressif/arduino-esp32/gh-pages/package_esp32_index.json", project.Platforms[1].IndexURL)
+	packageName, architecture, err := project.Platforms[1].PlatformID()
+	require.NoError(t, err)
+	require.Equal(t, "esp32", packageName)
+	require.Equal(t, "esp32", architecture)
+	require.Len(t, project.Libraries, 2)
+	require.Equal(t, "Servo@1.1.8", project.Libraries[0].String())
+	require.Equal(t, "ArduinoJson", project.Libraries[1].String())
+
+	// A sketch without project file
+	sketch, err = New(paths.New("testdata", "SketchSimple"))
+	require.NoError(t, err)
+	require.Nil(t, sketch.Project)
+}
+
+func TestLoadProjectWithUnknownKeys(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	sketchPath := tmp.Join("SketchWithToolKeys")
+	require.NoError(t, sketchPath.MkdirAll())
+	require.NoError(t, sketchPath.Join("SketchWithToolKeys.ino").WriteFile([]byte("void setup() {}\nvoid loop() {}\n")))
+
+	// The keys of other tools are ignored when the sketch is opened, but they
+	// are reported when the project file is loaded to install the dependencies
+	require.NoError(t, sketchPath.Join(ProjectFileName).WriteFile([]byte("fqbn: arduino:avr:uno\nother_tool: true\n")))
+	sketch, err := New(sketchPath)
+	require.NoError(t, err)
+	require.NotNil(t, sketch.Project)
+	require.Equal(t, "arduino:avr:uno", sketch.DefaultFqbn())
+	_, err = LoadProject(sketchPath)
+	require.Error(t, err)
+
+	// A malformed project file doesn't prevent opening the sketch
+	require.NoError(t, sketchPath.Join(ProjectFileName).WriteFile([]byte("fqbn: [")))
+	sketch, err = New(sketchPath)
+	require.NoError(t, err)
+	require.Nil(t, sketch.Project)
+	require.Equal(t, "", sketch.DefaultFqbn())
+	_, err = LoadProject(sketchPath)
+	require.Error(t, err)
+}
+
+func TestLockfile(t *testing.T) {
+	sketchPath := paths.New("testdata", "SketchWithProject")
+	project, err := LoadProject(sketchPath)
+	require.NoError(t, err)
+	lock, err := LoadLockfile(sketchPath)
+	require.NoError(t, err)
+	require.NotNil(t, lock)
+	require.True(t, lock.Satisfies(project))
+	require.Equal(t, "1.8.3", lock.FindPlatform("arduino:avr").Version)
+	require.Len(t, lock.FindPlatform("arduino:avr").Tools, 2)
+	require.Equal(t, "6.18.5", lock.FindLibrary("ArduinoJson").Version)
+	require.Nil(t, lock.FindLibrary("WiFiNINA"))
+
+	// Changing the required versions invalidates the lock file
+	project.Libraries[0].Version = "1.1.7"
+	require.False(t, lock.Satisfies(project))
+	project.Libraries[0].Version = "1.1.8"
+	project.Libraries = append(project.Libraries, &ProjectLibraryRef{Name: "WiFiNINA"})
+	require.False(t, lock.Satisfies(project))
+
+	// Save and load again
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	require.NoError(t, lock.Save(tmp))
+	reloaded, err := LoadLockfile(tmp)
+	require.NoError(t, err)
+	require.Equal(t, lock, reloaded)
+
+	// Missing lock file
+	lock, err = LoadLockfile(paths.New("testdata", "SketchSimple"))
+	require.NoError(t, err)
+	require.Nil(t, lock)
+}
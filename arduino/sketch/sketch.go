@@ -27,6 +27,7 @@ import (
 	"github.com/arduino/arduino-cli/i18n"
 	"github.com/arduino/go-paths-helper"
 	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
 )
 
 // Sketch holds all the files composing a sketch
@@ -39,11 +40,13 @@ type Sketch struct {
 	AdditionalFiles  paths.PathList
 	RootFolderFiles  paths.PathList // All files that are in the Sketch root
 	Metadata         *Metadata
+	Project          *Project // Project is nil if the sketch has no project file or it can't be read
 }
 
 // Metadata is the kind of data associated to a project such as the connected board
 type Metadata struct {
-	CPU BoardMetadata `json:"cpu,omitempty"`
+	CPU              BoardMetadata      `json:"cpu,omitempty"`
+	LibraryOverrides []*LibraryOverride `json:"library_overrides,omitempty"`
 }
 
 // BoardMetadata represents the board metadata for the sketch
@@ -53,6 +56,16 @@ type BoardMetadata struct {
 	Port string `json:"port,omitepty"`
 }
 
+// LibraryOverride pins the library that provides a header included by the
+// sketch or by its libraries. The path, relative to the sketch folder, and
+// the version of the library are optional.
+type LibraryOverride struct {
+	Header  string `json:"header"`
+	Library string `json:"library"`
+	Path    string `json:"path,omitempty"`
+	Version string `json:"version,omitempty"`
+}
+
 var tr = i18n.Tr
 
 // New creates an Sketch instance by reading all the files composing a sketch and grouping them
@@ -154,16 +167,52 @@ func New(path *paths.Path) (*Sketch, error) {
 	if err := sketch.importMetadata(); err != nil {
 		return nil, fmt.Errorf(tr("importing sketch metadata: %s"), err)
 	}
+	// The project file is checked only when its dependencies are installed, a
+	// project file that can't be read doesn't prevent using the sketch
+	if project, err := loadProject(path, false); err != nil {
+		logrus.WithError(err).Warn("Ignoring sketch project file")
+	} else {
+		sketch.Project = project
+	}
 	return sketch, nil
 }
 
+// DefaultFqbn returns the FQBN of the board attached to the sketch or, if no
+// board is attached, the one declared in the project file. An empty string is
+// returned if there is none.
+func (s *Sketch) DefaultFqbn() string {
+	if s.Metadata != nil && s.Metadata.CPU.Fqbn != "" {
+		return s.Metadata.CPU.Fqbn
+	}
+	if s.Project != nil {
+		return s.Project.Fqbn
+	}
+	return ""
+}
+
 // supportedFiles reads all files recursively contained in Sketch and
 // filter out unneded or unsupported ones and returns them
 func (s *Sketch) supportedFiles() (*paths.PathList, error) {
-	files, err := s.FullPath.ReadDirRecursive()
+	rootFiles, err := s.FullPath.ReadDir()
 	if err != nil {
 		return nil, err
 	}
+	files := paths.NewPathList()
+	for _, file := range rootFiles {
+		// The sketch environment contains platforms and libraries, not sketch files
+		if file.Base() == EnvironmentDirName {
+			continue
+		}
+		files.Add(file)
+		if !file.IsDir() {
+			continue
+		}
+		subFiles, err := file.ReadDirRecursive()
+		if err != nil {
+			return nil, err
+		}
+		files.AddAll(subFiles)
+	}
 	files.FilterOutDirs()
 	files.FilterOutHiddenFiles()
 	validExtensions := []string{}
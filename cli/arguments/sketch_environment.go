@@ -0,0 +1,56 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package arguments
+
+import (
+	"os"
+
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/spf13/cobra"
+)
+
+var sketchEnvironmentModes = map[string]rpc.SketchEnvironmentMode{
+	"none":     rpc.SketchEnvironmentMode_SKETCH_ENVIRONMENT_MODE_DISABLED,
+	"overlay":  rpc.SketchEnvironmentMode_SKETCH_ENVIRONMENT_MODE_OVERLAY,
+	"isolated": rpc.SketchEnvironmentMode_SKETCH_ENVIRONMENT_MODE_ISOLATED,
+}
+
+// SketchEnvironment contains the sketch environment arguments result.
+// This is useful so all flags used by commands that need
+// this information are consistent with each other.
+type SketchEnvironment struct {
+	mode string
+}
+
+// AddToCommand adds the flag used to select the sketch environment mode to the specified Command
+func (e *SketchEnvironment) AddToCommand(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&e.mode, "sketch-env", "none",
+		tr("Use the platforms and libraries installed in the %s folder of the sketch: none, overlay (on top of the global ones) or isolated (instead of the global ones).", sketch.EnvironmentDirName))
+}
+
+// GetMode returns the sketch environment mode obtained by parsing command line arguments.
+// The program exits with an error if the mode is not valid.
+func (e *SketchEnvironment) GetMode() rpc.SketchEnvironmentMode {
+	mode, ok := sketchEnvironmentModes[e.mode]
+	if !ok {
+		feedback.Errorf(tr("Invalid sketch environment mode: %s"), e.mode)
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+	return mode
+}
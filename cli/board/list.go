@@ -123,6 +123,7 @@ func (dr result) String() string {
 
 	t := table.New()
 	t.SetHeader(tr("Port"), tr("Protocol"), tr("Type"), tr("Board Name"), tr("FQBN"), tr("Core"))
+	notes := ""
 	for _, detectedPort := range dr.ports {
 		port := detectedPort.Port
 		protocol := port.GetProtocol()
@@ -145,10 +146,13 @@ func (dr result) String() string {
 				fqbn, err := cores.ParseFQBN(b.GetFqbn())
 				if err == nil {
 					coreName = fmt.Sprintf("%s:%s", fqbn.Package, fqbn.PlatformArch)
+					t.AddRow(address, protocol, protocolLabel, board, fqbn, coreName)
+				} else {
+					// the board has been found in a platform that must be installed
+					t.AddRow(address, protocol, protocolLabel, board, "", b.GetPlatformToInstall())
+					notes += platformToInstallNote(b)
 				}
 
-				t.AddRow(address, protocol, protocolLabel, board, fqbn, coreName)
-
 				// reset address and protocol, we only show them on the first row
 				address = ""
 				protocol = ""
@@ -160,7 +164,17 @@ func (dr result) String() string {
 			t.AddRow(address, protocol, board, fqbn, coreName)
 		}
 	}
-	return t.Render()
+	return t.Render() + notes
+}
+
+// platformToInstallNote returns the suggestion to install the platform
+// providing a board found in the package indexes
+func platformToInstallNote(b *rpc.BoardListItem) string {
+	if b.GetPlatformToInstall() == "" {
+		return ""
+	}
+	return "\n" + tr("Install the platform %[1]s to use the board %[2]s: %[3]s",
+		b.GetPlatformToInstall(), b.GetName(), os.Args[0]+" core install "+b.GetPlatformToInstall())
 }
 
 type watchEvent struct {
@@ -178,6 +192,7 @@ func (dr watchEvent) Data() interface{} {
 
 func (dr watchEvent) String() string {
 	t := table.New()
+	notes := ""
 
 	event := map[string]string{
 		"add":    tr("Connected"),
@@ -203,10 +218,13 @@ func (dr watchEvent) String() string {
 			fqbn, err := cores.ParseFQBN(b.GetFqbn())
 			if err == nil {
 				coreName = fmt.Sprintf("%s:%s", fqbn.Package, fqbn.PlatformArch)
+				t.AddRow(address, protocol, event, board, fqbn, coreName)
+			} else {
+				// the board has been found in a platform that must be installed
+				t.AddRow(address, protocol, event, board, "", b.GetPlatformToInstall())
+				notes += platformToInstallNote(b)
 			}
 
-			t.AddRow(address, protocol, event, board, fqbn, coreName)
-
 			// reset address and protocol, we only show them on the first row
 			address = ""
 			protocol = ""
@@ -217,5 +235,5 @@ func (dr watchEvent) String() string {
 		coreName := ""
 		t.AddRow(address, protocol, event, board, fqbn, coreName)
 	}
-	return t.Render()
+	return t.Render() + notes
 }
@@ -0,0 +1,43 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package bundle
+
+import (
+	"os"
+
+	"github.com/arduino/arduino-cli/i18n"
+	"github.com/spf13/cobra"
+)
+
+var tr = i18n.Tr
+
+// NewCommand created a new `bundle` command
+func NewCommand() *cobra.Command {
+	bundleCommand := &cobra.Command{
+		Use:   "bundle",
+		Short: tr("Offline bundle commands."),
+		Long:  tr("Exports and imports bundles of platforms, tools and libraries to install them on machines without network access."),
+		Example: "# " + tr("Export the platform of a board and a library.") + "\n" +
+			" " + os.Args[0] + " bundle export --fqbn arduino:avr:uno --library Servo bundle.tar.gz\n\n" +
+			"# " + tr("Import the bundle on a machine without network access.") + "\n" +
+			" " + os.Args[0] + " bundle import bundle.tar.gz\n\n",
+	}
+
+	bundleCommand.AddCommand(initExportCommand())
+	bundleCommand.AddCommand(initImportCommand())
+
+	return bundleCommand
+}
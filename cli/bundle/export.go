@@ -0,0 +1,128 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package bundle
+
+import (
+	"context"
+	"os"
+	"runtime"
+	"strings"
+
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/instance"
+	"github.com/arduino/arduino-cli/cli/lib"
+	"github.com/arduino/arduino-cli/cli/output"
+	"github.com/arduino/arduino-cli/commands/bundle"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var exportFlags struct {
+	fqbns     []string
+	libraries []string
+	hosts     []string
+}
+
+func initExportCommand() *cobra.Command {
+	exportCommand := &cobra.Command{
+		Use:   "export " + tr("OUTPUT"),
+		Short: tr("Exports a bundle to install platforms and libraries offline."),
+		Long: tr("Exports in the OUTPUT folder, or in a tarball if OUTPUT ends with .tar.gz, a bundle containing the platforms of the given boards, with the tools they need for the given hosts, and the given libraries with their dependencies, together with the indexes referencing them. The latest versions of the platforms are exported, the libraries are exported at the given version or at the latest one.") + "\n\n" +
+			tr("The bundle can be imported with the bundle import command on machines without network access."),
+		Example: "" +
+			"  " + os.Args[0] + " bundle export --fqbn arduino:avr:uno bundle\n" +
+			"  " + os.Args[0] + " bundle export --fqbn arduino:avr:uno --fqbn arduino:samd:mkr1000 --library Servo@1.1.8 --library ArduinoJson bundle.tar.gz\n" +
+			"  " + os.Args[0] + " bundle export --fqbn arduino:avr:uno --host linux/amd64 --host windows/amd64 --host darwin/amd64 bundle.tar.gz",
+		Args: cobra.ExactArgs(1),
+		Run:  runExportCommand,
+	}
+	exportCommand.Flags().StringArrayVarP(&exportFlags.fqbns, "fqbn", "b", []string{}, tr("Fully Qualified Board Name of a board whose platform is exported, can be used multiple times."))
+	exportCommand.Flags().StringArrayVar(&exportFlags.libraries, "library", []string{}, tr("Library to export, in the LIBRARY_NAME[@VERSION] form, can be used multiple times."))
+	exportCommand.Flags().StringArrayVar(&exportFlags.hosts, "host", []string{runtime.GOOS + "/" + runtime.GOARCH}, tr("Operating system and architecture, in the os/arch form, the tools are exported for, can be used multiple times."))
+	return exportCommand
+}
+
+func runExportCommand(cmd *cobra.Command, args []string) {
+	logrus.Info("Executing `arduino bundle export`")
+	if len(exportFlags.fqbns) == 0 && len(exportFlags.libraries) == 0 {
+		feedback.Errorf(tr("At least a board or a library to export is required"))
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+
+	inst := instance.CreateAndInit()
+	libRefs, err := lib.ParseLibraryReferenceArgsAndAdjustCase(inst, exportFlags.libraries)
+	if err != nil {
+		feedback.Errorf(tr("Arguments error: %v"), err)
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+	libraries := []*rpc.BundleLibrary{}
+	for _, libRef := range libRefs {
+		libraries = append(libraries, &rpc.BundleLibrary{Name: libRef.Name, Version: libRef.Version})
+	}
+
+	outputPath := paths.New(args[0])
+	res, err := bundle.Export(context.Background(), &rpc.BundleExportRequest{
+		Instance:  inst,
+		Fqbns:     exportFlags.fqbns,
+		Libraries: libraries,
+		Hosts:     exportFlags.hosts,
+		Output:    outputPath.String(),
+	}, output.ProgressBar())
+	if err != nil {
+		feedback.Errorf(tr("Error exporting bundle: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+
+	feedback.PrintResult(&exportResult{
+		Output:    outputPath.String(),
+		Hosts:     exportFlags.hosts,
+		Platforms: res.Platforms,
+		Tools:     res.Tools,
+		Libraries: res.Libraries,
+	})
+}
+
+type exportResult struct {
+	Output    string   `json:"output"`
+	Hosts     []string `json:"hosts"`
+	Platforms []string `json:"platforms"`
+	Tools     []string `json:"tools"`
+	Libraries []string `json:"libraries"`
+}
+
+func (r *exportResult) Data() interface{} {
+	return r
+}
+
+func (r *exportResult) String() string {
+	t := table.New()
+	t.AddRow(tr("Bundle:"), r.Output)
+	t.AddRow(tr("Hosts:"), strings.Join(r.Hosts, ", "))
+	for _, p := range r.Platforms {
+		t.AddRow(tr("Platform:"), p)
+	}
+	for _, tool := range r.Tools {
+		t.AddRow(tr("Tool:"), tool)
+	}
+	for _, l := range r.Libraries {
+		t.AddRow(tr("Library:"), l)
+	}
+	return t.Render()
+}
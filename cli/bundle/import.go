@@ -0,0 +1,107 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package bundle
+
+import (
+	"context"
+	"os"
+
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/instance"
+	"github.com/arduino/arduino-cli/cli/output"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/commands/bundle"
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func initImportCommand() *cobra.Command {
+	importCommand := &cobra.Command{
+		Use:   "import " + tr("BUNDLE"),
+		Short: tr("Imports a bundle to install platforms and libraries offline."),
+		Long: tr("Imports a bundle, a folder or a tarball, exported with the bundle export command. The package index of the bundle is added to the board_manager.additional_urls setting and its library index is set as the library.index_url setting, so that the platforms and the libraries of the bundle can be installed with core install and lib install without network access.") + "\n\n" +
+			tr("The tarballs are extracted in the data directory, while the folders are used in place and must not be moved or removed."),
+		Example: "" +
+			"  " + os.Args[0] + " bundle import bundle.tar.gz\n" +
+			"  " + os.Args[0] + " bundle import /media/usb/bundle",
+		Args: cobra.ExactArgs(1),
+		Run:  runImportCommand,
+	}
+	return importCommand
+}
+
+func runImportCommand(cmd *cobra.Command, args []string) {
+	logrus.Info("Executing `arduino bundle import`")
+
+	res, err := bundle.Import(context.Background(), &rpc.BundleImportRequest{BundlePath: args[0]})
+	if err != nil {
+		feedback.Errorf(tr("Error importing bundle: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+
+	urls := configuration.Settings.GetStringSlice("board_manager.additional_urls")
+	registered := false
+	for _, u := range urls {
+		registered = registered || u == res.GetPackageIndexUrl()
+	}
+	if !registered {
+		configuration.Settings.Set("board_manager.additional_urls", append(urls, res.GetPackageIndexUrl()))
+	}
+	configuration.Settings.Set("library.index_url", res.GetLibraryIndexUrl())
+	if err := configuration.Settings.WriteConfig(); err != nil {
+		feedback.Errorf(tr("Can't write config file: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+
+	// The library index of the bundle replaces the current one
+	inst, err := instance.Create()
+	if err != nil {
+		feedback.Errorf(tr("Error creating instance: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+	err = commands.UpdateLibrariesIndex(context.Background(), &rpc.UpdateLibrariesIndexRequest{
+		Instance: inst,
+	}, output.ProgressBar())
+	if err != nil {
+		feedback.Errorf(tr("Error updating library index: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+
+	feedback.PrintResult(&importResult{
+		PackageIndexURL: res.GetPackageIndexUrl(),
+		LibraryIndexURL: res.GetLibraryIndexUrl(),
+	})
+}
+
+type importResult struct {
+	PackageIndexURL string `json:"package_index_url"`
+	LibraryIndexURL string `json:"library_index_url"`
+}
+
+func (r *importResult) Data() interface{} {
+	return r
+}
+
+func (r *importResult) String() string {
+	t := table.New()
+	t.AddRow(tr("Package index:"), r.PackageIndexURL)
+	t.AddRow(tr("Library index:"), r.LibraryIndexURL)
+	return t.Render()
+}
@@ -31,10 +31,14 @@ func NewCommand() *cobra.Command {
 		Short: tr("Arduino cache commands."),
 		Long:  tr("Arduino cache commands."),
 		Example: "# " + tr("Clean caches.") + "\n" +
-			" " + os.Args[0] + " cache clean\n\n",
+			" " + os.Args[0] + " cache clean\n\n" +
+			"# " + tr("Show the size of the compilation cache.") + "\n" +
+			" " + os.Args[0] + " cache info\n\n",
 	}
 
 	cacheCommand.AddCommand(initCleanCommand())
+	cacheCommand.AddCommand(initInfoCommand())
+	cacheCommand.AddCommand(initPruneCommand())
 
 	return cacheCommand
 }
@@ -0,0 +1,108 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package cache
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+
+	"github.com/arduino/arduino-cli/arduino/buildcache"
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/configuration"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func initInfoCommand() *cobra.Command {
+	infoCommand := &cobra.Command{
+		Use:     "info",
+		Short:   tr("Show information about the compilation cache."),
+		Long:    tr("Show the location, the number of entries and the size of the compilation cache shared between builds."),
+		Example: "  " + os.Args[0] + " cache info",
+		Args:    cobra.NoArgs,
+		Run:     runInfoCommand,
+	}
+	return infoCommand
+}
+
+func runInfoCommand(cmd *cobra.Command, args []string) {
+	logrus.Info("Executing `arduino cache info`")
+
+	maxSize, err := buildcache.ParseSize(configuration.Settings.GetString("build_cache.max_size"))
+	if err != nil {
+		feedback.Errorf(tr("Invalid build_cache.max_size setting: %v"), err)
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+	cache := buildcache.New(configuration.BuildCacheDir(configuration.Settings))
+	stats, err := cache.Stats()
+	if err != nil {
+		feedback.Errorf(tr("Error reading compilation cache: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+	feedback.PrintResult(&infoResult{
+		Path:           cache.Dir().String(),
+		Enabled:        configuration.Settings.GetBool("build_cache.enabled"),
+		Entries:        stats.Entries,
+		Size:           stats.Size,
+		MaxSize:        maxSize,
+		RemoteURL:      redactURL(configuration.Settings.GetString("build_cache.remote.url")),
+		RemoteReadOnly: configuration.Settings.GetBool("build_cache.remote.read_only"),
+	})
+}
+
+type infoResult struct {
+	Path           string `json:"path"`
+	Enabled        bool   `json:"enabled"`
+	Entries        int    `json:"entries"`
+	Size           int64  `json:"size"`
+	MaxSize        int64  `json:"max_size"`
+	RemoteURL      string `json:"remote_url,omitempty"`
+	RemoteReadOnly bool   `json:"remote_read_only,omitempty"`
+}
+
+// redactURL hides the password that may be contained in the URL of the remote cache
+func redactURL(u string) string {
+	if parsed, err := url.Parse(u); err == nil {
+		return parsed.Redacted()
+	}
+	return u
+}
+
+func (r *infoResult) Data() interface{} {
+	return r
+}
+
+func (r *infoResult) String() string {
+	enabled := tr("disabled")
+	if r.Enabled {
+		enabled = tr("enabled")
+	}
+	t := table.New()
+	t.AddRow(tr("Compilation cache:"), r.Path+" ("+enabled+")")
+	t.AddRow(tr("Entries:"), fmt.Sprint(r.Entries))
+	t.AddRow(tr("Size:"), buildcache.FormatSize(r.Size)+" / "+buildcache.FormatSize(r.MaxSize))
+	if r.RemoteURL != "" {
+		if r.RemoteReadOnly {
+			t.AddRow(tr("Remote cache:"), r.RemoteURL+" ("+tr("read only")+")")
+		} else {
+			t.AddRow(tr("Remote cache:"), r.RemoteURL)
+		}
+	}
+	return t.Render()
+}
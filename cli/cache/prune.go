@@ -0,0 +1,86 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package cache
+
+import (
+	"os"
+
+	"github.com/arduino/arduino-cli/arduino/buildcache"
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/configuration"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var (
+	pruneMaxSize string
+	pruneAll     bool
+)
+
+func initPruneCommand() *cobra.Command {
+	pruneCommand := &cobra.Command{
+		Use:   "prune",
+		Short: tr("Remove the least recently used entries of the compilation cache."),
+		Long:  tr("Remove the least recently used entries of the compilation cache until its size is within the limit set by the `build_cache.max_size` setting or by the --max-size flag."),
+		Example: "  " + os.Args[0] + " cache prune\n" +
+			"  " + os.Args[0] + " cache prune --max-size 500MB\n" +
+			"  " + os.Args[0] + " cache prune --all",
+		Args: cobra.NoArgs,
+		Run:  runPruneCommand,
+	}
+	pruneCommand.Flags().StringVar(&pruneMaxSize, "max-size", "", tr("Size limit of the compilation cache, e.g.: 500MB, 2GB"))
+	pruneCommand.Flags().BoolVar(&pruneAll, "all", false, tr("Remove all the entries of the compilation cache."))
+	return pruneCommand
+}
+
+func runPruneCommand(cmd *cobra.Command, args []string) {
+	logrus.Info("Executing `arduino cache prune`")
+
+	maxSizeSetting := configuration.Settings.GetString("build_cache.max_size")
+	if pruneMaxSize != "" {
+		maxSizeSetting = pruneMaxSize
+	}
+	maxSize, err := buildcache.ParseSize(maxSizeSetting)
+	if err != nil {
+		feedback.Errorf(tr("Invalid cache size: %v"), err)
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+	if pruneAll {
+		maxSize = 0
+	}
+
+	cache := buildcache.New(configuration.BuildCacheDir(configuration.Settings))
+	removed, err := cache.Trim(maxSize)
+	if err != nil {
+		feedback.Errorf(tr("Error pruning compilation cache: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+	feedback.PrintResult(&pruneResult{Entries: removed.Entries, Size: removed.Size})
+}
+
+type pruneResult struct {
+	Entries int   `json:"removed_entries"`
+	Size    int64 `json:"removed_size"`
+}
+
+func (r *pruneResult) Data() interface{} {
+	return r
+}
+
+func (r *pruneResult) String() string {
+	return tr("Removed %[1]d entries (%[2]s) from the compilation cache.", r.Entries, buildcache.FormatSize(r.Size))
+}
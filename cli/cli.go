@@ -22,6 +22,7 @@ import (
 	"strings"
 
 	"github.com/arduino/arduino-cli/cli/board"
+	"github.com/arduino/arduino-cli/cli/bundle"
 	"github.com/arduino/arduino-cli/cli/burnbootloader"
 	"github.com/arduino/arduino-cli/cli/cache"
 	"github.com/arduino/arduino-cli/cli/compile"
@@ -35,9 +36,11 @@ import (
 	"github.com/arduino/arduino-cli/cli/generatedocs"
 	"github.com/arduino/arduino-cli/cli/globals"
 	"github.com/arduino/arduino-cli/cli/lib"
+	"github.com/arduino/arduino-cli/cli/monitor"
 	"github.com/arduino/arduino-cli/cli/outdated"
 	"github.com/arduino/arduino-cli/cli/output"
 	"github.com/arduino/arduino-cli/cli/sketch"
+	"github.com/arduino/arduino-cli/cli/test"
 	"github.com/arduino/arduino-cli/cli/update"
 	"github.com/arduino/arduino-cli/cli/updater"
 	"github.com/arduino/arduino-cli/cli/upgrade"
@@ -85,6 +88,7 @@ func NewCommand() *cobra.Command {
 // this is here only for testing
 func createCliCommandTree(cmd *cobra.Command) {
 	cmd.AddCommand(board.NewCommand())
+	cmd.AddCommand(bundle.NewCommand())
 	cmd.AddCommand(cache.NewCommand())
 	cmd.AddCommand(compile.NewCommand())
 	cmd.AddCommand(completion.NewCommand())
@@ -93,8 +97,10 @@ func createCliCommandTree(cmd *cobra.Command) {
 	cmd.AddCommand(daemon.NewCommand())
 	cmd.AddCommand(generatedocs.NewCommand())
 	cmd.AddCommand(lib.NewCommand())
+	cmd.AddCommand(monitor.NewCommand())
 	cmd.AddCommand(outdated.NewCommand())
 	cmd.AddCommand(sketch.NewCommand())
+	cmd.AddCommand(test.NewCommand())
 	cmd.AddCommand(update.NewCommand())
 	cmd.AddCommand(upgrade.NewCommand())
 	cmd.AddCommand(upload.NewCommand())
@@ -19,7 +19,9 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"os"
+	"strings"
 
 	"github.com/arduino/arduino-cli/arduino/discovery"
 	"github.com/arduino/arduino-cli/arduino/sketch"
@@ -40,25 +42,39 @@ import (
 )
 
 var (
-	fqbn                    string         // Fully Qualified Board Name, e.g.: arduino:avr:uno.
-	showProperties          bool           // Show all build preferences used instead of compiling.
-	preprocess              bool           // Print preprocessed code to stdout.
-	buildCachePath          string         // Builds of 'core.a' are saved into this path to be cached and reused.
-	buildPath               string         // Path where to save compiled files.
-	buildProperties         []string       // List of custom build properties separated by commas. Or can be used multiple times for multiple properties.
-	warnings                string         // Used to tell gcc which warning level to use.
-	verbose                 bool           // Turns on verbose mode.
-	quiet                   bool           // Suppresses almost every output.
-	vidPid                  string         // VID/PID specific build properties.
-	uploadAfterCompile      bool           // Upload the binary after the compilation.
-	port                    arguments.Port // Upload port, e.g.: COM10 or /dev/ttyACM0.
-	verify                  bool           // Upload, verify uploaded binary after the upload.
-	exportDir               string         // The compiled binary is written to this file
-	optimizeForDebug        bool           // Optimize compile output for debug, not for release
-	programmer              string         // Use the specified programmer to upload
-	clean                   bool           // Cleanup the build folder and do not use any cached build
-	compilationDatabaseOnly bool           // Only create compilation database without actually compiling
-	sourceOverrides         string         // Path to a .json file that contains a set of replacements of the sketch source code.
+	fqbn                    string                      // Fully Qualified Board Name, e.g.: arduino:avr:uno.
+	fqbns                   []string                    // Fully Qualified Board Names, the sketch is built for each of them.
+	matrixFile              string                      // Path of the build matrix file listing the targets to build.
+	parallelBuilds          int                         // Number of targets built in parallel.
+	showProperties          bool                        // Show all build preferences used instead of compiling.
+	preprocess              bool                        // Print preprocessed code to stdout.
+	buildCachePath          string                      // Builds of 'core.a' are saved into this path to be cached and reused.
+	buildPath               string                      // Path where to save compiled files.
+	buildProperties         []string                    // List of custom build properties separated by commas. Or can be used multiple times for multiple properties.
+	warnings                string                      // Used to tell gcc which warning level to use.
+	verbose                 bool                        // Turns on verbose mode.
+	quiet                   bool                        // Suppresses almost every output.
+	vidPid                  string                      // VID/PID specific build properties.
+	uploadAfterCompile      bool                        // Upload the binary after the compilation.
+	port                    arguments.Port              // Upload port, e.g.: COM10 or /dev/ttyACM0.
+	sketchEnv               arguments.SketchEnvironment // Selects how the sketch environment is used.
+	verify                  bool                        // Upload, verify uploaded binary after the upload.
+	exportDir               string                      // The compiled binary is written to this file
+	optimizeForDebug        bool                        // Optimize compile output for debug, not for release
+	programmer              string                      // Use the specified programmer to upload
+	clean                   bool                        // Cleanup the build folder and do not use any cached build
+	compilationDatabaseOnly bool                        // Only create compilation database without actually compiling
+	sourceOverrides         string                      // Path to a .json file that contains a set of replacements of the sketch source code.
+	sizeReport              string                      // Prints the detailed memory usage of the executable in the given format.
+	sizeReportSymbols       uint32                      // Number of symbols included in the size report.
+	sizeReportOut           string                      // Path of the file where the size report is saved.
+	compareSize             string                      // Path of the size report of a previous build to compare with.
+	sizeGrowthThreshold     string                      // Max growth of memory usage allowed with respect to the compared size report.
+	libraryOverrides        []string                    // Libraries pinned to headers, in the HEADER=LIBRARY[@VERSION][:PATH] format.
+	explainLibraries        bool                        // Explains how the libraries providing the included headers have been selected.
+	watch                   bool                        // Builds the sketch again each time it changes.
+	monitorAfterUpload      bool                        // Opens the monitor after each upload in watch mode.
+	monitorConfigs          []string                    // Configuration of the monitor port, in the KEY=VALUE format.
 	// library and libraries sound similar but they're actually different.
 	// library expects a path to the root folder of one single library.
 	// libraries expects a path to a directory containing multiple libraries, similarly to the <directories.user>/libraries path.
@@ -82,7 +98,8 @@ func NewCommand() *cobra.Command {
 		Run:  run,
 	}
 
-	command.Flags().StringVarP(&fqbn, "fqbn", "b", "", tr("Fully Qualified Board Name, e.g.: arduino:avr:uno"))
+	command.Flags().StringArrayVarP(&fqbns, "fqbn", "b", []string{},
+		tr("Fully Qualified Board Name, e.g.: arduino:avr:uno. Can be used multiple times to build the sketch for more boards."))
 	command.RegisterFlagCompletionFunc("fqbn", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		return getBoards(toComplete), cobra.ShellCompDirectiveDefault
 	})
@@ -102,12 +119,27 @@ func NewCommand() *cobra.Command {
 	command.Flags().BoolVar(&quiet, "quiet", false, tr("Optional, suppresses almost every output."))
 	command.Flags().BoolVarP(&uploadAfterCompile, "upload", "u", false, tr("Upload the binary after the compilation."))
 	port.AddToCommand(command)
+	sketchEnv.AddToCommand(command)
 	command.Flags().BoolVarP(&verify, "verify", "t", false, tr("Verify uploaded binary after the upload."))
 	command.Flags().StringVar(&vidPid, "vid-pid", "", tr("When specified, VID/PID specific build properties are used, if board supports them."))
 	command.Flags().StringSliceVar(&library, "library", []string{},
 		tr("List of paths to libraries root folders. Libraries set this way have top priority in case of conflicts. Can be used multiple times for different libraries."))
 	command.Flags().StringSliceVar(&libraries, "libraries", []string{},
 		tr("List of custom libraries dir paths separated by commas. Or can be used multiple times for multiple libraries dir paths."))
+	command.Flags().StringArrayVar(&libraryOverrides, "library-override", []string{},
+		tr("Pin the library that provides a header, in the HEADER=LIBRARY[@VERSION][:PATH] format (e.g. Servo.h=Servo@1.1.8). The compilation fails if the library is not available. Can be used multiple times for different headers."))
+	command.Flags().BoolVar(&explainLibraries, "explain-libraries", false,
+		tr("Optional, explain how the library that provides each included header has been selected among the installed ones."))
+	command.Flags().BoolVar(&watch, "watch", false,
+		tr("Optional, keep running and build the sketch again each time the sketch, its src folder or the unmanaged libraries it uses change."))
+	command.Flags().BoolVar(&monitorAfterUpload, "monitor", false,
+		tr("Optional, open the monitor after each upload, requires %s.", "--watch --upload"))
+	command.Flags().StringSliceVar(&monitorConfigs, "monitor-config", []string{},
+		tr("Configuration of the monitor port, e.g.: %s", "baudrate=115200"))
+	command.Flags().StringVar(&matrixFile, "matrix", "",
+		tr("Optional, build the sketch for the boards, board options and build properties listed in the given build matrix file."))
+	command.Flags().IntVar(&parallelBuilds, "parallel-builds", 0,
+		tr("Number of targets built in parallel when building for more boards, 0 to use the number of available CPUs."))
 	command.Flags().BoolVar(&optimizeForDebug, "optimize-for-debug", false, tr("Optional, optimize compile output for debugging, rather than for release."))
 	command.Flags().StringVarP(&programmer, "programmer", "P", "", tr("Optional, use the specified programmer to upload."))
 	command.Flags().BoolVar(&compilationDatabaseOnly, "only-compilation-database", false, tr("Just produce the compilation database, without actually compiling."))
@@ -118,6 +150,16 @@ func NewCommand() *cobra.Command {
 	command.Flags().BoolP("export-binaries", "e", false, tr("If set built binaries will be exported to the sketch folder."))
 	command.Flags().StringVar(&sourceOverrides, "source-override", "", tr("Optional. Path to a .json file that contains a set of replacements of the sketch source code."))
 	command.Flag("source-override").Hidden = true
+	command.Flags().StringVar(&sizeReport, "size-report", "",
+		tr("Optional, print the detailed memory usage of the executable, can be: %s.", "text, json"))
+	command.Flags().Uint32Var(&sizeReportSymbols, "size-report-symbols", 20,
+		tr("Number of the biggest symbols included in the size report, 0 to include all of them."))
+	command.Flags().StringVar(&sizeReportOut, "size-report-out", "",
+		tr("Optional, save the detailed memory usage of the executable, with all the symbols, in the given JSON file."))
+	command.Flags().StringVar(&compareSize, "compare-size", "",
+		tr("Optional, compare the memory usage of the executable with the report saved by a previous build with --size-report-out."))
+	command.Flags().StringVar(&sizeGrowthThreshold, "size-growth-threshold", "",
+		tr("Optional, fail if the flash or RAM usage grew more than the given number of bytes (e.g. 512) or percentage (e.g. 1.5%) with respect to the report passed with --compare-size."))
 
 	configuration.Settings.BindPFlag("sketch.always_export_binaries", command.Flags().Lookup("export-binaries"))
 
@@ -143,6 +185,83 @@ func run(cmd *cobra.Command, args []string) {
 		}
 	}
 
+	if sizeReport != "" && sizeReport != "text" && sizeReport != "json" {
+		feedback.Errorf(tr("Invalid size report format: %s"), sizeReport)
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+
+	if watch && (showProperties || preprocess) {
+		feedback.Error(tr("The --watch flag can't be used with --show-properties or --preprocess"))
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+	if monitorAfterUpload && (!watch || !uploadAfterCompile) {
+		feedback.Error(tr("The --monitor flag requires --watch and --upload"))
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+
+	if len(fqbns) == 1 {
+		fqbn = fqbns[0]
+	}
+	var matrixTargets []*rpc.CompileMatrixTarget
+	if len(fqbns) > 1 || matrixFile != "" {
+		if uploadAfterCompile || watch || showProperties || preprocess {
+			feedback.Error(tr("Building the sketch for more boards can't be used with --upload, --watch, --show-properties or --preprocess"))
+			os.Exit(errorcodes.ErrBadArgument)
+		}
+		if sizeReport != "" || sizeReportOut != "" || compareSize != "" || explainLibraries {
+			feedback.Error(tr("Building the sketch for more boards can't be used with --size-report, --size-report-out, --compare-size or --explain-libraries"))
+			os.Exit(errorcodes.ErrBadArgument)
+		}
+		for _, f := range fqbns {
+			matrixTargets = append(matrixTargets, &rpc.CompileMatrixTarget{Fqbn: f})
+		}
+		if matrixFile != "" {
+			matrix, err := sketch.LoadBuildMatrix(paths.New(matrixFile))
+			if err != nil {
+				feedback.Errorf(tr("Error loading build matrix: %v"), err)
+				os.Exit(errorcodes.ErrBadArgument)
+			}
+			for _, target := range matrix.Targets() {
+				matrixTargets = append(matrixTargets, &rpc.CompileMatrixTarget{
+					Fqbn:            target.Fqbn,
+					BuildProperties: target.BuildProperties,
+				})
+			}
+		}
+	}
+
+	var sizeBaseline *rpc.SizeReport
+	if compareSize != "" {
+		data, err := paths.New(compareSize).ReadFile()
+		if err != nil {
+			feedback.Errorf(tr("Error reading size report: %v"), err)
+			os.Exit(errorcodes.ErrGeneric)
+		}
+		sizeBaseline = &rpc.SizeReport{}
+		if err := json.Unmarshal(data, sizeBaseline); err != nil {
+			feedback.Errorf(tr("Error: invalid size report %[1]s: %[2]v"), compareSize, err)
+			os.Exit(errorcodes.ErrBadArgument)
+		}
+	} else if sizeGrowthThreshold != "" {
+		feedback.Error(tr("The --size-growth-threshold flag requires --compare-size"))
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+	// The whole report is needed to save it
+	sizeReportMaxSymbols := sizeReportSymbols
+	if sizeReportOut != "" {
+		sizeReportMaxSymbols = 0
+	}
+
+	pinnedLibraries := []*rpc.LibraryOverride{}
+	for _, o := range libraryOverrides {
+		libraryOverride, err := parseLibraryOverride(o)
+		if err != nil {
+			feedback.Errorf(tr("Invalid library override '%[1]s': %[2]v"), o, err)
+			os.Exit(errorcodes.ErrBadArgument)
+		}
+		pinnedLibraries = append(pinnedLibraries, libraryOverride)
+	}
+
 	var overrides map[string]string
 	if sourceOverrides != "" {
 		data, err := paths.New(sourceOverrides).ReadFile()
@@ -180,79 +299,48 @@ func run(cmd *cobra.Command, args []string) {
 		CreateCompilationDatabaseOnly: compilationDatabaseOnly,
 		SourceOverride:                overrides,
 		Library:                       library,
+		SketchEnvironment:             sketchEnv.GetMode(),
+		SizeReport:                    sizeReport != "" || sizeReportOut != "",
+		SizeReportMaxSymbols:          sizeReportMaxSymbols,
+		SizeBaseline:                  sizeBaseline,
+		SizeGrowthThreshold:           sizeGrowthThreshold,
+		LibraryOverrides:              pinnedLibraries,
+		ExplainLibraries:              explainLibraries,
+	}
+	if matrixTargets != nil {
+		runMatrix(compileRequest, matrixTargets)
+		return
+	}
+	if watch {
+		runWatch(inst, compileRequest, sketchPath)
+		return
 	}
+
 	compileStdOut := new(bytes.Buffer)
 	compileStdErr := new(bytes.Buffer)
 	verboseCompile := configuration.Settings.GetString("logging.level") == "debug"
 	var compileRes *rpc.CompileResponse
 	var compileError error
+	diagnostics := []*rpc.CompileDiagnostic{}
+	diagnosticCB := func(d *rpc.CompileDiagnostic) { diagnostics = append(diagnostics, d) }
 	if output.OutputFormat == "json" {
-		compileRes, compileError = compile.Compile(context.Background(), compileRequest, compileStdOut, compileStdErr, verboseCompile)
+		compileRes, compileError = compile.Compile(context.Background(), compileRequest, compileStdOut, compileStdErr, diagnosticCB, verboseCompile)
 	} else {
-		compileRes, compileError = compile.Compile(context.Background(), compileRequest, os.Stdout, os.Stderr, verboseCompile)
+		compileRes, compileError = compile.Compile(context.Background(), compileRequest, os.Stdout, os.Stderr, nil, verboseCompile)
 	}
 
 	if compileError == nil && uploadAfterCompile {
-		var sk *sketch.Sketch
-		sk, err := sketch.New(sketchPath)
-		if err != nil {
+		if _, err := uploadSketch(inst, sketchPath); err != nil {
 			feedback.Errorf(tr("Error during Upload: %v"), err)
 			os.Exit(errorcodes.ErrGeneric)
 		}
-		var discoveryPort *discovery.Port
-		discoveryPort, err = port.GetPort(inst, sk)
-		if err != nil {
-			feedback.Errorf(tr("Error during Upload: %v"), err)
-			os.Exit(errorcodes.ErrGeneric)
-		}
-
-		userFieldRes, err := upload.SupportedUserFields(context.Background(), &rpc.SupportedUserFieldsRequest{
-			Instance: inst,
-			Fqbn:     fqbn,
-			Protocol: discoveryPort.Protocol,
-		})
-		if err != nil {
-			feedback.Errorf(tr("Error during Upload: %v"), err)
-			os.Exit(errorcodes.ErrGeneric)
-		}
-
-		fields := map[string]string{}
-		if len(userFieldRes.UserFields) > 0 {
-			feedback.Print(tr("Uploading to specified board using %s protocol requires the following info:", discoveryPort.Protocol))
-			fields = arguments.AskForUserFields(userFieldRes.UserFields)
-		}
-
-		uploadRequest := &rpc.UploadRequest{
-			Instance:   inst,
-			Fqbn:       fqbn,
-			SketchPath: sketchPath.String(),
-			Port:       discoveryPort.ToRPC(),
-			Verbose:    verbose,
-			Verify:     verify,
-			ImportDir:  buildPath,
-			Programmer: programmer,
-			UserFields: fields,
-		}
-
-		var uploadError error
-		if output.OutputFormat == "json" {
-			// TODO: do not print upload output in json mode
-			uploadStdOut := new(bytes.Buffer)
-			uploadStdErr := new(bytes.Buffer)
-			_, uploadError = upload.Upload(context.Background(), uploadRequest, uploadStdOut, uploadStdErr)
-		} else {
-			_, uploadError = upload.Upload(context.Background(), uploadRequest, os.Stdout, os.Stderr)
-		}
-		if uploadError != nil {
-			feedback.Errorf(tr("Error during Upload: %v"), uploadError)
-			os.Exit(errorcodes.ErrGeneric)
-		}
 	}
 
-	feedback.PrintResult(&compileResult{
+	printCompileResult(&compileResult{
 		CompileOut:    compileStdOut.String(),
 		CompileErr:    compileStdErr.String(),
 		BuilderResult: compileRes,
+		Diagnostics:   diagnostics,
 		Success:       compileError == nil,
 	})
 	if compileError != nil && output.OutputFormat != "json" {
@@ -261,11 +349,98 @@ func run(cmd *cobra.Command, args []string) {
 	}
 }
 
+// printCompileResult prints the result of the build and the reports requested
+// with the command flags
+func printCompileResult(res *compileResult) {
+	feedback.PrintResult(res)
+	compileRes := res.BuilderResult
+	if report := compileRes.GetSizeReport(); report != nil && sizeReportOut != "" {
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err == nil {
+			err = paths.New(sizeReportOut).WriteFile(data)
+		}
+		if err != nil {
+			feedback.Errorf(tr("Error saving size report: %v"), err)
+			os.Exit(errorcodes.ErrGeneric)
+		}
+	}
+	// In JSON output the report, the comparison and the libraries resolution
+	// are already contained in the builder result
+	if output.OutputFormat != "json" {
+		if explainLibraries {
+			feedback.PrintResult(&libraryResolutionsResult{resolutions: compileRes.GetLibraryResolutions()})
+		}
+		if report := compileRes.GetSizeReport(); report != nil && sizeReport != "" {
+			feedback.PrintResult(&sizeReportResult{report: report, format: sizeReport, maxSymbols: int(sizeReportSymbols)})
+		}
+		if comparison := compileRes.GetSizeComparison(); comparison != nil {
+			feedback.PrintResult(&sizeComparisonResult{comparison: comparison, maxSymbols: int(sizeReportSymbols)})
+		}
+	}
+}
+
+// uploadSketch uploads the sketch built with the compile command, it returns
+// the port used for the upload
+func uploadSketch(inst *rpc.Instance, sketchPath *paths.Path) (*discovery.Port, error) {
+	sk, err := sketch.New(sketchPath)
+	if err != nil {
+		return nil, err
+	}
+	discoveryPort, err := port.GetPort(inst, sk)
+	if err != nil {
+		return nil, err
+	}
+
+	userFieldRes, err := upload.SupportedUserFields(context.Background(), &rpc.SupportedUserFieldsRequest{
+		Instance:          inst,
+		Fqbn:              fqbn,
+		Protocol:          discoveryPort.Protocol,
+		SketchPath:        sketchPath.String(),
+		SketchEnvironment: sketchEnv.GetMode(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[string]string{}
+	if len(userFieldRes.UserFields) > 0 {
+		feedback.Print(tr("Uploading to specified board using %s protocol requires the following info:", discoveryPort.Protocol))
+		fields = arguments.AskForUserFields(userFieldRes.UserFields)
+	}
+
+	uploadRequest := &rpc.UploadRequest{
+		Instance:          inst,
+		Fqbn:              fqbn,
+		SketchPath:        sketchPath.String(),
+		Port:              discoveryPort.ToRPC(),
+		Verbose:           verbose,
+		Verify:            verify,
+		ImportDir:         buildPath,
+		Programmer:        programmer,
+		UserFields:        fields,
+		SketchEnvironment: sketchEnv.GetMode(),
+	}
+
+	if output.OutputFormat == "json" {
+		// TODO: do not print upload output in json mode
+		uploadStdOut := new(bytes.Buffer)
+		uploadStdErr := new(bytes.Buffer)
+		_, err = upload.Upload(context.Background(), uploadRequest, uploadStdOut, uploadStdErr)
+	} else {
+		_, err = upload.Upload(context.Background(), uploadRequest, os.Stdout, os.Stderr)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return discoveryPort, nil
+}
+
 type compileResult struct {
-	CompileOut    string               `json:"compiler_out"`
-	CompileErr    string               `json:"compiler_err"`
-	BuilderResult *rpc.CompileResponse `json:"builder_result"`
-	Success       bool                 `json:"success"`
+	CompileOut    string                   `json:"compiler_out"`
+	CompileErr    string                   `json:"compiler_err"`
+	BuilderResult *rpc.CompileResponse     `json:"builder_result"`
+	Diagnostics   []*rpc.CompileDiagnostic `json:"diagnostics"`
+	Success       bool                     `json:"success"`
 }
 
 func (r *compileResult) Data() interface{} {
@@ -292,3 +467,26 @@ func getBoards(toComplete string) []string {
 	}
 	return res
 }
+
+// parseLibraryOverride parses a library override in the
+// HEADER=LIBRARY[@VERSION][:PATH] format
+func parseLibraryOverride(override string) (*rpc.LibraryOverride, error) {
+	split := strings.SplitN(override, "=", 2)
+	if len(split) != 2 || split[0] == "" || split[1] == "" {
+		return nil, errors.New(tr("expected HEADER=LIBRARY[@VERSION][:PATH]"))
+	}
+	res := &rpc.LibraryOverride{Header: split[0]}
+	// The library names can't contain a colon, the path can (e.g. C:\)
+	library := split[1]
+	if i := strings.Index(library, ":"); i != -1 {
+		library, res.Path = library[:i], library[i+1:]
+	}
+	if i := strings.Index(library, "@"); i != -1 {
+		library, res.Version = library[:i], library[i+1:]
+	}
+	if library == "" {
+		return nil, errors.New(tr("expected HEADER=LIBRARY[@VERSION][:PATH]"))
+	}
+	res.Library = library
+	return res, nil
+}
@@ -0,0 +1,98 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"fmt"
+	"strings"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/arduino-cli/table"
+)
+
+// libraryResolutionsResult prints how the libraries providing the included
+// headers have been selected, as requested with the --explain-libraries flag
+type libraryResolutionsResult struct {
+	resolutions []*rpc.LibraryResolution
+}
+
+func (r *libraryResolutionsResult) Data() interface{} {
+	return r.resolutions
+}
+
+func (r *libraryResolutionsResult) String() string {
+	if len(r.resolutions) == 0 {
+		return tr("No libraries have been resolved.")
+	}
+	var out strings.Builder
+	for _, resolution := range r.resolutions {
+		selected := ""
+		for _, c := range resolution.GetCandidates() {
+			if c.GetSelected() {
+				selected = libraryCandidateName(c)
+			}
+		}
+		out.WriteString(tr("%[1]s: selected %[2]s, %[3]s", resolution.GetHeader(), selected, resolutionReason(resolution.GetReason())) + "\n")
+
+		t := table.New()
+		t.SetHeader("", tr("Library"), tr("Location"), tr("Priority"), tr("Architecture priority"), tr("Name priority"), tr("Location priority"), tr("Path"))
+		for _, c := range resolution.GetCandidates() {
+			mark := ""
+			if c.GetSelected() {
+				mark = "*"
+			}
+			t.AddRow(mark, libraryCandidateName(c), c.GetLocation().String(),
+				priorityCell(c.GetPriority()), priorityCell(c.GetArchitecturePriority()),
+				priorityCell(c.GetNamePriority()), priorityCell(c.GetLocationPriority()),
+				c.GetInstallDir())
+		}
+		out.WriteString(t.Render() + "\n")
+	}
+	return strings.TrimRight(out.String(), "\n")
+}
+
+func libraryCandidateName(c *rpc.LibraryResolutionCandidate) string {
+	if c.GetVersion() == "" {
+		return c.GetName()
+	}
+	return c.GetName() + "@" + c.GetVersion()
+}
+
+// resolutionReason returns the description of the reason why a library has
+// been selected
+func resolutionReason(reason string) string {
+	switch reason {
+	case "pinned":
+		return tr("pinned by a library override")
+	case "only_candidate":
+		return tr("the only library that provides the header")
+	case "highest_priority":
+		return tr("the library with the highest priority")
+	case "best_name_match":
+		return tr("the library whose name best matches the header among the ones with the highest priority")
+	case "alphabetical_order":
+		return tr("the first in alphabetical order among the libraries with the highest priority")
+	default:
+		return reason
+	}
+}
+
+// priorityCell returns a table cell with the given priority aligned to the right
+func priorityCell(priority int32) *table.Cell {
+	cell := table.NewCell(fmt.Sprint(priority), nil)
+	cell.Justify(table.JustifyRight)
+	return cell
+}
@@ -0,0 +1,163 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/output"
+	"github.com/arduino/arduino-cli/commands/compile"
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/arduino-cli/table"
+)
+
+// runMatrix builds the sketch for each of the targets and prints a summary of
+// the results. The output of the builds is printed only for the failed ones,
+// unless the verbose mode is on.
+func runMatrix(req *rpc.CompileRequest, targets []*rpc.CompileMatrixTarget) {
+	verboseCompile := configuration.Settings.GetString("logging.level") == "debug"
+	matrixReq := &rpc.CompileMatrixRequest{
+		Compile:  req,
+		Targets:  targets,
+		Parallel: int32(parallelBuilds),
+	}
+	resultCB := func(r *rpc.CompileMatrixResult) {
+		if output.OutputFormat == "json" {
+			return
+		}
+		if r.GetSuccess() {
+			feedback.Printf(tr("Build of %s succeeded"), matrixTargetName(r.GetTarget()))
+		} else {
+			feedback.Printf(tr("Build of %s failed"), matrixTargetName(r.GetTarget()))
+		}
+	}
+	results, err := compile.CompileMatrix(context.Background(), matrixReq, resultCB, verboseCompile)
+	if err != nil {
+		feedback.Errorf(tr("Error during build: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+
+	res := &matrixResult{Targets: []*matrixTargetResult{}}
+	failed := 0
+	for _, r := range results {
+		if !r.GetSuccess() {
+			failed++
+		}
+		res.Targets = append(res.Targets, &matrixTargetResult{
+			Fqbn:            r.GetTarget().GetFqbn(),
+			BuildProperties: r.GetTarget().GetBuildProperties(),
+			ID:              r.GetId(),
+			CompileOut:      string(r.GetOutStream()),
+			CompileErr:      string(r.GetErrStream()),
+			BuilderResult:   r.GetCompile(),
+			Success:         r.GetSuccess(),
+			Error:           r.GetError(),
+		})
+	}
+
+	if output.OutputFormat != "json" {
+		for _, r := range res.Targets {
+			if r.Success && !verbose {
+				continue
+			}
+			feedback.Print("\n" + tr("Output of the build of %s:", matrixTargetName(&rpc.CompileMatrixTarget{Fqbn: r.Fqbn, BuildProperties: r.BuildProperties})))
+			os.Stdout.WriteString(r.CompileOut)
+			os.Stderr.WriteString(r.CompileErr)
+			if r.Error != "" {
+				feedback.Errorf(tr("Error during build: %v"), r.Error)
+			}
+		}
+		feedback.Print("")
+	}
+	feedback.PrintResult(res)
+	if failed > 0 && output.OutputFormat != "json" {
+		feedback.Errorf(tr("%[1]d of %[2]d builds failed"), failed, len(results))
+		os.Exit(errorcodes.ErrGeneric)
+	}
+}
+
+// matrixTargetName returns the FQBN of the target followed by its build
+// properties
+func matrixTargetName(target *rpc.CompileMatrixTarget) string {
+	if len(target.GetBuildProperties()) == 0 {
+		return target.GetFqbn()
+	}
+	return fmt.Sprintf("%s (%s)", target.GetFqbn(), strings.Join(target.GetBuildProperties(), " "))
+}
+
+type matrixResult struct {
+	Targets []*matrixTargetResult `json:"targets"`
+}
+
+type matrixTargetResult struct {
+	Fqbn            string               `json:"fqbn"`
+	BuildProperties []string             `json:"build_properties"`
+	ID              string               `json:"id"`
+	CompileOut      string               `json:"compiler_out"`
+	CompileErr      string               `json:"compiler_err"`
+	BuilderResult   *rpc.CompileResponse `json:"builder_result"`
+	Success         bool                 `json:"success"`
+	Error           string               `json:"error,omitempty"`
+}
+
+func (r *matrixResult) Data() interface{} {
+	return r
+}
+
+func (r *matrixResult) String() string {
+	t := table.New()
+	t.SetHeader(tr("FQBN"), tr("Build properties"), tr("Result"), tr("Flash"), tr("RAM"), tr("Build path"))
+	for _, target := range r.Targets {
+		result := tr("OK")
+		if !target.Success {
+			result = tr("FAILED")
+		}
+		flash, ram := "", ""
+		for _, section := range target.BuilderResult.GetExecutableSectionsSize() {
+			switch section.GetName() {
+			case "text":
+				flash = sectionSize(section)
+			case "data":
+				ram = sectionSize(section)
+			}
+		}
+		t.AddRow(target.Fqbn, strings.Join(target.BuildProperties, " "), result,
+			rightAligned(flash), rightAligned(ram), target.BuilderResult.GetBuildPath())
+	}
+	return t.Render()
+}
+
+// sectionSize returns the size of the section followed by the percentage of
+// the available space it uses, if known
+func sectionSize(section *rpc.ExecutableSectionSize) string {
+	if section.GetMaxSize() <= 0 {
+		return fmt.Sprint(section.GetSize())
+	}
+	return fmt.Sprintf("%d (%d%%)", section.GetSize(), section.GetSize()*100/section.GetMaxSize())
+}
+
+// rightAligned returns a table cell with the given text aligned to the right
+func rightAligned(text string) *table.Cell {
+	cell := table.NewCell(text, nil)
+	cell.Justify(table.JustifyRight)
+	return cell
+}
@@ -0,0 +1,172 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/arduino-cli/table"
+	"google.golang.org/protobuf/proto"
+)
+
+// sizeReportResult prints the size report in the format selected with the
+// --size-report flag
+type sizeReportResult struct {
+	report     *rpc.SizeReport
+	format     string
+	maxSymbols int
+}
+
+func (r *sizeReportResult) Data() interface{} {
+	return r.report
+}
+
+func (r *sizeReportResult) String() string {
+	report := r.report
+	if r.maxSymbols > 0 && len(report.GetSymbols()) > r.maxSymbols {
+		report = proto.Clone(report).(*rpc.SizeReport)
+		report.Symbols = report.Symbols[:r.maxSymbols]
+	}
+	if r.format == "json" {
+		d, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err.Error()
+		}
+		return string(d)
+	}
+
+	var out strings.Builder
+	usage := func(label string, size, max int64) {
+		if max > 0 {
+			out.WriteString(tr("%[1]s: %[2]d bytes (%[3]d%%) of %[4]d bytes", label, size, size*100/max, max) + "\n")
+		} else {
+			out.WriteString(tr("%[1]s: %[2]d bytes", label, size) + "\n")
+		}
+	}
+	usage(tr("Flash"), report.GetFlashSize(), report.GetMaxFlashSize())
+	usage(tr("RAM"), report.GetRamSize(), report.GetMaxRamSize())
+
+	t := table.New()
+	t.SetHeader(tr("Section"), tr("Address"), tr("Size"), tr("Flash"), tr("RAM"))
+	for _, s := range report.GetSections() {
+		t.AddRow(s.GetName(), fmt.Sprintf("0x%08x", s.GetAddress()), sizeCell(s.GetSize()), sizeCell(s.GetFlash()), sizeCell(s.GetRam()))
+	}
+	out.WriteString("\n" + t.Render())
+
+	if libraries := report.GetLibraries(); len(libraries) > 0 {
+		t := table.New()
+		t.SetHeader(tr("Library"), tr("Flash"), tr("RAM"))
+		for _, l := range libraries {
+			t.AddRow(l.GetName(), sizeCell(l.GetFlash()), sizeCell(l.GetRam()))
+		}
+		out.WriteString("\n" + t.Render())
+	}
+
+	if objects := report.GetObjects(); len(objects) > 0 {
+		t := table.New()
+		t.SetHeader(tr("Object"), tr("Library"), tr("Flash"), tr("RAM"))
+		for _, o := range objects {
+			t.AddRow(o.GetName(), o.GetLibrary(), sizeCell(o.GetFlash()), sizeCell(o.GetRam()))
+		}
+		out.WriteString("\n" + t.Render())
+	}
+
+	if symbols := report.GetSymbols(); len(symbols) > 0 {
+		t := table.New()
+		t.SetHeader(tr("Symbol"), tr("Section"), tr("Library"), tr("Object"), tr("Flash"), tr("RAM"))
+		for _, s := range symbols {
+			t.AddRow(s.GetName(), s.GetSection(), s.GetLibrary(), s.GetObject(), sizeCell(s.GetFlash()), sizeCell(s.GetRam()))
+		}
+		out.WriteString("\n" + t.Render())
+	}
+	return strings.TrimRight(out.String(), "\n")
+}
+
+// sizeComparisonResult prints the differences of memory usage with respect
+// to the baseline passed with the --compare-size flag
+type sizeComparisonResult struct {
+	comparison *rpc.SizeComparison
+	maxSymbols int
+}
+
+func (r *sizeComparisonResult) Data() interface{} {
+	return r.comparison
+}
+
+func (r *sizeComparisonResult) String() string {
+	c := r.comparison
+	var out strings.Builder
+	usage := func(label string, baseline, size int64) {
+		growth := tr("%[1]s: %[2]d bytes, was %[3]d bytes (%[4]s)", label, size, baseline, formatDelta(size-baseline))
+		if baseline > 0 {
+			growth = tr("%[1]s: %[2]d bytes, was %[3]d bytes (%[4]s, %+.2f%%)", label, size, baseline, formatDelta(size-baseline), float64(size-baseline)*100/float64(baseline))
+		}
+		out.WriteString(growth + "\n")
+	}
+	usage(tr("Flash"), c.GetBaselineFlashSize(), c.GetFlashSize())
+	usage(tr("RAM"), c.GetBaselineRamSize(), c.GetRamSize())
+
+	if libraries := c.GetLibraries(); len(libraries) > 0 {
+		t := table.New()
+		t.SetHeader(tr("Library"), tr("Flash"), tr("Change"), tr("RAM"), tr("Change"))
+		for _, l := range libraries {
+			t.AddRow(l.GetName(),
+				sizeCell(l.GetFlash()), deltaCell(l.GetFlash()-l.GetBaselineFlash()),
+				sizeCell(l.GetRam()), deltaCell(l.GetRam()-l.GetBaselineRam()))
+		}
+		out.WriteString("\n" + t.Render())
+	}
+
+	if symbols := c.GetSymbols(); len(symbols) > 0 {
+		if r.maxSymbols > 0 && len(symbols) > r.maxSymbols {
+			symbols = symbols[:r.maxSymbols]
+		}
+		t := table.New()
+		t.SetHeader(tr("Symbol"), tr("Library"), tr("Flash"), tr("Change"), tr("RAM"), tr("Change"))
+		for _, s := range symbols {
+			t.AddRow(s.GetName(), s.GetLibrary(),
+				sizeCell(s.GetFlash()), deltaCell(s.GetFlash()-s.GetBaselineFlash()),
+				sizeCell(s.GetRam()), deltaCell(s.GetRam()-s.GetBaselineRam()))
+		}
+		out.WriteString("\n" + t.Render())
+	}
+	return strings.TrimRight(out.String(), "\n")
+}
+
+func formatDelta(delta int64) string {
+	if delta > 0 {
+		return "+" + strconv.FormatInt(delta, 10)
+	}
+	return strconv.FormatInt(delta, 10)
+}
+
+// deltaCell returns a table cell with the given change of size aligned to the right
+func deltaCell(delta int64) *table.Cell {
+	cell := table.NewCell(formatDelta(delta), nil)
+	cell.Justify(table.JustifyRight)
+	return cell
+}
+
+// sizeCell returns a table cell with the given size aligned to the right
+func sizeCell(size int64) *table.Cell {
+	cell := table.NewCell(strconv.FormatInt(size, 10), nil)
+	cell.Justify(table.JustifyRight)
+	return cell
+}
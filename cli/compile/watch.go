@@ -0,0 +1,173 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+
+	"github.com/arduino/arduino-cli/arduino/discovery"
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/output"
+	"github.com/arduino/arduino-cli/commands/compile"
+	"github.com/arduino/arduino-cli/commands/monitor"
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+)
+
+// runWatch builds the sketch each time it changes, until CTRL-C is pressed.
+// If requested each build is uploaded and the monitor is reopened after the
+// upload.
+func runWatch(inst *rpc.Instance, req *rpc.CompileRequest, sketchPath *paths.Path) {
+	portConfiguration := &rpc.MonitorPortConfiguration{}
+	for _, config := range monitorConfigs {
+		split := strings.SplitN(config, "=", 2)
+		if len(split) != 2 || split[0] == "" {
+			feedback.Errorf(tr("Invalid port configuration %s, expected a %s value"), config, "KEY=VALUE")
+			os.Exit(errorcodes.ErrBadArgument)
+		}
+		portConfiguration.Settings = append(portConfiguration.Settings, &rpc.MonitorPortSetting{
+			SettingId: split[0],
+			Value:     split[1],
+		})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// Stop watching with CTRL-C
+	ctrlc := make(chan os.Signal, 1)
+	signal.Notify(ctrlc, os.Interrupt)
+	go func() {
+		<-ctrlc
+		cancel()
+	}()
+
+	var outStream, errStream io.Writer = os.Stdout, os.Stderr
+	var diagnosticCB compile.DiagnosticCB
+	compileStdOut := new(bytes.Buffer)
+	compileStdErr := new(bytes.Buffer)
+	diagnostics := []*rpc.CompileDiagnostic{}
+	if output.OutputFormat == "json" {
+		outStream, errStream = compileStdOut, compileStdErr
+		diagnosticCB = func(d *rpc.CompileDiagnostic) { diagnostics = append(diagnostics, d) }
+	}
+
+	mon := &watchMonitor{inst: inst, configuration: portConfiguration}
+	defer mon.close()
+	buildStarted := func(changedFiles []string) {
+		compileStdOut.Reset()
+		compileStdErr.Reset()
+		diagnostics = []*rpc.CompileDiagnostic{}
+		if len(changedFiles) > 0 && output.OutputFormat != "json" {
+			feedback.Print(tr("Changes detected in %s, building the sketch again...", strings.Join(changedFiles, ", ")))
+		}
+	}
+	buildCompleted := func(res *rpc.CompileResponse, watchedLocations paths.PathList, err error) {
+		printCompileResult(&compileResult{
+			CompileOut:    compileStdOut.String(),
+			CompileErr:    compileStdErr.String(),
+			BuilderResult: res,
+			Diagnostics:   diagnostics,
+			Success:       err == nil,
+		})
+		if err != nil {
+			feedback.Errorf(tr("Error during build: %v"), err)
+		} else if uploadAfterCompile {
+			// The upload needs the port used by the monitor
+			mon.close()
+			if uploadPort, err := uploadSketch(inst, sketchPath); err != nil {
+				feedback.Errorf(tr("Error during Upload: %v"), err)
+			} else if monitorAfterUpload {
+				if err := mon.open(uploadPort); err != nil {
+					feedback.Errorf(tr("Error opening monitor: %v"), err)
+				}
+			}
+		}
+		if output.OutputFormat != "json" {
+			feedback.Print(tr("Watching %s for changes, press CTRL-C to exit.", strings.Join(watchedLocations.AsStrings(), ", ")))
+		}
+	}
+
+	verboseCompile := configuration.Settings.GetString("logging.level") == "debug"
+	err := compile.Watch(ctx, &rpc.CompileWatchRequest{Compile: req}, outStream, errStream, diagnosticCB, buildStarted, buildCompleted, verboseCompile)
+	if err != nil {
+		feedback.Errorf(tr("Error watching sketch: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+}
+
+// watchMonitor shows the output of the board between the uploads made in
+// watch mode. It's closed before each upload, that needs the port, and opened
+// again after it. The standard input is forwarded to the open port.
+type watchMonitor struct {
+	inst          *rpc.Instance
+	configuration *rpc.MonitorPortConfiguration
+	mutex         sync.Mutex
+	proxy         *monitor.PortProxy
+	stdinOnce     sync.Once
+}
+
+func (m *watchMonitor) open(port *discovery.Port) error {
+	proxy, _, err := monitor.Monitor(context.Background(), &rpc.MonitorRequest{
+		Instance:          m.inst,
+		Port:              port.ToRPC(),
+		Fqbn:              fqbn,
+		PortConfiguration: m.configuration,
+	})
+	if err != nil {
+		return err
+	}
+	m.mutex.Lock()
+	m.proxy = proxy
+	m.mutex.Unlock()
+
+	// The copy ends when the port is closed
+	go io.Copy(os.Stdout, proxy)
+	m.stdinOnce.Do(func() { go m.forwardStdin() })
+	feedback.Print(tr("Connected to %s!", port.String()))
+	return nil
+}
+
+func (m *watchMonitor) forwardStdin() {
+	buff := make([]byte, 1024)
+	for {
+		n, err := os.Stdin.Read(buff)
+		if err != nil {
+			return
+		}
+		m.mutex.Lock()
+		if m.proxy != nil {
+			m.proxy.Write(buff[:n])
+		}
+		m.mutex.Unlock()
+	}
+}
+
+func (m *watchMonitor) close() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.proxy != nil {
+		m.proxy.Close()
+		m.proxy = nil
+	}
+}
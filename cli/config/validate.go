@@ -21,22 +21,29 @@ import (
 )
 
 var validMap = map[string]reflect.Kind{
-	"board_manager.additional_urls": reflect.Slice,
-	"daemon.port":                   reflect.String,
-	"directories.data":              reflect.String,
-	"directories.downloads":         reflect.String,
-	"directories.user":              reflect.String,
-	"library.enable_unsafe_install": reflect.Bool,
-	"logging.file":                  reflect.String,
-	"logging.format":                reflect.String,
-	"logging.level":                 reflect.String,
-	"sketch.always_export_binaries": reflect.Bool,
-	"metrics.addr":                  reflect.String,
-	"metrics.enabled":               reflect.Bool,
-	"network.proxy":                 reflect.String,
-	"network.user_agent_ext":        reflect.String,
-	"output.no_color":               reflect.Bool,
-	"updater.enable_notification":   reflect.Bool,
+	"board_manager.additional_urls":    reflect.Slice,
+	"board_manager.require_signatures": reflect.Bool,
+	"build_cache.enabled":              reflect.Bool,
+	"build_cache.max_size":             reflect.String,
+	"build_cache.path":                 reflect.String,
+	"build_cache.remote.read_only":     reflect.Bool,
+	"build_cache.remote.url":           reflect.String,
+	"daemon.port":                      reflect.String,
+	"directories.data":                 reflect.String,
+	"directories.downloads":            reflect.String,
+	"directories.user":                 reflect.String,
+	"library.enable_unsafe_install":    reflect.Bool,
+	"library.index_url":                reflect.String,
+	"logging.file":                     reflect.String,
+	"logging.format":                   reflect.String,
+	"logging.level":                    reflect.String,
+	"sketch.always_export_binaries":    reflect.Bool,
+	"metrics.addr":                     reflect.String,
+	"metrics.enabled":                  reflect.Bool,
+	"network.proxy":                    reflect.String,
+	"network.user_agent_ext":           reflect.String,
+	"output.no_color":                  reflect.Bool,
+	"updater.enable_notification":      reflect.Bool,
 }
 
 func typeOf(key string) (reflect.Kind, error) {
@@ -40,6 +40,7 @@ func NewCommand() *cobra.Command {
 	coreCommand.AddCommand(initUpgradeCommand())
 	coreCommand.AddCommand(initUninstallCommand())
 	coreCommand.AddCommand(initSearchCommand())
+	coreCommand.AddCommand(initTrustCommand())
 
 	return coreCommand
 }
@@ -0,0 +1,196 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package core
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/security"
+	"github.com/arduino/arduino-cli/arduino/utils"
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/configuration"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/arduino/go-paths-helper"
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/openpgp"
+)
+
+func initTrustCommand() *cobra.Command {
+	trustCommand := &cobra.Command{
+		Use:   "trust",
+		Short: tr("Manages the keys trusted to sign the package indexes."),
+		Long:  tr("Manages the OpenPGP public keys trusted to sign the package indexes. The signature of the indexes with a trusted key is verified each time they are updated or loaded, the indexes with an invalid or missing signature are refused. A key can be trusted for a single index URL or, if the URL ends with a slash, for all the indexes whose URL starts with it."),
+		Example: "" +
+			"  " + os.Args[0] + " core trust add https://example.com/package_example_index.json example_public_key.asc\n" +
+			"  " + os.Args[0] + " core trust list\n" +
+			"  " + os.Args[0] + " core trust remove https://example.com/package_example_index.json\n",
+	}
+	trustCommand.AddCommand(initTrustAddCommand())
+	trustCommand.AddCommand(initTrustListCommand())
+	trustCommand.AddCommand(initTrustRemoveCommand())
+	return trustCommand
+}
+
+func initTrustAddCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:     fmt.Sprintf("add %s %s", tr("URL"), tr("KEY_FILE")),
+		Short:   tr("Trusts a key to sign the package indexes downloaded from an URL."),
+		Long:    tr("Trusts the OpenPGP public key, armored or binary, contained in the given file to sign the package indexes downloaded from an URL or, if the URL ends with a slash, from all the URLs starting with it."),
+		Example: "  " + os.Args[0] + " core trust add https://example.com/boards/ example_public_key.asc",
+		Args:    cobra.ExactArgs(2),
+		Run:     runTrustAddCommand,
+	}
+}
+
+func runTrustAddCommand(cmd *cobra.Command, args []string) {
+	if _, err := utils.URLParse(args[0]); err != nil {
+		feedback.Errorf(tr("Invalid URL: %v"), err)
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+	keyPath, err := paths.New(args[1]).Abs()
+	if err != nil {
+		feedback.Errorf(tr("Invalid key file: %v"), err)
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+	keyRing, err := security.LoadKeyRing(keyPath)
+	if err != nil {
+		feedback.Errorf(tr("Invalid key file: %v"), err)
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+
+	keys := []*configuration.TrustedKey{}
+	for _, k := range configuration.TrustedKeys(configuration.Settings) {
+		if k.URL != args[0] || k.Key != keyPath.String() {
+			keys = append(keys, k)
+		}
+	}
+	keys = append(keys, &configuration.TrustedKey{URL: args[0], Key: keyPath.String()})
+	configuration.SetTrustedKeys(configuration.Settings, keys)
+	if err := configuration.Settings.WriteConfig(); err != nil {
+		feedback.Errorf(tr("Can't write config file: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+	feedback.Printf(tr("Key %[1]s trusted for %[2]s"), strings.Join(keyIDs(keyRing), ", "), args[0])
+}
+
+func initTrustListCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:     "list",
+		Short:   tr("Shows the keys trusted to sign the package indexes."),
+		Long:    tr("Shows the keys trusted to sign the package indexes, together with the URLs they are trusted for."),
+		Example: "  " + os.Args[0] + " core trust list",
+		Args:    cobra.NoArgs,
+		Run:     runTrustListCommand,
+	}
+}
+
+func runTrustListCommand(cmd *cobra.Command, args []string) {
+	res := &trustedKeysResult{Keys: []*trustedKeyResult{}}
+	for _, k := range configuration.TrustedKeys(configuration.Settings) {
+		key := &trustedKeyResult{URL: k.URL, Key: k.Key, KeyIDs: []string{}}
+		if keyRing, err := security.LoadKeyRing(paths.New(k.Key)); err != nil {
+			key.Error = err.Error()
+		} else {
+			key.KeyIDs = keyIDs(keyRing)
+		}
+		res.Keys = append(res.Keys, key)
+	}
+	feedback.PrintResult(res)
+}
+
+func initTrustRemoveCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:     fmt.Sprintf("remove %s [%s]", tr("URL"), tr("KEY_FILE")),
+		Short:   tr("Stops trusting the keys for the package indexes downloaded from an URL."),
+		Long:    tr("Stops trusting the keys for the package indexes downloaded from an URL, all of them unless a key file is given."),
+		Example: "  " + os.Args[0] + " core trust remove https://example.com/boards/",
+		Args:    cobra.RangeArgs(1, 2),
+		Run:     runTrustRemoveCommand,
+	}
+}
+
+func runTrustRemoveCommand(cmd *cobra.Command, args []string) {
+	var keyPath *paths.Path
+	if len(args) > 1 {
+		var err error
+		if keyPath, err = paths.New(args[1]).Abs(); err != nil {
+			feedback.Errorf(tr("Invalid key file: %v"), err)
+			os.Exit(errorcodes.ErrBadArgument)
+		}
+	}
+
+	keys := []*configuration.TrustedKey{}
+	removed := 0
+	for _, k := range configuration.TrustedKeys(configuration.Settings) {
+		if k.URL == args[0] && (keyPath == nil || k.Key == keyPath.String()) {
+			removed++
+			continue
+		}
+		keys = append(keys, k)
+	}
+	if removed == 0 {
+		feedback.Errorf(tr("No trusted keys found for %s"), args[0])
+		os.Exit(errorcodes.ErrGeneric)
+	}
+	configuration.SetTrustedKeys(configuration.Settings, keys)
+	if err := configuration.Settings.WriteConfig(); err != nil {
+		feedback.Errorf(tr("Can't write config file: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+}
+
+// keyIDs returns the IDs of the primary keys of the key ring
+func keyIDs(keyRing openpgp.EntityList) []string {
+	res := []string{}
+	for _, entity := range keyRing {
+		res = append(res, entity.PrimaryKey.KeyIdString())
+	}
+	return res
+}
+
+type trustedKeysResult struct {
+	Keys []*trustedKeyResult `json:"keys"`
+}
+
+type trustedKeyResult struct {
+	URL    string   `json:"url"`
+	Key    string   `json:"key"`
+	KeyIDs []string `json:"key_ids"`
+	Error  string   `json:"error,omitempty"`
+}
+
+func (r *trustedKeysResult) Data() interface{} {
+	return r
+}
+
+func (r *trustedKeysResult) String() string {
+	if len(r.Keys) == 0 {
+		return tr("No trusted keys.")
+	}
+	t := table.New()
+	t.SetHeader(tr("URL"), tr("Key file"), tr("Key ID"))
+	for _, k := range r.Keys {
+		keyID := strings.Join(k.KeyIDs, ", ")
+		if k.Error != "" {
+			keyID = k.Error
+		}
+		t.AddRow(k.URL, k.Key, keyID)
+	}
+	return t.Render()
+}
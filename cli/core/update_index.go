@@ -18,30 +18,50 @@ package core
 import (
 	"context"
 	"os"
+	"time"
 
+	"github.com/arduino/arduino-cli/arduino/cores/packageindex"
 	"github.com/arduino/arduino-cli/cli/errorcodes"
 	"github.com/arduino/arduino-cli/cli/feedback"
 	"github.com/arduino/arduino-cli/cli/instance"
 	"github.com/arduino/arduino-cli/cli/output"
 	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/configuration"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/arduino/go-paths-helper"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
 
 func initUpdateIndexCommand() *cobra.Command {
 	updateIndexCommand := &cobra.Command{
-		Use:     "update-index",
-		Short:   tr("Updates the index of cores."),
-		Long:    tr("Updates the index of cores to the latest version."),
-		Example: "  " + os.Args[0] + " core update-index",
-		Args:    cobra.NoArgs,
-		Run:     runUpdateIndexCommand,
+		Use:   "update-index",
+		Short: tr("Updates the index of cores."),
+		Long:  tr("Updates the index of cores to the latest version."),
+		Example: "" +
+			"  " + os.Args[0] + " core update-index\n" +
+			"  " + os.Args[0] + " core update-index --if-older-than 24h\n" +
+			"  " + os.Args[0] + " core update-index --list",
+		Args: cobra.NoArgs,
+		Run:  runUpdateIndexCommand,
 	}
+	updateIndexCommand.Flags().DurationVar(&updateIndexFlags.ifOlderThan, "if-older-than", 0, tr("Download again only the indexes refreshed more than the given time ago, for example 30m or 24h."))
+	updateIndexCommand.Flags().BoolVar(&updateIndexFlags.list, "list", false, tr("Show the package indexes downloaded, with their source URL and the time they were refreshed, without updating them."))
 	return updateIndexCommand
 }
 
+var updateIndexFlags struct {
+	ifOlderThan time.Duration
+	list        bool
+}
+
 func runUpdateIndexCommand(cmd *cobra.Command, args []string) {
+	if updateIndexFlags.list {
+		runListIndexesCommand()
+		return
+	}
+
 	logrus.Info("Executing `arduino core update-index`")
 	// We don't initialize any CoreInstance when updating indexes since we don't need to.
 	// Also meaningless errors might be returned when calling this command with --additional-urls
@@ -63,10 +83,58 @@ func runUpdateIndexCommand(cmd *cobra.Command, args []string) {
 	}
 
 	_, err := commands.UpdateIndex(context.Background(), &rpc.UpdateIndexRequest{
-		Instance: inst,
+		Instance:              inst,
+		UpdateIfOlderThanSecs: int64(updateIndexFlags.ifOlderThan.Seconds()),
 	}, output.ProgressBar())
 	if err != nil {
 		feedback.Errorf(tr("Error updating index: %v"), err)
 		os.Exit(errorcodes.ErrGeneric)
 	}
 }
+
+func runListIndexesCommand() {
+	logrus.Info("Executing `arduino core update-index --list`")
+	indexDir := paths.New(configuration.Settings.GetString("directories.Data"))
+	indexes, err := packageindex.LoadCachedIndexes(indexDir)
+	if err != nil {
+		feedback.Errorf(tr("Error listing package indexes: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+
+	res := &cachedIndexesResult{Indexes: []*cachedIndexResult{}}
+	for _, c := range indexes {
+		index := &cachedIndexResult{URL: c.URL, ETag: c.ETag, FetchedAt: c.FetchedAt.Format(time.RFC3339)}
+		if indexPath, err := c.IndexPath(); err == nil {
+			index.Path = indexPath.String()
+		}
+		res.Indexes = append(res.Indexes, index)
+	}
+	feedback.PrintResult(res)
+}
+
+type cachedIndexesResult struct {
+	Indexes []*cachedIndexResult `json:"indexes"`
+}
+
+type cachedIndexResult struct {
+	URL       string `json:"url"`
+	Path      string `json:"path"`
+	ETag      string `json:"etag,omitempty"`
+	FetchedAt string `json:"fetched_at"`
+}
+
+func (r *cachedIndexesResult) Data() interface{} {
+	return r
+}
+
+func (r *cachedIndexesResult) String() string {
+	if len(r.Indexes) == 0 {
+		return tr("No package indexes downloaded.")
+	}
+	t := table.New()
+	t.SetHeader(tr("URL"), tr("Path"), tr("Updated"))
+	for _, index := range r.Indexes {
+		t.AddRow(index.URL, index.Path, index.FetchedAt)
+	}
+	return t.Render()
+}
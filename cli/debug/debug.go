@@ -17,9 +17,13 @@ package debug
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"net"
 	"os"
 	"os/signal"
 	"sort"
+	"strings"
 
 	"github.com/arduino/arduino-cli/arduino/sketch"
 	"github.com/arduino/arduino-cli/cli/arguments"
@@ -32,6 +36,7 @@ import (
 	"github.com/arduino/arduino-cli/table"
 	"github.com/arduino/go-properties-orderedmap"
 	"github.com/fatih/color"
+	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
 
@@ -44,6 +49,9 @@ var (
 	importDir   string
 	printInfo   bool
 	programmer  string
+	dapMode     bool
+	dapPort     int
+	exportTo    string
 	tr          = i18n.Tr
 )
 
@@ -64,11 +72,18 @@ func NewCommand() *cobra.Command {
 	debugCommand.Flags().StringVar(&interpreter, "interpreter", "console", tr("Debug interpreter e.g.: %s", "console, mi, mi1, mi2, mi3"))
 	debugCommand.Flags().StringVarP(&importDir, "input-dir", "", "", tr("Directory containing binaries for debug."))
 	debugCommand.Flags().BoolVarP(&printInfo, "info", "I", false, tr("Show metadata about the debug session instead of starting the debugger."))
+	debugCommand.Flags().BoolVar(&dapMode, "dap", false, tr("Run a Debug Adapter Protocol session on stdin/stdout instead of the interactive gdb session."))
+	debugCommand.Flags().IntVar(&dapPort, "dap-port", 0, tr("Run a Debug Adapter Protocol session with a client connecting to the given TCP port on localhost."))
+	debugCommand.Flags().StringVar(&exportTo, "export", "", tr("Write the debug configuration for an editor into the sketch folder instead of starting the debugger: %s", strings.Join(debug.ExportFormats, ", ")))
 
 	return debugCommand
 }
 
 func run(command *cobra.Command, args []string) {
+	if dapMode {
+		// The standard output is reserved to the protocol messages
+		logrus.SetOutput(os.Stderr)
+	}
 	instance := instance.CreateAndInit()
 
 	path := ""
@@ -105,6 +120,35 @@ func run(command *cobra.Command, args []string) {
 			feedback.PrintResult(&debugInfoResult{res})
 		}
 
+	} else if exportTo != "" {
+
+		file, err := debug.ExportDebugConfig(context.Background(), debugConfigRequested, exportTo)
+		if err != nil {
+			feedback.Errorf(tr("Error exporting debug configuration: %v"), err)
+			os.Exit(errorcodes.ErrGeneric)
+		}
+		feedback.Print(tr("Debug configuration written to %s", file))
+
+	} else if dapMode || dapPort != 0 {
+
+		debugConfigRequested.Dap = true
+		var in io.Reader = os.Stdin
+		var out io.Writer = os.Stdout
+		if dapPort != 0 {
+			conn, err := acceptDAPClient(dapPort)
+			if err != nil {
+				feedback.Errorf(tr("Error during Debug: %v"), err)
+				os.Exit(errorcodes.ErrNetwork)
+			}
+			defer conn.Close()
+			in, out = conn, conn
+		}
+
+		if _, err := debug.Debug(context.Background(), debugConfigRequested, in, out, nil); err != nil {
+			feedback.Errorf(tr("Error during Debug: %v"), err)
+			os.Exit(errorcodes.ErrGeneric)
+		}
+
 	} else {
 
 		// Intercept SIGINT and forward them to debug process
@@ -119,6 +163,17 @@ func run(command *cobra.Command, args []string) {
 	}
 }
 
+// acceptDAPClient waits for a Debug Adapter Protocol client to connect
+func acceptDAPClient(port int) (net.Conn, error) {
+	listener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", port))
+	if err != nil {
+		return nil, err
+	}
+	defer listener.Close()
+	feedback.Printf(tr("Waiting for a Debug Adapter Protocol client on %s"), listener.Addr())
+	return listener.Accept()
+}
+
 type debugInfoResult struct {
 	info *dbg.GetDebugConfigResponse
 }
@@ -19,9 +19,13 @@ import (
 	"context"
 	"errors"
 	"os"
+	"path"
 
+	"github.com/arduino/arduino-cli/arduino/cores/packageindex"
+	"github.com/arduino/arduino-cli/arduino/utils"
 	"github.com/arduino/arduino-cli/cli/errorcodes"
 	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/globals"
 	"github.com/arduino/arduino-cli/cli/output"
 	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/configuration"
@@ -103,7 +107,16 @@ func FirstUpdate(instance *rpc.Instance) error {
 	dataDir := paths.New(configuration.Settings.GetString("directories.data"))
 
 	libraryIndex := dataDir.Join("library_index.json")
-	packageIndex := dataDir.Join("package_index.json")
+	// The package index may still be in the data directory if it has not been
+	// migrated yet into its own folder
+	defaultIndexURL, err := utils.URLParse(globals.DefaultIndexURL)
+	if err != nil {
+		return err
+	}
+	packageIndex := packageindex.CachedIndexPath(dataDir, defaultIndexURL)
+	if packageIndex.NotExist() {
+		packageIndex = dataDir.Join(path.Base(defaultIndexURL.Path))
+	}
 
 	if libraryIndex.Exist() && packageIndex.Exist() {
 		return nil
@@ -44,5 +44,6 @@ func NewCommand() *cobra.Command {
 	libCommand.AddCommand(initUpgradeCommand())
 	libCommand.AddCommand(initUpdateIndexCommand())
 	libCommand.AddCommand(initDepsCommand())
+	libCommand.AddCommand(initLintCommand())
 	return libCommand
 }
@@ -0,0 +1,94 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/instance"
+	"github.com/arduino/arduino-cli/commands/lib"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+func initLintCommand() *cobra.Command {
+	lintCommand := &cobra.Command{
+		Use:   fmt.Sprintf("lint %s", tr("LIBRARY_PATH")),
+		Short: tr("Checks the formal correctness of a library."),
+		Long:  tr("Checks the formal correctness of the library in the given folder. The command fails if any error is found."),
+		Example: "" +
+			"  " + os.Args[0] + " lib lint ~/Arduino/libraries/MyLibrary\n" +
+			"  " + os.Args[0] + " lib lint . --format json",
+		Args: cobra.ExactArgs(1),
+		Run:  runLintCommand,
+	}
+	return lintCommand
+}
+
+func runLintCommand(cmd *cobra.Command, args []string) {
+	instance := instance.CreateAndInit()
+
+	res, err := lib.LibraryLint(context.Background(), &rpc.LibraryLintRequest{
+		Instance: instance,
+		Path:     args[0],
+	})
+	if err != nil {
+		feedback.Errorf(tr("Error checking library: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+
+	feedback.PrintResult(&lintResult{Issues: res.GetIssues()})
+
+	for _, issue := range res.GetIssues() {
+		if issue.GetSeverity() == string(libraries.LintError) {
+			os.Exit(errorcodes.ErrGeneric)
+		}
+	}
+}
+
+// output from this command requires special formatting, let's create a dedicated
+// feedback.Result implementation
+type lintResult struct {
+	Issues []*rpc.LibraryLintIssue `json:"issues"`
+}
+
+func (lr *lintResult) Data() interface{} {
+	return lr
+}
+
+func (lr *lintResult) String() string {
+	if len(lr.Issues) == 0 {
+		return tr("No issues found.")
+	}
+
+	t := table.New()
+	t.SetHeader(tr("Severity"), tr("Rule"), tr("Message"))
+	for _, issue := range lr.Issues {
+		severityColor := color.New(color.FgYellow)
+		if issue.GetSeverity() == string(libraries.LintError) {
+			severityColor = color.New(color.FgRed)
+		}
+		t.AddRow(table.NewCell(issue.GetSeverity(), severityColor), issue.GetRule(), issue.GetMessage())
+	}
+	return t.Render()
+}
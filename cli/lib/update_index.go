@@ -18,6 +18,7 @@ package lib
 import (
 	"context"
 	"os"
+	"time"
 
 	"github.com/arduino/arduino-cli/cli/errorcodes"
 	"github.com/arduino/arduino-cli/cli/feedback"
@@ -29,12 +30,14 @@ import (
 )
 
 func initUpdateIndexCommand() *cobra.Command {
-	return &cobra.Command{
-		Use:     "update-index",
-		Short:   tr("Updates the libraries index."),
-		Long:    tr("Updates the libraries index to the latest version."),
-		Example: "  " + os.Args[0] + " lib update-index",
-		Args:    cobra.NoArgs,
+	updateIndexCommand := &cobra.Command{
+		Use:   "update-index",
+		Short: tr("Updates the libraries index."),
+		Long:  tr("Updates the libraries index to the latest version."),
+		Example: "" +
+			"  " + os.Args[0] + " lib update-index\n" +
+			"  " + os.Args[0] + " lib update-index --if-older-than 24h",
+		Args: cobra.NoArgs,
 		Run: func(cmd *cobra.Command, args []string) {
 			// We don't initialize any CoreInstance when updating indexes since we don't need to.
 			// Also meaningless errors might be returned when calling this command with --additional-urls
@@ -56,7 +59,8 @@ func initUpdateIndexCommand() *cobra.Command {
 			}
 
 			err := commands.UpdateLibrariesIndex(context.Background(), &rpc.UpdateLibrariesIndexRequest{
-				Instance: inst,
+				Instance:              inst,
+				UpdateIfOlderThanSecs: int64(updateIndexFlags.ifOlderThan.Seconds()),
 			}, output.ProgressBar())
 			if err != nil {
 				feedback.Errorf(tr("Error updating library index: %v"), err)
@@ -64,4 +68,10 @@ func initUpdateIndexCommand() *cobra.Command {
 			}
 		},
 	}
+	updateIndexCommand.Flags().DurationVar(&updateIndexFlags.ifOlderThan, "if-older-than", 0, tr("Download again only the indexes refreshed more than the given time ago, for example 30m or 24h."))
+	return updateIndexCommand
+}
+
+var updateIndexFlags struct {
+	ifOlderThan time.Duration
 }
@@ -0,0 +1,171 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+
+	"github.com/arduino/arduino-cli/cli/arguments"
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/instance"
+	"github.com/arduino/arduino-cli/commands/monitor"
+	"github.com/arduino/arduino-cli/i18n"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/spf13/cobra"
+)
+
+var (
+	port     arguments.Port
+	fqbn     string
+	describe bool
+	configs  []string
+	quiet    bool
+	tr       = i18n.Tr
+)
+
+// NewCommand created a new `monitor` command
+func NewCommand() *cobra.Command {
+	monitorCommand := &cobra.Command{
+		Use:   "monitor",
+		Short: tr("Open a communication port with a board."),
+		Long:  tr("Open a communication port with a board."),
+		Example: "" +
+			"  " + os.Args[0] + " monitor -p /dev/ttyACM0\n" +
+			"  " + os.Args[0] + " monitor -p /dev/ttyACM0 --config baudrate=115200,parity=even\n" +
+			"  " + os.Args[0] + " monitor -p /dev/ttyACM0 --describe\n" +
+			"  " + os.Args[0] + " monitor -p 192.168.1.20 -l network -b arduino:samd:mkrwifi1010",
+		Args: cobra.NoArgs,
+		Run:  runMonitorCmd,
+	}
+	port.AddToCommand(monitorCommand)
+	monitorCommand.Flags().StringVarP(&fqbn, "fqbn", "b", "", tr("Fully Qualified Board Name, e.g.: arduino:avr:uno"))
+	monitorCommand.Flags().BoolVar(&describe, "describe", false, tr("Show all the settings of the communication port."))
+	monitorCommand.Flags().StringSliceVarP(&configs, "config", "c", []string{}, tr("Configuration of the port, e.g.: %s", "baudrate=115200"))
+	monitorCommand.Flags().BoolVarP(&quiet, "quiet", "q", false, tr("Run in silent mode, show only monitor input and output."))
+	monitorCommand.MarkFlagRequired("port")
+	return monitorCommand
+}
+
+func runMonitorCmd(cmd *cobra.Command, args []string) {
+	instance := instance.CreateAndInit()
+
+	monitorPort, err := port.GetPort(instance, nil)
+	if err != nil {
+		feedback.Errorf(tr("Error getting port metadata: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+
+	if describe {
+		res, err := monitor.EnumerateMonitorPortSettings(context.Background(), &rpc.EnumerateMonitorPortSettingsRequest{
+			Instance:     instance,
+			PortProtocol: monitorPort.Protocol,
+			Fqbn:         fqbn,
+		})
+		if err != nil {
+			feedback.Errorf(tr("Error getting port settings details: %v"), err)
+			os.Exit(errorcodes.ErrGeneric)
+		}
+		feedback.PrintResult(&detailsResult{Settings: res.GetSettings()})
+		return
+	}
+
+	portConfiguration := &rpc.MonitorPortConfiguration{}
+	for _, config := range configs {
+		split := strings.SplitN(config, "=", 2)
+		if len(split) != 2 || split[0] == "" {
+			feedback.Errorf(tr("Invalid port configuration %s, expected a %s value"), config, "KEY=VALUE")
+			os.Exit(errorcodes.ErrBadArgument)
+		}
+		portConfiguration.Settings = append(portConfiguration.Settings, &rpc.MonitorPortSetting{
+			SettingId: split[0],
+			Value:     split[1],
+		})
+	}
+
+	portProxy, desc, err := monitor.Monitor(context.Background(), &rpc.MonitorRequest{
+		Instance:          instance,
+		Port:              monitorPort.ToRPC(),
+		Fqbn:              fqbn,
+		PortConfiguration: portConfiguration,
+	})
+	if err != nil {
+		feedback.Errorf(tr("Error opening monitor: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+	defer portProxy.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		_, err := io.Copy(os.Stdout, portProxy)
+		if err != nil && ctx.Err() == nil {
+			feedback.Errorf(tr("Port closed: %v"), err)
+		}
+		cancel()
+	}()
+	go func() {
+		_, err := io.Copy(portProxy, os.Stdin)
+		if err != nil && ctx.Err() == nil {
+			feedback.Errorf(tr("Port closed: %v"), err)
+		}
+		cancel()
+	}()
+
+	// Intercept SIGINT to close the port gracefully
+	ctrlc := make(chan os.Signal, 1)
+	signal.Notify(ctrlc, os.Interrupt)
+	go func() {
+		<-ctrlc
+		cancel()
+	}()
+
+	if !quiet {
+		params := []string{}
+		for _, setting := range desc.ToRPC() {
+			params = append(params, fmt.Sprintf("%s=%s", setting.GetSettingId(), setting.GetValue()))
+		}
+		if len(params) > 0 {
+			feedback.Print(tr("Monitor port settings: %s", strings.Join(params, ", ")))
+		}
+		feedback.Print(tr("Connected to %s! Press CTRL-C to exit.", monitorPort.String()))
+	}
+
+	// Wait for port closed
+	<-ctx.Done()
+}
+
+type detailsResult struct {
+	Settings []*rpc.MonitorPortSettingDescriptor `json:"settings"`
+}
+
+func (r *detailsResult) Data() interface{} {
+	return r
+}
+
+func (r *detailsResult) String() string {
+	t := table.New()
+	t.SetHeader(tr("ID"), tr("Setting"), tr("Current"), tr("Values"))
+	for _, setting := range r.Settings {
+		t.AddRow(setting.GetSettingId(), setting.GetLabel(), setting.GetValue(), strings.Join(setting.GetEnumValues(), ", "))
+	}
+	return t.Render()
+}
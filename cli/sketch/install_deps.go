@@ -0,0 +1,82 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/cli/core"
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/instance"
+	"github.com/arduino/arduino-cli/cli/output"
+	sk "github.com/arduino/arduino-cli/commands/sketch"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var updateLock bool
+
+// initInstallDepsCommand creates a new `install-deps` command
+func initInstallDepsCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   fmt.Sprintf("install-deps [%s]", tr("sketchPath")),
+		Short: tr("Installs the platforms and libraries required by a sketch."),
+		Long: tr("Installs the platforms and libraries declared in the %[1]s file of a sketch. The exact releases recorded in the %[2]s file are installed, if the file is missing or outdated the dependencies are resolved again and the file is updated.",
+			sketch.ProjectFileName, sketch.LockFileName),
+		Example: "" +
+			"  " + os.Args[0] + " sketch install-deps\n" +
+			"  " + os.Args[0] + " sketch install-deps /home/user/Arduino/MySketch\n" +
+			"  " + os.Args[0] + " sketch install-deps --update-lock",
+		Args: cobra.MaximumNArgs(1),
+		Run:  runInstallDepsCommand,
+	}
+
+	command.Flags().BoolVar(&updateLock, "update-lock", false, tr("Resolves again the dependencies ignoring the releases recorded in the %s file.", sketch.LockFileName))
+	core.AddPostInstallFlagsToCommand(command)
+
+	return command
+}
+
+func runInstallDepsCommand(cmd *cobra.Command, args []string) {
+	inst := instance.CreateAndInit()
+	logrus.Info("Executing `arduino sketch install-deps`")
+
+	sketchPath := paths.New(".")
+	if len(args) >= 1 {
+		sketchPath = paths.New(args[0])
+	}
+
+	err := sk.InstallDependencies(context.Background(),
+		&rpc.SketchInstallDependenciesRequest{
+			Instance:        inst,
+			SketchPath:      sketchPath.String(),
+			UpdateLock:      updateLock,
+			SkipPostInstall: core.DetectSkipPostInstallValue(),
+		},
+		output.ProgressBar(),
+		output.TaskProgress(),
+	)
+	if err != nil {
+		feedback.Errorf(tr("Error installing sketch dependencies: %v"), err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+}
@@ -35,6 +35,7 @@ func NewCommand() *cobra.Command {
 
 	cmd.AddCommand(initNewCommand())
 	cmd.AddCommand(initArchiveCommand())
+	cmd.AddCommand(initInstallDepsCommand())
 
 	return cmd
 }
@@ -0,0 +1,125 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package test
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+)
+
+type junitTestSuites struct {
+	XMLName  xml.Name          `xml:"testsuites"`
+	Tests    int               `xml:"tests,attr"`
+	Failures int               `xml:"failures,attr"`
+	Errors   int               `xml:"errors,attr"`
+	Time     string            `xml:"time,attr"`
+	Suites   []*junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string           `xml:"name,attr"`
+	Tests     int              `xml:"tests,attr"`
+	Failures  int              `xml:"failures,attr"`
+	Errors    int              `xml:"errors,attr"`
+	Time      string           `xml:"time,attr"`
+	TestCases []*junitTestCase `xml:"testcase"`
+	duration  float64
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+	SystemOut *junitOutput  `xml:"system-out,omitempty"`
+	SystemErr *junitOutput  `xml:"system-err,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",cdata"`
+}
+
+type junitOutput struct {
+	Text string `xml:",cdata"`
+}
+
+// junitXML returns the results of the tests in the JUnit XML format, the
+// tests are grouped in a test suite for each test file
+func junitXML(tests []*rpc.TestCaseResult) []byte {
+	res := &junitTestSuites{Suites: []*junitTestSuite{}}
+	suites := map[string]*junitTestSuite{}
+	duration := 0.0
+	for _, t := range tests {
+		suite, ok := suites[t.GetSuite()]
+		if !ok {
+			suite = &junitTestSuite{Name: t.GetSuite()}
+			suites[t.GetSuite()] = suite
+			res.Suites = append(res.Suites, suite)
+		}
+		testCase := &junitTestCase{
+			Name:      t.GetName(),
+			ClassName: t.GetSuite(),
+			Time:      junitTime(t.GetDuration()),
+		}
+		if t.GetOutput() != "" {
+			testCase.SystemOut = &junitOutput{Text: t.GetOutput()}
+		}
+		if t.GetErrorOutput() != "" {
+			testCase.SystemErr = &junitOutput{Text: t.GetErrorOutput()}
+		}
+		switch t.GetStatus() {
+		case rpc.TestStatus_TEST_STATUS_PASSED:
+		case rpc.TestStatus_TEST_STATUS_FAILED:
+			failures := []string{}
+			message := ""
+			for _, f := range t.GetFailures() {
+				failures = append(failures, fmt.Sprintf("%s:%d: %s", f.GetFile(), f.GetLine(), f.GetMessage()))
+				if message == "" {
+					// The message is the first line of the first failure
+					message = strings.SplitN(f.GetMessage(), "\n", 2)[0]
+				}
+			}
+			testCase.Failure = &junitMessage{Message: message, Text: strings.Join(failures, "\n")}
+			suite.Failures++
+			res.Failures++
+		default:
+			testCase.Error = &junitMessage{Message: t.GetError(), Text: t.GetError()}
+			suite.Errors++
+			res.Errors++
+		}
+		suite.TestCases = append(suite.TestCases, testCase)
+		suite.Tests++
+		suite.duration += t.GetDuration()
+		res.Tests++
+		duration += t.GetDuration()
+	}
+	for _, suite := range res.Suites {
+		suite.Time = junitTime(suite.duration)
+	}
+	res.Time = junitTime(duration)
+
+	data, _ := xml.MarshalIndent(res, "", "  ")
+	return append([]byte(xml.Header), append(data, '\n')...)
+}
+
+func junitTime(seconds float64) string {
+	return fmt.Sprintf("%.3f", seconds)
+}
@@ -0,0 +1,54 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package test
+
+import (
+	"encoding/xml"
+	"testing"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJUnitXML(t *testing.T) {
+	data := junitXML([]*rpc.TestCaseResult{
+		{Suite: "blink_test", Name: "led_on", Status: rpc.TestStatus_TEST_STATUS_PASSED, Duration: 0.5, Output: "hello"},
+		{Suite: "blink_test", Name: "crash", Status: rpc.TestStatus_TEST_STATUS_ERROR, Error: "test crashed"},
+		{Suite: "net/client_test", Name: "connect", Status: rpc.TestStatus_TEST_STATUS_FAILED, Duration: 1.25,
+			Failures: []*rpc.TestFailure{{File: "client_test.cpp", Line: 7, Message: "expected 1 == x\n  x = 2"}}},
+	})
+
+	res := &junitTestSuites{}
+	require.NoError(t, xml.Unmarshal(data, res))
+	require.Equal(t, 3, res.Tests)
+	require.Equal(t, 1, res.Failures)
+	require.Equal(t, 1, res.Errors)
+	require.Equal(t, "1.750", res.Time)
+	require.Len(t, res.Suites, 2)
+
+	suite := res.Suites[0]
+	require.Equal(t, "blink_test", suite.Name)
+	require.Equal(t, 2, suite.Tests)
+	require.Equal(t, 1, suite.Errors)
+	require.Equal(t, "hello", suite.TestCases[0].SystemOut.Text)
+	require.Nil(t, suite.TestCases[0].Failure)
+	require.Equal(t, "test crashed", suite.TestCases[1].Error.Message)
+
+	testCase := res.Suites[1].TestCases[0]
+	require.Equal(t, "net/client_test", testCase.ClassName)
+	require.Equal(t, "expected 1 == x", testCase.Failure.Message)
+	require.Equal(t, "client_test.cpp:7: expected 1 == x\n  x = 2", testCase.Failure.Text)
+}
@@ -0,0 +1,278 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/arduino/arduino-cli/cli/arguments"
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/instance"
+	"github.com/arduino/arduino-cli/cli/output"
+	"github.com/arduino/arduino-cli/commands/compile"
+	"github.com/arduino/arduino-cli/configuration"
+	"github.com/arduino/arduino-cli/i18n"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/spf13/cobra"
+)
+
+var (
+	buildPath       string                      // Path where to save compiled files.
+	buildProperties []string                    // Custom build properties, can be used multiple times.
+	warnings        string                      // Used to tell the compiler which warning level to use.
+	verbose         bool                        // Turns on verbose mode.
+	clean           bool                        // Cleanup the build folder and do not use any cached build.
+	sketchEnv       arguments.SketchEnvironment // Selects how the sketch environment is used.
+	library         []string                    // List of paths to libraries root folders.
+	libraries       []string                    // List of custom libraries dir paths.
+	filter          string                      // Regular expression selecting the tests to run.
+	timeout         time.Duration               // Maximum duration of each test.
+	junitReport     string                      // Path of the file where the JUnit XML report is saved.
+	tr              = i18n.Tr
+)
+
+// NewCommand created a new `test` command
+func NewCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "test",
+		Short: tr("Runs the unit tests of a sketch on this computer."),
+		Long:  tr("Builds the sketch together with the unit tests in its test folder using the compiler of this computer and a mock Arduino core, then runs the tests. Each test runs in its own process, so a crash affects only the test that caused it."),
+		Example: "" +
+			"  " + os.Args[0] + " test /home/user/Arduino/MySketch\n" +
+			"  " + os.Args[0] + " test --filter 'blink/.*' --junit report.xml /home/user/Arduino/MySketch\n",
+		Args: cobra.MaximumNArgs(1),
+		Run:  run,
+	}
+
+	command.Flags().StringVar(&buildPath, "build-path", "",
+		tr("Path where to save compiled files. If omitted, a directory will be created in the default temporary path of your OS."))
+	command.Flags().StringArrayVar(&buildProperties, "build-property", []string{},
+		tr("Override a build property with a custom value. Can be used multiple times for multiple properties."))
+	command.Flags().StringVar(&warnings, "warnings", "none",
+		tr(`Optional, can be: %s. Used to tell gcc which warning level to use (-W flag).`, "none, default, more, all"))
+	command.Flags().BoolVarP(&verbose, "verbose", "v", false, tr("Optional, turns on verbose mode."))
+	command.Flags().BoolVar(&clean, "clean", false, tr("Optional, cleanup the build folder and do not use any cached build."))
+	sketchEnv.AddToCommand(command)
+	command.Flags().StringSliceVar(&library, "library", []string{},
+		tr("List of paths to libraries root folders. Libraries set this way have top priority in case of conflicts. Can be used multiple times for different libraries."))
+	command.Flags().StringSliceVar(&libraries, "libraries", []string{},
+		tr("List of custom libraries dir paths separated by commas. Or can be used multiple times for multiple libraries dir paths."))
+	command.Flags().StringVar(&filter, "filter", "",
+		tr("Optional, run only the tests matching the given regular expression, in the SUITE/NAME format. The suite is the path of the test file in the test folder, without extension."))
+	command.Flags().DurationVar(&timeout, "timeout", compile.DefaultTestTimeout,
+		tr("Maximum duration of each test, the tests running longer are stopped."))
+	command.Flags().StringVar(&junitReport, "junit", "", tr("Optional, save the results of the tests in the given file in the JUnit XML format."))
+	return command
+}
+
+func run(cmd *cobra.Command, args []string) {
+	inst := instance.CreateAndInit()
+
+	path := ""
+	if len(args) > 0 {
+		path = args[0]
+	}
+	sketchPath := arguments.InitSketchPath(path)
+
+	if timeout < time.Millisecond {
+		feedback.Errorf(tr("Invalid timeout: %s"), timeout)
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+
+	req := &rpc.TestRequest{
+		Compile: &rpc.CompileRequest{
+			Instance:          inst,
+			SketchPath:        sketchPath.String(),
+			BuildPath:         buildPath,
+			BuildProperties:   buildProperties,
+			Warnings:          warnings,
+			Verbose:           verbose,
+			Clean:             clean,
+			Library:           library,
+			Libraries:         libraries,
+			SketchEnvironment: sketchEnv.GetMode(),
+		},
+		Filter:  filter,
+		Timeout: uint32(timeout / time.Millisecond),
+	}
+
+	compileStdOut := new(bytes.Buffer)
+	compileStdErr := new(bytes.Buffer)
+	verboseCompile := configuration.Settings.GetString("logging.level") == "debug"
+	var testRes *rpc.TestResult
+	var testError error
+	if output.OutputFormat == "json" {
+		testRes, testError = compile.Test(context.Background(), req, compileStdOut, compileStdErr, nil, verboseCompile)
+	} else {
+		testRes, testError = compile.Test(context.Background(), req, os.Stdout, os.Stderr, printTestCase, verboseCompile)
+	}
+
+	res := &testResult{
+		CompileOut:    compileStdOut.String(),
+		CompileErr:    compileStdErr.String(),
+		BuilderResult: testRes.GetCompile(),
+		Tests:         []*testCaseResult{},
+		Success:       testError == nil,
+	}
+	if testError != nil {
+		res.Error = testError.Error()
+	}
+	for _, t := range testRes.GetTests() {
+		tc := newTestCaseResult(t)
+		res.Tests = append(res.Tests, tc)
+		res.Success = res.Success && tc.Status == statusPassed
+	}
+
+	if junitReport != "" && testError == nil {
+		if err := paths.New(junitReport).WriteFile(junitXML(testRes.GetTests())); err != nil {
+			feedback.Errorf(tr("Error saving JUnit report: %v"), err)
+			os.Exit(errorcodes.ErrGeneric)
+		}
+	}
+
+	feedback.PrintResult(res)
+	if output.OutputFormat == "json" {
+		return
+	}
+	if testError != nil {
+		feedback.Errorf(tr("Error running tests: %v"), testError)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+	if !res.Success {
+		os.Exit(errorcodes.ErrGeneric)
+	}
+}
+
+// printTestCase prints the outcome of a test as soon as it ends, with the
+// failures and the output of the tests that didn't pass
+func printTestCase(t *rpc.TestCaseResult) {
+	tc := newTestCaseResult(t)
+	switch tc.Status {
+	case statusPassed:
+		feedback.Printf("--- PASS: %s/%s (%.2fs)", tc.Suite, tc.Name, tc.Duration)
+		if !verbose {
+			return
+		}
+	case statusFailed:
+		feedback.Printf("--- FAIL: %s/%s (%.2fs)", tc.Suite, tc.Name, tc.Duration)
+	default:
+		feedback.Printf("--- ERROR: %s/%s (%.2fs)", tc.Suite, tc.Name, tc.Duration)
+	}
+	for _, f := range tc.Failures {
+		feedback.Print(indent(fmt.Sprintf("%s:%d: %s", f.File, f.Line, f.Message)))
+	}
+	if tc.Error != "" {
+		feedback.Print(indent(tc.Error))
+	}
+	if tc.Output != "" {
+		feedback.Print(indent(strings.TrimRight(tc.Output, "\n")))
+	}
+	if tc.ErrorOutput != "" {
+		feedback.Print(indent(strings.TrimRight(tc.ErrorOutput, "\n")))
+	}
+}
+
+func indent(text string) string {
+	return "    " + strings.ReplaceAll(text, "\n", "\n    ")
+}
+
+const (
+	statusPassed = "passed"
+	statusFailed = "failed"
+	statusError  = "error"
+)
+
+type testResult struct {
+	CompileOut    string               `json:"compiler_out"`
+	CompileErr    string               `json:"compiler_err"`
+	BuilderResult *rpc.CompileResponse `json:"builder_result"`
+	Tests         []*testCaseResult    `json:"tests"`
+	Success       bool                 `json:"success"`
+	Error         string               `json:"error,omitempty"`
+}
+
+type testCaseResult struct {
+	Suite       string         `json:"suite"`
+	Name        string         `json:"name"`
+	Status      string         `json:"status"`
+	Duration    float64        `json:"duration"`
+	Failures    []*testFailure `json:"failures"`
+	Output      string         `json:"output"`
+	ErrorOutput string         `json:"error_output"`
+	Error       string         `json:"error,omitempty"`
+}
+
+type testFailure struct {
+	File    string `json:"file"`
+	Line    int32  `json:"line"`
+	Message string `json:"message"`
+}
+
+func newTestCaseResult(t *rpc.TestCaseResult) *testCaseResult {
+	res := &testCaseResult{
+		Suite:       t.GetSuite(),
+		Name:        t.GetName(),
+		Status:      statusError,
+		Duration:    t.GetDuration(),
+		Failures:    []*testFailure{},
+		Output:      t.GetOutput(),
+		ErrorOutput: t.GetErrorOutput(),
+		Error:       t.GetError(),
+	}
+	switch t.GetStatus() {
+	case rpc.TestStatus_TEST_STATUS_PASSED:
+		res.Status = statusPassed
+	case rpc.TestStatus_TEST_STATUS_FAILED:
+		res.Status = statusFailed
+	}
+	for _, f := range t.GetFailures() {
+		res.Failures = append(res.Failures, &testFailure{File: f.GetFile(), Line: f.GetLine(), Message: f.GetMessage()})
+	}
+	return res
+}
+
+func (r *testResult) Data() interface{} {
+	return r
+}
+
+func (r *testResult) String() string {
+	if r.Error != "" {
+		// The error is printed apart
+		return ""
+	}
+	passed, failed, errors := 0, 0, 0
+	for _, t := range r.Tests {
+		switch t.Status {
+		case statusPassed:
+			passed++
+		case statusFailed:
+			failed++
+		default:
+			errors++
+		}
+	}
+	if len(r.Tests) == 0 {
+		return tr("No tests run")
+	}
+	return tr("%[1]d tests: %[2]d passed, %[3]d failed, %[4]d errors", len(r.Tests), passed, failed, errors)
+}
@@ -18,6 +18,7 @@ package update
 import (
 	"context"
 	"os"
+	"time"
 
 	"github.com/arduino/arduino-cli/cli/errorcodes"
 	"github.com/arduino/arduino-cli/cli/feedback"
@@ -36,19 +37,23 @@ var tr = i18n.Tr
 // NewCommand creates a new `update` command
 func NewCommand() *cobra.Command {
 	updateCommand := &cobra.Command{
-		Use:     "update",
-		Short:   tr("Updates the index of cores and libraries"),
-		Long:    tr("Updates the index of cores and libraries to the latest versions."),
-		Example: "  " + os.Args[0] + " update",
-		Args:    cobra.NoArgs,
-		Run:     runUpdateCommand,
+		Use:   "update",
+		Short: tr("Updates the index of cores and libraries"),
+		Long:  tr("Updates the index of cores and libraries to the latest versions."),
+		Example: "" +
+			"  " + os.Args[0] + " update\n" +
+			"  " + os.Args[0] + " update --if-older-than 24h",
+		Args: cobra.NoArgs,
+		Run:  runUpdateCommand,
 	}
+	updateCommand.Flags().DurationVar(&updateFlags.ifOlderThan, "if-older-than", 0, tr("Download again only the indexes refreshed more than the given time ago, for example 30m or 24h."))
 	updateCommand.Flags().BoolVar(&updateFlags.showOutdated, "show-outdated", false, tr("Show outdated cores and libraries after index update"))
 	return updateCommand
 }
 
 var updateFlags struct {
 	showOutdated bool
+	ifOlderThan  time.Duration
 }
 
 func runUpdateCommand(cmd *cobra.Command, args []string) {
@@ -73,7 +78,8 @@ func runUpdateCommand(cmd *cobra.Command, args []string) {
 	}
 
 	err := commands.UpdateCoreLibrariesIndex(context.Background(), &rpc.UpdateCoreLibrariesIndexRequest{
-		Instance: inst,
+		Instance:              inst,
+		UpdateIfOlderThanSecs: int64(updateFlags.ifOlderThan.Seconds()),
 	}, output.ProgressBar())
 	if err != nil {
 		feedback.Errorf(tr("Error updating core and libraries index: %v"), err)
@@ -33,6 +33,7 @@ import (
 var (
 	fqbn       string
 	port       arguments.Port
+	sketchEnv  arguments.SketchEnvironment
 	verbose    bool
 	verify     bool
 	importDir  string
@@ -56,6 +57,7 @@ func NewCommand() *cobra.Command {
 
 	uploadCommand.Flags().StringVarP(&fqbn, "fqbn", "b", "", tr("Fully Qualified Board Name, e.g.: arduino:avr:uno"))
 	port.AddToCommand(uploadCommand)
+	sketchEnv.AddToCommand(uploadCommand)
 	uploadCommand.Flags().StringVarP(&importDir, "input-dir", "", "", tr("Directory containing binaries to upload."))
 	uploadCommand.Flags().StringVarP(&importFile, "input-file", "i", "", tr("Binary file to upload."))
 	uploadCommand.Flags().BoolVarP(&verify, "verify", "t", false, tr("Verify uploaded binary after the upload."))
@@ -102,16 +104,18 @@ func run(command *cobra.Command, args []string) {
 		os.Exit(errorcodes.ErrGeneric)
 	}
 
-	if fqbn == "" && sk != nil && sk.Metadata != nil {
-		// If the user didn't specify an FQBN and a sketch.json file is present
-		// read it from there.
-		fqbn = sk.Metadata.CPU.Fqbn
+	if fqbn == "" && sk != nil {
+		// If the user didn't specify an FQBN read it from the sketch.json file
+		// or, if missing, from the sketch project file.
+		fqbn = sk.DefaultFqbn()
 	}
 
 	userFieldRes, err := upload.SupportedUserFields(context.Background(), &rpc.SupportedUserFieldsRequest{
-		Instance: instance,
-		Fqbn:     fqbn,
-		Protocol: discoveryPort.Protocol,
+		Instance:          instance,
+		Fqbn:              fqbn,
+		Protocol:          discoveryPort.Protocol,
+		SketchPath:        sketchPath.String(),
+		SketchEnvironment: sketchEnv.GetMode(),
 	})
 	if err != nil {
 		feedback.Errorf(tr("Error during Upload: %v"), err)
@@ -129,17 +133,18 @@ func run(command *cobra.Command, args []string) {
 	}
 
 	if _, err := upload.Upload(context.Background(), &rpc.UploadRequest{
-		Instance:   instance,
-		Fqbn:       fqbn,
-		SketchPath: path,
-		Port:       discoveryPort.ToRPC(),
-		Verbose:    verbose,
-		Verify:     verify,
-		ImportFile: importFile,
-		ImportDir:  importDir,
-		Programmer: programmer,
-		DryRun:     dryRun,
-		UserFields: fields,
+		Instance:          instance,
+		Fqbn:              fqbn,
+		SketchPath:        path,
+		Port:              discoveryPort.ToRPC(),
+		Verbose:           verbose,
+		Verify:            verify,
+		ImportFile:        importFile,
+		ImportDir:         importDir,
+		Programmer:        programmer,
+		DryRun:            dryRun,
+		UserFields:        fields,
+		SketchEnvironment: sketchEnv.GetMode(),
 	}, os.Stdout, os.Stderr); err != nil {
 		feedback.Errorf(tr("Error during Upload: %v"), err)
 		os.Exit(errorcodes.ErrGeneric)
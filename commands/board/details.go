@@ -56,10 +56,7 @@ func Details(ctx context.Context, req *rpc.BoardDetailsRequest) (*rpc.BoardDetai
 
 	details.DebuggingSupported = boardProperties.ContainsKey("debug.executable") ||
 		boardPlatform.Properties.ContainsKey("debug.executable") ||
-		(boardRefPlatform != nil && boardRefPlatform.Properties.ContainsKey("debug.executable")) ||
-		// HOTFIX: Remove me when the `arduino:samd` core is updated
-		boardPlatform.String() == "arduino:samd@1.8.9" ||
-		boardPlatform.String() == "arduino:samd@1.8.8"
+		(boardRefPlatform != nil && boardRefPlatform.Properties.ContainsKey("debug.executable"))
 
 	details.Package = &rpc.Package{
 		Name:       boardPackage.Name,
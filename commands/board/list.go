@@ -25,6 +25,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/arduino/arduino-cli/arduino/cores/packageindex"
 	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
 	"github.com/arduino/arduino-cli/arduino/discovery"
 	"github.com/arduino/arduino-cli/commands"
@@ -116,8 +117,69 @@ func identifyViaCloudAPI(port *discovery.Port) ([]*rpc.BoardListItem, error) {
 	return apiByVidPid(id.Get("vid"), id.Get("pid"))
 }
 
-// identify returns a list of boards checking first the installed platforms or the Cloud API
-func identify(pm *packagemanager.PackageManager, port *discovery.Port) ([]*rpc.BoardListItem, error) {
+// loadBoardsDB loads the database of the USB IDs of the boards listed in the
+// package indexes, it returns nil if the database is not available
+func loadBoardsDB(pm *packagemanager.PackageManager) *packageindex.BoardsDB {
+	dbFile := pm.IndexDir.Join(packageindex.BoardsDBFileName)
+	if !dbFile.Exist() {
+		logrus.Debug("Boards database not found, run `core update-index` to create it")
+		return nil
+	}
+	db, err := packageindex.LoadBoardsDB(dbFile)
+	if err != nil {
+		logrus.Warn(err)
+		return nil
+	}
+	return db
+}
+
+// identifyViaBoardsDB returns the boards matching the port USB IDs in the
+// database built from the package indexes. The boards provided by platforms
+// that are not installed, or whose installed release doesn't contain them,
+// are reported together with the platform to install.
+func identifyViaBoardsDB(pm *packagemanager.PackageManager, db *packageindex.BoardsDB, port *discovery.Port) []*rpc.BoardListItem {
+	id := port.Properties
+	if db == nil || !id.ContainsKey("vid") || !id.ContainsKey("pid") {
+		return nil
+	}
+
+	logrus.Debug("Querying boards database for board identification...")
+	boards := []*rpc.BoardListItem{}
+	for _, entry := range db.Lookup(id.Get("vid"), id.Get("pid")) {
+		split := strings.Split(entry.Platform, ":")
+		if len(split) != 2 {
+			continue
+		}
+		item := &rpc.BoardListItem{
+			Name:              entry.Name,
+			Platform:          &rpc.Platform{Maintainer: entry.Maintainer},
+			PlatformToInstall: entry.Platform,
+		}
+		platform := pm.FindPlatform(&packagemanager.PlatformReference{
+			Package:              split[0],
+			PlatformArchitecture: split[1],
+		})
+		if platform != nil {
+			if installed := pm.GetInstalledPlatformRelease(platform); installed != nil {
+				item.PlatformToInstall = entry.Platform + "@" + entry.PlatformVersion
+				for _, board := range installed.Boards {
+					if board.Name() == entry.Name {
+						item.Fqbn = board.FQBN()
+						item.PlatformToInstall = ""
+						break
+					}
+				}
+			}
+		}
+		boards = append(boards, item)
+	}
+	return boards
+}
+
+// identify returns a list of boards checking first the installed platforms,
+// then the boards database built from the package indexes and finally the
+// Cloud API
+func identify(pm *packagemanager.PackageManager, db *packageindex.BoardsDB, port *discovery.Port) ([]*rpc.BoardListItem, error) {
 	boards := []*rpc.BoardListItem{}
 
 	// first query installed cores through the Package Manager
@@ -134,13 +196,23 @@ func identify(pm *packagemanager.PackageManager, port *discovery.Port) ([]*rpc.B
 		})
 	}
 
-	// if installed cores didn't recognize the board, try querying
-	// the builder API if the board is a USB device port
+	// if installed cores didn't recognize the board, look for it
+	// in the boards listed in the package indexes
+	if len(boards) == 0 {
+		boards = identifyViaBoardsDB(pm, db, port)
+	}
+
+	// if the board is still unknown, try querying the builder API
+	// if the board is a USB device port
 	if len(boards) == 0 {
 		items, err := identifyViaCloudAPI(port)
 		if errors.Is(err, ErrNotFound) {
 			// the board couldn't be detected, print a warning
 			logrus.Debug("Board not recognized")
+		} else if err != nil && db != nil {
+			// the boards database is available, the Cloud API may be
+			// unreachable because we are working offline
+			logrus.Warnf("Error getting board info from Arduino Cloud: %s", err)
 		} else if err != nil {
 			// this is bad, bail out
 			return nil, &commands.UnavailableError{Message: tr("Error getting board info from Arduino Cloud")}
@@ -210,8 +282,9 @@ func List(req *rpc.BoardListRequest) (r []*rpc.DetectedPort, e error) {
 	if len(errs) > 0 {
 		return nil, &commands.UnavailableError{Message: tr("Error getting board list"), Cause: fmt.Errorf("%v", errs)}
 	}
+	db := loadBoardsDB(pm)
 	for _, port := range ports {
-		boards, err := identify(pm, port)
+		boards, err := identify(pm, db, port)
 		if err != nil {
 			return nil, err
 		}
@@ -246,6 +319,7 @@ func Watch(instanceID int32, interrupt <-chan bool) (<-chan *rpc.BoardListWatchR
 	}
 
 	outChan := make(chan *rpc.BoardListWatchResponse)
+	db := loadBoardsDB(pm)
 
 	go func() {
 		defer close(outChan)
@@ -264,7 +338,7 @@ func Watch(instanceID int32, interrupt <-chan bool) (<-chan *rpc.BoardListWatchR
 
 				boardsError := ""
 				if event.Type == "add" {
-					boards, err := identify(pm, event.Port)
+					boards, err := identify(pm, db, event.Port)
 					if err != nil {
 						boardsError = err.Error()
 					}
@@ -22,6 +22,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/arduino/arduino-cli/arduino/cores/packageindex"
 	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
 	"github.com/arduino/arduino-cli/arduino/discovery"
 	"github.com/arduino/arduino-cli/configuration"
@@ -150,7 +151,7 @@ func TestBoardIdentifySorting(t *testing.T) {
 	idPrefs := properties.NewMap()
 	idPrefs.Set("vid", "0x0000")
 	idPrefs.Set("pid", "0x0000")
-	res, err := identify(pm, &discovery.Port{Properties: idPrefs})
+	res, err := identify(pm, nil, &discovery.Port{Properties: idPrefs})
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	require.Len(t, res, 4)
@@ -161,3 +162,56 @@ func TestBoardIdentifySorting(t *testing.T) {
 	require.Equal(t, res[2].Fqbn, "packager:platform:boardA")
 	require.Equal(t, res[3].Fqbn, "packager:platform:boardB")
 }
+
+func TestBoardIdentifyViaBoardsDB(t *testing.T) {
+	dataDir := paths.TempDir().Join("test", "data_dir")
+	dataDir.MkdirAll()
+	defer paths.TempDir().Join("test").RemoveAll()
+
+	pm := packagemanager.NewPackageManager(dataDir, dataDir, dataDir, dataDir)
+
+	// The installed platform doesn't provide the USB IDs of its boards
+	pack := pm.Packages.GetOrCreatePackage("test")
+	pack.Maintainer = "Test"
+	platformRelease := pack.GetOrCreatePlatform("samd").GetOrCreateRelease(semver.MustParse("1.0.0"))
+	platformRelease.InstallDir = dataDir
+	platformRelease.GetOrCreateBoard("mkr").Properties.Set("name", "Test MKR")
+
+	db := &packageindex.BoardsDB{Boards: []*packageindex.BoardsDBEntry{
+		{VID: "0x1234", PID: "0x0002", Name: "Other Uno", Platform: "other:avr", PlatformVersion: "2.0.0", Maintainer: "Other"},
+		{VID: "0x1234", PID: "0x0002", Name: "Test MKR", Platform: "test:samd", PlatformVersion: "1.1.0", Maintainer: "Test"},
+		{VID: "0x1234", PID: "0x00ab", Name: "Test Zero", Platform: "test:samd", PlatformVersion: "1.1.0", Maintainer: "Test"},
+	}}
+
+	idPrefs := properties.NewMap()
+	idPrefs.Set("vid", "0x1234")
+	idPrefs.Set("pid", "0x0002")
+	res, err := identify(pm, db, &discovery.Port{Properties: idPrefs})
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	require.Equal(t, "Other Uno", res[0].Name)
+	require.Empty(t, res[0].Fqbn)
+	require.Equal(t, "other:avr", res[0].PlatformToInstall)
+	require.Equal(t, "Test MKR", res[1].Name)
+	require.Equal(t, "test:samd:mkr", res[1].Fqbn)
+	require.Empty(t, res[1].PlatformToInstall)
+
+	// The installed release of the platform doesn't contain the board
+	idPrefs.Set("pid", "0x00AB")
+	res, err = identify(pm, db, &discovery.Port{Properties: idPrefs})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	require.Equal(t, "Test Zero", res[0].Name)
+	require.Equal(t, "test:samd@1.1.0", res[0].PlatformToInstall)
+
+	// The Cloud API is unreachable while working offline
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	ts.Close()
+	vidPidURL = ts.URL
+	idPrefs.Set("pid", "0x9999")
+	res, err = identify(pm, db, &discovery.Port{Properties: idPrefs})
+	require.NoError(t, err)
+	require.Empty(t, res)
+	_, err = identify(pm, nil, &discovery.Port{Properties: idPrefs})
+	require.Error(t, err)
+}
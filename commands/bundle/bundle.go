@@ -0,0 +1,131 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package bundle
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/arduino/arduino-cli/i18n"
+	"github.com/arduino/go-paths-helper"
+)
+
+var tr = i18n.Tr
+
+// A bundle is a directory, or a tarball of it, containing the package and
+// library indexes and all the archives they reference, so that platforms and
+// libraries can be installed on machines without network access.
+const (
+	// PackageIndexFileName is the name of the package index of the bundle
+	PackageIndexFileName = "package_index.json"
+	// LibraryIndexFileName is the name of the library index of the bundle
+	LibraryIndexFileName = "library_index.json"
+
+	// packagesDirName is the folder containing the platform and tool archives
+	packagesDirName = "packages"
+	// librariesDirName is the folder containing the library archives
+	librariesDirName = "libraries"
+	// defaultIndexDirName is the folder containing a copy of the signed
+	// Arduino package index, used when the importing machine has none
+	defaultIndexDirName = "default_index"
+	// bundlesDirName is the folder, in the data directory, where the
+	// bundles are imported
+	bundlesDirName = "bundles"
+)
+
+// isTarball returns true if the bundle at path is a gzipped tarball
+func isTarball(path *paths.Path) bool {
+	return strings.HasSuffix(path.Base(), ".tar.gz") || strings.HasSuffix(path.Base(), ".tgz")
+}
+
+// bundleName returns the name of the bundle at path, that is its file name
+// without the tarball extensions
+func bundleName(path *paths.Path) string {
+	name := path.Base()
+	for _, ext := range []string{".tar.gz", ".tgz"} {
+		name = strings.TrimSuffix(name, ext)
+	}
+	return name
+}
+
+// fileURL returns the file URL of the absolute path p
+func fileURL(p *paths.Path) string {
+	URL := &url.URL{Scheme: "file", Path: filepath.ToSlash(p.String())}
+	if runtime.GOOS == "windows" {
+		// The path of local file URLs on Windows starts with a / followed by the drive letter
+		URL.Path = "/" + URL.Path
+	}
+	return URL.String()
+}
+
+// createTarball writes in file a gzipped tarball with the content of dir
+func createTarball(dir, file *paths.Path) error {
+	out, err := file.Create()
+	if err != nil {
+		return err
+	}
+	gz := gzip.NewWriter(out)
+	tw := tar.NewWriter(gz)
+	err = filepath.Walk(dir.String(), func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir.String(), path)
+		if err != nil || rel == "." {
+			return err
+		}
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(rel)
+		if info.IsDir() {
+			header.Name += "/"
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		in, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+		_, err = io.Copy(tw, in)
+		return err
+	})
+	if err == nil {
+		err = tw.Close()
+	}
+	if err == nil {
+		err = gz.Close()
+	}
+	if cerr := out.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		file.Remove()
+	}
+	return err
+}
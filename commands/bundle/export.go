@@ -0,0 +1,290 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package bundle
+
+import (
+	"context"
+	"encoding/json"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packageindex"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"github.com/arduino/arduino-cli/arduino/resources"
+	"github.com/arduino/arduino-cli/arduino/utils"
+	"github.com/arduino/arduino-cli/cli/globals"
+	"github.com/arduino/arduino-cli/commands"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"go.bug.st/downloader/v2"
+)
+
+// bundleArchive is an archive to copy in the bundle
+type bundleArchive struct {
+	resource *resources.DownloadResource
+	dir      string
+	label    string
+}
+
+// Export writes a bundle containing the platforms of the requested boards,
+// with the tools they need for the requested hosts, and the requested
+// libraries. The archives are downloaded, if they are not in the downloads
+// folder already, and referenced by the indexes of the bundle with URLs
+// relative to the bundle itself.
+func Export(ctx context.Context, req *rpc.BundleExportRequest, downloadCB commands.DownloadProgressCB) (*rpc.BundleExportResponse, error) {
+	pm := commands.GetPackageManager(req.GetInstance().GetId())
+	if pm == nil {
+		return nil, &commands.InvalidInstanceError{}
+	}
+	lm := commands.GetLibraryManager(req.GetInstance().GetId())
+	if lm == nil {
+		return nil, &commands.InvalidInstanceError{}
+	}
+	if len(req.GetFqbns()) == 0 && len(req.GetLibraries()) == 0 {
+		return nil, &commands.InvalidArgumentError{Message: tr("No boards or libraries to export")}
+	}
+	if req.GetOutput() == "" {
+		return nil, &commands.InvalidArgumentError{Message: tr("Missing output of the bundle")}
+	}
+	output := paths.New(req.GetOutput())
+	if output.Exist() {
+		return nil, &commands.InvalidArgumentError{Message: tr("Output %s already exists", output)}
+	}
+	hosts := [][]string{}
+	if len(req.GetHosts()) == 0 {
+		hosts = append(hosts, []string{runtime.GOOS, runtime.GOARCH})
+	}
+	for _, host := range req.GetHosts() {
+		osArch := strings.Split(host, "/")
+		if len(osArch) != 2 || osArch[0] == "" || osArch[1] == "" {
+			return nil, &commands.InvalidArgumentError{Message: tr("Invalid host %s, it must be in the os/arch form", host)}
+		}
+		hosts = append(hosts, osArch)
+	}
+
+	res := &rpc.BundleExportResponse{Platforms: []string{}, Tools: []string{}, Libraries: []string{}}
+	archives := []*bundleArchive{}
+	bundled := map[*resources.DownloadResource]bool{}
+	addArchive := func(resource *resources.DownloadResource, dir, label string) {
+		if !bundled[resource] {
+			bundled[resource] = true
+			archives = append(archives, &bundleArchive{resource: resource, dir: dir, label: label})
+		}
+	}
+
+	// Platforms and their tools
+	platformReleases := []*cores.PlatformRelease{}
+	toolReleases := []*cores.ToolRelease{}
+	addTool := func(toolRelease *cores.ToolRelease) error {
+		for _, t := range toolReleases {
+			if t == toolRelease {
+				return nil
+			}
+		}
+		for _, osArch := range hosts {
+			resource := toolRelease.GetFlavourCompatibleWith(osArch[0], osArch[1])
+			if resource == nil {
+				return &commands.NotFoundError{Message: tr("Tool %[1]s is not available for %[2]s", toolRelease, strings.Join(osArch, "/"))}
+			}
+			addArchive(resource, packagesDirName, toolRelease.String())
+		}
+		toolReleases = append(toolReleases, toolRelease)
+		res.Tools = append(res.Tools, toolRelease.String())
+		return nil
+	}
+	for _, fqbnIn := range req.GetFqbns() {
+		fqbn, err := cores.ParseFQBN(fqbnIn)
+		if err != nil {
+			return nil, &commands.InvalidFQBNError{Cause: err}
+		}
+		ref := &packagemanager.PlatformReference{
+			Package:              fqbn.Package,
+			PlatformArchitecture: fqbn.PlatformArch,
+		}
+		platformRelease, tools, err := pm.FindPlatformReleaseDependencies(ref)
+		if err != nil {
+			return nil, &commands.PlatformNotFound{Platform: ref.String(), Cause: err}
+		}
+		if !bundled[platformRelease.Resource] {
+			addArchive(platformRelease.Resource, packagesDirName, platformRelease.String())
+			platformReleases = append(platformReleases, platformRelease)
+			res.Platforms = append(res.Platforms, platformRelease.String())
+		}
+		for _, tool := range tools {
+			if err := addTool(tool); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// The builtin tools, needed to use the platforms, are installed by the CLI
+	// itself when missing
+	if builtin := pm.Packages["builtin"]; builtin != nil && len(req.GetFqbns()) > 0 {
+		for _, tool := range builtin.Tools {
+			for _, toolRelease := range tool.Releases {
+				if len(toolRelease.Flavors) == 0 {
+					continue
+				}
+				if err := addTool(toolRelease); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	// Libraries and their dependencies
+	libraryReleases := []*librariesindex.Release{}
+	for _, library := range req.GetLibraries() {
+		version, err := commands.ParseVersion(library)
+		if err != nil {
+			return nil, &commands.InvalidVersionError{Cause: err}
+		}
+		ref := &librariesindex.Reference{Name: library.GetName(), Version: version}
+		release := lm.Index.FindRelease(ref)
+		if release == nil {
+			return nil, &commands.LibraryNotFound{Library: ref.String()}
+		}
+		deps := lm.Index.ResolveDependencies(release)
+		if len(deps) == 0 {
+			return nil, &commands.LibraryDependenciesResolutionFailedError{}
+		}
+		for _, dep := range deps {
+			if !bundled[dep.Resource] {
+				addArchive(dep.Resource, librariesDirName, dep.String())
+				libraryReleases = append(libraryReleases, dep)
+				res.Libraries = append(res.Libraries, dep.String())
+			}
+		}
+	}
+
+	// Download all the archives in the downloads folder
+	config, err := commands.GetDownloaderConfig()
+	if err != nil {
+		return nil, err
+	}
+	downloads := []func(commands.DownloadProgressCB) error{}
+	for _, archive := range archives {
+		archive := archive
+		downloads = append(downloads, func(downloadCB commands.DownloadProgressCB) error {
+			err := commands.DownloadWithRetry(func() (*downloader.Downloader, error) {
+				return archive.resource.Download(pm.DownloadDir, config)
+			}, archive.label, downloadCB)
+			if err != nil {
+				return &commands.FailedDownloadError{Message: tr("Error downloading %s", archive.label), Cause: err}
+			}
+			return nil
+		})
+	}
+	if err := commands.DownloadParallel(downloads, downloadCB); err != nil {
+		return nil, err
+	}
+
+	// Fill the bundle folder, or a temporary one if a tarball is requested.
+	// An incomplete bundle is removed.
+	exported := false
+	defer func() {
+		if !exported {
+			output.RemoveAll()
+		}
+	}()
+	bundleDir := output
+	if isTarball(output) {
+		tmp, err := paths.MkTempDir("", "bundle")
+		if err != nil {
+			return nil, &commands.TempDirCreationFailedError{Cause: err}
+		}
+		defer tmp.RemoveAll()
+		bundleDir = tmp
+	}
+	for _, dir := range []string{packagesDirName, librariesDirName} {
+		if err := bundleDir.Join(dir).MkdirAll(); err != nil {
+			return nil, &commands.PermissionDeniedError{Message: tr("Can't create bundle directory %s", bundleDir), Cause: err}
+		}
+	}
+	for _, archive := range archives {
+		// The archives must be the ones described by the indexes
+		if ok, err := archive.resource.TestLocalArchiveIntegrity(pm.DownloadDir); err != nil {
+			return nil, &commands.FailedDownloadError{Message: tr("Error checking the integrity of %s", archive.label), Cause: err}
+		} else if !ok {
+			return nil, &commands.FailedDownloadError{Message: tr("The archive of %s is corrupted", archive.label)}
+		}
+		archivePath, err := archive.resource.ArchivePath(pm.DownloadDir)
+		if err != nil {
+			return nil, &commands.PermissionDeniedError{Message: tr("Error copying %s in the bundle", archive.label), Cause: err}
+		}
+		if err := archivePath.CopyTo(bundleDir.Join(archive.dir, archive.resource.ArchiveFileName)); err != nil {
+			return nil, &commands.PermissionDeniedError{Message: tr("Error copying %s in the bundle", archive.label), Cause: err}
+		}
+	}
+
+	// Write the indexes referencing the archives in the bundle
+	archiveURL := func(resource *resources.DownloadResource) string {
+		for _, archive := range archives {
+			if archive.resource == resource {
+				return archive.dir + "/" + resource.ArchiveFileName
+			}
+		}
+		return ""
+	}
+	packageIndex, err := json.MarshalIndent(packageindex.IndexFromReleases(platformReleases, toolReleases, archiveURL), "", "  ")
+	if err != nil {
+		return nil, &commands.PermissionDeniedError{Message: tr("Error writing the package index of the bundle"), Cause: err}
+	}
+	if err := bundleDir.Join(PackageIndexFileName).WriteFile(packageIndex); err != nil {
+		return nil, &commands.PermissionDeniedError{Message: tr("Error writing the package index of the bundle"), Cause: err}
+	}
+	if err := librariesindex.WriteIndex(bundleDir.Join(LibraryIndexFileName), libraryReleases, archiveURL); err != nil {
+		return nil, &commands.PermissionDeniedError{Message: tr("Error writing the library index of the bundle"), Cause: err}
+	}
+	if err := exportDefaultIndex(pm.IndexDir, bundleDir.Join(defaultIndexDirName)); err != nil {
+		return nil, &commands.PermissionDeniedError{Message: tr("Error copying the Arduino package index in the bundle"), Cause: err}
+	}
+
+	if bundleDir != output {
+		if err := createTarball(bundleDir, output); err != nil {
+			return nil, &commands.PermissionDeniedError{Message: tr("Error writing the bundle"), Cause: err}
+		}
+	}
+	exported = true
+	sort.Strings(res.Platforms)
+	sort.Strings(res.Tools)
+	sort.Strings(res.Libraries)
+	return res, nil
+}
+
+// exportDefaultIndex copies in dir the Arduino package index, together with
+// its signature, if it has been downloaded
+func exportDefaultIndex(indexDir, dir *paths.Path) error {
+	defaultIndexURL, err := utils.URLParse(globals.DefaultIndexURL)
+	if err != nil {
+		return err
+	}
+	index := packageindex.CachedIndexPath(indexDir, defaultIndexURL)
+	signature := index.Parent().Join(index.Base() + ".sig")
+	if index.NotExist() || signature.NotExist() {
+		return nil
+	}
+	if err := dir.MkdirAll(); err != nil {
+		return err
+	}
+	if err := index.CopyTo(dir.Join(index.Base())); err != nil {
+		return err
+	}
+	return signature.CopyTo(dir.Join(signature.Base()))
+}
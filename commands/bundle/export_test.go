@@ -0,0 +1,75 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package bundle
+
+import (
+	"context"
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/utils"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExport(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	configuration.Settings = configuration.Init("")
+	configuration.Settings.Set("directories.Data", tmp.Join("data").String())
+	configuration.Settings.Set("directories.Downloads", tmp.Join("staging").String())
+
+	// The platform is exported from an imported bundle
+	bundleDir := tmp.Join("my-bundle")
+	createTestBundle(t, bundleDir)
+	imported, err := Import(context.Background(), &rpc.BundleImportRequest{BundlePath: bundleDir.String()})
+	require.NoError(t, err)
+	indexURL, err := utils.URLParse(imported.GetPackageIndexUrl())
+	require.NoError(t, err)
+
+	res, err := commands.Create(&rpc.CreateRequest{})
+	require.NoError(t, err)
+	defer commands.Destroy(context.Background(), &rpc.DestroyRequest{Instance: res.GetInstance()})
+	pm := commands.GetPackageManager(res.GetInstance().GetId())
+	_, err = pm.LoadPackageIndexFromFile(paths.New(indexURL.Path))
+	require.NoError(t, err)
+
+	req := &rpc.BundleExportRequest{
+		Instance: res.GetInstance(),
+		Fqbns:    []string{"test:avr:board"},
+		Hosts:    []string{"linux/amd64"},
+		Output:   tmp.Join("exported").String(),
+	}
+	exported, err := Export(context.Background(), req, func(*rpc.DownloadProgress) {})
+	require.NoError(t, err)
+	require.Equal(t, []string{"test:avr@1.0.0"}, exported.Platforms)
+	require.Equal(t, []string{"test:test-tool@1.0.0"}, exported.Tools)
+	data, err := tmp.Join("exported", "packages", "test-avr-1.0.0.tar.bz2").ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, "platform", string(data))
+
+	// The archives that don't match the index are not exported
+	require.NoError(t, tmp.Join("staging").RemoveAll())
+	require.NoError(t, bundleDir.Join("packages", "test-avr-1.0.0.tar.bz2").WriteFile([]byte("tampered")))
+	req.Output = tmp.Join("corrupted").String()
+	_, err = Export(context.Background(), req, func(*rpc.DownloadProgress) {})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "integrity")
+	require.True(t, tmp.Join("corrupted").NotExist())
+}
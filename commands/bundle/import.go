@@ -0,0 +1,232 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package bundle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packageindex"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"github.com/arduino/arduino-cli/arduino/resources"
+	"github.com/arduino/arduino-cli/arduino/utils"
+	"github.com/arduino/arduino-cli/cli/globals"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/codeclysm/extract/v3"
+)
+
+// Import makes the bundle at req.BundlePath, a folder or a tarball, available
+// to install platforms and libraries without network access. The tarballs are
+// extracted in the data directory, while the bundle folders are used in place.
+// The indexes of the bundle are rewritten in the data directory with the file
+// URLs of the archives, and the Arduino package index of the bundle is used if
+// none has been downloaded yet. The settings are left untouched: the URLs of
+// the rewritten indexes are returned to be configured by the caller.
+func Import(ctx context.Context, req *rpc.BundleImportRequest) (*rpc.BundleImportResponse, error) {
+	if req.GetBundlePath() == "" {
+		return nil, &commands.InvalidArgumentError{Message: tr("Missing bundle path")}
+	}
+	bundlePath, err := paths.New(req.GetBundlePath()).Abs()
+	if err != nil {
+		return nil, &commands.InvalidArgumentError{Message: tr("Invalid bundle %s", bundlePath), Cause: err}
+	}
+	dataDir := paths.New(configuration.Settings.GetString("directories.Data"))
+	importDir := dataDir.Join(bundlesDirName, bundleName(bundlePath))
+
+	contentDir := bundlePath
+	if bundlePath.IsNotDir() {
+		if !isTarball(bundlePath) {
+			return nil, &commands.InvalidArgumentError{Message: tr("Invalid bundle %s: it must be a folder or a .tar.gz tarball", bundlePath)}
+		}
+		if err := extractTarball(ctx, bundlePath, importDir); err != nil {
+			return nil, &commands.PermissionDeniedError{Message: tr("Error extracting bundle %s", bundlePath), Cause: err}
+		}
+		contentDir = importDir
+	} else if bundlePath.NotExist() {
+		return nil, &commands.NotFoundError{Message: tr("Bundle %s not found", bundlePath)}
+	}
+	if contentDir.Join(PackageIndexFileName).NotExist() || contentDir.Join(LibraryIndexFileName).NotExist() {
+		return nil, &commands.InvalidArgumentError{Message: tr("Invalid bundle %s: the indexes are missing", bundlePath)}
+	}
+	if err := importDir.MkdirAll(); err != nil {
+		return nil, &commands.PermissionDeniedError{Message: tr("Can't create data directory %s", importDir), Cause: err}
+	}
+
+	// The archives are referenced with URLs relative to the bundle
+	archiveURL := func(resource *resources.DownloadResource) string {
+		if URL, err := url.Parse(resource.URL); err == nil && URL.Scheme == "" {
+			return fileURL(contentDir.Join(filepath.FromSlash(resource.URL)))
+		}
+		return resource.URL
+	}
+
+	packageIndex, err := packageindex.LoadIndex(contentDir.Join(PackageIndexFileName))
+	if err != nil {
+		return nil, &commands.InvalidArgumentError{Message: tr("Invalid package index in %s", bundlePath), Cause: err}
+	}
+	platformReleases, toolReleases := indexReleases(packageIndex)
+	for _, release := range platformReleases {
+		if err := checkArchiveURL(contentDir, release.Resource); err != nil {
+			return nil, &commands.InvalidArgumentError{Message: tr("Invalid package index in %s", bundlePath), Cause: err}
+		}
+	}
+	for _, release := range toolReleases {
+		for _, flavor := range release.Flavors {
+			if err := checkArchiveURL(contentDir, flavor.Resource); err != nil {
+				return nil, &commands.InvalidArgumentError{Message: tr("Invalid package index in %s", bundlePath), Cause: err}
+			}
+		}
+	}
+	data, err := json.MarshalIndent(packageindex.IndexFromReleases(platformReleases, toolReleases, archiveURL), "", "  ")
+	if err != nil {
+		return nil, &commands.PermissionDeniedError{Message: tr("Error writing the package index of the bundle"), Cause: err}
+	}
+	if err := importDir.Join(PackageIndexFileName).WriteFile(data); err != nil {
+		return nil, &commands.PermissionDeniedError{Message: tr("Error writing the package index of the bundle"), Cause: err}
+	}
+
+	libraryIndex, err := librariesindex.LoadIndex(contentDir.Join(LibraryIndexFileName))
+	if err != nil {
+		return nil, &commands.InvalidArgumentError{Message: tr("Invalid library index in %s", bundlePath), Cause: err}
+	}
+	libraryReleases := []*librariesindex.Release{}
+	for _, library := range libraryIndex.Libraries {
+		for _, release := range library.Releases {
+			if err := checkArchiveURL(contentDir, release.Resource); err != nil {
+				return nil, &commands.InvalidArgumentError{Message: tr("Invalid library index in %s", bundlePath), Cause: err}
+			}
+			libraryReleases = append(libraryReleases, release)
+		}
+	}
+	sort.Slice(libraryReleases, func(i, j int) bool { return libraryReleases[i].String() < libraryReleases[j].String() })
+	if err := librariesindex.WriteIndex(importDir.Join(LibraryIndexFileName), libraryReleases, archiveURL); err != nil {
+		return nil, &commands.PermissionDeniedError{Message: tr("Error writing the library index of the bundle"), Cause: err}
+	}
+
+	if err := importDefaultIndex(contentDir.Join(defaultIndexDirName), dataDir); err != nil {
+		return nil, &commands.PermissionDeniedError{Message: tr("Error saving the Arduino package index of the bundle"), Cause: err}
+	}
+
+	return &rpc.BundleImportResponse{
+		PackageIndexUrl: fileURL(importDir.Join(PackageIndexFileName)),
+		LibraryIndexUrl: fileURL(importDir.Join(LibraryIndexFileName)),
+	}, nil
+}
+
+// checkArchiveURL returns an error if the archive of resource is a local file
+// outside of the bundle in contentDir: the archives of the bundle are
+// referenced with URLs relative to the bundle itself
+func checkArchiveURL(contentDir *paths.Path, resource *resources.DownloadResource) error {
+	URL, err := url.Parse(resource.URL)
+	if err != nil {
+		return err
+	}
+	if URL.Scheme == "file" {
+		return fmt.Errorf(tr("archive %s is not in the bundle"), resource.URL)
+	}
+	if URL.Scheme != "" {
+		return nil
+	}
+	inside, err := contentDir.Join(filepath.FromSlash(resource.URL)).IsInsideDir(contentDir)
+	if err != nil {
+		return err
+	}
+	if !inside {
+		return fmt.Errorf(tr("archive %s is not in the bundle"), resource.URL)
+	}
+	return nil
+}
+
+// extractTarball extracts the bundle tarball in dir, replacing its content
+func extractTarball(ctx context.Context, tarball, dir *paths.Path) error {
+	file, err := tarball.Open()
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	if err := dir.RemoveAll(); err != nil {
+		return err
+	}
+	if err := dir.MkdirAll(); err != nil {
+		return err
+	}
+	return extract.Archive(ctx, file, dir.String(), nil)
+}
+
+// indexReleases returns all the platform and tool releases of index, sorted
+func indexReleases(index *packageindex.Index) ([]*cores.PlatformRelease, []*cores.ToolRelease) {
+	packages := cores.NewPackages()
+	index.MergeIntoPackages(packages)
+	platformReleases := []*cores.PlatformRelease{}
+	toolReleases := []*cores.ToolRelease{}
+	for _, p := range packages {
+		for _, platform := range p.Platforms {
+			for _, release := range platform.Releases {
+				platformReleases = append(platformReleases, release)
+			}
+		}
+		for _, tool := range p.Tools {
+			for _, release := range tool.Releases {
+				toolReleases = append(toolReleases, release)
+			}
+		}
+	}
+	sort.Slice(platformReleases, func(i, j int) bool { return platformReleases[i].String() < platformReleases[j].String() })
+	sort.Slice(toolReleases, func(i, j int) bool { return toolReleases[i].String() < toolReleases[j].String() })
+	return platformReleases, toolReleases
+}
+
+// importDefaultIndex saves the Arduino package index found in dir as if it
+// had been downloaded, unless an Arduino package index is already available.
+// This allows to use the CLI without ever downloading the indexes.
+func importDefaultIndex(dir, dataDir *paths.Path) error {
+	defaultIndexURL, err := utils.URLParse(globals.DefaultIndexURL)
+	if err != nil {
+		return err
+	}
+	indexPath := packageindex.CachedIndexPath(dataDir, defaultIndexURL)
+	legacyIndexPath := dataDir.Join(indexPath.Base())
+	bundleIndex := dir.Join(indexPath.Base())
+	bundleSignature := dir.Join(indexPath.Base() + ".sig")
+	if indexPath.Exist() || legacyIndexPath.Exist() || bundleIndex.NotExist() || bundleSignature.NotExist() {
+		return nil
+	}
+
+	if err := indexPath.Parent().MkdirAll(); err != nil {
+		return err
+	}
+	if err := bundleIndex.CopyTo(indexPath); err != nil {
+		return err
+	}
+	if err := bundleSignature.CopyTo(indexPath.Parent().Join(bundleSignature.Base())); err != nil {
+		return err
+	}
+	c := &packageindex.CachedIndex{
+		URL:       defaultIndexURL.String(),
+		FetchedAt: time.Now(),
+		Dir:       indexPath.Parent(),
+	}
+	return c.Save()
+}
@@ -0,0 +1,166 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package bundle
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packageindex"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"github.com/arduino/arduino-cli/arduino/resources"
+	"github.com/arduino/arduino-cli/arduino/utils"
+	"github.com/arduino/arduino-cli/cli/globals"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+// createTestBundle writes in dir a bundle with a platform, a tool and a library
+func createTestBundle(t *testing.T, dir *paths.Path) {
+	archive := func(name, content string) string {
+		require.NoError(t, dir.Join(name).Parent().MkdirAll())
+		require.NoError(t, dir.Join(name).WriteFile([]byte(content)))
+		return fmt.Sprintf(`"url": "%s", "archiveFileName": "%s", "size": %d, "checksum": "SHA-256:%x"`,
+			name, dir.Join(name).Base(), len(content), sha256.Sum256([]byte(content)))
+	}
+	require.NoError(t, dir.Join(PackageIndexFileName).WriteFile([]byte(`{"packages": [{
+		"name": "test", "maintainer": "Test",
+		"platforms": [{"name": "Test Boards", "architecture": "avr", "version": "1.0.0",
+			`+archive("packages/test-avr-1.0.0.tar.bz2", "platform")+`,
+			"boards": [{"name": "Test Board"}],
+			"toolsDependencies": [{"packager": "test", "name": "test-tool", "version": "1.0.0"}]}],
+		"tools": [{"name": "test-tool", "version": "1.0.0", "systems": [
+			{"host": "x86_64-pc-linux-gnu", `+archive("packages/test-tool-1.0.0.tar.bz2", "tool")+`}]}]
+	}]}`)))
+	require.NoError(t, dir.Join(LibraryIndexFileName).WriteFile([]byte(`{"libraries": [
+		{"name": "Test Library", "version": "1.0.0", `+archive("libraries/TestLibrary-1.0.0.zip", "library")+`}
+	]}`)))
+	require.NoError(t, dir.Join(defaultIndexDirName).MkdirAll())
+	require.NoError(t, dir.Join(defaultIndexDirName, "package_index.json").WriteFile([]byte(`{"packages": []}`)))
+	require.NoError(t, dir.Join(defaultIndexDirName, "package_index.json.sig").WriteFile([]byte("signature")))
+}
+
+// requireBundleArchive checks that the archive of resource can be downloaded
+// from the imported bundle
+func requireBundleArchive(t *testing.T, resource *resources.DownloadResource, bundleDir *paths.Path, name string) {
+	require.Equal(t, fileURL(bundleDir.Join(name)), resource.URL)
+
+	downloadDir, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer downloadDir.RemoveAll()
+	config, err := commands.GetDownloaderConfig()
+	require.NoError(t, err)
+	d, err := resource.Download(downloadDir, config)
+	require.NoError(t, err)
+	require.NoError(t, d.Run())
+	ok, err := resource.TestLocalArchiveIntegrity(downloadDir)
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func requireImportedBundle(t *testing.T, res *rpc.BundleImportResponse, importDir, bundleDir *paths.Path) {
+	require.Equal(t, fileURL(importDir.Join(PackageIndexFileName)), res.GetPackageIndexUrl())
+	require.Equal(t, fileURL(importDir.Join(LibraryIndexFileName)), res.GetLibraryIndexUrl())
+
+	URL, err := utils.URLParse(res.GetPackageIndexUrl())
+	require.NoError(t, err)
+	index, err := packageindex.LoadIndex(paths.New(URL.Path))
+	require.NoError(t, err)
+	packages := cores.NewPackages()
+	index.MergeIntoPackages(packages)
+	platformRelease := packages["test"].Platforms["avr"].Releases["1.0.0"]
+	require.NotNil(t, platformRelease)
+	requireBundleArchive(t, platformRelease.Resource, bundleDir, "packages/test-avr-1.0.0.tar.bz2")
+	toolRelease := packages["test"].Tools["test-tool"].Releases["1.0.0"]
+	require.NotNil(t, toolRelease)
+	requireBundleArchive(t, toolRelease.GetFlavourCompatibleWith("linux", "amd64"), bundleDir, "packages/test-tool-1.0.0.tar.bz2")
+
+	URL, err = utils.URLParse(res.GetLibraryIndexUrl())
+	require.NoError(t, err)
+	libraryIndex, err := librariesindex.LoadIndex(paths.New(URL.Path))
+	require.NoError(t, err)
+	libraryRelease := libraryIndex.Libraries["Test Library"].Latest
+	require.NotNil(t, libraryRelease)
+	requireBundleArchive(t, libraryRelease.Resource, bundleDir, "libraries/TestLibrary-1.0.0.zip")
+}
+
+func TestImport(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	dataDir := tmp.Join("data")
+	configuration.Settings = configuration.Init("")
+	configuration.Settings.Set("directories.Data", dataDir.String())
+
+	bundleDir := tmp.Join("my-bundle")
+	createTestBundle(t, bundleDir)
+
+	// The bundle folders are used in place
+	res, err := Import(context.Background(), &rpc.BundleImportRequest{BundlePath: bundleDir.String()})
+	require.NoError(t, err)
+	requireImportedBundle(t, res, dataDir.Join(bundlesDirName, "my-bundle"), bundleDir)
+
+	// The Arduino package index of the bundle is used if none is available
+	defaultIndexURL, err := utils.URLParse(globals.DefaultIndexURL)
+	require.NoError(t, err)
+	defaultIndex := packageindex.CachedIndexPath(dataDir, defaultIndexURL)
+	require.True(t, defaultIndex.Exist())
+	require.True(t, defaultIndex.Parent().Join("package_index.json.sig").Exist())
+	cachedIndex, err := packageindex.LoadCachedIndex(defaultIndex.Parent())
+	require.NoError(t, err)
+	require.Equal(t, globals.DefaultIndexURL, cachedIndex.URL)
+
+	// but it doesn't replace the one already available
+	require.NoError(t, defaultIndex.WriteFile([]byte(`{"packages": [{"name": "arduino"}]}`)))
+	_, err = Import(context.Background(), &rpc.BundleImportRequest{BundlePath: bundleDir.String()})
+	require.NoError(t, err)
+	data, err := defaultIndex.ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, `{"packages": [{"name": "arduino"}]}`, string(data))
+
+	// The bundle tarballs are extracted in the data directory
+	tarball := tmp.Join("my-tarball.tar.gz")
+	require.NoError(t, createTarball(bundleDir, tarball))
+	res, err = Import(context.Background(), &rpc.BundleImportRequest{BundlePath: tarball.String()})
+	require.NoError(t, err)
+	importDir := dataDir.Join(bundlesDirName, "my-tarball")
+	requireImportedBundle(t, res, importDir, importDir)
+
+	// Invalid bundles
+	_, err = Import(context.Background(), &rpc.BundleImportRequest{BundlePath: tmp.Join("missing").String()})
+	require.Error(t, err)
+	_, err = Import(context.Background(), &rpc.BundleImportRequest{BundlePath: bundleDir.Join(PackageIndexFileName).String()})
+	require.Error(t, err)
+	_, err = Import(context.Background(), &rpc.BundleImportRequest{BundlePath: dataDir.String()})
+	require.Error(t, err)
+
+	// The archives must be inside the bundle
+	require.NoError(t, tmp.Join("outside.zip").WriteFile([]byte("library")))
+	for _, archiveURL := range []string{"../outside.zip", "libraries/../../outside.zip", fileURL(tmp.Join("outside.zip"))} {
+		require.NoError(t, bundleDir.Join(LibraryIndexFileName).WriteFile([]byte(`{"libraries": [
+			{"name": "Test Library", "version": "1.0.0", "url": "`+archiveURL+`", "archiveFileName": "outside.zip",
+			"size": 7, "checksum": "SHA-256:0000000000000000000000000000000000000000000000000000000000000000"}
+		]}`)))
+		_, err = Import(context.Background(), &rpc.BundleImportRequest{BundlePath: bundleDir.String()})
+		require.Error(t, err, archiveURL)
+	}
+}
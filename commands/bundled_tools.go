@@ -19,6 +19,7 @@ import (
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"go.bug.st/downloader/v2"
 )
 
 // DownloadToolRelease downloads a ToolRelease
@@ -27,11 +28,9 @@ func DownloadToolRelease(pm *packagemanager.PackageManager, toolRelease *cores.T
 	if err != nil {
 		return err
 	}
-	resp, err := pm.DownloadToolRelease(toolRelease, config)
-	if err != nil {
-		return err
-	}
-	return Download(resp, toolRelease.String(), downloadCB)
+	return DownloadWithRetry(func() (*downloader.Downloader, error) {
+		return pm.DownloadToolRelease(toolRelease, config)
+	}, toolRelease.String(), downloadCB)
 }
 
 // InstallToolRelease installs a ToolRelease
@@ -23,10 +23,13 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 
+	"github.com/arduino/arduino-cli/arduino/buildcache"
 	bldr "github.com/arduino/arduino-cli/arduino/builder"
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/sizereport"
 	"github.com/arduino/arduino-cli/arduino/sketch"
 	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/configuration"
@@ -44,8 +47,32 @@ import (
 
 var tr = i18n.Tr
 
+// DiagnosticCB is called for each diagnostic reported by the compiler
+type DiagnosticCB func(d *rpc.CompileDiagnostic)
+
 // Compile FIXMEDOC
-func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream io.Writer, debug bool) (r *rpc.CompileResponse, e error) {
+func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream io.Writer, diagnosticCB DiagnosticCB, debug bool) (*rpc.CompileResponse, error) {
+	return compile(ctx, req, outStream, errStream, diagnosticCB, nil, debug)
+}
+
+// compileOptions are the options of the builds run by Watch and CompileMatrix
+type compileOptions struct {
+	// If not nil it's called after the build, even if it failed, with the
+	// folders whose changes require a new build
+	watchedLocationsCB func(paths.PathList)
+	// If not nil the libraries are shared with the other builds using it
+	librariesCache *types.LibrariesManagersCache
+	// If not nil it replaces the environment of the sketch
+	environment *commands.SketchEnvironment
+	// If true the test folder of the sketch is built too
+	compileSketchTests bool
+}
+
+// compile builds the sketch with the given options, that may be nil
+func compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream io.Writer, diagnosticCB DiagnosticCB, opts *compileOptions, debug bool) (r *rpc.CompileResponse, e error) {
+	if opts == nil {
+		opts = &compileOptions{}
+	}
 
 	// There is a binding between the export binaries setting and the CLI flag to explicitly set it,
 	// since we want this binding to work also for the gRPC interface we must read it here in this
@@ -75,6 +102,8 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 		"libraries":       strings.Join(req.Libraries, ","),
 		"clean":           strconv.FormatBool(req.GetClean()),
 		"exportBinaries":  strconv.FormatBool(exportBinaries),
+		"environment":     req.GetSketchEnvironment().String(),
+		"sizeReport":      strconv.FormatBool(req.GetSizeReport()),
 	}
 
 	// Use defer func() to evaluate tags map when function returns
@@ -102,9 +131,18 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 		return nil, &commands.CantOpenSketchError{Cause: err}
 	}
 
+	env := opts.environment
+	if env == nil {
+		env, err = commands.GetSketchEnvironment(pm, sk, req.GetSketchEnvironment())
+		if err != nil {
+			return nil, err
+		}
+	}
+	pm = env.PackageManager
+
 	fqbnIn := req.GetFqbn()
-	if fqbnIn == "" && sk != nil && sk.Metadata != nil {
-		fqbnIn = sk.Metadata.CPU.Fqbn
+	if fqbnIn == "" && sk != nil {
+		fqbnIn = sk.DefaultFqbn()
 	}
 	if fqbnIn == "" {
 		return nil, &commands.MissingFQBNError{}
@@ -133,13 +171,20 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 	builderCtx.SketchLocation = sk.FullPath
 
 	// FIXME: This will be redundant when arduino-builder will be part of the cli
-	builderCtx.HardwareDirs = configuration.HardwareDirectories(configuration.Settings)
-	builderCtx.BuiltInToolsDirs = configuration.BundleToolsDirectories(configuration.Settings)
+	builderCtx.HardwareDirs = env.HardwareDirs
+	builderCtx.BuiltInToolsDirs = env.BuiltInToolsDirs
 
 	builderCtx.OtherLibrariesDirs = paths.NewPathList(req.GetLibraries()...)
-	builderCtx.OtherLibrariesDirs.Add(configuration.LibrariesDir(configuration.Settings))
+	builderCtx.OtherLibrariesDirs.AddAll(env.LibrariesDirs)
 
 	builderCtx.LibraryDirs = paths.NewPathList(req.Library...)
+	// The libraries of the sketch environment have top priority
+	builderCtx.LibraryDirs.AddAll(env.Libraries)
+
+	builderCtx.LibraryOverrides, err = libraryOverrides(sk, req)
+	if err != nil {
+		return nil, &commands.InvalidArgumentError{Message: tr("Invalid library override"), Cause: err}
+	}
 
 	if req.GetBuildPath() == "" {
 		builderCtx.BuildPath = sk.BuildPath
@@ -181,6 +226,25 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 		}
 	}
 
+	var compilationCache *buildcache.Cache
+	var compilationCacheMaxSize int64
+	if configuration.Settings.GetBool("build_cache.enabled") {
+		compilationCacheMaxSize, err = buildcache.ParseSize(configuration.Settings.GetString("build_cache.max_size"))
+		if err != nil {
+			return nil, &commands.InvalidArgumentError{Message: tr("Invalid build_cache.max_size setting"), Cause: err}
+		}
+		compilationCache = buildcache.New(configuration.BuildCacheDir(configuration.Settings))
+		builderCtx.CompilationCache = compilationCache
+		if remoteURL := configuration.Settings.GetString("build_cache.remote.url"); remoteURL != "" {
+			remote, err := buildcache.NewHTTPBackend(remoteURL)
+			if err != nil {
+				return nil, &commands.InvalidArgumentError{Message: tr("Invalid build_cache.remote.url setting"), Cause: err}
+			}
+			readOnly := configuration.Settings.GetBool("build_cache.remote.read_only")
+			builderCtx.CompilationCache = buildcache.NewTieredBackend(compilationCache, remote, readOnly)
+		}
+	}
+
 	// Will be deprecated.
 	builderCtx.ArduinoAPIVersion = "10607"
 
@@ -188,7 +252,7 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 	dataDir := paths.New(configuration.Settings.GetString("directories.Data"))
 	preferencesTxt := dataDir.Join("preferences.txt")
 	ideProperties, err := properties.LoadFromPath(preferencesTxt)
-	if err == nil {
+	if err == nil && !env.Isolated {
 		lastIdeSubProperties := ideProperties.SubTree("last").SubTree("ide")
 		// Preferences can contain records from previous IDE versions. Find the latest one.
 		var pathVariants []string
@@ -205,12 +269,40 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 
 	builderCtx.ExecStdout = outStream
 	builderCtx.ExecStderr = errStream
+	if diagnosticCB != nil {
+		// The diagnostics are reported concurrently by the compilation jobs
+		var diagnosticsMux sync.Mutex
+		builderCtx.CompilerDiagnosticsCB = func(d *bldr.CompilerDiagnostic) {
+			diagnosticsMux.Lock()
+			defer diagnosticsMux.Unlock()
+			diagnosticCB(d.ToRPC())
+		}
+	}
 	builderCtx.SetLogger(legacyi18n.LoggerToCustomStreams{Stdout: outStream, Stderr: errStream})
 	builderCtx.Clean = req.GetClean()
 	builderCtx.OnlyUpdateCompilationDatabase = req.GetCreateCompilationDatabaseOnly()
 
 	builderCtx.SourceOverride = req.GetSourceOverride()
 
+	builderCtx.ExplainLibraries = req.GetExplainLibraries()
+	builderCtx.LibrariesManagersCache = opts.librariesCache
+	builderCtx.CompileSketchTests = opts.compileSketchTests
+
+	builderCtx.ComputeSizeReport = req.GetSizeReport()
+	builderCtx.SizeReportMaxSymbols = int(req.GetSizeReportMaxSymbols())
+	var sizeGrowthThreshold *sizereport.Threshold
+	if req.GetSizeBaseline() != nil {
+		// The comparison requires all the symbols
+		builderCtx.ComputeSizeReport = true
+		builderCtx.SizeReportMaxSymbols = 0
+		if threshold := req.GetSizeGrowthThreshold(); threshold != "" {
+			sizeGrowthThreshold, err = sizereport.ParseThreshold(threshold)
+			if err != nil {
+				return nil, &commands.InvalidArgumentError{Message: tr("Invalid size growth threshold"), Cause: err}
+			}
+		}
+	}
+
 	r = &rpc.CompileResponse{}
 	defer func() {
 		if p := builderCtx.BuildPath; p != nil {
@@ -234,9 +326,42 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 	}
 
 	// if it's a regular build, go on...
-	if err := builder.RunBuilder(builderCtx); err != nil {
+	err = builder.RunBuilder(builderCtx)
+	if opts.watchedLocationsCB != nil {
+		opts.watchedLocationsCB(builderCtx.WatchedLocations)
+	}
+	if compilationCache != nil {
+		// Evict the least recently used entries if the cache grew over the limit
+		if _, err := compilationCache.Trim(compilationCacheMaxSize); err != nil {
+			logrus.WithError(err).Warn("Error trimming compilation cache")
+		}
+	}
+	// The size report is useful also if the sketch is too big
+	var sizeComparison *sizereport.Comparison
+	if report := builderCtx.SizeReport; report != nil {
+		if baseline := req.GetSizeBaseline(); baseline != nil {
+			sizeComparison = sizereport.Compare(sizereport.FromRPC(baseline), report)
+			r.SizeComparison = sizeComparison.ToRPC()
+		}
+		if req.GetSizeReport() {
+			r.SizeReport = report.ToRPC()
+			if maxSymbols := int(req.GetSizeReportMaxSymbols()); maxSymbols > 0 && len(r.SizeReport.Symbols) > maxSymbols {
+				r.SizeReport.Symbols = r.SizeReport.Symbols[:maxSymbols]
+			}
+		}
+	}
+	// The libraries resolution is useful also to investigate a failed build
+	for _, resolution := range builderCtx.LibrariesResolutions {
+		r.LibraryResolutions = append(r.LibraryResolutions, resolution.ToRPC())
+	}
+	if err != nil {
 		return r, &commands.CompileFailedError{Message: err.Error()}
 	}
+	if sizeComparison != nil && sizeGrowthThreshold != nil {
+		if exceeded := sizeComparison.ExceededBy(sizeGrowthThreshold); len(exceeded) > 0 {
+			return r, &commands.SizeGrowthThresholdExceededError{Memories: exceeded, Threshold: sizeGrowthThreshold.String()}
+		}
+	}
 
 	// If the export directory is set we assume you want to export the binaries
 	if req.GetExportDir() != "" {
@@ -296,5 +421,8 @@ func Compile(ctx context.Context, req *rpc.CompileRequest, outStream, errStream
 	return &rpc.CompileResponse{
 		UsedLibraries:          importedLibs,
 		ExecutableSectionsSize: builderCtx.ExecutableSectionsSize.ToRPCExecutableSectionSizeArray(),
+		SizeReport:             r.SizeReport,
+		SizeComparison:         r.SizeComparison,
+		LibraryResolutions:     r.LibraryResolutions,
 	}, nil
 }
@@ -0,0 +1,195 @@
+/*
+  ArduinoMock.cpp - Implementation of the Arduino functions on top of the
+  mocked hardware.
+*/
+
+#include <map>
+
+#include "Arduino.h"
+
+namespace {
+
+struct Pin {
+  Pin() : mode(-1), digital(LOW), analogInput(0), analogOutput(-1), tone(0), interruptMode(0), interrupt(NULL) {}
+  int mode;
+  int digital;
+  int analogInput;
+  int analogOutput;
+  unsigned int tone;
+  std::vector<mock::PinEvent> digitalWrites;
+  std::vector<mock::PinEvent> analogWrites;
+  int interruptMode;
+  void (*interrupt)(void);
+};
+
+std::map<uint8_t, Pin> pins;
+unsigned long now = 0;
+unsigned long randomState = 1;
+
+Pin &pin(uint8_t p) { return pins[p]; }
+
+} // namespace
+
+void pinMode(uint8_t p, uint8_t mode) {
+  pin(p).mode = mode;
+  if (mode == INPUT_PULLUP) {
+    pin(p).digital = HIGH;
+  }
+}
+
+void digitalWrite(uint8_t p, uint8_t val) {
+  Pin &state = pin(p);
+  state.digital = val ? HIGH : LOW;
+  mock::PinEvent event = {now, state.digital};
+  state.digitalWrites.push_back(event);
+}
+
+int digitalRead(uint8_t p) { return pin(p).digital; }
+
+int analogRead(uint8_t p) { return pin(p).analogInput; }
+
+void analogReference(uint8_t mode) { (void)mode; }
+
+void analogWrite(uint8_t p, int val) {
+  Pin &state = pin(p);
+  state.analogOutput = val;
+  mock::PinEvent event = {now, val};
+  state.analogWrites.push_back(event);
+}
+
+unsigned long millis(void) { return now / 1000; }
+
+unsigned long micros(void) { return now; }
+
+void delay(unsigned long ms) { now += ms * 1000; }
+
+void delayMicroseconds(unsigned int us) { now += us; }
+
+void yield(void) {}
+
+void shiftOut(uint8_t dataPin, uint8_t clockPin, uint8_t bitOrder, uint8_t val) {
+  for (uint8_t i = 0; i < 8; i++) {
+    if (bitOrder == LSBFIRST) {
+      digitalWrite(dataPin, !!(val & (1 << i)));
+    } else {
+      digitalWrite(dataPin, !!(val & (1 << (7 - i))));
+    }
+    digitalWrite(clockPin, HIGH);
+    digitalWrite(clockPin, LOW);
+  }
+}
+
+uint8_t shiftIn(uint8_t dataPin, uint8_t clockPin, uint8_t bitOrder) {
+  uint8_t value = 0;
+  for (uint8_t i = 0; i < 8; ++i) {
+    digitalWrite(clockPin, HIGH);
+    if (bitOrder == LSBFIRST) {
+      value |= digitalRead(dataPin) << i;
+    } else {
+      value |= digitalRead(dataPin) << (7 - i);
+    }
+    digitalWrite(clockPin, LOW);
+  }
+  return value;
+}
+
+void attachInterrupt(uint8_t interruptNum, void (*userFunc)(void), int mode) {
+  pin(interruptNum).interrupt = userFunc;
+  pin(interruptNum).interruptMode = mode;
+}
+
+void detachInterrupt(uint8_t interruptNum) {
+  pin(interruptNum).interrupt = NULL;
+  pin(interruptNum).interruptMode = 0;
+}
+
+void tone(uint8_t p, unsigned int frequency, unsigned long duration) {
+  (void)duration;
+  pin(p).tone = frequency;
+}
+
+void noTone(uint8_t p) { pin(p).tone = 0; }
+
+long random(long max) {
+  if (max == 0) {
+    return 0;
+  }
+  // A deterministic generator, so that the tests are repeatable
+  randomState = randomState * 1103515245 + 12345;
+  return (long)((randomState / 65536) % 32768) % max;
+}
+
+long random(long min, long max) {
+  if (min >= max) {
+    return min;
+  }
+  return random(max - min) + min;
+}
+
+void randomSeed(unsigned long seed) {
+  if (seed != 0) {
+    randomState = seed;
+  }
+}
+
+long map(long x, long in_min, long in_max, long out_min, long out_max) {
+  return (x - in_min) * (out_max - out_min) / (in_max - in_min) + out_min;
+}
+
+uint16_t makeWord(uint16_t w) { return w; }
+
+uint16_t makeWord(uint8_t h, uint8_t l) { return (h << 8) | l; }
+
+namespace mock {
+
+int pinMode(uint8_t p) { return pin(p).mode; }
+
+int digitalValue(uint8_t p) { return pin(p).digital; }
+
+const std::vector<PinEvent> &digitalWrites(uint8_t p) { return pin(p).digitalWrites; }
+
+void setDigitalInput(uint8_t p, int value) {
+  Pin &state = pin(p);
+  int previous = state.digital;
+  state.digital = value ? HIGH : LOW;
+  if (!state.interrupt || previous == state.digital) {
+    return;
+  }
+  if (state.interruptMode == CHANGE ||
+      (state.interruptMode == RISING && state.digital == HIGH) ||
+      (state.interruptMode == FALLING && state.digital == LOW)) {
+    state.interrupt();
+  }
+}
+
+int analogValue(uint8_t p) { return pin(p).analogOutput; }
+
+const std::vector<PinEvent> &analogWrites(uint8_t p) { return pin(p).analogWrites; }
+
+void setAnalogInput(uint8_t p, int value) { pin(p).analogInput = value; }
+
+unsigned int toneFrequency(uint8_t p) { return pin(p).tone; }
+
+bool triggerInterrupt(uint8_t p) {
+  if (!pin(p).interrupt) {
+    return false;
+  }
+  pin(p).interrupt();
+  return true;
+}
+
+void advanceMillis(unsigned long ms) { now += ms * 1000; }
+
+void advanceMicros(unsigned long us) { now += us; }
+
+void setMicros(unsigned long us) { now = us; }
+
+String serialOutput() { return String(Serial.output); }
+
+void clearSerialOutput() { Serial.output.clear(); }
+
+void serialInput(const String &data) { Serial.input += data.std_string(); }
+
+unsigned long serialBaudRate() { return Serial.baudRate; }
+
+} // namespace mock
@@ -0,0 +1,75 @@
+/*
+  ArduinoMock.h - Inspect and drive the mocked hardware from the unit tests.
+
+  Time doesn't flow by itself: it advances only when the sketch calls delay()
+  or delayMicroseconds(), or when the test calls mock::advanceMillis() or
+  mock::advanceMicros(). Each test runs in its own process, so it starts with
+  all the pins unconfigured, the time at 0 and the serial port buffers empty.
+*/
+
+#ifndef ArduinoMock_h
+#define ArduinoMock_h
+
+#include <stdint.h>
+#include <vector>
+
+#include "WString.h"
+
+namespace mock {
+
+// A change of the value of a pin, at the given time
+struct PinEvent {
+  unsigned long micros;
+  int value;
+};
+
+// The mode set with pinMode, or -1 if the pin has not been configured
+int pinMode(uint8_t pin);
+
+// The value of a digital pin: the last one written with digitalWrite for the
+// outputs, the one set with setDigitalInput for the inputs
+int digitalValue(uint8_t pin);
+
+// All the values written to the pin with digitalWrite
+const std::vector<PinEvent> &digitalWrites(uint8_t pin);
+
+// Sets the value read from a digital input, running the interrupt attached to
+// the pin if the change triggers it
+void setDigitalInput(uint8_t pin, int value);
+
+// The last value written to the pin with analogWrite, or -1 if none
+int analogValue(uint8_t pin);
+
+// All the values written to the pin with analogWrite
+const std::vector<PinEvent> &analogWrites(uint8_t pin);
+
+// Sets the value read from an analog input
+void setAnalogInput(uint8_t pin, int value);
+
+// The frequency of the tone played on the pin, 0 if none
+unsigned int toneFrequency(uint8_t pin);
+
+// Runs the interrupt attached to the pin, returns false if there is none
+bool triggerInterrupt(uint8_t pin);
+
+// Moves the time forward
+void advanceMillis(unsigned long ms);
+void advanceMicros(unsigned long us);
+
+// Sets the time returned by micros() and millis()
+void setMicros(unsigned long us);
+
+// The data written to the serial port since the start of the test, or since
+// the last call to clearSerialOutput
+String serialOutput();
+void clearSerialOutput();
+
+// Appends data to the one that can be read from the serial port
+void serialInput(const String &data);
+
+// The baud rate set with Serial.begin, or 0 if the port is closed
+unsigned long serialBaudRate();
+
+} // namespace mock
+
+#endif
@@ -0,0 +1,136 @@
+/*
+  ArduinoTest.cpp - Runner of the unit tests.
+
+  The test binary is driven by arduino-cli, that runs each test in its own
+  process: "--list" prints the tests, one per line, as FILE<TAB>NAME, and
+  "--run INDEX --report FILE" runs a test writing its failures in the report
+  file, one per line, as LINE<TAB>FILE<TAB>MESSAGE, with the backslashes,
+  the tabs and the newlines of the message escaped. Without arguments all the
+  tests are run in the same process.
+*/
+
+#include <exception>
+#include <stdio.h>
+#include <string.h>
+#include <vector>
+
+#include "ArduinoTest.h"
+
+namespace {
+
+struct Test {
+  const char *file;
+  const char *name;
+  arduino_test::TestFunction function;
+};
+
+struct FatalFailure {};
+
+std::vector<Test> &tests() {
+  static std::vector<Test> all;
+  return all;
+}
+
+FILE *report = NULL;
+int failures = 0;
+
+std::string escape(const std::string &s) {
+  std::string res;
+  for (size_t i = 0; i < s.length(); i++) {
+    switch (s[i]) {
+    case '\\':
+      res += "\\\\";
+      break;
+    case '\t':
+      res += "\\t";
+      break;
+    case '\n':
+      res += "\\n";
+      break;
+    default:
+      res += s[i];
+    }
+  }
+  return res;
+}
+
+bool runTest(const Test &test) {
+  failures = 0;
+  try {
+    test.function();
+  } catch (FatalFailure &) {
+  } catch (std::exception &e) {
+    arduino_test::fail(test.file, 0, std::string("uncaught exception: ") + e.what(), false);
+  } catch (...) {
+    arduino_test::fail(test.file, 0, "uncaught exception", false);
+  }
+  fflush(stdout);
+  return failures == 0;
+}
+
+} // namespace
+
+namespace arduino_test {
+
+Registration::Registration(const char *file, const char *name, TestFunction function) {
+  Test test = {file, name, function};
+  tests().push_back(test);
+}
+
+void fail(const char *file, int line, const std::string &message, bool fatal) {
+  failures++;
+  if (report) {
+    fprintf(report, "%d\t%s\t%s\n", line, escape(file).c_str(), escape(message).c_str());
+    fflush(report);
+  } else {
+    fprintf(stderr, "%s:%d: %s\n", file, line, message.c_str());
+  }
+  if (fatal) {
+    throw FatalFailure();
+  }
+}
+
+int run(int argc, char **argv) {
+  if (argc == 2 && strcmp(argv[1], "--list") == 0) {
+    for (size_t i = 0; i < tests().size(); i++) {
+      printf("%s\t%s\n", tests()[i].file, tests()[i].name);
+    }
+    return 0;
+  }
+
+  if (argc == 5 && strcmp(argv[1], "--run") == 0 && strcmp(argv[3], "--report") == 0) {
+    size_t index = strtoul(argv[2], NULL, 10);
+    if (index >= tests().size()) {
+      fprintf(stderr, "test %s not found\n", argv[2]);
+      return 2;
+    }
+    report = fopen(argv[4], "w");
+    if (!report) {
+      fprintf(stderr, "can't create report file %s\n", argv[4]);
+      return 2;
+    }
+    bool passed = runTest(tests()[index]);
+    fclose(report);
+    return passed ? 0 : 1;
+  }
+
+  if (argc != 1) {
+    fprintf(stderr, "usage: %s [--list | --run INDEX --report FILE]\n", argv[0]);
+    return 2;
+  }
+  int failed = 0;
+  for (size_t i = 0; i < tests().size(); i++) {
+    printf("=== RUN %s\n", tests()[i].name);
+    fflush(stdout);
+    if (runTest(tests()[i])) {
+      printf("--- PASS: %s\n", tests()[i].name);
+    } else {
+      printf("--- FAIL: %s\n", tests()[i].name);
+      failed++;
+    }
+  }
+  printf("%d tests, %d failed\n", (int)tests().size(), failed);
+  return failed == 0 ? 0 : 1;
+}
+
+} // namespace arduino_test
@@ -0,0 +1,142 @@
+/*
+  ArduinoTest.h - Unit tests of the sketches run on the host.
+
+  The tests are defined in the test folder of the sketch with the TEST macro
+  and use the ASSERT_* macros, that stop the test at the first failure, or
+  the EXPECT_* macros, that let it continue:
+
+    #include <ArduinoTest.h>
+
+    TEST(led_is_turned_on) {
+      setup();
+      loop();
+      ASSERT_EQ(HIGH, mock::digitalValue(LED_BUILTIN));
+    }
+*/
+
+#ifndef ArduinoTest_h
+#define ArduinoTest_h
+
+#include <sstream>
+#include <string>
+
+#include "Arduino.h"
+
+namespace arduino_test {
+
+typedef void (*TestFunction)();
+
+// Registration adds a test to the ones run by the test binary
+struct Registration {
+  Registration(const char *file, const char *name, TestFunction function);
+};
+
+// fail records a failure of the running test, if fatal the test is stopped
+void fail(const char *file, int line, const std::string &message, bool fatal);
+
+template <typename T>
+std::string describe(const T &value) {
+  std::ostringstream s;
+  s << value;
+  return s.str();
+}
+
+inline std::string describe(bool value) { return value ? "true" : "false"; }
+inline std::string describe(unsigned char value) { return describe((unsigned int)value); }
+inline std::string describe(signed char value) { return describe((int)value); }
+inline std::string describe(char value) { return std::string("'") + value + "'"; }
+inline std::string describe(const String &value) { return "\"" + value.std_string() + "\""; }
+inline std::string describe(const char *value) { return value ? "\"" + std::string(value) + "\"" : "NULL"; }
+inline std::string describe(char *value) { return describe((const char *)value); }
+
+// cString allows to compare C strings and Strings with the *_STREQ macros
+inline const char *cString(const char *value) { return value; }
+inline const char *cString(const String &value) { return value.c_str(); }
+
+template <typename A, typename B>
+std::string comparisonMessage(const char *expressionA, const char *op, const char *expressionB, const A &a, const B &b) {
+  return std::string("expected ") + expressionA + " " + op + " " + expressionB +
+         "\n  " + expressionA + " = " + describe(a) +
+         "\n  " + expressionB + " = " + describe(b);
+}
+
+} // namespace arduino_test
+
+#define TEST(name)                                                                              \
+  static void arduino_test_##name();                                                           \
+  static arduino_test::Registration arduino_test_registration_##name(__FILE__, #name, arduino_test_##name); \
+  static void arduino_test_##name()
+
+// The expressions are stringified by the ASSERT_* and EXPECT_* macros, so
+// that the messages contain the macros used in the test, not their values
+#define ARDUINO_TEST_COMPARE(a, op, b, textA, textB, fatal)                                                   \
+  do {                                                                                                       \
+    const auto &arduino_test_a = (a);                                                                        \
+    const auto &arduino_test_b = (b);                                                                        \
+    if (!(arduino_test_a op arduino_test_b)) {                                                               \
+      arduino_test::fail(__FILE__, __LINE__,                                                                 \
+                         arduino_test::comparisonMessage(textA, #op, textB, arduino_test_a, arduino_test_b), \
+                         fatal);                                                                             \
+    }                                                                                                        \
+  } while (0)
+
+#define ARDUINO_TEST_CONDITION(condition, expected, text, fatal)                                            \
+  do {                                                                                                      \
+    if (!(condition) == expected) {                                                                         \
+      arduino_test::fail(__FILE__, __LINE__, std::string("expected ") + text + " to be " + #expected, fatal); \
+    }                                                                                                       \
+  } while (0)
+
+#define ARDUINO_TEST_STREQ(a, b, textA, textB, fatal)                                                      \
+  do {                                                                                                     \
+    const auto &arduino_test_a_value = (a);                                                                \
+    const auto &arduino_test_b_value = (b);                                                                \
+    const char *arduino_test_a = arduino_test::cString(arduino_test_a_value);                              \
+    const char *arduino_test_b = arduino_test::cString(arduino_test_b_value);                              \
+    if (!arduino_test_a || !arduino_test_b || strcmp(arduino_test_a, arduino_test_b) != 0) {               \
+      arduino_test::fail(__FILE__, __LINE__,                                                               \
+                         arduino_test::comparisonMessage(textA, "==", textB, arduino_test_a, arduino_test_b), \
+                         fatal);                                                                           \
+    }                                                                                                      \
+  } while (0)
+
+#define ARDUINO_TEST_NEAR(a, b, tolerance, textA, textB, fatal)                                            \
+  do {                                                                                                     \
+    double arduino_test_a = (a);                                                                           \
+    double arduino_test_b = (b);                                                                           \
+    double arduino_test_tolerance = (tolerance);                                                           \
+    if (fabs(arduino_test_a - arduino_test_b) > arduino_test_tolerance) {                                  \
+      arduino_test::fail(__FILE__, __LINE__,                                                               \
+                         arduino_test::comparisonMessage(textA, "~=", textB, arduino_test_a, arduino_test_b) + \
+                             "\n  tolerance = " + arduino_test::describe(arduino_test_tolerance),          \
+                         fatal);                                                                           \
+    }                                                                                                      \
+  } while (0)
+
+#define ASSERT_TRUE(condition) ARDUINO_TEST_CONDITION(condition, true, #condition, true)
+#define ASSERT_FALSE(condition) ARDUINO_TEST_CONDITION(condition, false, #condition, true)
+#define ASSERT_EQ(expected, actual) ARDUINO_TEST_COMPARE(expected, ==, actual, #expected, #actual, true)
+#define ASSERT_NE(a, b) ARDUINO_TEST_COMPARE(a, !=, b, #a, #b, true)
+#define ASSERT_LT(a, b) ARDUINO_TEST_COMPARE(a, <, b, #a, #b, true)
+#define ASSERT_LE(a, b) ARDUINO_TEST_COMPARE(a, <=, b, #a, #b, true)
+#define ASSERT_GT(a, b) ARDUINO_TEST_COMPARE(a, >, b, #a, #b, true)
+#define ASSERT_GE(a, b) ARDUINO_TEST_COMPARE(a, >=, b, #a, #b, true)
+#define ASSERT_STREQ(expected, actual) ARDUINO_TEST_STREQ(expected, actual, #expected, #actual, true)
+#define ASSERT_NEAR(expected, actual, tolerance) \
+  ARDUINO_TEST_NEAR(expected, actual, tolerance, #expected, #actual, true)
+
+#define EXPECT_TRUE(condition) ARDUINO_TEST_CONDITION(condition, true, #condition, false)
+#define EXPECT_FALSE(condition) ARDUINO_TEST_CONDITION(condition, false, #condition, false)
+#define EXPECT_EQ(expected, actual) ARDUINO_TEST_COMPARE(expected, ==, actual, #expected, #actual, false)
+#define EXPECT_NE(a, b) ARDUINO_TEST_COMPARE(a, !=, b, #a, #b, false)
+#define EXPECT_LT(a, b) ARDUINO_TEST_COMPARE(a, <, b, #a, #b, false)
+#define EXPECT_LE(a, b) ARDUINO_TEST_COMPARE(a, <=, b, #a, #b, false)
+#define EXPECT_GT(a, b) ARDUINO_TEST_COMPARE(a, >, b, #a, #b, false)
+#define EXPECT_GE(a, b) ARDUINO_TEST_COMPARE(a, >=, b, #a, #b, false)
+#define EXPECT_STREQ(expected, actual) ARDUINO_TEST_STREQ(expected, actual, #expected, #actual, false)
+#define EXPECT_NEAR(expected, actual, tolerance) \
+  ARDUINO_TEST_NEAR(expected, actual, tolerance, #expected, #actual, false)
+
+#define FAIL(message) arduino_test::fail(__FILE__, __LINE__, message, true)
+
+#endif
@@ -0,0 +1,37 @@
+/*
+  HardwareSerial.cpp - Mocked serial port, the data written is also printed
+  to the standard output of the test.
+*/
+
+#include <stdio.h>
+
+#include "HardwareSerial.h"
+
+HardwareSerial Serial;
+
+void HardwareSerial::begin(unsigned long baud, uint8_t config) {
+  (void)config;
+  baudRate = baud;
+}
+
+void HardwareSerial::end() { baudRate = 0; }
+
+int HardwareSerial::available() { return input.length(); }
+
+int HardwareSerial::peek() { return input.empty() ? -1 : (unsigned char)input[0]; }
+
+int HardwareSerial::read() {
+  int c = peek();
+  if (c >= 0) {
+    input.erase(0, 1);
+  }
+  return c;
+}
+
+int HardwareSerial::availableForWrite() { return 64; }
+
+size_t HardwareSerial::write(uint8_t c) {
+  output += (char)c;
+  fputc(c, stdout);
+  return 1;
+}
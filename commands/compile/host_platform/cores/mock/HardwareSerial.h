@@ -0,0 +1,36 @@
+/*
+  HardwareSerial.h - Mocked serial port: the data written is recorded and the
+  data read is the one provided by the test, see ArduinoMock.h.
+*/
+
+#ifndef HardwareSerial_h
+#define HardwareSerial_h
+
+#include <string>
+
+#include "Stream.h"
+
+class HardwareSerial : public Stream {
+public:
+  void begin(unsigned long baud) { begin(baud, 0); }
+  void begin(unsigned long baud, uint8_t config);
+  void end();
+  operator bool() { return true; }
+
+  virtual int available();
+  virtual int peek();
+  virtual int read();
+  virtual int availableForWrite();
+  virtual void flush() {}
+  virtual size_t write(uint8_t c);
+  using Print::write;
+
+  // Used by ArduinoMock.h
+  unsigned long baudRate;
+  std::string input;
+  std::string output;
+};
+
+extern HardwareSerial Serial;
+
+#endif
@@ -0,0 +1,90 @@
+/*
+  Stream.cpp - Base class of the mock Arduino core for the objects that read
+  and write data.
+*/
+
+#include <string.h>
+
+#include "Stream.h"
+
+size_t Stream::readBytes(char *buffer, size_t length) {
+  size_t count = 0;
+  while (count < length) {
+    int c = read();
+    if (c < 0) {
+      break;
+    }
+    *buffer++ = (char)c;
+    count++;
+  }
+  return count;
+}
+
+size_t Stream::readBytesUntil(char terminator, char *buffer, size_t length) {
+  size_t count = 0;
+  while (count < length) {
+    int c = read();
+    if (c < 0 || c == terminator) {
+      break;
+    }
+    *buffer++ = (char)c;
+    count++;
+  }
+  return count;
+}
+
+String Stream::readString() {
+  String res;
+  int c;
+  while ((c = read()) >= 0) {
+    res += (char)c;
+  }
+  return res;
+}
+
+String Stream::readStringUntil(char terminator) {
+  String res;
+  int c;
+  while ((c = read()) >= 0 && c != terminator) {
+    res += (char)c;
+  }
+  return res;
+}
+
+long Stream::parseInt() {
+  // Skip the characters that can't start a number
+  int c;
+  while ((c = peek()) >= 0 && c != '-' && (c < '0' || c > '9')) {
+    read();
+  }
+  bool negative = false;
+  long value = 0;
+  if (peek() == '-') {
+    negative = true;
+    read();
+  }
+  while ((c = peek()) >= '0' && c <= '9') {
+    value = value * 10 + c - '0';
+    read();
+  }
+  return negative ? -value : value;
+}
+
+bool Stream::find(const char *target) {
+  size_t len = strlen(target);
+  size_t matched = 0;
+  if (len == 0) {
+    return true;
+  }
+  int c;
+  while ((c = read()) >= 0) {
+    if (c == target[matched]) {
+      if (++matched == len) {
+        return true;
+      }
+    } else {
+      matched = c == target[0] ? 1 : 0;
+    }
+  }
+  return false;
+}
@@ -0,0 +1,29 @@
+/*
+  Stream.h - Base class of the mock Arduino core for the objects that read
+  and write data, like the serial ports.
+*/
+
+#ifndef Stream_h
+#define Stream_h
+
+#include "Print.h"
+
+class Stream : public Print {
+public:
+  virtual int available() = 0;
+  virtual int read() = 0;
+  virtual int peek() = 0;
+
+  // The mock streams never wait for data, the timeout is ignored
+  void setTimeout(unsigned long timeout) { (void)timeout; }
+
+  size_t readBytes(char *buffer, size_t length);
+  size_t readBytes(uint8_t *buffer, size_t length) { return readBytes((char *)buffer, length); }
+  size_t readBytesUntil(char terminator, char *buffer, size_t length);
+  String readString();
+  String readStringUntil(char terminator);
+  long parseInt();
+  bool find(const char *target);
+};
+
+#endif
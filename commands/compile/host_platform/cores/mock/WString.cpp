@@ -0,0 +1,242 @@
+/*
+  WString.cpp - String class of the mock Arduino core.
+*/
+
+#include "WString.h"
+
+#include <ctype.h>
+#include <stdio.h>
+#include <stdlib.h>
+#include <strings.h>
+
+static std::string formatInteger(unsigned long value, unsigned char base, bool negative) {
+  if (base < 2 || base > 36) {
+    base = 10;
+  }
+  std::string res;
+  do {
+    unsigned long digit = value % base;
+    res.insert(res.begin(), (char)(digit < 10 ? '0' + digit : 'a' + digit - 10));
+    value /= base;
+  } while (value > 0);
+  if (negative) {
+    res.insert(res.begin(), '-');
+  }
+  return res;
+}
+
+static std::string formatSigned(long value, unsigned char base) {
+  if (base == 10 && value < 0) {
+    return formatInteger(-(unsigned long)value, base, true);
+  }
+  return formatInteger((unsigned long)value, base, false);
+}
+
+static std::string formatDouble(double value, unsigned char decimalPlaces) {
+  char buf[64];
+  snprintf(buf, sizeof(buf), "%.*f", decimalPlaces, value);
+  return buf;
+}
+
+String::String(const char *cstr) : str(cstr ? cstr : "") {}
+String::String(const char *cstr, unsigned int length) : str(cstr, length) {}
+String::String(const std::string &s) : str(s) {}
+String::String(const String &s) : str(s.str) {}
+String::String(char c) : str(1, c) {}
+String::String(unsigned char value, unsigned char base) : str(formatInteger(value, base, false)) {}
+String::String(int value, unsigned char base) : str(formatSigned(value, base)) {}
+String::String(unsigned int value, unsigned char base) : str(formatInteger(value, base, false)) {}
+String::String(long value, unsigned char base) : str(formatSigned(value, base)) {}
+String::String(unsigned long value, unsigned char base) : str(formatInteger(value, base, false)) {}
+String::String(float value, unsigned char decimalPlaces) : str(formatDouble(value, decimalPlaces)) {}
+String::String(double value, unsigned char decimalPlaces) : str(formatDouble(value, decimalPlaces)) {}
+
+String &String::operator=(const String &rhs) {
+  str = rhs.str;
+  return *this;
+}
+
+String &String::operator=(const char *cstr) {
+  str = cstr ? cstr : "";
+  return *this;
+}
+
+unsigned char String::reserve(unsigned int size) {
+  str.reserve(size);
+  return 1;
+}
+
+unsigned char String::concat(const String &s) {
+  str += s.str;
+  return 1;
+}
+
+unsigned char String::concat(const char *cstr) {
+  if (!cstr) {
+    return 0;
+  }
+  str += cstr;
+  return 1;
+}
+
+unsigned char String::concat(char c) { return concat(String(c)); }
+unsigned char String::concat(unsigned char num) { return concat(String(num)); }
+unsigned char String::concat(int num) { return concat(String(num)); }
+unsigned char String::concat(unsigned int num) { return concat(String(num)); }
+unsigned char String::concat(long num) { return concat(String(num)); }
+unsigned char String::concat(unsigned long num) { return concat(String(num)); }
+unsigned char String::concat(float num) { return concat(String(num)); }
+unsigned char String::concat(double num) { return concat(String(num)); }
+
+int String::compareTo(const String &s) const { return str.compare(s.str); }
+unsigned char String::equals(const String &s) const { return str == s.str; }
+unsigned char String::equals(const char *cstr) const { return cstr && str == cstr; }
+
+unsigned char String::equalsIgnoreCase(const String &s) const {
+  return str.length() == s.str.length() && strcasecmp(str.c_str(), s.str.c_str()) == 0;
+}
+
+unsigned char String::startsWith(const String &prefix) const { return startsWith(prefix, 0); }
+
+unsigned char String::startsWith(const String &prefix, unsigned int offset) const {
+  return offset <= str.length() && str.compare(offset, prefix.str.length(), prefix.str) == 0;
+}
+
+unsigned char String::endsWith(const String &suffix) const {
+  return str.length() >= suffix.str.length() &&
+         str.compare(str.length() - suffix.str.length(), suffix.str.length(), suffix.str) == 0;
+}
+
+char String::charAt(unsigned int index) const { return (*this)[index]; }
+
+void String::setCharAt(unsigned int index, char c) {
+  if (index < str.length()) {
+    str[index] = c;
+  }
+}
+
+char String::operator[](unsigned int index) const { return index < str.length() ? str[index] : 0; }
+
+char &String::operator[](unsigned int index) {
+  static char dummy;
+  if (index >= str.length()) {
+    dummy = 0;
+    return dummy;
+  }
+  return str[index];
+}
+
+void String::getBytes(unsigned char *buf, unsigned int bufsize, unsigned int index) const {
+  if (!bufsize || !buf) {
+    return;
+  }
+  if (index >= str.length()) {
+    buf[0] = 0;
+    return;
+  }
+  unsigned int n = str.copy((char *)buf, bufsize - 1, index);
+  buf[n] = 0;
+}
+
+void String::toCharArray(char *buf, unsigned int bufsize, unsigned int index) const {
+  getBytes((unsigned char *)buf, bufsize, index);
+}
+
+static int toIndex(size_t pos) { return pos == std::string::npos ? -1 : (int)pos; }
+
+int String::indexOf(char ch) const { return indexOf(ch, 0); }
+int String::indexOf(char ch, unsigned int fromIndex) const { return toIndex(str.find(ch, fromIndex)); }
+int String::indexOf(const String &s) const { return indexOf(s, 0); }
+int String::indexOf(const String &s, unsigned int fromIndex) const { return toIndex(str.find(s.str, fromIndex)); }
+int String::lastIndexOf(char ch) const { return toIndex(str.rfind(ch)); }
+int String::lastIndexOf(char ch, unsigned int fromIndex) const { return toIndex(str.rfind(ch, fromIndex)); }
+int String::lastIndexOf(const String &s) const { return toIndex(str.rfind(s.str)); }
+int String::lastIndexOf(const String &s, unsigned int fromIndex) const { return toIndex(str.rfind(s.str, fromIndex)); }
+
+String String::substring(unsigned int beginIndex) const { return substring(beginIndex, str.length()); }
+
+String String::substring(unsigned int beginIndex, unsigned int endIndex) const {
+  if (beginIndex > endIndex) {
+    unsigned int tmp = beginIndex;
+    beginIndex = endIndex;
+    endIndex = tmp;
+  }
+  if (beginIndex >= str.length()) {
+    return String();
+  }
+  if (endIndex > str.length()) {
+    endIndex = str.length();
+  }
+  return String(str.substr(beginIndex, endIndex - beginIndex));
+}
+
+void String::replace(char find, char replace) {
+  for (size_t i = 0; i < str.length(); i++) {
+    if (str[i] == find) {
+      str[i] = replace;
+    }
+  }
+}
+
+void String::replace(const String &find, const String &replace) {
+  if (find.str.empty()) {
+    return;
+  }
+  size_t pos = 0;
+  while ((pos = str.find(find.str, pos)) != std::string::npos) {
+    str.replace(pos, find.str.length(), replace.str);
+    pos += replace.str.length();
+  }
+}
+
+void String::remove(unsigned int index) { remove(index, (unsigned int)-1); }
+
+void String::remove(unsigned int index, unsigned int count) {
+  if (index < str.length()) {
+    str.erase(index, count);
+  }
+}
+
+void String::toLowerCase() {
+  for (size_t i = 0; i < str.length(); i++) {
+    str[i] = tolower((unsigned char)str[i]);
+  }
+}
+
+void String::toUpperCase() {
+  for (size_t i = 0; i < str.length(); i++) {
+    str[i] = toupper((unsigned char)str[i]);
+  }
+}
+
+void String::trim() {
+  size_t begin = 0;
+  while (begin < str.length() && isspace((unsigned char)str[begin])) {
+    begin++;
+  }
+  size_t end = str.length();
+  while (end > begin && isspace((unsigned char)str[end - 1])) {
+    end--;
+  }
+  str = str.substr(begin, end - begin);
+}
+
+long String::toInt() const { return atol(str.c_str()); }
+float String::toFloat() const { return (float)atof(str.c_str()); }
+double String::toDouble() const { return atof(str.c_str()); }
+
+String operator+(const String &lhs, const String &rhs) {
+  String res(lhs);
+  res.concat(rhs);
+  return res;
+}
+
+String operator+(const String &lhs, const char *rhs) { return lhs + String(rhs); }
+String operator+(const char *lhs, const String &rhs) { return String(lhs) + rhs; }
+String operator+(const String &lhs, char rhs) { return lhs + String(rhs); }
+String operator+(const String &lhs, int rhs) { return lhs + String(rhs); }
+String operator+(const String &lhs, unsigned int rhs) { return lhs + String(rhs); }
+String operator+(const String &lhs, long rhs) { return lhs + String(rhs); }
+String operator+(const String &lhs, unsigned long rhs) { return lhs + String(rhs); }
+String operator+(const String &lhs, float rhs) { return lhs + String(rhs); }
+String operator+(const String &lhs, double rhs) { return lhs + String(rhs); }
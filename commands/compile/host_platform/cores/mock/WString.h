@@ -0,0 +1,118 @@
+/*
+  WString.h - String class of the mock Arduino core, compatible with the one
+  of the Arduino cores and backed by a std::string.
+*/
+
+#ifndef WString_h
+#define WString_h
+
+#ifdef __cplusplus
+
+#include <stddef.h>
+#include <string>
+
+class String {
+public:
+  String(const char *cstr = "");
+  String(const char *cstr, unsigned int length);
+  String(const std::string &str);
+  String(const String &str);
+  explicit String(char c);
+  explicit String(unsigned char value, unsigned char base = 10);
+  explicit String(int value, unsigned char base = 10);
+  explicit String(unsigned int value, unsigned char base = 10);
+  explicit String(long value, unsigned char base = 10);
+  explicit String(unsigned long value, unsigned char base = 10);
+  explicit String(float value, unsigned char decimalPlaces = 2);
+  explicit String(double value, unsigned char decimalPlaces = 2);
+
+  String &operator=(const String &rhs);
+  String &operator=(const char *cstr);
+
+  unsigned char reserve(unsigned int size);
+  unsigned int length() const { return str.length(); }
+  bool isEmpty() const { return str.empty(); }
+  const char *c_str() const { return str.c_str(); }
+  const std::string &std_string() const { return str; }
+
+  unsigned char concat(const String &s);
+  unsigned char concat(const char *cstr);
+  unsigned char concat(char c);
+  unsigned char concat(unsigned char num);
+  unsigned char concat(int num);
+  unsigned char concat(unsigned int num);
+  unsigned char concat(long num);
+  unsigned char concat(unsigned long num);
+  unsigned char concat(float num);
+  unsigned char concat(double num);
+
+  template <typename T>
+  String &operator+=(const T &rhs) {
+    concat(rhs);
+    return *this;
+  }
+
+  int compareTo(const String &s) const;
+  unsigned char equals(const String &s) const;
+  unsigned char equals(const char *cstr) const;
+  unsigned char equalsIgnoreCase(const String &s) const;
+  unsigned char startsWith(const String &prefix) const;
+  unsigned char startsWith(const String &prefix, unsigned int offset) const;
+  unsigned char endsWith(const String &suffix) const;
+
+  unsigned char operator==(const String &rhs) const { return equals(rhs); }
+  unsigned char operator==(const char *cstr) const { return equals(cstr); }
+  unsigned char operator!=(const String &rhs) const { return !equals(rhs); }
+  unsigned char operator!=(const char *cstr) const { return !equals(cstr); }
+  unsigned char operator<(const String &rhs) const { return compareTo(rhs) < 0; }
+  unsigned char operator>(const String &rhs) const { return compareTo(rhs) > 0; }
+  unsigned char operator<=(const String &rhs) const { return compareTo(rhs) <= 0; }
+  unsigned char operator>=(const String &rhs) const { return compareTo(rhs) >= 0; }
+
+  char charAt(unsigned int index) const;
+  void setCharAt(unsigned int index, char c);
+  char operator[](unsigned int index) const;
+  char &operator[](unsigned int index);
+  void getBytes(unsigned char *buf, unsigned int bufsize, unsigned int index = 0) const;
+  void toCharArray(char *buf, unsigned int bufsize, unsigned int index = 0) const;
+
+  int indexOf(char ch) const;
+  int indexOf(char ch, unsigned int fromIndex) const;
+  int indexOf(const String &s) const;
+  int indexOf(const String &s, unsigned int fromIndex) const;
+  int lastIndexOf(char ch) const;
+  int lastIndexOf(char ch, unsigned int fromIndex) const;
+  int lastIndexOf(const String &s) const;
+  int lastIndexOf(const String &s, unsigned int fromIndex) const;
+  String substring(unsigned int beginIndex) const;
+  String substring(unsigned int beginIndex, unsigned int endIndex) const;
+
+  void replace(char find, char replace);
+  void replace(const String &find, const String &replace);
+  void remove(unsigned int index);
+  void remove(unsigned int index, unsigned int count);
+  void toLowerCase();
+  void toUpperCase();
+  void trim();
+
+  long toInt() const;
+  float toFloat() const;
+  double toDouble() const;
+
+private:
+  std::string str;
+};
+
+String operator+(const String &lhs, const String &rhs);
+String operator+(const String &lhs, const char *rhs);
+String operator+(const char *lhs, const String &rhs);
+String operator+(const String &lhs, char rhs);
+String operator+(const String &lhs, int rhs);
+String operator+(const String &lhs, unsigned int rhs);
+String operator+(const String &lhs, long rhs);
+String operator+(const String &lhs, unsigned long rhs);
+String operator+(const String &lhs, float rhs);
+String operator+(const String &lhs, double rhs);
+
+#endif // __cplusplus
+#endif
@@ -0,0 +1,10 @@
+/*
+  main.cpp - Entry point of the unit tests binary, the sketch setup() and
+  loop() functions are called only by the tests.
+*/
+
+namespace arduino_test {
+int run(int argc, char **argv);
+}
+
+int main(int argc, char **argv) { return arduino_test::run(argc, argv); }
@@ -0,0 +1,74 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesresolver"
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	semver "go.bug.st/relaxed-semver"
+)
+
+// libraryOverrides returns the libraries pinned to headers in the sketch
+// metadata and in the request, the latter take precedence
+func libraryOverrides(sk *sketch.Sketch, req *rpc.CompileRequest) ([]*librariesresolver.Override, error) {
+	res := []*librariesresolver.Override{}
+	byHeader := map[string]int{}
+	add := func(header, library, path, version string) error {
+		if header == "" || library == "" {
+			return errors.New(tr("header and library name are required"))
+		}
+		override := &librariesresolver.Override{Header: header, Library: library}
+		if path != "" {
+			override.Path = paths.New(path)
+			if !override.Path.IsAbs() {
+				override.Path = sk.FullPath.JoinPath(override.Path)
+			}
+		}
+		if version != "" {
+			v, err := semver.Parse(version)
+			if err != nil {
+				return fmt.Errorf(tr("invalid version %[1]s for library %[2]s: %[3]s"), version, library, err)
+			}
+			override.Version = v
+		}
+		if i, ok := byHeader[header]; ok {
+			res[i] = override
+		} else {
+			byHeader[header] = len(res)
+			res = append(res, override)
+		}
+		return nil
+	}
+
+	if sk.Metadata != nil {
+		for _, o := range sk.Metadata.LibraryOverrides {
+			if err := add(o.Header, o.Library, o.Path, o.Version); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, o := range req.GetLibraryOverrides() {
+		if err := add(o.GetHeader(), o.GetLibrary(), o.GetPath(), o.GetVersion()); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,164 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/configuration"
+	"github.com/arduino/arduino-cli/legacy/builder/types"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"google.golang.org/protobuf/proto"
+)
+
+// CompileMatrix builds the sketch for each of the targets of the request,
+// running up to req.Parallel builds at the same time. The builds share the
+// loaded libraries and the compiler jobs, each one uses its own build folder. resultCB, if not
+// nil, is called as soon as each build ends; the results are returned in the
+// same order of the targets. The error returned is about the request, the
+// failed builds are reported in their results.
+func CompileMatrix(ctx context.Context, req *rpc.CompileMatrixRequest, resultCB func(*rpc.CompileMatrixResult), debug bool) ([]*rpc.CompileMatrixResult, error) {
+	compileReq := req.GetCompile()
+	if compileReq == nil {
+		return nil, &commands.InvalidArgumentError{Message: tr("Missing compile request")}
+	}
+	if compileReq.GetShowProperties() || compileReq.GetPreprocess() {
+		return nil, &commands.InvalidArgumentError{Message: tr("Can't build more targets while showing the build properties or preprocessing the sketch")}
+	}
+	if compileReq.GetSizeBaseline() != nil {
+		return nil, &commands.InvalidArgumentError{Message: tr("Can't compare the size of more targets with the same baseline")}
+	}
+	if len(req.GetTargets()) == 0 {
+		return nil, &commands.InvalidArgumentError{Message: tr("No targets to build")}
+	}
+	for _, target := range req.GetTargets() {
+		if target.GetFqbn() == "" {
+			return nil, &commands.InvalidArgumentError{Message: tr("Missing FQBN (Fully Qualified Board Name)")}
+		}
+	}
+	if compileReq.GetSketchPath() == "" {
+		return nil, &commands.MissingSketchPathError{}
+	}
+	sk, err := sketch.New(paths.New(compileReq.GetSketchPath()))
+	if err != nil {
+		return nil, &commands.CantOpenSketchError{Cause: err}
+	}
+
+	exportBinaries := configuration.Settings.GetBool("sketch.always_export_binaries")
+	if reqExportBinaries := compileReq.GetExportBinaries(); reqExportBinaries != nil {
+		exportBinaries = reqExportBinaries.Value
+	}
+
+	parallel := int(req.GetParallel())
+	if parallel <= 0 {
+		parallel = runtime.NumCPU()
+	}
+	if parallel > len(req.GetTargets()) {
+		parallel = len(req.GetTargets())
+	}
+	// The compiler jobs are split among the parallel builds, otherwise
+	// each build would run as many jobs as the available CPUs
+	jobs := int(compileReq.GetJobs())
+	if jobs <= 0 {
+		jobs = runtime.NumCPU()
+	}
+	jobs /= parallel
+	if jobs < 1 {
+		jobs = 1
+	}
+
+	opts := &compileOptions{librariesCache: types.NewLibrariesManagersCache()}
+	results := make([]*rpc.CompileMatrixResult, len(req.GetTargets()))
+	usedIDs := map[string]bool{}
+	semaphore := make(chan struct{}, parallel)
+	var resultMutex sync.Mutex
+	var wg sync.WaitGroup
+	for i, target := range req.GetTargets() {
+		id := matrixTargetID(target)
+		for n := 2; usedIDs[id]; n++ {
+			id = fmt.Sprintf("%s-%d", matrixTargetID(target), n)
+		}
+		usedIDs[id] = true
+
+		targetReq := proto.Clone(compileReq).(*rpc.CompileRequest)
+		targetReq.Fqbn = target.GetFqbn()
+		targetReq.Jobs = int32(jobs)
+		targetReq.BuildProperties = append(targetReq.BuildProperties, target.GetBuildProperties()...)
+		if buildPath := compileReq.GetBuildPath(); buildPath != "" {
+			targetReq.BuildPath = paths.New(buildPath).Join(id).String()
+		} else {
+			targetReq.BuildPath = sk.BuildPath.String() + "-" + id
+		}
+		if exportDir := compileReq.GetExportDir(); exportDir != "" {
+			targetReq.ExportDir = paths.New(exportDir).Join(id).String()
+		} else if exportBinaries && !compileReq.GetCreateCompilationDatabaseOnly() {
+			// The default export folder depends only on the FQBN
+			targetReq.ExportDir = sk.FullPath.Join("build", id).String()
+		}
+
+		wg.Add(1)
+		go func(i int, target *rpc.CompileMatrixTarget, id string, targetReq *rpc.CompileRequest) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			result := &rpc.CompileMatrixResult{Target: target, Id: id}
+			if ctx.Err() != nil {
+				result.Error = ctx.Err().Error()
+			} else {
+				outStream := new(bytes.Buffer)
+				errStream := new(bytes.Buffer)
+				res, err := compile(ctx, targetReq, outStream, errStream, nil, opts, debug)
+				result.Compile = res
+				result.Success = err == nil
+				if err != nil {
+					result.Error = err.Error()
+				}
+				result.OutStream = outStream.Bytes()
+				result.ErrStream = errStream.Bytes()
+			}
+
+			resultMutex.Lock()
+			defer resultMutex.Unlock()
+			results[i] = result
+			if resultCB != nil {
+				resultCB(result)
+			}
+		}(i, target, id, targetReq)
+	}
+	wg.Wait()
+	return results, nil
+}
+
+// matrixTargetID returns an identifier of the target that can be used as a
+// folder name: the FQBN followed by a hash of the build properties, if any
+func matrixTargetID(target *rpc.CompileMatrixTarget) string {
+	id := strings.NewReplacer(":", ".", "=", "-", ",", "_").Replace(target.GetFqbn())
+	if len(target.GetBuildProperties()) > 0 {
+		hash := sha256.Sum256([]byte(strings.Join(target.GetBuildProperties(), "\n")))
+		id += fmt.Sprintf("-%x", hash[:4])
+	}
+	return id
+}
@@ -0,0 +1,96 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"context"
+	"os/exec"
+	"testing"
+
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatrixTargetID(t *testing.T) {
+	require.Equal(t, "arduino.avr.uno", matrixTargetID(&rpc.CompileMatrixTarget{Fqbn: "arduino:avr:uno"}))
+	require.Equal(t, "arduino.avr.nano.cpu-atmega328old_clock-16",
+		matrixTargetID(&rpc.CompileMatrixTarget{Fqbn: "arduino:avr:nano:cpu=atmega328old,clock=16"}))
+
+	debug := matrixTargetID(&rpc.CompileMatrixTarget{Fqbn: "arduino:avr:uno", BuildProperties: []string{"build.extra_flags=-DDEBUG"}})
+	require.Regexp(t, "^arduino\\.avr\\.uno-[0-9a-f]{8}$", debug)
+	trace := matrixTargetID(&rpc.CompileMatrixTarget{Fqbn: "arduino:avr:uno", BuildProperties: []string{"build.extra_flags=-DTRACE"}})
+	require.NotEqual(t, debug, trace)
+}
+
+func TestCompileMatrix(t *testing.T) {
+	if _, err := exec.LookPath("c++"); err != nil {
+		t.Skip("c++ not available")
+	}
+	tmp, err := paths.MkTempDir("", "compile_matrix_test")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	configuration.Settings = configuration.Init("")
+	configuration.Settings.Set("directories.Data", tmp.Join("data").String())
+	configuration.Settings.Set("directories.Downloads", tmp.Join("staging").String())
+	configuration.Settings.Set("directories.User", tmp.Join("user").String())
+
+	// The host platform, built with the native toolchain, and a library
+	// shared by the builds
+	hardwareDir := tmp.Join("user", "hardware")
+	require.NoError(t, hardwareDir.Join("arduino-cli").MkdirAll())
+	require.NoError(t, paths.New("host_platform").CopyDirTo(hardwareDir.Join("arduino-cli", "host")))
+	libraryDir := tmp.Join("user", "libraries", "Greeting")
+	require.NoError(t, libraryDir.MkdirAll())
+	require.NoError(t, libraryDir.Join("Greeting.h").WriteFile([]byte("#define GREETING \"hello\"\n")))
+	sketchDir := tmp.Join("Matrix")
+	require.NoError(t, sketchDir.MkdirAll())
+	require.NoError(t, sketchDir.Join("Matrix.ino").WriteFile([]byte("#include <Greeting.h>\nvoid setup() { Serial.println(GREETING); }\nvoid loop() {}\n")))
+
+	res, err := commands.Create(&rpc.CreateRequest{})
+	require.NoError(t, err)
+	defer commands.Destroy(context.Background(), &rpc.DestroyRequest{Instance: res.GetInstance()})
+	pm := commands.GetPackageManager(res.GetInstance().GetId())
+	require.Empty(t, pm.LoadHardwareFromDirectory(hardwareDir))
+
+	req := &rpc.CompileMatrixRequest{
+		Compile: &rpc.CompileRequest{
+			Instance:   res.GetInstance(),
+			SketchPath: sketchDir.String(),
+			BuildPath:  tmp.Join("build").String(),
+			// ctags is not installed, the sketch doesn't need prototypes
+			BuildProperties: []string{"tools.ctags.pattern=true"},
+		},
+		Targets: []*rpc.CompileMatrixTarget{
+			{Fqbn: "arduino-cli:host:native"},
+			{Fqbn: "arduino-cli:host:native", BuildProperties: []string{"build.extra_flags=-DTRACE"}},
+		},
+		Parallel: 2,
+	}
+	results, err := CompileMatrix(context.Background(), req, nil, false)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	for i, result := range results {
+		require.True(t, result.GetSuccess(), "%s: %s%s", result.GetError(), result.GetOutStream(), result.GetErrStream())
+		require.Equal(t, req.GetTargets()[i], result.GetTarget())
+		require.Equal(t, tmp.Join("build", result.GetId()).String(), result.GetCompile().GetBuildPath())
+		require.Len(t, result.GetCompile().GetUsedLibraries(), 1)
+		require.Equal(t, "Greeting", result.GetCompile().GetUsedLibraries()[0].GetName())
+	}
+	require.NotEqual(t, results[0].GetId(), results[1].GetId())
+}
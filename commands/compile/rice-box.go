@@ -0,0 +1,186 @@
+// Code generated by rice embed-go; DO NOT EDIT.
+package compile
+
+import (
+	"time"
+
+	"github.com/cmaglie/go.rice/embedded"
+)
+
+func init() {
+
+	// define files
+	file2 := &embedded.EmbeddedFile{
+		Filename:    "boards.txt",
+		FileModTime: time.Unix(1792207673, 0),
+
+		Content: string("native.name=Host\nnative.build.board=HOST\nnative.build.core=mock\n"),
+	}
+	file5 := &embedded.EmbeddedFile{
+		Filename:    "cores/mock/Arduino.h",
+		FileModTime: time.Unix(1792207700, 0),
+
+		Content: string("/*\n  Arduino.h - Mock Arduino core used to run the unit tests of the sketches\n  on the host, see ArduinoMock.h to inspect and drive the mocked hardware.\n*/\n\n#ifndef Arduino_h\n#define Arduino_h\n\n#include <math.h>\n#include <stdbool.h>\n#include <stdint.h>\n#include <stdlib.h>\n#include <string.h>\n\n#ifdef __cplusplus\nextern \"C\" {\n#endif\n\ntypedef bool boolean;\ntypedef uint8_t byte;\ntypedef unsigned int word;\n\n#define HIGH 0x1\n#define LOW 0x0\n\n#define INPUT 0x0\n#define OUTPUT 0x1\n#define INPUT_PULLUP 0x2\n\n#define LSBFIRST 0\n#define MSBFIRST 1\n\n#define CHANGE 1\n#define FALLING 2\n#define RISING 3\n\n#define DEFAULT 1\n#define EXTERNAL 0\n\n#define LED_BUILTIN 13\n#define A0 14\n#define A1 15\n#define A2 16\n#define A3 17\n#define A4 18\n#define A5 19\n#define A6 20\n#define A7 21\n\n#define PI 3.1415926535897932384626433832795\n#define HALF_PI 1.5707963267948966192313216916398\n#define TWO_PI 6.283185307179586476925286766559\n#define DEG_TO_RAD 0.017453292519943295769236907684886\n#define RAD_TO_DEG 57.295779513082320876798154814105\n#define EULER 2.718281828459045235360287471352\n\n#define constrain(amt, low, high) ((amt) < (low) ? (low) : ((amt) > (high) ? (high) : (amt)))\n#define radians(deg) ((deg)*DEG_TO_RAD)\n#define degrees(rad) ((rad)*RAD_TO_DEG)\n#define sq(x) ((x) * (x))\n\n#define lowByte(w) ((uint8_t)((w)&0xff))\n#define highByte(w) ((uint8_t)((w) >> 8))\n#define bitRead(value, bit) (((value) >> (bit)) & 0x01)\n#define bitSet(value, bit) ((value) |= (1UL << (bit)))\n#define bitClear(value, bit) ((value) &= ~(1UL << (bit)))\n#define bitWrite(value, bit, bitvalue) ((bitvalue) ? bitSet(value, bit) : bitClear(value, bit))\n#define bit(b) (1UL << (b))\n\n#define interrupts()\n#define noInterrupts()\n#define digitalPinToInterrupt(p) (p)\n#define clockCyclesPerMicrosecond() (F_CPU / 1000000L)\n#ifndef F_CPU\n#define F_CPU 16000000L\n#endif\n\n#define PROGMEM\n#define F(string_literal) (string_literal)\n#define pgm_read_byte(addr) (*(const unsigned char *)(addr))\n#define pgm_read_word(addr) (*(const unsigned short *)(addr))\n\nvoid pinMode(uint8_t pin, uint8_t mode);\nvoid digitalWrite(uint8_t pin, uint8_t val);\nint digitalRead(uint8_t pin);\nint analogRead(uint8_t pin);\nvoid analogReference(uint8_t mode);\nvoid analogWrite(uint8_t pin, int val);\n\nunsigned long millis(void);\nunsigned long micros(void);\nvoid delay(unsigned long ms);\nvoid delayMicroseconds(unsigned int us);\nvoid yield(void);\n\nvoid shiftOut(uint8_t dataPin, uint8_t clockPin, uint8_t bitOrder, uint8_t val);\nuint8_t shiftIn(uint8_t dataPin, uint8_t clockPin, uint8_t bitOrder);\n\nvoid attachInterrupt(uint8_t interruptNum, void (*userFunc)(void), int mode);\nvoid detachInterrupt(uint8_t interruptNum);\n\nvoid noTone(uint8_t pin);\n\nvoid setup(void);\nvoid loop(void);\n\n#ifdef __cplusplus\n} // extern \"C\"\n\ntemplate <class T, class L>\nauto min(const T &a, const L &b) -> decltype((b < a) ? b : a) {\n  return (b < a) ? b : a;\n}\n\ntemplate <class T, class L>\nauto max(const T &a, const L &b) -> decltype((b < a) ? b : a) {\n  return (a < b) ? b : a;\n}\n\nvoid tone(uint8_t pin, unsigned int frequency, unsigned long duration = 0);\n\nlong random(long max);\nlong random(long min, long max);\nvoid randomSeed(unsigned long seed);\nlong map(long x, long in_min, long in_max, long out_min, long out_max);\n\nuint16_t makeWord(uint16_t w);\nuint16_t makeWord(uint8_t h, uint8_t l);\n\n#include \"WString.h\"\n#include \"HardwareSerial.h\"\n#include \"ArduinoMock.h\"\n#endif\n\n#endif\n"),
+	}
+	file6 := &embedded.EmbeddedFile{
+		Filename:    "cores/mock/ArduinoMock.cpp",
+		FileModTime: time.Unix(1792207759, 0),
+
+		Content: string("/*\n  ArduinoMock.cpp - Implementation of the Arduino functions on top of the\n  mocked hardware.\n*/\n\n#include <map>\n\n#include \"Arduino.h\"\n\nnamespace {\n\nstruct Pin {\n  Pin() : mode(-1), digital(LOW), analogInput(0), analogOutput(-1), tone(0), interruptMode(0), interrupt(NULL) {}\n  int mode;\n  int digital;\n  int analogInput;\n  int analogOutput;\n  unsigned int tone;\n  std::vector<mock::PinEvent> digitalWrites;\n  std::vector<mock::PinEvent> analogWrites;\n  int interruptMode;\n  void (*interrupt)(void);\n};\n\nstd::map<uint8_t, Pin> pins;\nunsigned long now = 0;\nunsigned long randomState = 1;\n\nPin &pin(uint8_t p) { return pins[p]; }\n\n} // namespace\n\nvoid pinMode(uint8_t p, uint8_t mode) {\n  pin(p).mode = mode;\n  if (mode == INPUT_PULLUP) {\n    pin(p).digital = HIGH;\n  }\n}\n\nvoid digitalWrite(uint8_t p, uint8_t val) {\n  Pin &state = pin(p);\n  state.digital = val ? HIGH : LOW;\n  mock::PinEvent event = {now, state.digital};\n  state.digitalWrites.push_back(event);\n}\n\nint digitalRead(uint8_t p) { return pin(p).digital; }\n\nint analogRead(uint8_t p) { return pin(p).analogInput; }\n\nvoid analogReference(uint8_t mode) { (void)mode; }\n\nvoid analogWrite(uint8_t p, int val) {\n  Pin &state = pin(p);\n  state.analogOutput = val;\n  mock::PinEvent event = {now, val};\n  state.analogWrites.push_back(event);\n}\n\nunsigned long millis(void) { return now / 1000; }\n\nunsigned long micros(void) { return now; }\n\nvoid delay(unsigned long ms) { now += ms * 1000; }\n\nvoid delayMicroseconds(unsigned int us) { now += us; }\n\nvoid yield(void) {}\n\nvoid shiftOut(uint8_t dataPin, uint8_t clockPin, uint8_t bitOrder, uint8_t val) {\n  for (uint8_t i = 0; i < 8; i++) {\n    if (bitOrder == LSBFIRST) {\n      digitalWrite(dataPin, !!(val & (1 << i)));\n    } else {\n      digitalWrite(dataPin, !!(val & (1 << (7 - i))));\n    }\n    digitalWrite(clockPin, HIGH);\n    digitalWrite(clockPin, LOW);\n  }\n}\n\nuint8_t shiftIn(uint8_t dataPin, uint8_t clockPin, uint8_t bitOrder) {\n  uint8_t value = 0;\n  for (uint8_t i = 0; i < 8; ++i) {\n    digitalWrite(clockPin, HIGH);\n    if (bitOrder == LSBFIRST) {\n      value |= digitalRead(dataPin) << i;\n    } else {\n      value |= digitalRead(dataPin) << (7 - i);\n    }\n    digitalWrite(clockPin, LOW);\n  }\n  return value;\n}\n\nvoid attachInterrupt(uint8_t interruptNum, void (*userFunc)(void), int mode) {\n  pin(interruptNum).interrupt = userFunc;\n  pin(interruptNum).interruptMode = mode;\n}\n\nvoid detachInterrupt(uint8_t interruptNum) {\n  pin(interruptNum).interrupt = NULL;\n  pin(interruptNum).interruptMode = 0;\n}\n\nvoid tone(uint8_t p, unsigned int frequency, unsigned long duration) {\n  (void)duration;\n  pin(p).tone = frequency;\n}\n\nvoid noTone(uint8_t p) { pin(p).tone = 0; }\n\nlong random(long max) {\n  if (max == 0) {\n    return 0;\n  }\n  // A deterministic generator, so that the tests are repeatable\n  randomState = randomState * 1103515245 + 12345;\n  return (long)((randomState / 65536) % 32768) % max;\n}\n\nlong random(long min, long max) {\n  if (min >= max) {\n    return min;\n  }\n  return random(max - min) + min;\n}\n\nvoid randomSeed(unsigned long seed) {\n  if (seed != 0) {\n    randomState = seed;\n  }\n}\n\nlong map(long x, long in_min, long in_max, long out_min, long out_max) {\n  return (x - in_min) * (out_max - out_min) / (in_max - in_min) + out_min;\n}\n\nuint16_t makeWord(uint16_t w) { return w; }\n\nuint16_t makeWord(uint8_t h, uint8_t l) { return (h << 8) | l; }\n\nnamespace mock {\n\nint pinMode(uint8_t p) { return pin(p).mode; }\n\nint digitalValue(uint8_t p) { return pin(p).digital; }\n\nconst std::vector<PinEvent> &digitalWrites(uint8_t p) { return pin(p).digitalWrites; }\n\nvoid setDigitalInput(uint8_t p, int value) {\n  Pin &state = pin(p);\n  int previous = state.digital;\n  state.digital = value ? HIGH : LOW;\n  if (!state.interrupt || previous == state.digital) {\n    return;\n  }\n  if (state.interruptMode == CHANGE ||\n      (state.interruptMode == RISING && state.digital == HIGH) ||\n      (state.interruptMode == FALLING && state.digital == LOW)) {\n    state.interrupt();\n  }\n}\n\nint analogValue(uint8_t p) { return pin(p).analogOutput; }\n\nconst std::vector<PinEvent> &analogWrites(uint8_t p) { return pin(p).analogWrites; }\n\nvoid setAnalogInput(uint8_t p, int value) { pin(p).analogInput = value; }\n\nunsigned int toneFrequency(uint8_t p) { return pin(p).tone; }\n\nbool triggerInterrupt(uint8_t p) {\n  if (!pin(p).interrupt) {\n    return false;\n  }\n  pin(p).interrupt();\n  return true;\n}\n\nvoid advanceMillis(unsigned long ms) { now += ms * 1000; }\n\nvoid advanceMicros(unsigned long us) { now += us; }\n\nvoid setMicros(unsigned long us) { now = us; }\n\nString serialOutput() { return String(Serial.output); }\n\nvoid clearSerialOutput() { Serial.output.clear(); }\n\nvoid serialInput(const String &data) { Serial.input += data.std_string(); }\n\nunsigned long serialBaudRate() { return Serial.baudRate; }\n\n} // namespace mock\n"),
+	}
+	file7 := &embedded.EmbeddedFile{
+		Filename:    "cores/mock/ArduinoMock.h",
+		FileModTime: time.Unix(1792207759, 0),
+
+		Content: string("/*\n  ArduinoMock.h - Inspect and drive the mocked hardware from the unit tests.\n\n  Time doesn't flow by itself: it advances only when the sketch calls delay()\n  or delayMicroseconds(), or when the test calls mock::advanceMillis() or\n  mock::advanceMicros(). Each test runs in its own process, so it starts with\n  all the pins unconfigured, the time at 0 and the serial port buffers empty.\n*/\n\n#ifndef ArduinoMock_h\n#define ArduinoMock_h\n\n#include <stdint.h>\n#include <vector>\n\n#include \"WString.h\"\n\nnamespace mock {\n\n// A change of the value of a pin, at the given time\nstruct PinEvent {\n  unsigned long micros;\n  int value;\n};\n\n// The mode set with pinMode, or -1 if the pin has not been configured\nint pinMode(uint8_t pin);\n\n// The value of a digital pin: the last one written with digitalWrite for the\n// outputs, the one set with setDigitalInput for the inputs\nint digitalValue(uint8_t pin);\n\n// All the values written to the pin with digitalWrite\nconst std::vector<PinEvent> &digitalWrites(uint8_t pin);\n\n// Sets the value read from a digital input, running the interrupt attached to\n// the pin if the change triggers it\nvoid setDigitalInput(uint8_t pin, int value);\n\n// The last value written to the pin with analogWrite, or -1 if none\nint analogValue(uint8_t pin);\n\n// All the values written to the pin with analogWrite\nconst std::vector<PinEvent> &analogWrites(uint8_t pin);\n\n// Sets the value read from an analog input\nvoid setAnalogInput(uint8_t pin, int value);\n\n// The frequency of the tone played on the pin, 0 if none\nunsigned int toneFrequency(uint8_t pin);\n\n// Runs the interrupt attached to the pin, returns false if there is none\nbool triggerInterrupt(uint8_t pin);\n\n// Moves the time forward\nvoid advanceMillis(unsigned long ms);\nvoid advanceMicros(unsigned long us);\n\n// Sets the time returned by micros() and millis()\nvoid setMicros(unsigned long us);\n\n// The data written to the serial port since the start of the test, or since\n// the last call to clearSerialOutput\nString serialOutput();\nvoid clearSerialOutput();\n\n// Appends data to the one that can be read from the serial port\nvoid serialInput(const String &data);\n\n// The baud rate set with Serial.begin, or 0 if the port is closed\nunsigned long serialBaudRate();\n\n} // namespace mock\n\n#endif\n"),
+	}
+	file8 := &embedded.EmbeddedFile{
+		Filename:    "cores/mock/ArduinoTest.cpp",
+		FileModTime: time.Unix(1792207787, 0),
+
+		Content: string("/*\n  ArduinoTest.cpp - Runner of the unit tests.\n\n  The test binary is driven by arduino-cli, that runs each test in its own\n  process: \"--list\" prints the tests, one per line, as FILE<TAB>NAME, and\n  \"--run INDEX --report FILE\" runs a test writing its failures in the report\n  file, one per line, as LINE<TAB>FILE<TAB>MESSAGE, with the backslashes,\n  the tabs and the newlines of the message escaped. Without arguments all the\n  tests are run in the same process.\n*/\n\n#include <exception>\n#include <stdio.h>\n#include <string.h>\n#include <vector>\n\n#include \"ArduinoTest.h\"\n\nnamespace {\n\nstruct Test {\n  const char *file;\n  const char *name;\n  arduino_test::TestFunction function;\n};\n\nstruct FatalFailure {};\n\nstd::vector<Test> &tests() {\n  static std::vector<Test> all;\n  return all;\n}\n\nFILE *report = NULL;\nint failures = 0;\n\nstd::string escape(const std::string &s) {\n  std::string res;\n  for (size_t i = 0; i < s.length(); i++) {\n    switch (s[i]) {\n    case '\\\\':\n      res += \"\\\\\\\\\";\n      break;\n    case '\\t':\n      res += \"\\\\t\";\n      break;\n    case '\\n':\n      res += \"\\\\n\";\n      break;\n    default:\n      res += s[i];\n    }\n  }\n  return res;\n}\n\nbool runTest(const Test &test) {\n  failures = 0;\n  try {\n    test.function();\n  } catch (FatalFailure &) {\n  } catch (std::exception &e) {\n    arduino_test::fail(test.file, 0, std::string(\"uncaught exception: \") + e.what(), false);\n  } catch (...) {\n    arduino_test::fail(test.file, 0, \"uncaught exception\", false);\n  }\n  fflush(stdout);\n  return failures == 0;\n}\n\n} // namespace\n\nnamespace arduino_test {\n\nRegistration::Registration(const char *file, const char *name, TestFunction function) {\n  Test test = {file, name, function};\n  tests().push_back(test);\n}\n\nvoid fail(const char *file, int line, const std::string &message, bool fatal) {\n  failures++;\n  if (report) {\n    fprintf(report, \"%d\\t%s\\t%s\\n\", line, escape(file).c_str(), escape(message).c_str());\n    fflush(report);\n  } else {\n    fprintf(stderr, \"%s:%d: %s\\n\", file, line, message.c_str());\n  }\n  if (fatal) {\n    throw FatalFailure();\n  }\n}\n\nint run(int argc, char **argv) {\n  if (argc == 2 && strcmp(argv[1], \"--list\") == 0) {\n    for (size_t i = 0; i < tests().size(); i++) {\n      printf(\"%s\\t%s\\n\", tests()[i].file, tests()[i].name);\n    }\n    return 0;\n  }\n\n  if (argc == 5 && strcmp(argv[1], \"--run\") == 0 && strcmp(argv[3], \"--report\") == 0) {\n    size_t index = strtoul(argv[2], NULL, 10);\n    if (index >= tests().size()) {\n      fprintf(stderr, \"test %s not found\\n\", argv[2]);\n      return 2;\n    }\n    report = fopen(argv[4], \"w\");\n    if (!report) {\n      fprintf(stderr, \"can't create report file %s\\n\", argv[4]);\n      return 2;\n    }\n    bool passed = runTest(tests()[index]);\n    fclose(report);\n    return passed ? 0 : 1;\n  }\n\n  if (argc != 1) {\n    fprintf(stderr, \"usage: %s [--list | --run INDEX --report FILE]\\n\", argv[0]);\n    return 2;\n  }\n  int failed = 0;\n  for (size_t i = 0; i < tests().size(); i++) {\n    printf(\"=== RUN %s\\n\", tests()[i].name);\n    fflush(stdout);\n    if (runTest(tests()[i])) {\n      printf(\"--- PASS: %s\\n\", tests()[i].name);\n    } else {\n      printf(\"--- FAIL: %s\\n\", tests()[i].name);\n      failed++;\n    }\n  }\n  printf(\"%d tests, %d failed\\n\", (int)tests().size(), failed);\n  return failed == 0 ? 0 : 1;\n}\n\n} // namespace arduino_test\n"),
+	}
+	file9 := &embedded.EmbeddedFile{
+		Filename:    "cores/mock/ArduinoTest.h",
+		FileModTime: time.Unix(1792208047, 0),
+
+		Content: string("/*\n  ArduinoTest.h - Unit tests of the sketches run on the host.\n\n  The tests are defined in the test folder of the sketch with the TEST macro\n  and use the ASSERT_* macros, that stop the test at the first failure, or\n  the EXPECT_* macros, that let it continue:\n\n    #include <ArduinoTest.h>\n\n    TEST(led_is_turned_on) {\n      setup();\n      loop();\n      ASSERT_EQ(HIGH, mock::digitalValue(LED_BUILTIN));\n    }\n*/\n\n#ifndef ArduinoTest_h\n#define ArduinoTest_h\n\n#include <sstream>\n#include <string>\n\n#include \"Arduino.h\"\n\nnamespace arduino_test {\n\ntypedef void (*TestFunction)();\n\n// Registration adds a test to the ones run by the test binary\nstruct Registration {\n  Registration(const char *file, const char *name, TestFunction function);\n};\n\n// fail records a failure of the running test, if fatal the test is stopped\nvoid fail(const char *file, int line, const std::string &message, bool fatal);\n\ntemplate <typename T>\nstd::string describe(const T &value) {\n  std::ostringstream s;\n  s << value;\n  return s.str();\n}\n\ninline std::string describe(bool value) { return value ? \"true\" : \"false\"; }\ninline std::string describe(unsigned char value) { return describe((unsigned int)value); }\ninline std::string describe(signed char value) { return describe((int)value); }\ninline std::string describe(char value) { return std::string(\"'\") + value + \"'\"; }\ninline std::string describe(const String &value) { return \"\\\"\" + value.std_string() + \"\\\"\"; }\ninline std::string describe(const char *value) { return value ? \"\\\"\" + std::string(value) + \"\\\"\" : \"NULL\"; }\ninline std::string describe(char *value) { return describe((const char *)value); }\n\n// cString allows to compare C strings and Strings with the *_STREQ macros\ninline const char *cString(const char *value) { return value; }\ninline const char *cString(const String &value) { return value.c_str(); }\n\ntemplate <typename A, typename B>\nstd::string comparisonMessage(const char *expressionA, const char *op, const char *expressionB, const A &a, const B &b) {\n  return std::string(\"expected \") + expressionA + \" \" + op + \" \" + expressionB +\n         \"\\n  \" + expressionA + \" = \" + describe(a) +\n         \"\\n  \" + expressionB + \" = \" + describe(b);\n}\n\n} // namespace arduino_test\n\n#define TEST(name)                                                                              \\\n  static void arduino_test_##name();                                                           \\\n  static arduino_test::Registration arduino_test_registration_##name(__FILE__, #name, arduino_test_##name); \\\n  static void arduino_test_##name()\n\n// The expressions are stringified by the ASSERT_* and EXPECT_* macros, so\n// that the messages contain the macros used in the test, not their values\n#define ARDUINO_TEST_COMPARE(a, op, b, textA, textB, fatal)                                                   \\\n  do {                                                                                                       \\\n    const auto &arduino_test_a = (a);                                                                        \\\n    const auto &arduino_test_b = (b);                                                                        \\\n    if (!(arduino_test_a op arduino_test_b)) {                                                               \\\n      arduino_test::fail(__FILE__, __LINE__,                                                                 \\\n                         arduino_test::comparisonMessage(textA, #op, textB, arduino_test_a, arduino_test_b), \\\n                         fatal);                                                                             \\\n    }                                                                                                        \\\n  } while (0)\n\n#define ARDUINO_TEST_CONDITION(condition, expected, text, fatal)                                            \\\n  do {                                                                                                      \\\n    if (!(condition) == expected) {                                                                         \\\n      arduino_test::fail(__FILE__, __LINE__, std::string(\"expected \") + text + \" to be \" + #expected, fatal); \\\n    }                                                                                                       \\\n  } while (0)\n\n#define ARDUINO_TEST_STREQ(a, b, textA, textB, fatal)                                                      \\\n  do {                                                                                                     \\\n    const auto &arduino_test_a_value = (a);                                                                \\\n    const auto &arduino_test_b_value = (b);                                                                \\\n    const char *arduino_test_a = arduino_test::cString(arduino_test_a_value);                              \\\n    const char *arduino_test_b = arduino_test::cString(arduino_test_b_value);                              \\\n    if (!arduino_test_a || !arduino_test_b || strcmp(arduino_test_a, arduino_test_b) != 0) {               \\\n      arduino_test::fail(__FILE__, __LINE__,                                                               \\\n                         arduino_test::comparisonMessage(textA, \"==\", textB, arduino_test_a, arduino_test_b), \\\n                         fatal);                                                                           \\\n    }                                                                                                      \\\n  } while (0)\n\n#define ARDUINO_TEST_NEAR(a, b, tolerance, textA, textB, fatal)                                            \\\n  do {                                                                                                     \\\n    double arduino_test_a = (a);                                                                           \\\n    double arduino_test_b = (b);                                                                           \\\n    double arduino_test_tolerance = (tolerance);                                                           \\\n    if (fabs(arduino_test_a - arduino_test_b) > arduino_test_tolerance) {                                  \\\n      arduino_test::fail(__FILE__, __LINE__,                                                               \\\n                         arduino_test::comparisonMessage(textA, \"~=\", textB, arduino_test_a, arduino_test_b) + \\\n                             \"\\n  tolerance = \" + arduino_test::describe(arduino_test_tolerance),          \\\n                         fatal);                                                                           \\\n    }                                                                                                      \\\n  } while (0)\n\n#define ASSERT_TRUE(condition) ARDUINO_TEST_CONDITION(condition, true, #condition, true)\n#define ASSERT_FALSE(condition) ARDUINO_TEST_CONDITION(condition, false, #condition, true)\n#define ASSERT_EQ(expected, actual) ARDUINO_TEST_COMPARE(expected, ==, actual, #expected, #actual, true)\n#define ASSERT_NE(a, b) ARDUINO_TEST_COMPARE(a, !=, b, #a, #b, true)\n#define ASSERT_LT(a, b) ARDUINO_TEST_COMPARE(a, <, b, #a, #b, true)\n#define ASSERT_LE(a, b) ARDUINO_TEST_COMPARE(a, <=, b, #a, #b, true)\n#define ASSERT_GT(a, b) ARDUINO_TEST_COMPARE(a, >, b, #a, #b, true)\n#define ASSERT_GE(a, b) ARDUINO_TEST_COMPARE(a, >=, b, #a, #b, true)\n#define ASSERT_STREQ(expected, actual) ARDUINO_TEST_STREQ(expected, actual, #expected, #actual, true)\n#define ASSERT_NEAR(expected, actual, tolerance) \\\n  ARDUINO_TEST_NEAR(expected, actual, tolerance, #expected, #actual, true)\n\n#define EXPECT_TRUE(condition) ARDUINO_TEST_CONDITION(condition, true, #condition, false)\n#define EXPECT_FALSE(condition) ARDUINO_TEST_CONDITION(condition, false, #condition, false)\n#define EXPECT_EQ(expected, actual) ARDUINO_TEST_COMPARE(expected, ==, actual, #expected, #actual, false)\n#define EXPECT_NE(a, b) ARDUINO_TEST_COMPARE(a, !=, b, #a, #b, false)\n#define EXPECT_LT(a, b) ARDUINO_TEST_COMPARE(a, <, b, #a, #b, false)\n#define EXPECT_LE(a, b) ARDUINO_TEST_COMPARE(a, <=, b, #a, #b, false)\n#define EXPECT_GT(a, b) ARDUINO_TEST_COMPARE(a, >, b, #a, #b, false)\n#define EXPECT_GE(a, b) ARDUINO_TEST_COMPARE(a, >=, b, #a, #b, false)\n#define EXPECT_STREQ(expected, actual) ARDUINO_TEST_STREQ(expected, actual, #expected, #actual, false)\n#define EXPECT_NEAR(expected, actual, tolerance) \\\n  ARDUINO_TEST_NEAR(expected, actual, tolerance, #expected, #actual, false)\n\n#define FAIL(message) arduino_test::fail(__FILE__, __LINE__, message, true)\n\n#endif\n"),
+	}
+	filea := &embedded.EmbeddedFile{
+		Filename:    "cores/mock/HardwareSerial.cpp",
+		FileModTime: time.Unix(1792207740, 0),
+
+		Content: string("/*\n  HardwareSerial.cpp - Mocked serial port, the data written is also printed\n  to the standard output of the test.\n*/\n\n#include <stdio.h>\n\n#include \"HardwareSerial.h\"\n\nHardwareSerial Serial;\n\nvoid HardwareSerial::begin(unsigned long baud, uint8_t config) {\n  (void)config;\n  baudRate = baud;\n}\n\nvoid HardwareSerial::end() { baudRate = 0; }\n\nint HardwareSerial::available() { return input.length(); }\n\nint HardwareSerial::peek() { return input.empty() ? -1 : (unsigned char)input[0]; }\n\nint HardwareSerial::read() {\n  int c = peek();\n  if (c >= 0) {\n    input.erase(0, 1);\n  }\n  return c;\n}\n\nint HardwareSerial::availableForWrite() { return 64; }\n\nsize_t HardwareSerial::write(uint8_t c) {\n  output += (char)c;\n  fputc(c, stdout);\n  return 1;\n}\n"),
+	}
+	fileb := &embedded.EmbeddedFile{
+		Filename:    "cores/mock/HardwareSerial.h",
+		FileModTime: time.Unix(1792207740, 0),
+
+		Content: string("/*\n  HardwareSerial.h - Mocked serial port: the data written is recorded and the\n  data read is the one provided by the test, see ArduinoMock.h.\n*/\n\n#ifndef HardwareSerial_h\n#define HardwareSerial_h\n\n#include <string>\n\n#include \"Stream.h\"\n\nclass HardwareSerial : public Stream {\npublic:\n  void begin(unsigned long baud) { begin(baud, 0); }\n  void begin(unsigned long baud, uint8_t config);\n  void end();\n  operator bool() { return true; }\n\n  virtual int available();\n  virtual int peek();\n  virtual int read();\n  virtual int availableForWrite();\n  virtual void flush() {}\n  virtual size_t write(uint8_t c);\n  using Print::write;\n\n  // Used by ArduinoMock.h\n  unsigned long baudRate;\n  std::string input;\n  std::string output;\n};\n\nextern HardwareSerial Serial;\n\n#endif\n"),
+	}
+	filec := &embedded.EmbeddedFile{
+		Filename:    "cores/mock/Print.cpp",
+		FileModTime: time.Unix(1792207734, 0),
+
+		Content: string("/*\n  Print.cpp - Base class of the mock Arduino core for the objects that print\n  text, it formats the numbers like the Arduino cores.\n*/\n\n#include <math.h>\n#include <string.h>\n\n#include \"Print.h\"\n\nsize_t Print::write(const uint8_t *buffer, size_t size) {\n  size_t n = 0;\n  while (size--) {\n    if (write(*buffer++)) {\n      n++;\n    } else {\n      break;\n    }\n  }\n  return n;\n}\n\nsize_t Print::print(const String &s) { return write(s.c_str(), s.length()); }\nsize_t Print::print(const char str[]) { return write(str); }\nsize_t Print::print(char c) { return write((uint8_t)c); }\nsize_t Print::print(unsigned char b, int base) { return print((unsigned long)b, base); }\nsize_t Print::print(int n, int base) { return print((long)n, base); }\nsize_t Print::print(unsigned int n, int base) { return print((unsigned long)n, base); }\n\nsize_t Print::print(long n, int base) {\n  if (base == 0) {\n    return write((uint8_t)n);\n  }\n  if (base == 10 && n < 0) {\n    size_t t = print('-');\n    return printNumber(-(unsigned long)n, 10) + t;\n  }\n  return printNumber(n, base);\n}\n\nsize_t Print::print(unsigned long n, int base) {\n  if (base == 0) {\n    return write((uint8_t)n);\n  }\n  return printNumber(n, base);\n}\n\nsize_t Print::print(double n, int digits) { return printFloat(n, digits); }\n\nsize_t Print::println(void) { return write(\"\\r\\n\"); }\nsize_t Print::println(const String &s) { return print(s) + println(); }\nsize_t Print::println(const char c[]) { return print(c) + println(); }\nsize_t Print::println(char c) { return print(c) + println(); }\nsize_t Print::println(unsigned char b, int base) { return print(b, base) + println(); }\nsize_t Print::println(int num, int base) { return print(num, base) + println(); }\nsize_t Print::println(unsigned int num, int base) { return print(num, base) + println(); }\nsize_t Print::println(long num, int base) { return print(num, base) + println(); }\nsize_t Print::println(unsigned long num, int base) { return print(num, base) + println(); }\nsize_t Print::println(double num, int digits) { return print(num, digits) + println(); }\n\nsize_t Print::printNumber(unsigned long n, uint8_t base) {\n  char buf[8 * sizeof(long) + 1];\n  char *str = &buf[sizeof(buf) - 1];\n  *str = '\\0';\n  if (base < 2) {\n    base = 10;\n  }\n  do {\n    char c = n % base;\n    n /= base;\n    *--str = c < 10 ? c + '0' : c + 'A' - 10;\n  } while (n);\n  return write(str);\n}\n\nsize_t Print::printFloat(double number, uint8_t digits) {\n  if (isnan(number)) {\n    return print(\"nan\");\n  }\n  if (isinf(number)) {\n    return print(\"inf\");\n  }\n  if (number > 4294967040.0 || number < -4294967040.0) {\n    return print(\"ovf\");\n  }\n\n  size_t n = 0;\n  if (number < 0.0) {\n    n += print('-');\n    number = -number;\n  }\n\n  double rounding = 0.5;\n  for (uint8_t i = 0; i < digits; ++i) {\n    rounding /= 10.0;\n  }\n  number += rounding;\n\n  unsigned long intPart = (unsigned long)number;\n  double remainder = number - (double)intPart;\n  n += print(intPart);\n  if (digits > 0) {\n    n += print('.');\n  }\n  while (digits-- > 0) {\n    remainder *= 10.0;\n    unsigned int toPrint = (unsigned int)remainder;\n    n += print(toPrint);\n    remainder -= toPrint;\n  }\n  return n;\n}\n"),
+	}
+	filed := &embedded.EmbeddedFile{
+		Filename:    "cores/mock/Print.h",
+		FileModTime: time.Unix(1792207740, 0),
+
+		Content: string("/*\n  Print.h - Base class of the mock Arduino core for the objects that print\n  text, like the serial ports.\n*/\n\n#ifndef Print_h\n#define Print_h\n\n#include <stddef.h>\n#include <stdint.h>\n#include <string.h>\n\n#include \"WString.h\"\n\n#define DEC 10\n#define HEX 16\n#define OCT 8\n#define BIN 2\n\nclass Print {\npublic:\n  virtual ~Print() {}\n\n  virtual size_t write(uint8_t c) = 0;\n  virtual size_t write(const uint8_t *buffer, size_t size);\n  size_t write(const char *str) { return str ? write((const uint8_t *)str, strlen(str)) : 0; }\n  size_t write(const char *buffer, size_t size) { return write((const uint8_t *)buffer, size); }\n  virtual int availableForWrite() { return 0; }\n  virtual void flush() {}\n\n  size_t print(const String &s);\n  size_t print(const char str[]);\n  size_t print(char c);\n  size_t print(unsigned char n, int base = DEC);\n  size_t print(int n, int base = DEC);\n  size_t print(unsigned int n, int base = DEC);\n  size_t print(long n, int base = DEC);\n  size_t print(unsigned long n, int base = DEC);\n  size_t print(double n, int digits = 2);\n\n  size_t println(const String &s);\n  size_t println(const char str[]);\n  size_t println(char c);\n  size_t println(unsigned char n, int base = DEC);\n  size_t println(int n, int base = DEC);\n  size_t println(unsigned int n, int base = DEC);\n  size_t println(long n, int base = DEC);\n  size_t println(unsigned long n, int base = DEC);\n  size_t println(double n, int digits = 2);\n  size_t println(void);\n\nprivate:\n  size_t printNumber(unsigned long n, uint8_t base);\n  size_t printFloat(double number, uint8_t digits);\n};\n\n#endif\n"),
+	}
+	filee := &embedded.EmbeddedFile{
+		Filename:    "cores/mock/Stream.cpp",
+		FileModTime: time.Unix(1792207734, 0),
+
+		Content: string("/*\n  Stream.cpp - Base class of the mock Arduino core for the objects that read\n  and write data.\n*/\n\n#include <string.h>\n\n#include \"Stream.h\"\n\nsize_t Stream::readBytes(char *buffer, size_t length) {\n  size_t count = 0;\n  while (count < length) {\n    int c = read();\n    if (c < 0) {\n      break;\n    }\n    *buffer++ = (char)c;\n    count++;\n  }\n  return count;\n}\n\nsize_t Stream::readBytesUntil(char terminator, char *buffer, size_t length) {\n  size_t count = 0;\n  while (count < length) {\n    int c = read();\n    if (c < 0 || c == terminator) {\n      break;\n    }\n    *buffer++ = (char)c;\n    count++;\n  }\n  return count;\n}\n\nString Stream::readString() {\n  String res;\n  int c;\n  while ((c = read()) >= 0) {\n    res += (char)c;\n  }\n  return res;\n}\n\nString Stream::readStringUntil(char terminator) {\n  String res;\n  int c;\n  while ((c = read()) >= 0 && c != terminator) {\n    res += (char)c;\n  }\n  return res;\n}\n\nlong Stream::parseInt() {\n  // Skip the characters that can't start a number\n  int c;\n  while ((c = peek()) >= 0 && c != '-' && (c < '0' || c > '9')) {\n    read();\n  }\n  bool negative = false;\n  long value = 0;\n  if (peek() == '-') {\n    negative = true;\n    read();\n  }\n  while ((c = peek()) >= '0' && c <= '9') {\n    value = value * 10 + c - '0';\n    read();\n  }\n  return negative ? -value : value;\n}\n\nbool Stream::find(const char *target) {\n  size_t len = strlen(target);\n  size_t matched = 0;\n  if (len == 0) {\n    return true;\n  }\n  int c;\n  while ((c = read()) >= 0) {\n    if (c == target[matched]) {\n      if (++matched == len) {\n        return true;\n      }\n    } else {\n      matched = c == target[0] ? 1 : 0;\n    }\n  }\n  return false;\n}\n"),
+	}
+	filef := &embedded.EmbeddedFile{
+		Filename:    "cores/mock/Stream.h",
+		FileModTime: time.Unix(1792207734, 0),
+
+		Content: string("/*\n  Stream.h - Base class of the mock Arduino core for the objects that read\n  and write data, like the serial ports.\n*/\n\n#ifndef Stream_h\n#define Stream_h\n\n#include \"Print.h\"\n\nclass Stream : public Print {\npublic:\n  virtual int available() = 0;\n  virtual int read() = 0;\n  virtual int peek() = 0;\n\n  // The mock streams never wait for data, the timeout is ignored\n  void setTimeout(unsigned long timeout) { (void)timeout; }\n\n  size_t readBytes(char *buffer, size_t length);\n  size_t readBytes(uint8_t *buffer, size_t length) { return readBytes((char *)buffer, length); }\n  size_t readBytesUntil(char terminator, char *buffer, size_t length);\n  String readString();\n  String readStringUntil(char terminator);\n  long parseInt();\n  bool find(const char *target);\n};\n\n#endif\n"),
+	}
+	fileg := &embedded.EmbeddedFile{
+		Filename:    "cores/mock/WString.cpp",
+		FileModTime: time.Unix(1792207717, 0),
+
+		Content: string("/*\n  WString.cpp - String class of the mock Arduino core.\n*/\n\n#include \"WString.h\"\n\n#include <ctype.h>\n#include <stdio.h>\n#include <stdlib.h>\n#include <strings.h>\n\nstatic std::string formatInteger(unsigned long value, unsigned char base, bool negative) {\n  if (base < 2 || base > 36) {\n    base = 10;\n  }\n  std::string res;\n  do {\n    unsigned long digit = value % base;\n    res.insert(res.begin(), (char)(digit < 10 ? '0' + digit : 'a' + digit - 10));\n    value /= base;\n  } while (value > 0);\n  if (negative) {\n    res.insert(res.begin(), '-');\n  }\n  return res;\n}\n\nstatic std::string formatSigned(long value, unsigned char base) {\n  if (base == 10 && value < 0) {\n    return formatInteger(-(unsigned long)value, base, true);\n  }\n  return formatInteger((unsigned long)value, base, false);\n}\n\nstatic std::string formatDouble(double value, unsigned char decimalPlaces) {\n  char buf[64];\n  snprintf(buf, sizeof(buf), \"%.*f\", decimalPlaces, value);\n  return buf;\n}\n\nString::String(const char *cstr) : str(cstr ? cstr : \"\") {}\nString::String(const char *cstr, unsigned int length) : str(cstr, length) {}\nString::String(const std::string &s) : str(s) {}\nString::String(const String &s) : str(s.str) {}\nString::String(char c) : str(1, c) {}\nString::String(unsigned char value, unsigned char base) : str(formatInteger(value, base, false)) {}\nString::String(int value, unsigned char base) : str(formatSigned(value, base)) {}\nString::String(unsigned int value, unsigned char base) : str(formatInteger(value, base, false)) {}\nString::String(long value, unsigned char base) : str(formatSigned(value, base)) {}\nString::String(unsigned long value, unsigned char base) : str(formatInteger(value, base, false)) {}\nString::String(float value, unsigned char decimalPlaces) : str(formatDouble(value, decimalPlaces)) {}\nString::String(double value, unsigned char decimalPlaces) : str(formatDouble(value, decimalPlaces)) {}\n\nString &String::operator=(const String &rhs) {\n  str = rhs.str;\n  return *this;\n}\n\nString &String::operator=(const char *cstr) {\n  str = cstr ? cstr : \"\";\n  return *this;\n}\n\nunsigned char String::reserve(unsigned int size) {\n  str.reserve(size);\n  return 1;\n}\n\nunsigned char String::concat(const String &s) {\n  str += s.str;\n  return 1;\n}\n\nunsigned char String::concat(const char *cstr) {\n  if (!cstr) {\n    return 0;\n  }\n  str += cstr;\n  return 1;\n}\n\nunsigned char String::concat(char c) { return concat(String(c)); }\nunsigned char String::concat(unsigned char num) { return concat(String(num)); }\nunsigned char String::concat(int num) { return concat(String(num)); }\nunsigned char String::concat(unsigned int num) { return concat(String(num)); }\nunsigned char String::concat(long num) { return concat(String(num)); }\nunsigned char String::concat(unsigned long num) { return concat(String(num)); }\nunsigned char String::concat(float num) { return concat(String(num)); }\nunsigned char String::concat(double num) { return concat(String(num)); }\n\nint String::compareTo(const String &s) const { return str.compare(s.str); }\nunsigned char String::equals(const String &s) const { return str == s.str; }\nunsigned char String::equals(const char *cstr) const { return cstr && str == cstr; }\n\nunsigned char String::equalsIgnoreCase(const String &s) const {\n  return str.length() == s.str.length() && strcasecmp(str.c_str(), s.str.c_str()) == 0;\n}\n\nunsigned char String::startsWith(const String &prefix) const { return startsWith(prefix, 0); }\n\nunsigned char String::startsWith(const String &prefix, unsigned int offset) const {\n  return offset <= str.length() && str.compare(offset, prefix.str.length(), prefix.str) == 0;\n}\n\nunsigned char String::endsWith(const String &suffix) const {\n  return str.length() >= suffix.str.length() &&\n         str.compare(str.length() - suffix.str.length(), suffix.str.length(), suffix.str) == 0;\n}\n\nchar String::charAt(unsigned int index) const { return (*this)[index]; }\n\nvoid String::setCharAt(unsigned int index, char c) {\n  if (index < str.length()) {\n    str[index] = c;\n  }\n}\n\nchar String::operator[](unsigned int index) const { return index < str.length() ? str[index] : 0; }\n\nchar &String::operator[](unsigned int index) {\n  static char dummy;\n  if (index >= str.length()) {\n    dummy = 0;\n    return dummy;\n  }\n  return str[index];\n}\n\nvoid String::getBytes(unsigned char *buf, unsigned int bufsize, unsigned int index) const {\n  if (!bufsize || !buf) {\n    return;\n  }\n  if (index >= str.length()) {\n    buf[0] = 0;\n    return;\n  }\n  unsigned int n = str.copy((char *)buf, bufsize - 1, index);\n  buf[n] = 0;\n}\n\nvoid String::toCharArray(char *buf, unsigned int bufsize, unsigned int index) const {\n  getBytes((unsigned char *)buf, bufsize, index);\n}\n\nstatic int toIndex(size_t pos) { return pos == std::string::npos ? -1 : (int)pos; }\n\nint String::indexOf(char ch) const { return indexOf(ch, 0); }\nint String::indexOf(char ch, unsigned int fromIndex) const { return toIndex(str.find(ch, fromIndex)); }\nint String::indexOf(const String &s) const { return indexOf(s, 0); }\nint String::indexOf(const String &s, unsigned int fromIndex) const { return toIndex(str.find(s.str, fromIndex)); }\nint String::lastIndexOf(char ch) const { return toIndex(str.rfind(ch)); }\nint String::lastIndexOf(char ch, unsigned int fromIndex) const { return toIndex(str.rfind(ch, fromIndex)); }\nint String::lastIndexOf(const String &s) const { return toIndex(str.rfind(s.str)); }\nint String::lastIndexOf(const String &s, unsigned int fromIndex) const { return toIndex(str.rfind(s.str, fromIndex)); }\n\nString String::substring(unsigned int beginIndex) const { return substring(beginIndex, str.length()); }\n\nString String::substring(unsigned int beginIndex, unsigned int endIndex) const {\n  if (beginIndex > endIndex) {\n    unsigned int tmp = beginIndex;\n    beginIndex = endIndex;\n    endIndex = tmp;\n  }\n  if (beginIndex >= str.length()) {\n    return String();\n  }\n  if (endIndex > str.length()) {\n    endIndex = str.length();\n  }\n  return String(str.substr(beginIndex, endIndex - beginIndex));\n}\n\nvoid String::replace(char find, char replace) {\n  for (size_t i = 0; i < str.length(); i++) {\n    if (str[i] == find) {\n      str[i] = replace;\n    }\n  }\n}\n\nvoid String::replace(const String &find, const String &replace) {\n  if (find.str.empty()) {\n    return;\n  }\n  size_t pos = 0;\n  while ((pos = str.find(find.str, pos)) != std::string::npos) {\n    str.replace(pos, find.str.length(), replace.str);\n    pos += replace.str.length();\n  }\n}\n\nvoid String::remove(unsigned int index) { remove(index, (unsigned int)-1); }\n\nvoid String::remove(unsigned int index, unsigned int count) {\n  if (index < str.length()) {\n    str.erase(index, count);\n  }\n}\n\nvoid String::toLowerCase() {\n  for (size_t i = 0; i < str.length(); i++) {\n    str[i] = tolower((unsigned char)str[i]);\n  }\n}\n\nvoid String::toUpperCase() {\n  for (size_t i = 0; i < str.length(); i++) {\n    str[i] = toupper((unsigned char)str[i]);\n  }\n}\n\nvoid String::trim() {\n  size_t begin = 0;\n  while (begin < str.length() && isspace((unsigned char)str[begin])) {\n    begin++;\n  }\n  size_t end = str.length();\n  while (end > begin && isspace((unsigned char)str[end - 1])) {\n    end--;\n  }\n  str = str.substr(begin, end - begin);\n}\n\nlong String::toInt() const { return atol(str.c_str()); }\nfloat String::toFloat() const { return (float)atof(str.c_str()); }\ndouble String::toDouble() const { return atof(str.c_str()); }\n\nString operator+(const String &lhs, const String &rhs) {\n  String res(lhs);\n  res.concat(rhs);\n  return res;\n}\n\nString operator+(const String &lhs, const char *rhs) { return lhs + String(rhs); }\nString operator+(const char *lhs, const String &rhs) { return String(lhs) + rhs; }\nString operator+(const String &lhs, char rhs) { return lhs + String(rhs); }\nString operator+(const String &lhs, int rhs) { return lhs + String(rhs); }\nString operator+(const String &lhs, unsigned int rhs) { return lhs + String(rhs); }\nString operator+(const String &lhs, long rhs) { return lhs + String(rhs); }\nString operator+(const String &lhs, unsigned long rhs) { return lhs + String(rhs); }\nString operator+(const String &lhs, float rhs) { return lhs + String(rhs); }\nString operator+(const String &lhs, double rhs) { return lhs + String(rhs); }\n"),
+	}
+	fileh := &embedded.EmbeddedFile{
+		Filename:    "cores/mock/WString.h",
+		FileModTime: time.Unix(1792207700, 0),
+
+		Content: string("/*\n  WString.h - String class of the mock Arduino core, compatible with the one\n  of the Arduino cores and backed by a std::string.\n*/\n\n#ifndef WString_h\n#define WString_h\n\n#ifdef __cplusplus\n\n#include <stddef.h>\n#include <string>\n\nclass String {\npublic:\n  String(const char *cstr = \"\");\n  String(const char *cstr, unsigned int length);\n  String(const std::string &str);\n  String(const String &str);\n  explicit String(char c);\n  explicit String(unsigned char value, unsigned char base = 10);\n  explicit String(int value, unsigned char base = 10);\n  explicit String(unsigned int value, unsigned char base = 10);\n  explicit String(long value, unsigned char base = 10);\n  explicit String(unsigned long value, unsigned char base = 10);\n  explicit String(float value, unsigned char decimalPlaces = 2);\n  explicit String(double value, unsigned char decimalPlaces = 2);\n\n  String &operator=(const String &rhs);\n  String &operator=(const char *cstr);\n\n  unsigned char reserve(unsigned int size);\n  unsigned int length() const { return str.length(); }\n  bool isEmpty() const { return str.empty(); }\n  const char *c_str() const { return str.c_str(); }\n  const std::string &std_string() const { return str; }\n\n  unsigned char concat(const String &s);\n  unsigned char concat(const char *cstr);\n  unsigned char concat(char c);\n  unsigned char concat(unsigned char num);\n  unsigned char concat(int num);\n  unsigned char concat(unsigned int num);\n  unsigned char concat(long num);\n  unsigned char concat(unsigned long num);\n  unsigned char concat(float num);\n  unsigned char concat(double num);\n\n  template <typename T>\n  String &operator+=(const T &rhs) {\n    concat(rhs);\n    return *this;\n  }\n\n  int compareTo(const String &s) const;\n  unsigned char equals(const String &s) const;\n  unsigned char equals(const char *cstr) const;\n  unsigned char equalsIgnoreCase(const String &s) const;\n  unsigned char startsWith(const String &prefix) const;\n  unsigned char startsWith(const String &prefix, unsigned int offset) const;\n  unsigned char endsWith(const String &suffix) const;\n\n  unsigned char operator==(const String &rhs) const { return equals(rhs); }\n  unsigned char operator==(const char *cstr) const { return equals(cstr); }\n  unsigned char operator!=(const String &rhs) const { return !equals(rhs); }\n  unsigned char operator!=(const char *cstr) const { return !equals(cstr); }\n  unsigned char operator<(const String &rhs) const { return compareTo(rhs) < 0; }\n  unsigned char operator>(const String &rhs) const { return compareTo(rhs) > 0; }\n  unsigned char operator<=(const String &rhs) const { return compareTo(rhs) <= 0; }\n  unsigned char operator>=(const String &rhs) const { return compareTo(rhs) >= 0; }\n\n  char charAt(unsigned int index) const;\n  void setCharAt(unsigned int index, char c);\n  char operator[](unsigned int index) const;\n  char &operator[](unsigned int index);\n  void getBytes(unsigned char *buf, unsigned int bufsize, unsigned int index = 0) const;\n  void toCharArray(char *buf, unsigned int bufsize, unsigned int index = 0) const;\n\n  int indexOf(char ch) const;\n  int indexOf(char ch, unsigned int fromIndex) const;\n  int indexOf(const String &s) const;\n  int indexOf(const String &s, unsigned int fromIndex) const;\n  int lastIndexOf(char ch) const;\n  int lastIndexOf(char ch, unsigned int fromIndex) const;\n  int lastIndexOf(const String &s) const;\n  int lastIndexOf(const String &s, unsigned int fromIndex) const;\n  String substring(unsigned int beginIndex) const;\n  String substring(unsigned int beginIndex, unsigned int endIndex) const;\n\n  void replace(char find, char replace);\n  void replace(const String &find, const String &replace);\n  void remove(unsigned int index);\n  void remove(unsigned int index, unsigned int count);\n  void toLowerCase();\n  void toUpperCase();\n  void trim();\n\n  long toInt() const;\n  float toFloat() const;\n  double toDouble() const;\n\nprivate:\n  std::string str;\n};\n\nString operator+(const String &lhs, const String &rhs);\nString operator+(const String &lhs, const char *rhs);\nString operator+(const char *lhs, const String &rhs);\nString operator+(const String &lhs, char rhs);\nString operator+(const String &lhs, int rhs);\nString operator+(const String &lhs, unsigned int rhs);\nString operator+(const String &lhs, long rhs);\nString operator+(const String &lhs, unsigned long rhs);\nString operator+(const String &lhs, float rhs);\nString operator+(const String &lhs, double rhs);\n\n#endif // __cplusplus\n#endif\n"),
+	}
+	filei := &embedded.EmbeddedFile{
+		Filename:    "cores/mock/main.cpp",
+		FileModTime: time.Unix(1792207787, 0),
+
+		Content: string("/*\n  main.cpp - Entry point of the unit tests binary, the sketch setup() and\n  loop() functions are called only by the tests.\n*/\n\nnamespace arduino_test {\nint run(int argc, char **argv);\n}\n\nint main(int argc, char **argv) { return arduino_test::run(argc, argv); }\n"),
+	}
+	filej := &embedded.EmbeddedFile{
+		Filename:    "platform.txt",
+		FileModTime: time.Unix(1792207673, 0),
+
+		Content: string("# Host platform used by \"arduino-cli test\" to build the sketches and their\n# unit tests with the native toolchain and a mock Arduino core.\n\nname=Host unit tests\nversion=1.0.0\n\ncompiler.path=\ncompiler.c.cmd=cc\ncompiler.cpp.cmd=c++\ncompiler.ar.cmd=ar\ncompiler.c.flags=-c -g -O0 -std=gnu11 -MMD\ncompiler.cpp.flags=-c -g -O0 -std=gnu++11 -MMD\ncompiler.S.flags=-c -g -x assembler-with-cpp -MMD\ncompiler.ldflags=\ncompiler.c.extra_flags=\ncompiler.cpp.extra_flags=\ncompiler.S.extra_flags=\ncompiler.ar.extra_flags=\ncompiler.c.elf.extra_flags=\nbuild.extra_flags=\n\ncompiler.warning_flags=-w\ncompiler.warning_flags.none=-w\ncompiler.warning_flags.default=\ncompiler.warning_flags.more=-Wall\ncompiler.warning_flags.all=-Wall -Wextra\n\ncompiler.defines=-DARDUINO={runtime.ide.version} -DARDUINO_{build.board} -DARDUINO_ARCH_{build.arch} -DARDUINO_HOST_TEST\n\nrecipe.c.o.pattern=\"{compiler.path}{compiler.c.cmd}\" {compiler.c.flags} {compiler.defines} {compiler.warning_flags} {compiler.c.extra_flags} {build.extra_flags} {includes} \"{source_file}\" -o \"{object_file}\"\nrecipe.cpp.o.pattern=\"{compiler.path}{compiler.cpp.cmd}\" {compiler.cpp.flags} {compiler.defines} {compiler.warning_flags} {compiler.cpp.extra_flags} {build.extra_flags} {includes} \"{source_file}\" -o \"{object_file}\"\nrecipe.S.o.pattern=\"{compiler.path}{compiler.c.cmd}\" {compiler.S.flags} {compiler.defines} {compiler.S.extra_flags} {build.extra_flags} {includes} \"{source_file}\" -o \"{object_file}\"\nrecipe.ar.pattern=\"{compiler.path}{compiler.ar.cmd}\" rcs {compiler.ar.extra_flags} \"{archive_file_path}\" \"{object_file}\"\nrecipe.c.combine.pattern=\"{compiler.path}{compiler.cpp.cmd}\" {compiler.c.elf.extra_flags} -o \"{build.path}/{build.project_name}.test{build.executable_extension}\" {object_files} \"{build.path}/{archive_file}\" {compiler.ldflags}\nrecipe.preproc.macros=\"{compiler.path}{compiler.cpp.cmd}\" {compiler.cpp.flags} {compiler.defines} -w -x c++ -E -CC {compiler.cpp.extra_flags} {build.extra_flags} {includes} \"{source_file}\" -o \"{preprocessed_file_path}\"\n\nbuild.executable_extension=\nbuild.executable_extension.windows=.exe\n"),
+	}
+
+	// define dirs
+	dir1 := &embedded.EmbeddedDir{
+		Filename:   "",
+		DirModTime: time.Unix(1792207673, 0),
+		ChildFiles: []*embedded.EmbeddedFile{
+			file2, // "boards.txt"
+			filej, // "platform.txt"
+
+		},
+	}
+	dir3 := &embedded.EmbeddedDir{
+		Filename:   "cores",
+		DirModTime: time.Unix(1792207673, 0),
+		ChildFiles: []*embedded.EmbeddedFile{},
+	}
+	dir4 := &embedded.EmbeddedDir{
+		Filename:   "cores/mock",
+		DirModTime: time.Unix(1792207787, 0),
+		ChildFiles: []*embedded.EmbeddedFile{
+			file5, // "cores/mock/Arduino.h"
+			file6, // "cores/mock/ArduinoMock.cpp"
+			file7, // "cores/mock/ArduinoMock.h"
+			file8, // "cores/mock/ArduinoTest.cpp"
+			file9, // "cores/mock/ArduinoTest.h"
+			filea, // "cores/mock/HardwareSerial.cpp"
+			fileb, // "cores/mock/HardwareSerial.h"
+			filec, // "cores/mock/Print.cpp"
+			filed, // "cores/mock/Print.h"
+			filee, // "cores/mock/Stream.cpp"
+			filef, // "cores/mock/Stream.h"
+			fileg, // "cores/mock/WString.cpp"
+			fileh, // "cores/mock/WString.h"
+			filei, // "cores/mock/main.cpp"
+
+		},
+	}
+
+	// link ChildDirs
+	dir1.ChildDirs = []*embedded.EmbeddedDir{
+		dir3, // "cores"
+
+	}
+	dir3.ChildDirs = []*embedded.EmbeddedDir{
+		dir4, // "cores/mock"
+
+	}
+	dir4.ChildDirs = []*embedded.EmbeddedDir{}
+
+	// register embeddedBox
+	embedded.RegisterEmbeddedBox(`host_platform`, &embedded.EmbeddedBox{
+		Name: `host_platform`,
+		Time: time.Unix(1792207673, 0),
+		Dirs: map[string]*embedded.EmbeddedDir{
+			"":           dir1,
+			"cores":      dir3,
+			"cores/mock": dir4,
+		},
+		Files: map[string]*embedded.EmbeddedFile{
+			"boards.txt":                    file2,
+			"cores/mock/Arduino.h":          file5,
+			"cores/mock/ArduinoMock.cpp":    file6,
+			"cores/mock/ArduinoMock.h":      file7,
+			"cores/mock/ArduinoTest.cpp":    file8,
+			"cores/mock/ArduinoTest.h":      file9,
+			"cores/mock/HardwareSerial.cpp": filea,
+			"cores/mock/HardwareSerial.h":   fileb,
+			"cores/mock/Print.cpp":          filec,
+			"cores/mock/Print.h":            filed,
+			"cores/mock/Stream.cpp":         filee,
+			"cores/mock/Stream.h":           filef,
+			"cores/mock/WString.cpp":        fileg,
+			"cores/mock/WString.h":          fileh,
+			"cores/mock/main.cpp":           filei,
+			"platform.txt":                  filej,
+		},
+	})
+}
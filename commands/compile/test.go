@@ -0,0 +1,333 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/commands"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	rice "github.com/cmaglie/go.rice"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// HostTestFQBN is the FQBN of the board used to build the sketch tests, it
+// belongs to the host platform embedded in the CLI
+const HostTestFQBN = "arduino-cli:host:native"
+
+// DefaultTestTimeout is the maximum duration of each test, if the request
+// doesn't set it
+const DefaultTestTimeout = 60 * time.Second
+
+// TestResultCB is called as soon as each test ends
+type TestResultCB func(*rpc.TestCaseResult)
+
+// Test builds the sketch, together with the tests in its test folder, for the
+// host with the mock Arduino core and runs the tests selected by the filter of
+// the request. Each test runs in its own process, so a crash or a timeout
+// affects only the test that caused it. The error returned is about the
+// request or the build, the failed tests are reported in the result.
+func Test(ctx context.Context, req *rpc.TestRequest, outStream, errStream io.Writer, testResultCB TestResultCB, debug bool) (*rpc.TestResult, error) {
+	compileReq := req.GetCompile()
+	if compileReq == nil {
+		return nil, &commands.InvalidArgumentError{Message: tr("Missing compile request")}
+	}
+	if compileReq.GetShowProperties() || compileReq.GetPreprocess() || compileReq.GetCreateCompilationDatabaseOnly() {
+		return nil, &commands.InvalidArgumentError{Message: tr("Can't run the tests while showing the build properties or preprocessing the sketch")}
+	}
+	var filter *regexp.Regexp
+	if req.GetFilter() != "" {
+		var err error
+		if filter, err = regexp.Compile(req.GetFilter()); err != nil {
+			return nil, &commands.InvalidArgumentError{Message: tr("Invalid test filter"), Cause: err}
+		}
+	}
+	timeout := DefaultTestTimeout
+	if req.GetTimeout() > 0 {
+		timeout = time.Duration(req.GetTimeout()) * time.Millisecond
+	}
+
+	pm := commands.GetPackageManager(compileReq.GetInstance().GetId())
+	if pm == nil {
+		return nil, &commands.InvalidInstanceError{}
+	}
+	if compileReq.GetSketchPath() == "" {
+		return nil, &commands.MissingSketchPathError{}
+	}
+	sk, err := sketch.New(paths.New(compileReq.GetSketchPath()))
+	if err != nil {
+		return nil, &commands.CantOpenSketchError{Cause: err}
+	}
+	if testDir := sk.FullPath.Join("test"); !testDir.IsDir() {
+		return nil, &commands.NotFoundError{Message: tr("Tests folder %s not found", testDir)}
+	}
+
+	// The host platform is extracted in a folder of the user, used only by
+	// this build
+	hardwareDir, err := installHostPlatform(pm.TempDir)
+	if err != nil {
+		return nil, &commands.PermissionDeniedError{Message: tr("Error installing the host platform"), Cause: err}
+	}
+	defer hardwareDir.RemoveAll()
+	env, err := hostTestEnvironment(pm, sk, hardwareDir, compileReq.GetSketchEnvironment())
+	if err != nil {
+		return nil, err
+	}
+
+	buildReq := proto.Clone(compileReq).(*rpc.CompileRequest)
+	buildReq.Fqbn = HostTestFQBN
+	buildReq.ExportBinaries = wrapperspb.Bool(false)
+	buildReq.ExportDir = ""
+	buildReq.SizeReport = false
+	buildReq.SizeBaseline = nil
+	if buildReq.GetBuildPath() == "" {
+		// The tests are built apart, to not mess with the build for the board
+		buildReq.BuildPath = sk.BuildPath.String() + "-test"
+	}
+	buildPath := paths.New(buildReq.GetBuildPath()).Canonical()
+
+	compileRes, err := compile(ctx, buildReq, outStream, errStream, nil, &compileOptions{environment: env, compileSketchTests: true}, debug)
+	result := &rpc.TestResult{Compile: compileRes, Tests: []*rpc.TestCaseResult{}}
+	if err != nil {
+		return result, err
+	}
+
+	testBinary := buildPath.Join(sk.MainFile.Base() + ".test")
+	if runtime.GOOS == "windows" {
+		testBinary = buildPath.Join(sk.MainFile.Base() + ".test.exe")
+	}
+	tests, err := listTests(ctx, testBinary)
+	if err != nil {
+		return result, &commands.FailedTestError{Message: tr("Error listing the tests"), Cause: err}
+	}
+
+	reportsDir := buildPath.Join("test-reports")
+	if err := reportsDir.MkdirAll(); err != nil {
+		return result, &commands.PermissionDeniedError{Message: tr("Cannot create build directory"), Cause: err}
+	}
+	sketchBuildPath := buildPath.Join("sketch")
+	for i, test := range tests {
+		test.suite = testSuite(test.file, sk.FullPath.Join("test"), sketchBuildPath.Join("test"))
+		if filter != nil && !filter.MatchString(test.suite+"/"+test.name) {
+			continue
+		}
+		if ctx.Err() != nil {
+			return result, ctx.Err()
+		}
+		testResult := runTest(ctx, testBinary, i, test, reportsDir.Join(fmt.Sprintf("%d.txt", i)), timeout, sk.FullPath)
+		for _, failure := range testResult.Failures {
+			// The failures refer to the copy of the sketch in the build folder
+			if rel, err := sketchBuildPath.RelTo(paths.New(failure.File)); err == nil && !strings.HasPrefix(rel.String(), "..") {
+				failure.File = sk.FullPath.JoinPath(rel).String()
+			}
+		}
+		result.Tests = append(result.Tests, testResult)
+		if testResultCB != nil {
+			testResultCB(testResult)
+		}
+	}
+	return result, nil
+}
+
+// hostTestEnvironment returns the sketch environment with the host platform,
+// installed in hardwareDir, in place of the installed ones, the libraries are
+// the same
+func hostTestEnvironment(pm *packagemanager.PackageManager, sk *sketch.Sketch, hardwareDir *paths.Path, mode rpc.SketchEnvironmentMode) (*commands.SketchEnvironment, error) {
+	env, err := commands.GetSketchEnvironment(pm, sk, mode)
+	if err != nil {
+		return nil, err
+	}
+	hostPM := packagemanager.NewPackageManager(pm.IndexDir, pm.PackagesDir, pm.DownloadDir, pm.TempDir)
+	// The builtin tools (ctags...) are needed to preprocess the sketch
+	if builtin, ok := env.PackageManager.Packages["builtin"]; ok {
+		hostPM.Packages["builtin"] = builtin
+	}
+	if errs := hostPM.LoadHardwareFromDirectory(hardwareDir); len(errs) > 0 {
+		return nil, &commands.PermissionDeniedError{Message: tr("Error loading the host platform"), Cause: errs[0].Err()}
+	}
+	return &commands.SketchEnvironment{
+		PackageManager:   hostPM,
+		HardwareDirs:     paths.NewPathList(hardwareDir.String()),
+		BuiltInToolsDirs: env.BuiltInToolsDirs,
+		LibrariesDirs:    env.LibrariesDirs,
+		Libraries:        env.Libraries,
+		Isolated:         env.Isolated,
+	}, nil
+}
+
+// installHostPlatform writes the host platform embedded in the CLI in a new
+// folder created in tempDir and returns it, it's the hardware folder
+// containing the platform. The caller must remove it when done.
+func installHostPlatform(tempDir *paths.Path) (*paths.Path, error) {
+	box, err := rice.FindBox("host_platform")
+	if err != nil {
+		return nil, err
+	}
+	if err := tempDir.MkdirAll(); err != nil {
+		return nil, err
+	}
+	hardwareDir, err := paths.MkTempDir(tempDir.String(), "host-platform")
+	if err != nil {
+		return nil, err
+	}
+	platformDir := hardwareDir.Join("arduino-cli", "host")
+	err = box.Walk("", func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		content, err := box.Bytes(path)
+		if err != nil {
+			return err
+		}
+		target := platformDir.Join(filepath.FromSlash(path))
+		if err := target.Parent().MkdirAll(); err != nil {
+			return err
+		}
+		return target.WriteFile(content)
+	})
+	if err != nil {
+		hardwareDir.RemoveAll()
+		return nil, err
+	}
+	return hardwareDir, nil
+}
+
+type hostTest struct {
+	file  string
+	name  string
+	suite string
+}
+
+// listTests returns the tests contained in the test binary, in the order
+// used to run them
+func listTests(ctx context.Context, testBinary *paths.Path) ([]*hostTest, error) {
+	out, err := exec.CommandContext(ctx, testBinary.String(), "--list").Output()
+	if err != nil {
+		return nil, err
+	}
+	tests := []*hostTest{}
+	for _, line := range strings.Split(string(out), "\n") {
+		line = strings.TrimRight(line, "\r")
+		if line == "" {
+			continue
+		}
+		fields := strings.SplitN(line, "\t", 2)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf(tr("invalid test: %s"), line)
+		}
+		tests = append(tests, &hostTest{file: fields[0], name: fields[1]})
+	}
+	return tests, nil
+}
+
+// testSuite returns the name of the suite of the tests defined in the given
+// file: its path relative to the test folder, without extension. The file
+// usually refers to the test folder of the sketch, thanks to the #line
+// directives added to the copy in the build folder.
+func testSuite(file string, testDirs ...*paths.Path) string {
+	suite := filepath.Base(file)
+	for _, testDir := range testDirs {
+		if rel, err := testDir.RelTo(paths.New(file)); err == nil && !strings.HasPrefix(rel.String(), "..") {
+			suite = filepath.ToSlash(rel.String())
+			break
+		}
+	}
+	return strings.TrimSuffix(suite, filepath.Ext(suite))
+}
+
+// runTest runs the test with the given index in its own process, the
+// working folder is the sketch one
+func runTest(ctx context.Context, testBinary *paths.Path, index int, test *hostTest, reportFile *paths.Path, timeout time.Duration, workDir *paths.Path) *rpc.TestCaseResult {
+	result := &rpc.TestCaseResult{Suite: test.suite, Name: test.name, Failures: []*rpc.TestFailure{}}
+	_ = reportFile.Remove()
+
+	testCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	cmd := exec.CommandContext(testCtx, testBinary.String(), "--run", strconv.Itoa(index), "--report", reportFile.String())
+	cmd.Dir = workDir.String()
+	stdout := new(bytes.Buffer)
+	stderr := new(bytes.Buffer)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	start := time.Now()
+	err := cmd.Run()
+	result.Duration = time.Since(start).Seconds()
+	result.Output = stdout.String()
+	result.ErrorOutput = stderr.String()
+
+	if report, readErr := reportFile.Open(); readErr == nil {
+		result.Failures = parseTestReport(report)
+		report.Close()
+	}
+
+	exitErr, isExitErr := err.(*exec.ExitError)
+	switch {
+	case err == nil && len(result.Failures) == 0:
+		result.Status = rpc.TestStatus_TEST_STATUS_PASSED
+	case testCtx.Err() == context.DeadlineExceeded:
+		result.Status = rpc.TestStatus_TEST_STATUS_ERROR
+		result.Error = tr("test timed out after %s", timeout)
+	case ctx.Err() != nil:
+		result.Status = rpc.TestStatus_TEST_STATUS_ERROR
+		result.Error = tr("test interrupted")
+	case (err == nil || (isExitErr && exitErr.ExitCode() == 1)) && len(result.Failures) > 0:
+		result.Status = rpc.TestStatus_TEST_STATUS_FAILED
+	default:
+		// The test binary crashed or exited by itself
+		result.Status = rpc.TestStatus_TEST_STATUS_ERROR
+		result.Error = tr("test crashed: %v", err)
+	}
+	return result
+}
+
+// parseTestReport reads the failures written by the test binary, one per
+// line as LINE<TAB>FILE<TAB>MESSAGE with the backslashes, the tabs and the
+// newlines escaped
+func parseTestReport(report io.Reader) []*rpc.TestFailure {
+	unescape := strings.NewReplacer(`\\`, `\`, `\t`, "\t", `\n`, "\n")
+	failures := []*rpc.TestFailure{}
+	scanner := bufio.NewScanner(report)
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		fields := strings.SplitN(scanner.Text(), "\t", 3)
+		if len(fields) != 3 {
+			continue
+		}
+		line, _ := strconv.Atoi(fields[0])
+		failures = append(failures, &rpc.TestFailure{
+			File:    unescape.Replace(fields[1]),
+			Line:    int32(line),
+			Message: unescape.Replace(fields[2]),
+		})
+	}
+	return failures
+}
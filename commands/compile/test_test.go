@@ -0,0 +1,68 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"strings"
+	"testing"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTestReport(t *testing.T) {
+	report := "12\t/sketch/test/a_test.cpp\texpected 1 == x\\n  x = 2\n" +
+		"0\t/sketch/test/tab\\tname.cpp\tuncaught exception: back\\\\slash\n" +
+		"invalid line\n"
+	failures := parseTestReport(strings.NewReader(report))
+	require.Equal(t, []*rpc.TestFailure{
+		{File: "/sketch/test/a_test.cpp", Line: 12, Message: "expected 1 == x\n  x = 2"},
+		{File: "/sketch/test/tab\tname.cpp", Line: 0, Message: "uncaught exception: back\\slash"},
+	}, failures)
+
+	require.Empty(t, parseTestReport(strings.NewReader("")))
+}
+
+func TestTestSuite(t *testing.T) {
+	testDir := paths.New("sketch", "test")
+	buildTestDir := paths.New("build", "sketch", "test")
+	require.Equal(t, "blink_test", testSuite(testDir.Join("blink_test.cpp").String(), testDir, buildTestDir))
+	require.Equal(t, "net/client_test", testSuite(testDir.Join("net", "client_test.cpp").String(), testDir, buildTestDir))
+	require.Equal(t, "net/client_test", testSuite(buildTestDir.Join("net", "client_test.cpp").String(), testDir, buildTestDir))
+	// Files outside the test folders are identified by their name
+	require.Equal(t, "helper", testSuite(paths.New("other", "helper.h").String(), testDir, buildTestDir))
+}
+
+func TestInstallHostPlatform(t *testing.T) {
+	tempDir, err := paths.MkTempDir("", "host_platform_test")
+	require.NoError(t, err)
+	defer tempDir.RemoveAll()
+
+	// Each build gets its own copy of the platform
+	hardwareDir1, err := installHostPlatform(tempDir.Join("tmp"))
+	require.NoError(t, err)
+	hardwareDir2, err := installHostPlatform(tempDir.Join("tmp"))
+	require.NoError(t, err)
+	require.NotEqual(t, hardwareDir1, hardwareDir2)
+	for _, hardwareDir := range []*paths.Path{hardwareDir1, hardwareDir2} {
+		inside, err := hardwareDir.IsInsideDir(tempDir)
+		require.NoError(t, err)
+		require.True(t, inside)
+		require.True(t, hardwareDir.Join("arduino-cli", "host", "platform.txt").Exist())
+		require.True(t, hardwareDir.Join("arduino-cli", "host", "cores", "mock", "Arduino.h").Exist())
+	}
+}
@@ -0,0 +1,230 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"context"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino/globals"
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/commands"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/fsnotify/fsnotify"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultWatchDebounce is the time without further changes Watch waits for
+// before starting a new build
+const DefaultWatchDebounce = 500 * time.Millisecond
+
+// BuildStartedCB is called by Watch when a build starts, with the files whose
+// changes triggered it
+type BuildStartedCB func(changedFiles []string)
+
+// BuildCompletedCB is called by Watch when a build ends, with its result and
+// the folders watched for changes until the next build
+type BuildCompletedCB func(res *rpc.CompileResponse, watchedLocations paths.PathList, err error)
+
+// Watch compiles the sketch and compiles it again each time the sketch, its
+// src folder or the unmanaged libraries it uses change, until ctx is
+// cancelled. The changes are debounced: a new build starts only when no other
+// changes happen for the debounce time. The builds following the first one
+// are incremental, they reuse the build folder.
+func Watch(ctx context.Context, req *rpc.CompileWatchRequest, outStream, errStream io.Writer, diagnosticCB DiagnosticCB, buildStartedCB BuildStartedCB, buildCompletedCB BuildCompletedCB, debug bool) error {
+	if req.GetCompile() == nil {
+		return &commands.InvalidArgumentError{Message: tr("Missing compile request")}
+	}
+	compileReq := proto.Clone(req.GetCompile()).(*rpc.CompileRequest)
+	if compileReq.GetShowProperties() || compileReq.GetPreprocess() {
+		return &commands.InvalidArgumentError{Message: tr("Can't watch the sketch while showing the build properties or preprocessing it")}
+	}
+	if compileReq.GetSketchPath() == "" {
+		return &commands.MissingSketchPathError{}
+	}
+	sk, err := sketch.New(paths.New(compileReq.GetSketchPath()))
+	if err != nil {
+		return &commands.CantOpenSketchError{Cause: err}
+	}
+	debounce := DefaultWatchDebounce
+	if req.GetDebounce() > 0 {
+		debounce = time.Duration(req.GetDebounce()) * time.Millisecond
+	}
+
+	watcher, err := newSketchWatcher(sk)
+	if err != nil {
+		return &commands.PermissionDeniedError{Message: tr("Error watching sketch"), Cause: err}
+	}
+	defer watcher.close()
+
+	changedFiles := []string{}
+	for {
+		buildStartedCB(changedFiles)
+		watchedLocations := paths.PathList{}
+		opts := &compileOptions{
+			watchedLocationsCB: func(locations paths.PathList) { watchedLocations = locations },
+		}
+		res, compileErr := compile(ctx, compileReq, outStream, errStream, diagnosticCB, opts, debug)
+		// The sketch is watched even if the build failed before finding it
+		watchedLocations.AddIfMissing(sk.FullPath)
+		if err := watcher.watch(watchedLocations); err != nil {
+			return &commands.PermissionDeniedError{Message: tr("Error watching sketch"), Cause: err}
+		}
+		buildCompletedCB(res, watchedLocations, compileErr)
+
+		// Only the first build can be a clean one
+		compileReq.Clean = false
+
+		changedFiles, err = watcher.waitForChanges(ctx, debounce)
+		if err != nil {
+			return &commands.PermissionDeniedError{Message: tr("Error watching sketch"), Cause: err}
+		}
+		if changedFiles == nil {
+			return nil
+		}
+	}
+}
+
+// sketchWatcher watches the folders of the sketch and of its libraries
+type sketchWatcher struct {
+	sketch  *sketch.Sketch
+	watcher *fsnotify.Watcher
+	folders map[string]bool
+}
+
+func newSketchWatcher(sk *sketch.Sketch) (*sketchWatcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	return &sketchWatcher{sketch: sk, watcher: watcher, folders: map[string]bool{}}, nil
+}
+
+func (w *sketchWatcher) close() {
+	w.watcher.Close()
+}
+
+// watch replaces the watched folders with the given locations and all their
+// subfolders. Only the src subfolder of the sketch folder is watched, the
+// others may contain the build artifacts.
+func (w *sketchWatcher) watch(locations paths.PathList) error {
+	folders := map[string]bool{}
+	for _, location := range locations {
+		if location.EquivalentTo(w.sketch.FullPath) {
+			continue
+		}
+		addFolderRecursively(folders, location)
+	}
+	folders[w.sketch.FullPath.String()] = true
+	addFolderRecursively(folders, w.sketch.FullPath.Join("src"))
+
+	for folder := range folders {
+		if w.folders[folder] {
+			continue
+		}
+		if err := w.watcher.Add(folder); err != nil {
+			return err
+		}
+	}
+	for folder := range w.folders {
+		if !folders[folder] {
+			w.watcher.Remove(folder)
+		}
+	}
+	w.folders = folders
+	return nil
+}
+
+func addFolderRecursively(folders map[string]bool, folder *paths.Path) {
+	if !folder.IsDir() || strings.HasPrefix(folder.Base(), ".") {
+		return
+	}
+	folders[folder.String()] = true
+	files, err := folder.ReadDir()
+	if err != nil {
+		logrus.WithError(err).Warnf("Error reading %s", folder)
+		return
+	}
+	for _, file := range files {
+		addFolderRecursively(folders, file)
+	}
+}
+
+// isRelevant returns true if the changed file requires a new build
+func (w *sketchWatcher) isRelevant(event fsnotify.Event) bool {
+	if event.Op == fsnotify.Chmod {
+		return false
+	}
+	file := paths.New(event.Name)
+	// Skip the hidden files and the backups made by the editors
+	if name := file.Base(); strings.HasPrefix(name, ".") || strings.HasSuffix(name, "~") {
+		return false
+	}
+	if file.Parent().String() != w.sketch.FullPath.String() {
+		return true
+	}
+	// In the sketch folder only the sketch files, the sketch metadata and the
+	// src folder are relevant
+	if name := file.Base(); name == "sketch.json" || name == sketch.ProjectFileName || name == "src" {
+		return true
+	}
+	if _, ok := globals.MainFileValidExtensions[file.Ext()]; ok {
+		return true
+	}
+	_, ok := globals.AdditionalFileValidExtensions[file.Ext()]
+	return ok
+}
+
+// waitForChanges waits for changes of the watched files and returns the
+// changed files once no other changes happen for the debounce time. It
+// returns nil if ctx is cancelled.
+func (w *sketchWatcher) waitForChanges(ctx context.Context, debounce time.Duration) ([]string, error) {
+	changed := map[string]bool{}
+	var timeout <-chan time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, nil
+		case event, ok := <-w.watcher.Events:
+			if !ok {
+				return nil, nil
+			}
+			if !w.isRelevant(event) {
+				continue
+			}
+			logrus.WithField("file", event.Name).WithField("op", event.Op).Info("Sketch changed")
+			changed[event.Name] = true
+			timeout = time.After(debounce)
+		case err, ok := <-w.watcher.Errors:
+			if !ok {
+				return nil, nil
+			}
+			return nil, err
+		case <-timeout:
+			res := []string{}
+			for file := range changed {
+				res = append(res, file)
+			}
+			sort.Strings(res)
+			return res, nil
+		}
+	}
+}
@@ -0,0 +1,77 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSketchWatcher(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "sketch_watcher")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	sketchPath := tmp.Join("Sketch")
+	require.NoError(t, sketchPath.Join("src", "utils").MkdirAll())
+	require.NoError(t, sketchPath.Join("build").MkdirAll())
+	require.NoError(t, sketchPath.Join("Sketch.ino").WriteFile([]byte("void setup() {}\nvoid loop() {}\n")))
+	libPath := tmp.Join("MyLib")
+	require.NoError(t, libPath.Join("src").MkdirAll())
+	sk, err := sketch.New(sketchPath)
+	require.NoError(t, err)
+
+	watcher, err := newSketchWatcher(sk)
+	require.NoError(t, err)
+	defer watcher.close()
+	require.NoError(t, watcher.watch(paths.NewPathList(sketchPath.String(), libPath.String())))
+	require.True(t, watcher.folders[sketchPath.Join("src", "utils").String()])
+	require.True(t, watcher.folders[libPath.Join("src").String()])
+	require.False(t, watcher.folders[sketchPath.Join("build").String()])
+
+	// The build artifacts and the editor backups don't trigger a build
+	require.NoError(t, sketchPath.Join("build", "Sketch.ino.hex").WriteFile([]byte{}))
+	require.NoError(t, sketchPath.Join("Sketch.ino.elf").WriteFile([]byte{}))
+	require.NoError(t, sketchPath.Join("src", ".utils.h.swp").WriteFile([]byte{}))
+	// More changes are collected in a single build
+	require.NoError(t, sketchPath.Join("Sketch.ino").WriteFile([]byte("void setup() {}\nvoid loop() {}\n\n")))
+	require.NoError(t, sketchPath.Join("src", "utils", "utils.h").WriteFile([]byte{}))
+	require.NoError(t, libPath.Join("src", "MyLib.h").WriteFile([]byte{}))
+
+	changed, err := watcher.waitForChanges(context.Background(), 100*time.Millisecond)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		libPath.Join("src", "MyLib.h").String(),
+		sketchPath.Join("Sketch.ino").String(),
+		sketchPath.Join("src", "utils", "utils.h").String(),
+	}, changed)
+
+	// The folders not used anymore are not watched
+	require.NoError(t, watcher.watch(paths.NewPathList(sketchPath.String())))
+	require.False(t, watcher.folders[libPath.Join("src").String()])
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	require.NoError(t, libPath.Join("src", "MyLib.h").WriteFile([]byte("//")))
+	changed, err = watcher.waitForChanges(ctx, 50*time.Millisecond)
+	require.NoError(t, err)
+	require.Nil(t, changed)
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/i18n"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"go.bug.st/downloader/v2"
 )
 
 var tr = i18n.Tr
@@ -50,16 +51,10 @@ func PlatformDownload(ctx context.Context, req *rpc.PlatformDownloadRequest, dow
 		return nil, &commands.PlatformNotFound{Platform: ref.String(), Cause: err}
 	}
 
-	if err := downloadPlatform(pm, platform, downloadCB); err != nil {
+	if err := downloadPlatformAndTools(pm, platform, tools, downloadCB); err != nil {
 		return nil, err
 	}
 
-	for _, tool := range tools {
-		if err := downloadTool(pm, tool, downloadCB); err != nil {
-			return nil, err
-		}
-	}
-
 	return &rpc.PlatformDownloadResponse{}, nil
 }
 
@@ -69,11 +64,37 @@ func downloadPlatform(pm *packagemanager.PackageManager, platformRelease *cores.
 	if err != nil {
 		return &commands.FailedDownloadError{Message: tr("Error downloading platform %s", platformRelease), Cause: err}
 	}
-	resp, err := pm.DownloadPlatformRelease(platformRelease, config)
+	err = commands.DownloadWithRetry(func() (*downloader.Downloader, error) {
+		return pm.DownloadPlatformRelease(platformRelease, config)
+	}, platformRelease.String(), downloadCB)
 	if err != nil {
 		return &commands.FailedDownloadError{Message: tr("Error downloading platform %s", platformRelease), Cause: err}
 	}
-	return commands.Download(resp, platformRelease.String(), downloadCB)
+	return nil
+}
+
+// downloadPlatformAndTools downloads the platform and its tools, more of them at
+// the same time
+func downloadPlatformAndTools(pm *packagemanager.PackageManager, platformRelease *cores.PlatformRelease, tools []*cores.ToolRelease, downloadCB commands.DownloadProgressCB) error {
+	downloads := []func(commands.DownloadProgressCB) error{
+		func(downloadCB commands.DownloadProgressCB) error {
+			return downloadPlatform(pm, platformRelease, downloadCB)
+		},
+	}
+	// The same tool may be both a tool and a discovery dependency, it must not
+	// be downloaded twice at the same time
+	seen := map[*cores.ToolRelease]bool{}
+	for _, tool := range tools {
+		if seen[tool] {
+			continue
+		}
+		seen[tool] = true
+		tool := tool
+		downloads = append(downloads, func(downloadCB commands.DownloadProgressCB) error {
+			return downloadTool(pm, tool, downloadCB)
+		})
+	}
+	return commands.DownloadParallel(downloads, downloadCB)
 }
 
 func downloadTool(pm *packagemanager.PackageManager, tool *cores.ToolRelease, downloadCB commands.DownloadProgressCB) error {
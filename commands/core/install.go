@@ -84,12 +84,7 @@ func installPlatform(pm *packagemanager.PackageManager,
 
 	// Package download
 	taskCB(&rpc.TaskProgress{Name: tr("Downloading packages")})
-	for _, tool := range toolsToInstall {
-		if err := downloadTool(pm, tool, downloadCB); err != nil {
-			return err
-		}
-	}
-	if err := downloadPlatform(pm, platformRelease, downloadCB); err != nil {
+	if err := downloadPlatformAndTools(pm, platformRelease, toolsToInstall, downloadCB); err != nil {
 		return err
 	}
 	taskCB(&rpc.TaskProgress{Completed: true})
@@ -17,19 +17,24 @@ package daemon
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"sync"
 
 	"github.com/arduino/arduino-cli/arduino/utils"
 	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/commands/board"
+	"github.com/arduino/arduino-cli/commands/bundle"
 	"github.com/arduino/arduino-cli/commands/compile"
 	"github.com/arduino/arduino-cli/commands/core"
 	"github.com/arduino/arduino-cli/commands/lib"
+	"github.com/arduino/arduino-cli/commands/monitor"
 	"github.com/arduino/arduino-cli/commands/sketch"
 	"github.com/arduino/arduino-cli/commands/upload"
 	"github.com/arduino/arduino-cli/i18n"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
 	"github.com/sirupsen/logrus"
 )
 
@@ -246,13 +251,73 @@ func (s *ArduinoCoreServerImpl) Compile(req *rpc.CompileRequest, stream rpc.Ardu
 		stream.Context(), req,
 		utils.FeedStreamTo(func(data []byte) { stream.Send(&rpc.CompileResponse{OutStream: data}) }),
 		utils.FeedStreamTo(func(data []byte) { stream.Send(&rpc.CompileResponse{ErrStream: data}) }),
+		func(d *rpc.CompileDiagnostic) { stream.Send(&rpc.CompileResponse{Diagnostic: d}) },
 		false) // Set debug to false
 	if err != nil {
+		// The size report, the comparison and the libraries resolution are
+		// useful also when the build fails
+		if resp.GetSizeReport() != nil || resp.GetSizeComparison() != nil || len(resp.GetLibraryResolutions()) > 0 {
+			stream.Send(resp)
+		}
 		return convertErrorToRPCStatus(err)
 	}
 	return stream.Send(resp)
 }
 
+// CompileWatch compiles the sketch each time it changes
+func (s *ArduinoCoreServerImpl) CompileWatch(req *rpc.CompileWatchRequest, stream rpc.ArduinoCoreService_CompileWatchServer) error {
+	sendCompileResponse := func(resp *rpc.CompileResponse) {
+		stream.Send(&rpc.CompileWatchResponse{Compile: resp})
+	}
+	err := compile.Watch(
+		stream.Context(), req,
+		utils.FeedStreamTo(func(data []byte) { sendCompileResponse(&rpc.CompileResponse{OutStream: data}) }),
+		utils.FeedStreamTo(func(data []byte) { sendCompileResponse(&rpc.CompileResponse{ErrStream: data}) }),
+		func(d *rpc.CompileDiagnostic) { sendCompileResponse(&rpc.CompileResponse{Diagnostic: d}) },
+		func(changedFiles []string) {
+			stream.Send(&rpc.CompileWatchResponse{BuildStarted: &rpc.CompileWatchBuildStarted{ChangedFiles: changedFiles}})
+		},
+		func(resp *rpc.CompileResponse, watchedLocations paths.PathList, err error) {
+			completed := &rpc.CompileWatchBuildCompleted{Success: err == nil, WatchedLocations: watchedLocations.AsStrings()}
+			if err != nil {
+				completed.Error = err.Error()
+			}
+			stream.Send(&rpc.CompileWatchResponse{Compile: resp, BuildCompleted: completed})
+		},
+		false) // Set debug to false
+	if err != nil {
+		return convertErrorToRPCStatus(err)
+	}
+	return nil
+}
+
+// CompileMatrix builds the sketch for more targets, streaming the result of
+// each target as soon as its build ends
+func (s *ArduinoCoreServerImpl) CompileMatrix(req *rpc.CompileMatrixRequest, stream rpc.ArduinoCoreService_CompileMatrixServer) error {
+	_, err := compile.CompileMatrix(
+		stream.Context(), req,
+		func(r *rpc.CompileMatrixResult) { stream.Send(&rpc.CompileMatrixResponse{Result: r}) },
+		false) // Set debug to false
+	if err != nil {
+		return convertErrorToRPCStatus(err)
+	}
+	return nil
+}
+
+// Test builds the sketch with its unit tests for the host and runs them
+func (s *ArduinoCoreServerImpl) Test(req *rpc.TestRequest, stream rpc.ArduinoCoreService_TestServer) error {
+	resp, err := compile.Test(
+		stream.Context(), req,
+		utils.FeedStreamTo(func(data []byte) { stream.Send(&rpc.TestResponse{OutStream: data}) }),
+		utils.FeedStreamTo(func(data []byte) { stream.Send(&rpc.TestResponse{ErrStream: data}) }),
+		func(r *rpc.TestCaseResult) { stream.Send(&rpc.TestResponse{TestResult: r}) },
+		false) // Set debug to false
+	if err != nil {
+		return convertErrorToRPCStatus(err)
+	}
+	return stream.Send(&rpc.TestResponse{Result: resp})
+}
+
 // PlatformInstall FIXMEDOC
 func (s *ArduinoCoreServerImpl) PlatformInstall(req *rpc.PlatformInstallRequest, stream rpc.ArduinoCoreService_PlatformInstallServer) error {
 	resp, err := core.PlatformInstall(
@@ -429,12 +494,31 @@ func (s *ArduinoCoreServerImpl) LibraryList(ctx context.Context, req *rpc.Librar
 	return resp, convertErrorToRPCStatus(err)
 }
 
+// LibraryLint FIXMEDOC
+func (s *ArduinoCoreServerImpl) LibraryLint(ctx context.Context, req *rpc.LibraryLintRequest) (*rpc.LibraryLintResponse, error) {
+	resp, err := lib.LibraryLint(ctx, req)
+	return resp, convertErrorToRPCStatus(err)
+}
+
 // ArchiveSketch FIXMEDOC
 func (s *ArduinoCoreServerImpl) ArchiveSketch(ctx context.Context, req *rpc.ArchiveSketchRequest) (*rpc.ArchiveSketchResponse, error) {
 	resp, err := sketch.ArchiveSketch(ctx, req)
 	return resp, convertErrorToRPCStatus(err)
 }
 
+// SketchInstallDependencies FIXMEDOC
+func (s *ArduinoCoreServerImpl) SketchInstallDependencies(req *rpc.SketchInstallDependenciesRequest, stream rpc.ArduinoCoreService_SketchInstallDependenciesServer) error {
+	err := sketch.InstallDependencies(
+		stream.Context(), req,
+		func(p *rpc.DownloadProgress) { stream.Send(&rpc.SketchInstallDependenciesResponse{Progress: p}) },
+		func(p *rpc.TaskProgress) { stream.Send(&rpc.SketchInstallDependenciesResponse{TaskProgress: p}) },
+	)
+	if err != nil {
+		return convertErrorToRPCStatus(err)
+	}
+	return stream.Send(&rpc.SketchInstallDependenciesResponse{})
+}
+
 //ZipLibraryInstall FIXMEDOC
 func (s *ArduinoCoreServerImpl) ZipLibraryInstall(req *rpc.ZipLibraryInstallRequest, stream rpc.ArduinoCoreService_ZipLibraryInstallServer) error {
 	err := lib.ZipLibraryInstall(
@@ -458,3 +542,125 @@ func (s *ArduinoCoreServerImpl) GitLibraryInstall(req *rpc.GitLibraryInstallRequ
 	}
 	return stream.Send(&rpc.GitLibraryInstallResponse{})
 }
+
+// EnumerateMonitorPortSettings FIXMEDOC
+func (s *ArduinoCoreServerImpl) EnumerateMonitorPortSettings(ctx context.Context, req *rpc.EnumerateMonitorPortSettingsRequest) (*rpc.EnumerateMonitorPortSettingsResponse, error) {
+	resp, err := monitor.EnumerateMonitorPortSettings(ctx, req)
+	return resp, convertErrorToRPCStatus(err)
+}
+
+// Monitor FIXMEDOC
+func (s *ArduinoCoreServerImpl) Monitor(stream rpc.ArduinoCoreService_MonitorServer) error {
+	// The configuration must be sent on the first message
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	portProxy, desc, err := monitor.Monitor(stream.Context(), req)
+	if err != nil {
+		return convertErrorToRPCStatus(err)
+	}
+	if err := stream.Send(&rpc.MonitorResponse{AppliedSettings: desc.ToRPC()}); err != nil {
+		portProxy.Close()
+		return err
+	}
+
+	// The stream is shared by the two goroutines below, Send is not
+	// safe to be called concurrently.
+	var sendMutex sync.Mutex
+	send := func(resp *rpc.MonitorResponse) error {
+		sendMutex.Lock()
+		defer sendMutex.Unlock()
+		return stream.Send(resp)
+	}
+	// Send a message with Error set to the client
+	sendError := func(err error) {
+		if err := send(&rpc.MonitorResponse{Error: err.Error()}); err != nil {
+			logrus.Infof("sending monitor error: %v", err)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	go func() {
+		defer cancel()
+		if txData := req.GetTxData(); len(txData) > 0 {
+			if _, err := portProxy.Write(txData); err != nil {
+				sendError(err)
+				return
+			}
+		}
+		for {
+			msg, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if err != nil {
+				sendError(err)
+				return
+			}
+			if conf := msg.GetPortConfiguration(); conf != nil {
+				for _, c := range conf.GetSettings() {
+					if err := portProxy.Config(c.GetSettingId(), c.GetValue()); err != nil {
+						sendError(err)
+					}
+				}
+				if desc, err := portProxy.Describe(); err != nil {
+					sendError(err)
+				} else if err := send(&rpc.MonitorResponse{AppliedSettings: desc.ToRPC()}); err != nil {
+					return
+				}
+			}
+			tx := msg.GetTxData()
+			for len(tx) > 0 {
+				n, err := portProxy.Write(tx)
+				if errors.Is(err, io.EOF) {
+					return
+				}
+				if err != nil {
+					sendError(err)
+					return
+				}
+				tx = tx[n:]
+			}
+		}
+	}()
+	go func() {
+		defer cancel()
+		buff := make([]byte, 4096)
+		for {
+			n, err := portProxy.Read(buff)
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if err != nil {
+				sendError(err)
+				return
+			}
+			if err := send(&rpc.MonitorResponse{RxData: buff[:n]}); err != nil {
+				return
+			}
+		}
+	}()
+	<-ctx.Done()
+	portProxy.Close()
+	return nil
+}
+
+// BundleExport exports a bundle to install platforms and libraries offline
+func (s *ArduinoCoreServerImpl) BundleExport(req *rpc.BundleExportRequest, stream rpc.ArduinoCoreService_BundleExportServer) error {
+	resp, err := bundle.Export(
+		stream.Context(), req,
+		func(p *rpc.DownloadProgress) { stream.Send(&rpc.BundleExportResponse{Progress: p}) },
+	)
+	if err != nil {
+		return convertErrorToRPCStatus(err)
+	}
+	return stream.Send(resp)
+}
+
+// BundleImport imports a bundle to install platforms and libraries offline
+func (s *ArduinoCoreServerImpl) BundleImport(ctx context.Context, req *rpc.BundleImportRequest) (*rpc.BundleImportResponse, error) {
+	resp, err := bundle.Import(ctx, req)
+	return resp, convertErrorToRPCStatus(err)
+}
@@ -17,7 +17,9 @@ package daemon
 
 import (
 	"errors"
+	"fmt"
 	"io"
+	"strconv"
 	"sync/atomic"
 
 	"github.com/arduino/arduino-cli/arduino/monitors"
@@ -49,19 +51,14 @@ func (s *MonitorService) StreamingOpen(stream rpc.MonitorService_StreamingOpenSe
 	var mon monitors.Monitor
 	switch config.GetType() {
 	case rpc.MonitorConfig_TARGET_TYPE_SERIAL:
-		// grab port speed from additional config data
-		var baudRate float64
-		addCfg := config.GetAdditionalConfig()
-		for k, v := range addCfg.GetFields() {
-			if k == "BaudRate" {
-				baudRate = v.GetNumberValue()
-				break
-			}
+		// grab port settings from additional config data
+		settings, err := serialSettingsFromAdditionalConfig(config.GetAdditionalConfig().AsMap())
+		if err != nil {
+			return err
 		}
 
 		// get the Monitor instance
-		var err error
-		if mon, err = monitors.OpenSerialMonitor(config.GetTarget(), int(baudRate)); err != nil {
+		if mon, err = monitors.OpenSerialMonitor(config.GetTarget(), settings); err != nil {
 			return err
 		}
 
@@ -192,3 +189,43 @@ func (s *MonitorService) StreamingOpen(stream rpc.MonitorService_StreamingOpenSe
 		}
 	}
 }
+
+// serialSettingsFromAdditionalConfig builds the serial port settings from the
+// additional config data of the MonitorConfig. The supported fields are
+// "BaudRate" and "DataBits" (numbers), "Parity" and "StopBits" (strings),
+// "DTR" and "RTS" (booleans). Missing fields keep the default value.
+func serialSettingsFromAdditionalConfig(addCfg map[string]interface{}) (*monitors.SerialMonitorSettings, error) {
+	settings := monitors.NewSerialMonitorSettings()
+	fields := map[string]string{
+		"BaudRate": monitors.SerialSettingBaudRate,
+		"DataBits": monitors.SerialSettingDataBits,
+		"Parity":   monitors.SerialSettingParity,
+		"StopBits": monitors.SerialSettingStopBits,
+		"DTR":      monitors.SerialSettingDTR,
+		"RTS":      monitors.SerialSettingRTS,
+	}
+	for field, setting := range fields {
+		v, ok := addCfg[field]
+		if !ok {
+			continue
+		}
+		var value string
+		switch v := v.(type) {
+		case float64:
+			value = strconv.FormatFloat(v, 'f', -1, 64)
+		case string:
+			value = v
+		case bool:
+			value = "off"
+			if v {
+				value = "on"
+			}
+		default:
+			return nil, fmt.Errorf(tr("invalid type for %s in serial monitor configuration"), field)
+		}
+		if err := settings.Set(setting, value); err != nil {
+			return nil, err
+		}
+	}
+	return settings, nil
+}
@@ -0,0 +1,933 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package debug
+
+import (
+	"bufio"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/gdbmi"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/executils"
+	dbg "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/debug/v1"
+	"github.com/google/go-dap"
+	"github.com/sirupsen/logrus"
+)
+
+// debugAdapter runs a Debug Adapter Protocol session on the given streams.
+// GDB is started, with the MI interpreter, when the client sends the launch
+// or the attach request.
+func debugAdapter(req *dbg.DebugConfigRequest, pm *packagemanager.PackageManager, in io.Reader, out io.Writer) (*dbg.DebugResponse, error) {
+	debugInfo, err := getDebugProperties(req, pm)
+	if err != nil {
+		return nil, err
+	}
+	gdbPath, err := getGDBPath(debugInfo)
+	if err != nil {
+		return nil, err
+	}
+	server, err := getGDBServerConfig(debugInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	// Transform every path to forward slashes as done for the console session
+	commandLine := []string{
+		gdbPath.String(),
+		"--interpreter=mi2",
+		debugInfo.Executable,
+	}
+	toSlash := func(args []string) []string {
+		res := []string{}
+		for _, arg := range args {
+			res = append(res, filepath.ToSlash(arg))
+		}
+		return res
+	}
+	session := newDAPSession(in, out, toSlash(commandLine), toSlash(server.targetCommands))
+	if server.commandLine != nil {
+		server.commandLine = toSlash(server.commandLine)
+		session.server = server
+	}
+	if err := session.serve(); err != nil {
+		return &dbg.DebugResponse{Error: err.Error()}, nil
+	}
+	return &dbg.DebugResponse{}, nil
+}
+
+// dapSession translates the requests of a Debug Adapter Protocol client into
+// GDB/MI commands, and the GDB notifications into DAP events
+type dapSession struct {
+	reader *bufio.Reader
+
+	writeMux sync.Mutex
+	writer   io.Writer
+	seq      int
+	closed   bool
+
+	// startGDB runs GDB with the MI interpreter and returns its input and
+	// output streams and a function that terminates it
+	startGDB func() (io.WriteCloser, io.Reader, func(), error)
+	// server is the GDB server to run in background, if any
+	server *gdbServerConfig
+	// targetCommands connect GDB to the GDB server of the board, they are
+	// empty when debugging a native program
+	targetCommands []string
+
+	// mux is held while handling a request or a GDB notification, so that
+	// the events caused by a request are sent after its response
+	mux                 sync.Mutex
+	gdb                 *gdbmi.Client
+	stopGDB             func()
+	stopOnEntry         bool
+	entryPending        bool
+	pauseRequested      bool
+	terminated          bool
+	disconnected        bool
+	sourceBreakpoints   map[string][]string
+	functionBreakpoints []string
+	frames              []dapFrame
+	variables           []*dapVariables
+	varObjects          []string
+	afterResponse       []dap.EventMessage
+}
+
+type dapFrame struct {
+	thread int
+	level  int
+}
+
+// dapVariables are the variables of a DAP variables reference: the locals of
+// a frame or the children of a GDB variable object
+type dapVariables struct {
+	frame     dapFrame
+	varObject string
+	cache     []dap.Variable
+}
+
+func newDAPSession(in io.Reader, out io.Writer, gdbCommandLine []string, targetCommands []string) *dapSession {
+	s := &dapSession{
+		reader:            bufio.NewReader(in),
+		writer:            out,
+		targetCommands:    targetCommands,
+		sourceBreakpoints: map[string][]string{},
+	}
+	s.startGDB = func() (io.WriteCloser, io.Reader, func(), error) {
+		stopServer := func() {}
+		if s.server != nil {
+			var err error
+			stopServer, err = startGDBServer(s.server, &dapOutputWriter{session: s, category: "console"})
+			if err != nil {
+				return nil, nil, nil, err
+			}
+		}
+
+		logrus.WithField("cmdline", gdbCommandLine).Debug("Executing debugger")
+		cmd, err := executils.NewProcess(gdbCommandLine...)
+		if err != nil {
+			stopServer()
+			return nil, nil, nil, &commands.FailedDebugError{Message: tr("Cannot execute debug tool"), Cause: err}
+		}
+		gdbIn, err := cmd.StdinPipe()
+		if err != nil {
+			stopServer()
+			return nil, nil, nil, err
+		}
+		gdbOut, err := cmd.StdoutPipe()
+		if err != nil {
+			stopServer()
+			return nil, nil, nil, err
+		}
+		cmd.RedirectStderrTo(&dapOutputWriter{session: s, category: "stderr"})
+		if err := cmd.Start(); err != nil {
+			stopServer()
+			return nil, nil, nil, &commands.FailedDebugError{Message: tr("Cannot execute debug tool"), Cause: err}
+		}
+		stop := func() {
+			gdbIn.Close()
+			exited := make(chan struct{})
+			go func() {
+				cmd.Wait()
+				close(exited)
+			}()
+			select {
+			case <-exited:
+			case <-time.After(time.Second):
+				// Avoid leaving zombie processes
+				cmd.Kill()
+				<-exited
+			}
+			stopServer()
+		}
+		return gdbIn, gdbOut, stop, nil
+	}
+	return s
+}
+
+// serve handles the requests until the client disconnects or closes the stream
+func (s *dapSession) serve() error {
+	defer func() {
+		s.mux.Lock()
+		s.disconnected = true
+		stopGDB := s.stopGDB
+		s.mux.Unlock()
+		if stopGDB != nil {
+			stopGDB()
+		}
+		s.writeMux.Lock()
+		s.closed = true
+		s.writeMux.Unlock()
+	}()
+
+	for {
+		content, err := dap.ReadBaseMessage(s.reader)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		msg, err := dap.DecodeProtocolMessage(content)
+		if err != nil {
+			var fieldErr *dap.DecodeProtocolMessageFieldError
+			if errors.As(err, &fieldErr) && fieldErr.SubType == "Request" {
+				s.sendErrorResponse(&dap.Request{
+					ProtocolMessage: dap.ProtocolMessage{Seq: fieldErr.Seq},
+					Command:         fieldErr.FieldValue,
+				}, errors.New(tr("Request not supported")))
+				continue
+			}
+			return err
+		}
+		request, ok := msg.(dap.RequestMessage)
+		if !ok {
+			// Responses to reverse requests are not expected
+			continue
+		}
+
+		s.mux.Lock()
+		s.handle(request)
+		disconnected := s.disconnected
+		s.mux.Unlock()
+		if disconnected {
+			return nil
+		}
+	}
+}
+
+func (s *dapSession) handle(request dap.RequestMessage) {
+	var res dap.ResponseMessage
+	var err error
+	switch req := request.(type) {
+	case *dap.InitializeRequest:
+		res = &dap.InitializeResponse{Body: dap.Capabilities{
+			SupportsConfigurationDoneRequest: true,
+			SupportsFunctionBreakpoints:      true,
+			SupportsConditionalBreakpoints:   true,
+			SupportsEvaluateForHovers:        true,
+			SupportsReadMemoryRequest:        true,
+		}}
+	case *dap.LaunchRequest:
+		res, err = &dap.LaunchResponse{}, s.launch(req.Arguments)
+	case *dap.AttachRequest:
+		res, err = &dap.AttachResponse{}, s.launch(req.Arguments)
+	case *dap.SetBreakpointsRequest:
+		res, err = s.setBreakpoints(&req.Arguments)
+	case *dap.SetFunctionBreakpointsRequest:
+		res, err = s.setFunctionBreakpoints(&req.Arguments)
+	case *dap.SetExceptionBreakpointsRequest:
+		res = &dap.SetExceptionBreakpointsResponse{}
+	case *dap.ConfigurationDoneRequest:
+		res, err = &dap.ConfigurationDoneResponse{}, s.configurationDone()
+	case *dap.ThreadsRequest:
+		res, err = s.threads()
+	case *dap.StackTraceRequest:
+		res, err = s.stackTrace(&req.Arguments)
+	case *dap.ScopesRequest:
+		res, err = s.scopes(&req.Arguments)
+	case *dap.VariablesRequest:
+		res, err = s.listVariables(&req.Arguments)
+	case *dap.EvaluateRequest:
+		res, err = s.evaluate(&req.Arguments)
+	case *dap.ReadMemoryRequest:
+		res, err = s.readMemory(&req.Arguments)
+	case *dap.ContinueRequest:
+		res, err = &dap.ContinueResponse{Body: dap.ContinueResponseBody{AllThreadsContinued: true}}, s.resume("-exec-continue")
+	case *dap.NextRequest:
+		command := "-exec-next"
+		if req.Arguments.Granularity == "instruction" {
+			command = "-exec-next-instruction"
+		}
+		res, err = &dap.NextResponse{}, s.resume(command, "--thread", strconv.Itoa(req.Arguments.ThreadId))
+	case *dap.StepInRequest:
+		command := "-exec-step"
+		if req.Arguments.Granularity == "instruction" {
+			command = "-exec-step-instruction"
+		}
+		res, err = &dap.StepInResponse{}, s.resume(command, "--thread", strconv.Itoa(req.Arguments.ThreadId))
+	case *dap.StepOutRequest:
+		res, err = &dap.StepOutResponse{}, s.resume("-exec-finish", "--thread", strconv.Itoa(req.Arguments.ThreadId))
+	case *dap.PauseRequest:
+		res, err = &dap.PauseResponse{}, s.pause()
+	case *dap.DisconnectRequest:
+		res = &dap.DisconnectResponse{}
+		s.disconnect()
+	default:
+		err = errors.New(tr("Request not supported"))
+	}
+
+	if err != nil {
+		s.sendErrorResponse(request.GetRequest(), err)
+	} else {
+		r := res.GetResponse()
+		r.Type = "response"
+		r.RequestSeq = request.GetSeq()
+		r.Command = request.GetRequest().Command
+		r.Success = true
+		s.send(res)
+	}
+	for _, event := range s.afterResponse {
+		s.send(event)
+	}
+	s.afterResponse = nil
+}
+
+func (s *dapSession) send(msg dap.Message) {
+	s.writeMux.Lock()
+	defer s.writeMux.Unlock()
+	if s.closed {
+		return
+	}
+	s.seq++
+	switch m := msg.(type) {
+	case dap.ResponseMessage:
+		m.GetResponse().Seq = s.seq
+	case dap.EventMessage:
+		m.GetEvent().Seq = s.seq
+	}
+	if err := dap.WriteProtocolMessage(s.writer, msg); err != nil {
+		logrus.WithError(err).Warn("Error sending DAP message")
+	}
+}
+
+func (s *dapSession) sendErrorResponse(req *dap.Request, err error) {
+	res := &dap.ErrorResponse{}
+	res.Type = "response"
+	res.RequestSeq = req.Seq
+	res.Command = req.Command
+	res.Success = false
+	res.Message = err.Error()
+	res.Body.Error = dap.ErrorMessage{Format: err.Error(), ShowUser: true}
+	s.send(res)
+}
+
+func newEvent(event dap.EventMessage, name string) dap.EventMessage {
+	e := event.GetEvent()
+	e.Type = "event"
+	e.Event = name
+	return event
+}
+
+// dapOutputWriter sends the data written to it as DAP output events
+type dapOutputWriter struct {
+	session  *dapSession
+	category string
+}
+
+func (w *dapOutputWriter) Write(data []byte) (int, error) {
+	w.session.send(newEvent(&dap.OutputEvent{Body: dap.OutputEventBody{Category: w.category, Output: string(data)}}, "output"))
+	return len(data), nil
+}
+
+func (s *dapSession) checkStarted() error {
+	if s.gdb == nil {
+		return errors.New(tr("The debug session has not been launched"))
+	}
+	return nil
+}
+
+func (s *dapSession) launch(arguments json.RawMessage) error {
+	if s.gdb != nil {
+		return errors.New(tr("The debug session has already been launched"))
+	}
+	var args struct {
+		StopOnEntry bool `json:"stopOnEntry"`
+	}
+	if len(arguments) > 0 {
+		if err := json.Unmarshal(arguments, &args); err != nil {
+			return fmt.Errorf(tr("invalid launch arguments: %s"), err)
+		}
+	}
+	s.stopOnEntry = args.StopOnEntry
+
+	gdbIn, gdbOut, stop, err := s.startGDB()
+	if err != nil {
+		return err
+	}
+	if err := s.setupGDB(gdbIn, gdbOut, stop); err != nil {
+		s.gdb = nil
+		s.stopGDB = nil
+		stop()
+		return err
+	}
+
+	s.afterResponse = append(s.afterResponse, newEvent(&dap.InitializedEvent{}, "initialized"))
+	return nil
+}
+
+func (s *dapSession) setupGDB(gdbIn io.Writer, gdbOut io.Reader, stop func()) error {
+	s.stopGDB = stop
+	s.gdb = gdbmi.NewClient(gdbIn, gdbOut, s.onGDBRecord, s.onGDBOutput)
+	gdb := s.gdb
+	go func() {
+		<-gdb.Done()
+		s.onGDBClosed()
+	}()
+
+	// Needed to interrupt the program while it's running
+	if _, err := s.gdb.Send("-gdb-set", "mi-async", "on"); err != nil {
+		logrus.WithError(err).Info("Cannot enable GDB async mode")
+	}
+	if len(s.targetCommands) > 0 {
+		if _, err := s.gdb.Send("-gdb-set", "remotetimeout", "5"); err != nil {
+			return err
+		}
+	}
+	for _, targetCommand := range s.targetCommands {
+		if _, err := s.gdb.Send("-interpreter-exec", "console", targetCommand); err != nil {
+			return fmt.Errorf(tr("cannot connect to the board: %s"), err)
+		}
+	}
+	return nil
+}
+
+func (s *dapSession) setBreakpoints(args *dap.SetBreakpointsArguments) (dap.ResponseMessage, error) {
+	if err := s.checkStarted(); err != nil {
+		return nil, err
+	}
+	path := args.Source.Path
+	if err := s.deleteBreakpoints(s.sourceBreakpoints[path]); err != nil {
+		return nil, err
+	}
+	delete(s.sourceBreakpoints, path)
+
+	requested := args.Breakpoints
+	if len(requested) == 0 {
+		// Deprecated form of the request
+		for _, line := range args.Lines {
+			requested = append(requested, dap.SourceBreakpoint{Line: line})
+		}
+	}
+	res := &dap.SetBreakpointsResponse{Body: dap.SetBreakpointsResponseBody{Breakpoints: []dap.Breakpoint{}}}
+	for _, bp := range requested {
+		breakpoint, number := s.insertBreakpoint(fmt.Sprintf("%s:%d", path, bp.Line), bp.Condition)
+		if number != "" {
+			s.sourceBreakpoints[path] = append(s.sourceBreakpoints[path], number)
+		}
+		if breakpoint.Line == 0 {
+			breakpoint.Line = bp.Line
+		}
+		if breakpoint.Source.Path == "" {
+			breakpoint.Source = args.Source
+		}
+		res.Body.Breakpoints = append(res.Body.Breakpoints, breakpoint)
+	}
+	return res, nil
+}
+
+func (s *dapSession) setFunctionBreakpoints(args *dap.SetFunctionBreakpointsArguments) (dap.ResponseMessage, error) {
+	if err := s.checkStarted(); err != nil {
+		return nil, err
+	}
+	if err := s.deleteBreakpoints(s.functionBreakpoints); err != nil {
+		return nil, err
+	}
+	s.functionBreakpoints = nil
+
+	res := &dap.SetFunctionBreakpointsResponse{Body: dap.SetFunctionBreakpointsResponseBody{Breakpoints: []dap.Breakpoint{}}}
+	for _, bp := range args.Breakpoints {
+		breakpoint, number := s.insertBreakpoint(bp.Name, bp.Condition)
+		if number != "" {
+			s.functionBreakpoints = append(s.functionBreakpoints, number)
+		}
+		res.Body.Breakpoints = append(res.Body.Breakpoints, breakpoint)
+	}
+	return res, nil
+}
+
+func (s *dapSession) deleteBreakpoints(numbers []string) error {
+	if len(numbers) == 0 {
+		return nil
+	}
+	_, err := s.gdb.Send("-break-delete", numbers...)
+	return err
+}
+
+// insertBreakpoint inserts a breakpoint and returns its DAP representation
+// and the GDB breakpoint number, empty if the breakpoint could not be set
+func (s *dapSession) insertBreakpoint(location, condition string) (dap.Breakpoint, string) {
+	args := []string{"-f"}
+	if condition != "" {
+		args = append(args, "-c", condition)
+	}
+	rec, err := s.gdb.Send("-break-insert", append(args, location)...)
+	if err != nil {
+		return dap.Breakpoint{Verified: false, Message: err.Error()}, ""
+	}
+	bkpt := rec.Results.Tuple("bkpt")
+	number := bkpt.String("number")
+	res := dap.Breakpoint{Id: bkpt.Int("number")}
+	if bkpt.String("pending") != "" {
+		res.Message = tr("The breakpoint is pending")
+		return res, number
+	}
+	res.Verified = true
+	if bkpt.String("line") == "" {
+		// Breakpoint with multiple locations
+		if locations := bkpt.List("locations").Tuples(); len(locations) > 0 {
+			bkpt = locations[0]
+		}
+	}
+	res.Line = bkpt.Int("line")
+	if fullname := bkpt.String("fullname"); fullname != "" {
+		res.Source = dap.Source{Name: filepath.Base(fullname), Path: fullname}
+	}
+	return res, number
+}
+
+func (s *dapSession) configurationDone() error {
+	if err := s.checkStarted(); err != nil {
+		return err
+	}
+	s.invalidateState()
+	if len(s.targetCommands) == 0 {
+		// Native program
+		args := []string{}
+		if s.stopOnEntry {
+			args = append(args, "--start")
+			s.entryPending = true
+		}
+		_, err := s.gdb.Send("-exec-run", args...)
+		return err
+	}
+
+	// The board has been halted by the GDB server on connection
+	if s.stopOnEntry {
+		thread := 1
+		if rec, err := s.gdb.Send("-thread-info"); err == nil {
+			if id := rec.Results.Int("current-thread-id"); id != 0 {
+				thread = id
+			}
+		}
+		s.afterResponse = append(s.afterResponse, newEvent(&dap.StoppedEvent{Body: dap.StoppedEventBody{
+			Reason:            "entry",
+			ThreadId:          thread,
+			AllThreadsStopped: true,
+		}}, "stopped"))
+		return nil
+	}
+	_, err := s.gdb.Send("-exec-continue")
+	return err
+}
+
+func (s *dapSession) threads() (dap.ResponseMessage, error) {
+	if err := s.checkStarted(); err != nil {
+		return nil, err
+	}
+	rec, err := s.gdb.Send("-thread-info")
+	if err != nil {
+		return nil, err
+	}
+	res := &dap.ThreadsResponse{Body: dap.ThreadsResponseBody{Threads: []dap.Thread{}}}
+	for _, thread := range rec.Results.List("threads").Tuples() {
+		name := thread.String("name")
+		if name == "" {
+			name = thread.String("target-id")
+		}
+		res.Body.Threads = append(res.Body.Threads, dap.Thread{Id: thread.Int("id"), Name: name})
+	}
+	return res, nil
+}
+
+func (s *dapSession) stackTrace(args *dap.StackTraceArguments) (dap.ResponseMessage, error) {
+	if err := s.checkStarted(); err != nil {
+		return nil, err
+	}
+	cmdArgs := []string{"--thread", strconv.Itoa(args.ThreadId)}
+	if args.Levels > 0 {
+		cmdArgs = append(cmdArgs, strconv.Itoa(args.StartFrame), strconv.Itoa(args.StartFrame+args.Levels-1))
+	}
+	rec, err := s.gdb.Send("-stack-list-frames", cmdArgs...)
+	if err != nil {
+		return nil, err
+	}
+	res := &dap.StackTraceResponse{Body: dap.StackTraceResponseBody{StackFrames: []dap.StackFrame{}}}
+	for _, frame := range rec.Results.List("stack").Tuples() {
+		s.frames = append(s.frames, dapFrame{thread: args.ThreadId, level: frame.Int("level")})
+		name := frame.String("func")
+		if name == "" {
+			name = frame.String("addr")
+		}
+		stackFrame := dap.StackFrame{
+			Id:                          len(s.frames),
+			Name:                        name,
+			Line:                        frame.Int("line"),
+			InstructionPointerReference: frame.String("addr"),
+		}
+		if fullname := frame.String("fullname"); fullname != "" {
+			stackFrame.Source = dap.Source{Name: filepath.Base(fullname), Path: fullname}
+		} else {
+			stackFrame.PresentationHint = "subtle"
+		}
+		res.Body.StackFrames = append(res.Body.StackFrames, stackFrame)
+	}
+	return res, nil
+}
+
+func (s *dapSession) getFrame(id int) (dapFrame, error) {
+	if id < 1 || id > len(s.frames) {
+		return dapFrame{}, errors.New(tr("Invalid frame"))
+	}
+	return s.frames[id-1], nil
+}
+
+func (s *dapSession) addVariables(vars *dapVariables) int {
+	s.variables = append(s.variables, vars)
+	return len(s.variables)
+}
+
+func (s *dapSession) scopes(args *dap.ScopesArguments) (dap.ResponseMessage, error) {
+	frame, err := s.getFrame(args.FrameId)
+	if err != nil {
+		return nil, err
+	}
+	return &dap.ScopesResponse{Body: dap.ScopesResponseBody{Scopes: []dap.Scope{{
+		Name:               tr("Locals"),
+		PresentationHint:   "locals",
+		VariablesReference: s.addVariables(&dapVariables{frame: frame}),
+	}}}}, nil
+}
+
+func (s *dapSession) listVariables(args *dap.VariablesArguments) (dap.ResponseMessage, error) {
+	ref := args.VariablesReference
+	if ref < 1 || ref > len(s.variables) {
+		return nil, errors.New(tr("Invalid variables reference"))
+	}
+	vars := s.variables[ref-1]
+	if vars.cache == nil {
+		var err error
+		if vars.varObject == "" {
+			vars.cache, err = s.listLocals(vars.frame)
+		} else {
+			vars.cache, err = s.listChildren(vars.frame, vars.varObject)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &dap.VariablesResponse{Body: dap.VariablesResponseBody{Variables: vars.cache}}, nil
+}
+
+func (s *dapSession) listLocals(frame dapFrame) ([]dap.Variable, error) {
+	rec, err := s.gdb.Send("-stack-list-variables", "--thread", strconv.Itoa(frame.thread), "--frame", strconv.Itoa(frame.level), "--no-values")
+	if err != nil {
+		return nil, err
+	}
+	res := []dap.Variable{}
+	for _, v := range rec.Results.List("variables").Tuples() {
+		name := v.String("name")
+		variable, err := s.createVariable(frame, name)
+		if err != nil {
+			variable = dap.Variable{Value: err.Error()}
+		}
+		variable.Name = name
+		variable.EvaluateName = name
+		res = append(res, variable)
+	}
+	return res, nil
+}
+
+// createVariable creates a GDB variable object for the expression, so that
+// its children can be listed
+func (s *dapSession) createVariable(frame dapFrame, expression string) (dap.Variable, error) {
+	rec, err := s.gdb.Send("-var-create", "--thread", strconv.Itoa(frame.thread), "--frame", strconv.Itoa(frame.level), "-", "*", expression)
+	if err != nil {
+		return dap.Variable{}, err
+	}
+	s.varObjects = append(s.varObjects, rec.Results.String("name"))
+	return s.toVariable(frame, rec.Results), nil
+}
+
+func (s *dapSession) listChildren(frame dapFrame, varObject string) ([]dap.Variable, error) {
+	rec, err := s.gdb.Send("-var-list-children", "--all-values", varObject)
+	if err != nil {
+		return nil, err
+	}
+	res := []dap.Variable{}
+	for _, child := range rec.Results.List("children").Tuples() {
+		exp := child.String("exp")
+		if child.String("type") == "" && (exp == "public" || exp == "private" || exp == "protected") {
+			// Flatten the C++ access specifiers
+			children, err := s.listChildren(frame, child.String("name"))
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, children...)
+			continue
+		}
+		variable := s.toVariable(frame, child)
+		variable.Name = exp
+		res = append(res, variable)
+	}
+	return res, nil
+}
+
+func (s *dapSession) toVariable(frame dapFrame, varObject gdbmi.Tuple) dap.Variable {
+	res := dap.Variable{
+		Value: varObject.String("value"),
+		Type:  varObject.String("type"),
+	}
+	if varObject.Int("numchild") > 0 || varObject.String("dynamic") == "1" {
+		res.VariablesReference = s.addVariables(&dapVariables{frame: frame, varObject: varObject.String("name")})
+	}
+	if strings.HasPrefix(res.Value, "0x") {
+		res.MemoryReference = strings.Fields(res.Value)[0]
+	}
+	return res
+}
+
+func (s *dapSession) evaluate(args *dap.EvaluateArguments) (dap.ResponseMessage, error) {
+	if err := s.checkStarted(); err != nil {
+		return nil, err
+	}
+	if args.Context == "repl" && strings.HasPrefix(args.Expression, "-exec ") {
+		// Run a GDB command, its output is sent through the output events
+		command := strings.TrimPrefix(args.Expression, "-exec ")
+		if _, err := s.gdb.Send("-interpreter-exec", "console", command); err != nil {
+			return nil, err
+		}
+		return &dap.EvaluateResponse{}, nil
+	}
+
+	frame := dapFrame{thread: 1}
+	if args.FrameId != 0 {
+		var err error
+		if frame, err = s.getFrame(args.FrameId); err != nil {
+			return nil, err
+		}
+	} else if rec, err := s.gdb.Send("-thread-info"); err == nil {
+		if id := rec.Results.Int("current-thread-id"); id != 0 {
+			frame.thread = id
+		}
+	}
+	variable, err := s.createVariable(frame, args.Expression)
+	if err != nil {
+		return nil, err
+	}
+	return &dap.EvaluateResponse{Body: dap.EvaluateResponseBody{
+		Result:             variable.Value,
+		Type:               variable.Type,
+		VariablesReference: variable.VariablesReference,
+		MemoryReference:    variable.MemoryReference,
+	}}, nil
+}
+
+func (s *dapSession) readMemory(args *dap.ReadMemoryArguments) (dap.ResponseMessage, error) {
+	if err := s.checkStarted(); err != nil {
+		return nil, err
+	}
+	res := &dap.ReadMemoryResponse{}
+	rec, err := s.gdb.Send("-data-read-memory-bytes", "-o", strconv.Itoa(args.Offset), args.MemoryReference, strconv.Itoa(args.Count))
+	if err != nil {
+		// The memory is not readable
+		res.Body.Address = args.MemoryReference
+		res.Body.UnreadableBytes = args.Count
+		return res, nil
+	}
+
+	data := []byte{}
+	var next uint64
+	for i, block := range rec.Results.List("memory").Tuples() {
+		begin, err := strconv.ParseUint(block.String("begin"), 0, 64)
+		if err != nil {
+			return nil, fmt.Errorf(tr("invalid memory address: %s"), block.String("begin"))
+		}
+		if i == 0 {
+			res.Body.Address = block.String("begin")
+		} else if begin != next {
+			// Only the contiguous memory is returned
+			break
+		}
+		contents, err := hex.DecodeString(block.String("contents"))
+		if err != nil {
+			return nil, fmt.Errorf(tr("invalid memory contents: %s"), err)
+		}
+		data = append(data, contents...)
+		next = begin + uint64(len(contents))
+	}
+	res.Body.Data = base64.StdEncoding.EncodeToString(data)
+	if len(data) < args.Count {
+		res.Body.UnreadableBytes = args.Count - len(data)
+	}
+	return res, nil
+}
+
+// invalidateState forgets the frames and the variables, that are valid only
+// while the program is stopped
+func (s *dapSession) invalidateState() {
+	for _, varObject := range s.varObjects {
+		if _, err := s.gdb.Send("-var-delete", varObject); err != nil {
+			logrus.WithError(err).Debug("Cannot delete GDB variable object")
+		}
+	}
+	s.varObjects = nil
+	s.frames = nil
+	s.variables = nil
+}
+
+func (s *dapSession) resume(command string, args ...string) error {
+	if err := s.checkStarted(); err != nil {
+		return err
+	}
+	s.invalidateState()
+	_, err := s.gdb.Send(command, args...)
+	return err
+}
+
+func (s *dapSession) pause() error {
+	if err := s.checkStarted(); err != nil {
+		return err
+	}
+	s.pauseRequested = true
+	_, err := s.gdb.Send("-exec-interrupt")
+	return err
+}
+
+func (s *dapSession) disconnect() {
+	s.disconnected = true
+	if s.gdb == nil {
+		return
+	}
+	exited := make(chan struct{})
+	go func() {
+		s.gdb.Send("-gdb-exit")
+		close(exited)
+	}()
+	select {
+	case <-exited:
+	case <-time.After(time.Second):
+	}
+}
+
+// onGDBRecord translates the GDB notifications into DAP events
+func (s *dapSession) onGDBRecord(rec *gdbmi.Record) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if s.disconnected {
+		return
+	}
+
+	switch rec.Type {
+	case gdbmi.ConsoleStreamRecord:
+		s.send(newEvent(&dap.OutputEvent{Body: dap.OutputEventBody{Category: "console", Output: rec.Stream}}, "output"))
+	case gdbmi.TargetStreamRecord:
+		s.send(newEvent(&dap.OutputEvent{Body: dap.OutputEventBody{Category: "stdout", Output: rec.Stream}}, "output"))
+	case gdbmi.ExecAsyncRecord:
+		if rec.Class == "stopped" {
+			s.onStopped(rec.Results)
+		}
+	}
+}
+
+func (s *dapSession) onStopped(results gdbmi.Tuple) {
+	reason := results.String("reason")
+	switch reason {
+	case "exited-normally", "exited", "exited-signalled":
+		// The exit code is in octal
+		exitCode, _ := strconv.ParseInt(results.String("exit-code"), 8, 32)
+		s.send(newEvent(&dap.ExitedEvent{Body: dap.ExitedEventBody{ExitCode: int(exitCode)}}, "exited"))
+		s.terminate()
+		return
+	}
+
+	body := dap.StoppedEventBody{
+		ThreadId:          results.Int("thread-id"),
+		AllThreadsStopped: results.String("stopped-threads") == "all",
+	}
+	switch reason {
+	case "breakpoint-hit":
+		if s.entryPending && results.String("disp") == "del" {
+			body.Reason = "entry"
+		} else {
+			body.Reason = "breakpoint"
+			body.HitBreakpointIds = []int{results.Int("bkptno")}
+		}
+	case "watchpoint-trigger", "read-watchpoint-trigger", "access-watchpoint-trigger":
+		body.Reason = "data breakpoint"
+	case "end-stepping-range", "function-finished", "location-reached":
+		body.Reason = "step"
+	case "signal-received":
+		if s.pauseRequested {
+			body.Reason = "pause"
+		} else {
+			body.Reason = "exception"
+			body.Description = results.String("signal-meaning")
+			body.Text = results.String("signal-name")
+		}
+	default:
+		body.Reason = "pause"
+	}
+	s.entryPending = false
+	s.pauseRequested = false
+	s.send(newEvent(&dap.StoppedEvent{Body: body}, "stopped"))
+}
+
+func (s *dapSession) onGDBOutput(output string) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if s.disconnected {
+		return
+	}
+	s.send(newEvent(&dap.OutputEvent{Body: dap.OutputEventBody{Category: "stdout", Output: output}}, "output"))
+}
+
+func (s *dapSession) onGDBClosed() {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if s.disconnected {
+		return
+	}
+	s.terminate()
+}
+
+func (s *dapSession) terminate() {
+	if s.terminated {
+		return
+	}
+	s.terminated = true
+	s.send(newEvent(&dap.TerminatedEvent{}, "terminated"))
+}
@@ -0,0 +1,462 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package debug
+
+import (
+	"bufio"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"os/exec"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/google/go-dap"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeGDB answers to the GDB/MI commands with the scripted output
+type fakeGDB struct {
+	mux      sync.Mutex
+	script   map[string]string
+	commands []string
+}
+
+func (g *fakeGDB) start() (io.WriteCloser, io.Reader, func(), error) {
+	cmdReader, cmdWriter := io.Pipe()
+	outReader, outWriter := io.Pipe()
+	go func() {
+		commands := bufio.NewScanner(cmdReader)
+		for commands.Scan() {
+			line := commands.Text()
+			token := line[:strings.Index(line, "-")]
+			command := line[len(token):]
+			g.mux.Lock()
+			g.commands = append(g.commands, command)
+			output, ok := g.script[command]
+			g.mux.Unlock()
+			if !ok {
+				output = `^error,msg="Undefined command"`
+			}
+			output = strings.ReplaceAll(output, "^", token+"^")
+			io.WriteString(outWriter, output+"\n(gdb)\n")
+			if command == "-gdb-exit" {
+				outWriter.Close()
+			}
+		}
+	}()
+	stop := func() {
+		cmdWriter.Close()
+		outWriter.Close()
+	}
+	return cmdWriter, outReader, stop, nil
+}
+
+func (g *fakeGDB) receivedCommands() []string {
+	g.mux.Lock()
+	defer g.mux.Unlock()
+	res := g.commands
+	g.commands = nil
+	return res
+}
+
+type dapTestClient struct {
+	t        *testing.T
+	writer   io.Writer
+	seq      int
+	messages chan dap.Message
+}
+
+func newDAPTestClient(t *testing.T, in io.Writer, out io.Reader) *dapTestClient {
+	c := &dapTestClient{t: t, writer: in, messages: make(chan dap.Message, 100)}
+	go func() {
+		reader := bufio.NewReader(out)
+		for {
+			msg, err := dap.ReadProtocolMessage(reader)
+			if err != nil {
+				close(c.messages)
+				return
+			}
+			c.messages <- msg
+		}
+	}()
+	return c
+}
+
+func (c *dapTestClient) send(command string, args interface{}) {
+	c.seq++
+	data, err := json.Marshal(map[string]interface{}{
+		"seq":       c.seq,
+		"type":      "request",
+		"command":   command,
+		"arguments": args,
+	})
+	require.NoError(c.t, err)
+	require.NoError(c.t, dap.WriteBaseMessage(c.writer, data))
+}
+
+func (c *dapTestClient) next() dap.Message {
+	select {
+	case msg, ok := <-c.messages:
+		require.True(c.t, ok, "DAP stream closed")
+		return msg
+	case <-time.After(5 * time.Second):
+		require.FailNow(c.t, "timeout waiting for DAP message")
+		return nil
+	}
+}
+
+func (c *dapTestClient) request(command string, args interface{}) dap.ResponseMessage {
+	c.send(command, args)
+	msg, ok := c.next().(dap.ResponseMessage)
+	require.True(c.t, ok, "expected response to %s", command)
+	res := msg.GetResponse()
+	require.Equal(c.t, command, res.Command)
+	require.Equal(c.t, c.seq, res.RequestSeq)
+	require.True(c.t, res.Success, "%s failed: %s", command, res.Message)
+	return msg
+}
+
+func (c *dapTestClient) event(name string) dap.EventMessage {
+	msg, ok := c.next().(dap.EventMessage)
+	require.True(c.t, ok, "expected %s event", name)
+	require.Equal(c.t, name, msg.GetEvent().Event)
+	return msg
+}
+
+func TestDAPSession(t *testing.T) {
+	gdb := &fakeGDB{script: map[string]string{
+		`-gdb-set mi-async on`:                                 `^done`,
+		`-break-insert -f /sketch/Sketch.ino:5`:                `^done,bkpt={number="1",type="breakpoint",file="/sketch/Sketch.ino",fullname="/sketch/Sketch.ino",line="5"}`,
+		`-break-insert -f /sketch/Sketch.ino:99`:               `^error,msg="No line 99 in file \"/sketch/Sketch.ino\"."`,
+		`-break-insert -f -c "count > 2" /sketch/Sketch.ino:7`: `^done,bkpt={number="2",type="breakpoint",fullname="/sketch/Sketch.ino",line="8"}`,
+		`-break-delete 1`:                                      `^done`,
+		`-break-insert -f loop`:                                `^done,bkpt={number="3",pending="loop"}`,
+		`-exec-run`: "^running\n" +
+			`*running,thread-id="all"` + "\n" +
+			`*stopped,reason="breakpoint-hit",disp="keep",bkptno="2",frame={func="loop"},thread-id="1",stopped-threads="all"`,
+		`-thread-info`: `^done,threads=[{id="1",target-id="process 42",name="Sketch",state="stopped"}],current-thread-id="1"`,
+		`-stack-list-frames --thread 1`: `^done,stack=[` +
+			`frame={level="0",addr="0x0000555555555139",func="loop",file="Sketch.ino",fullname="/sketch/Sketch.ino",line="8"},` +
+			`frame={level="1",addr="0x0000555555555160",func="main",file="main.cpp",fullname="/core/main.cpp",line="12"},` +
+			`frame={level="2",addr="0x00007ffff7dea083",func="__libc_start_main"}]`,
+		`-stack-list-variables --thread 1 --frame 0 --no-values`: `^done,variables=[{name="count"},{name="p"}]`,
+		`-var-create --thread 1 --frame 0 - * count`:             `^done,name="var1",numchild="0",value="3",type="int",has_more="0"`,
+		`-var-create --thread 1 --frame 0 - * p`:                 `^done,name="var2",numchild="1",value="{...}",type="Point",has_more="0"`,
+		`-var-list-children --all-values var2`:                   `^done,numchild="1",children=[child={name="var2.public",exp="public",numchild="2"}],has_more="0"`,
+		`-var-list-children --all-values var2.public`: `^done,numchild="2",children=[` +
+			`child={name="var2.public.x",exp="x",numchild="0",value="1",type="int"},` +
+			`child={name="var2.public.y",exp="y",numchild="0",value="2",type="int"}],has_more="0"`,
+		`-var-create --thread 1 --frame 1 - * "count * 2"`: `^done,name="var3",numchild="0",value="6",type="int",has_more="0"`,
+		`-var-create --thread 1 --frame 0 - * &count`:      `^done,name="var4",numchild="1",value="0x7fffffffe0ac",type="int *",has_more="0"`,
+		`-data-read-memory-bytes -o 2 0x7fffffffe0ac 4`:    `^done,memory=[{begin="0x7fffffffe0ae",offset="0x0",end="0x7fffffffe0b0",contents="0300"}]`,
+		`-var-delete var1`: `^done,ndeleted="1"`,
+		`-var-delete var2`: `^done,ndeleted="3"`,
+		`-var-delete var3`: `^done,ndeleted="1"`,
+		`-var-delete var4`: `^done,ndeleted="2"`,
+		`-exec-next --thread 1`: "^running\n" +
+			`*running,thread-id="all"` + "\n" +
+			`*stopped,reason="end-stepping-range",frame={func="loop"},thread-id="1",stopped-threads="all"`,
+		`-exec-continue`: "^running\n" +
+			`*running,thread-id="all"` + "\n" +
+			"Hello from the sketch\n" +
+			`@"target output\n"` + "\n" +
+			`*stopped,reason="exited",exit-code="012"`,
+		`-gdb-exit`: `^exit`,
+	}}
+
+	dapInReader, dapInWriter := io.Pipe()
+	dapOutReader, dapOutWriter := io.Pipe()
+	session := newDAPSession(dapInReader, dapOutWriter, nil, nil)
+	session.startGDB = gdb.start
+	served := make(chan error)
+	go func() {
+		served <- session.serve()
+		dapOutWriter.Close()
+	}()
+	client := newDAPTestClient(t, dapInWriter, dapOutReader)
+
+	// Requests before the launch fail
+	client.send("threads", nil)
+	require.False(t, client.next().(dap.ResponseMessage).GetResponse().Success)
+	client.send("unknownCommand", nil)
+	require.False(t, client.next().(dap.ResponseMessage).GetResponse().Success)
+
+	res := client.request("initialize", map[string]interface{}{"adapterID": "arduino"})
+	capabilities := res.(*dap.InitializeResponse).Body
+	require.True(t, capabilities.SupportsConfigurationDoneRequest)
+	require.True(t, capabilities.SupportsReadMemoryRequest)
+
+	client.request("launch", map[string]interface{}{})
+	client.event("initialized")
+	require.Equal(t, []string{"-gdb-set mi-async on"}, gdb.receivedCommands())
+
+	res = client.request("setBreakpoints", map[string]interface{}{
+		"source":      map[string]interface{}{"path": "/sketch/Sketch.ino"},
+		"breakpoints": []map[string]interface{}{{"line": 5}, {"line": 99}},
+	})
+	breakpoints := res.(*dap.SetBreakpointsResponse).Body.Breakpoints
+	require.Len(t, breakpoints, 2)
+	require.Equal(t, 1, breakpoints[0].Id)
+	require.True(t, breakpoints[0].Verified)
+	require.Equal(t, 5, breakpoints[0].Line)
+	require.Equal(t, "/sketch/Sketch.ino", breakpoints[0].Source.Path)
+	require.False(t, breakpoints[1].Verified)
+	require.Contains(t, breakpoints[1].Message, "No line 99")
+
+	// Setting the breakpoints of a source replaces the previous ones
+	res = client.request("setBreakpoints", map[string]interface{}{
+		"source":      map[string]interface{}{"path": "/sketch/Sketch.ino"},
+		"breakpoints": []map[string]interface{}{{"line": 7, "condition": "count > 2"}},
+	})
+	breakpoints = res.(*dap.SetBreakpointsResponse).Body.Breakpoints
+	require.Len(t, breakpoints, 1)
+	require.True(t, breakpoints[0].Verified)
+	require.Equal(t, 8, breakpoints[0].Line)
+	gdb.receivedCommands()
+
+	res = client.request("setFunctionBreakpoints", map[string]interface{}{
+		"breakpoints": []map[string]interface{}{{"name": "loop"}},
+	})
+	breakpoints = res.(*dap.SetFunctionBreakpointsResponse).Body.Breakpoints
+	require.Len(t, breakpoints, 1)
+	require.False(t, breakpoints[0].Verified)
+
+	client.request("configurationDone", nil)
+	stopped := client.event("stopped").(*dap.StoppedEvent).Body
+	require.Equal(t, "breakpoint", stopped.Reason)
+	require.Equal(t, 1, stopped.ThreadId)
+	require.Equal(t, []int{2}, stopped.HitBreakpointIds)
+	require.True(t, stopped.AllThreadsStopped)
+
+	res = client.request("threads", nil)
+	require.Equal(t, []dap.Thread{{Id: 1, Name: "Sketch"}}, res.(*dap.ThreadsResponse).Body.Threads)
+
+	res = client.request("stackTrace", map[string]interface{}{"threadId": 1})
+	frames := res.(*dap.StackTraceResponse).Body.StackFrames
+	require.Len(t, frames, 3)
+	require.Equal(t, "loop", frames[0].Name)
+	require.Equal(t, 8, frames[0].Line)
+	require.Equal(t, dap.Source{Name: "Sketch.ino", Path: "/sketch/Sketch.ino"}, frames[0].Source)
+	require.Equal(t, "0x0000555555555139", frames[0].InstructionPointerReference)
+	require.Equal(t, "main", frames[1].Name)
+	require.Equal(t, "subtle", frames[2].PresentationHint)
+
+	res = client.request("scopes", map[string]interface{}{"frameId": frames[0].Id})
+	scopes := res.(*dap.ScopesResponse).Body.Scopes
+	require.Len(t, scopes, 1)
+
+	res = client.request("variables", map[string]interface{}{"variablesReference": scopes[0].VariablesReference})
+	variables := res.(*dap.VariablesResponse).Body.Variables
+	require.Len(t, variables, 2)
+	require.Equal(t, "count", variables[0].Name)
+	require.Equal(t, "3", variables[0].Value)
+	require.Equal(t, "int", variables[0].Type)
+	require.Zero(t, variables[0].VariablesReference)
+	require.Equal(t, "p", variables[1].Name)
+	require.NotZero(t, variables[1].VariablesReference)
+
+	// The access specifiers of C++ classes are flattened
+	res = client.request("variables", map[string]interface{}{"variablesReference": variables[1].VariablesReference})
+	members := res.(*dap.VariablesResponse).Body.Variables
+	require.Len(t, members, 2)
+	require.Equal(t, "x", members[0].Name)
+	require.Equal(t, "1", members[0].Value)
+	require.Equal(t, "y", members[1].Name)
+
+	res = client.request("evaluate", map[string]interface{}{"expression": "count * 2", "frameId": frames[1].Id})
+	require.Equal(t, "6", res.(*dap.EvaluateResponse).Body.Result)
+
+	res = client.request("evaluate", map[string]interface{}{"expression": "&count", "frameId": frames[0].Id})
+	evaluated := res.(*dap.EvaluateResponse).Body
+	require.Equal(t, "0x7fffffffe0ac", evaluated.MemoryReference)
+
+	res = client.request("readMemory", map[string]interface{}{"memoryReference": evaluated.MemoryReference, "offset": 2, "count": 4})
+	memory := res.(*dap.ReadMemoryResponse).Body
+	require.Equal(t, "0x7fffffffe0ae", memory.Address)
+	require.Equal(t, base64.StdEncoding.EncodeToString([]byte{3, 0}), memory.Data)
+	require.Equal(t, 2, memory.UnreadableBytes)
+	gdb.receivedCommands()
+
+	// Resuming the execution invalidates the frames and the variables
+	client.request("next", map[string]interface{}{"threadId": 1})
+	require.Equal(t, []string{
+		"-var-delete var1",
+		"-var-delete var2",
+		"-var-delete var3",
+		"-var-delete var4",
+		"-exec-next --thread 1",
+	}, gdb.receivedCommands())
+	require.Equal(t, "step", client.event("stopped").(*dap.StoppedEvent).Body.Reason)
+	client.send("scopes", map[string]interface{}{"frameId": frames[0].Id})
+	require.False(t, client.next().(dap.ResponseMessage).GetResponse().Success)
+
+	client.request("continue", map[string]interface{}{"threadId": 1})
+	output := client.event("output").(*dap.OutputEvent).Body
+	require.Equal(t, "stdout", output.Category)
+	require.Equal(t, "Hello from the sketch\n", output.Output)
+	require.Equal(t, "target output\n", client.event("output").(*dap.OutputEvent).Body.Output)
+	require.Equal(t, 10, client.event("exited").(*dap.ExitedEvent).Body.ExitCode)
+	client.event("terminated")
+
+	client.request("disconnect", nil)
+	select {
+	case err := <-served:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "session not closed")
+	}
+	_, ok := <-client.messages
+	require.False(t, ok)
+}
+
+func TestDAPSessionRemoteTarget(t *testing.T) {
+	gdb := &fakeGDB{script: map[string]string{
+		`-gdb-set mi-async on`:     `^done`,
+		`-gdb-set remotetimeout 5`: `^done`,
+		`-interpreter-exec console "target extended-remote | \"openocd\" -c \"gdb_port pipe\""`: `~"Remote debugging using | openocd\n"` + "\n^done",
+		`-thread-info`:    `^done,threads=[{id="1",target-id="Remote target",state="stopped"}],current-thread-id="1"`,
+		`-exec-continue`:  "^running\n" + `*running,thread-id="all"`,
+		`-exec-interrupt`: "^done\n" + `*stopped,reason="signal-received",signal-name="SIGINT",signal-meaning="Interrupt",thread-id="1",stopped-threads="all"`,
+	}}
+
+	dapInReader, dapInWriter := io.Pipe()
+	dapOutReader, dapOutWriter := io.Pipe()
+	session := newDAPSession(dapInReader, dapOutWriter, nil, []string{`target extended-remote | "openocd" -c "gdb_port pipe"`})
+	session.startGDB = gdb.start
+	go func() {
+		session.serve()
+		dapOutWriter.Close()
+	}()
+	client := newDAPTestClient(t, dapInWriter, dapOutReader)
+
+	client.request("initialize", map[string]interface{}{"adapterID": "arduino"})
+	client.request("attach", map[string]interface{}{"stopOnEntry": true})
+	// The events generated while handling a request are sent after its response
+	client.event("initialized")
+	require.Equal(t, "Remote debugging using | openocd\n", client.event("output").(*dap.OutputEvent).Body.Output)
+
+	// The board is halted on connection
+	client.request("configurationDone", nil)
+	stopped := client.event("stopped").(*dap.StoppedEvent).Body
+	require.Equal(t, "entry", stopped.Reason)
+	require.Equal(t, 1, stopped.ThreadId)
+
+	res := client.request("threads", nil)
+	require.Equal(t, []dap.Thread{{Id: 1, Name: "Remote target"}}, res.(*dap.ThreadsResponse).Body.Threads)
+
+	client.request("continue", map[string]interface{}{"threadId": 1})
+	client.request("pause", map[string]interface{}{"threadId": 1})
+	require.Equal(t, "pause", client.event("stopped").(*dap.StoppedEvent).Body.Reason)
+
+	client.request("disconnect", nil)
+	_, ok := <-client.messages
+	require.False(t, ok)
+}
+
+func TestDAPSessionWithHostGDB(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test with the host GDB in short mode")
+	}
+	gdbPath, err := exec.LookPath("gdb")
+	if err != nil {
+		t.Skip("gdb not available")
+	}
+	gccPath, err := exec.LookPath("gcc")
+	if err != nil {
+		t.Skip("gcc not available")
+	}
+
+	tmp, err := paths.MkTempDir("", "dap_test")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	source := tmp.Join("main.c")
+	require.NoError(t, source.WriteFile([]byte(
+		"#include <stdio.h>\n"+
+			"int counter = 0;\n"+
+			"void increment(void) {\n"+
+			"  counter++;\n"+
+			"}\n"+
+			"int main(void) {\n"+
+			"  increment();\n"+
+			"  printf(\"counter=%d\\n\", counter);\n"+
+			"  return 0;\n"+
+			"}\n")))
+	executable := tmp.Join("main")
+	require.NoError(t, exec.Command(gccPath, "-g", "-O0", "-o", executable.String(), source.String()).Run())
+
+	dapInReader, dapInWriter := io.Pipe()
+	dapOutReader, dapOutWriter := io.Pipe()
+	session := newDAPSession(dapInReader, dapOutWriter, []string{gdbPath, "--interpreter=mi2", "-nx", executable.String()}, nil)
+	go func() {
+		session.serve()
+		dapOutWriter.Close()
+	}()
+	client := newDAPTestClient(t, dapInWriter, dapOutReader)
+
+	client.request("initialize", map[string]interface{}{"adapterID": "arduino"})
+	client.request("launch", map[string]interface{}{})
+	client.event("initialized")
+	res := client.request("setBreakpoints", map[string]interface{}{
+		"source":      map[string]interface{}{"path": source.String()},
+		"breakpoints": []map[string]interface{}{{"line": 4}},
+	})
+	require.True(t, res.(*dap.SetBreakpointsResponse).Body.Breakpoints[0].Verified)
+	client.request("configurationDone", nil)
+
+	// Skip the output of GDB
+	var stopped *dap.StoppedEvent
+	for stopped == nil {
+		switch event := client.next().(type) {
+		case *dap.StoppedEvent:
+			stopped = event
+		case *dap.OutputEvent:
+		default:
+			require.FailNow(t, "unexpected message", "%#v", event)
+		}
+	}
+	require.Equal(t, "breakpoint", stopped.Body.Reason)
+
+	res = client.request("stackTrace", map[string]interface{}{"threadId": stopped.Body.ThreadId})
+	frames := res.(*dap.StackTraceResponse).Body.StackFrames
+	require.GreaterOrEqual(t, len(frames), 2)
+	require.Equal(t, "increment", frames[0].Name)
+	require.Equal(t, 4, frames[0].Line)
+	require.Equal(t, "main", frames[1].Name)
+
+	res = client.request("evaluate", map[string]interface{}{"expression": "counter + 1", "frameId": frames[0].Id})
+	require.Equal(t, "1", res.(*dap.EvaluateResponse).Body.Result)
+
+	client.request("continue", map[string]interface{}{"threadId": stopped.Body.ThreadId})
+	output := ""
+	for {
+		msg := client.next()
+		if event, ok := msg.(*dap.OutputEvent); ok {
+			output += event.Body.Output
+			continue
+		}
+		exited, ok := msg.(*dap.ExitedEvent)
+		require.True(t, ok, "expected exited event")
+		require.Equal(t, 0, exited.Body.ExitCode)
+		break
+	}
+	require.Contains(t, output, "counter=1")
+	client.event("terminated")
+	client.request("disconnect", nil)
+}
@@ -40,12 +40,18 @@ var tr = i18n.Tr
 // gRPC In -> tool stdIn
 // grpc Out <- tool stdOut
 // grpc Out <- tool stdErr
-// It also implements tool process lifecycle management
+// It also implements tool process lifecycle management.
+// If req.Dap is set a Debug Adapter Protocol session is run on the streams
+// instead, and the interrupt channel is ignored.
 func Debug(ctx context.Context, req *dbg.DebugConfigRequest, inStream io.Reader, out io.Writer, interrupt <-chan os.Signal) (*dbg.DebugResponse, error) {
 
-	// Get debugging command line to run debugger
 	pm := commands.GetPackageManager(req.GetInstance().GetId())
-	commandLine, err := getCommandLine(req, pm)
+	if req.GetDap() {
+		return debugAdapter(req, pm, inStream, out)
+	}
+
+	// Get debugging command line to run debugger
+	commandLine, server, err := getCommandLine(req, pm)
 	if err != nil {
 		return nil, err
 	}
@@ -54,6 +60,15 @@ func Debug(ctx context.Context, req *dbg.DebugConfigRequest, inStream io.Reader,
 		fmt.Printf("%2d: %s\n", i, arg)
 	}
 
+	// Run the GDB server, if it's not started by GDB itself
+	if server.commandLine != nil {
+		stopServer, err := startGDBServer(server, out)
+		if err != nil {
+			return nil, err
+		}
+		defer stopServer()
+	}
+
 	// Run Tool
 	entry := logrus.NewEntry(logrus.StandardLogger())
 	for i, param := range commandLine {
@@ -110,27 +125,22 @@ func Debug(ctx context.Context, req *dbg.DebugConfigRequest, inStream io.Reader,
 	return &dbg.DebugResponse{}, nil
 }
 
-// getCommandLine compose a debug command represented by a core recipe
-func getCommandLine(req *dbg.DebugConfigRequest, pm *packagemanager.PackageManager) ([]string, error) {
+// getCommandLine compose a debug command represented by a core recipe. The
+// configuration of the GDB server, that may have to run in background, is
+// returned too.
+func getCommandLine(req *dbg.DebugConfigRequest, pm *packagemanager.PackageManager) ([]string, *gdbServerConfig, error) {
 	debugInfo, err := getDebugProperties(req, pm)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	cmdArgs := []string{}
 	add := func(s string) { cmdArgs = append(cmdArgs, s) }
 
 	// Add path to GDB Client to command line
-	var gdbPath *paths.Path
-	switch debugInfo.GetToolchain() {
-	case "gcc":
-		gdbexecutable := debugInfo.ToolchainPrefix + "gdb"
-		if runtime.GOOS == "windows" {
-			gdbexecutable += ".exe"
-		}
-		gdbPath = paths.New(debugInfo.ToolchainPath).Join(gdbexecutable)
-	default:
-		return nil, &commands.FailedDebugError{Message: tr("Toolchain '%s' is not supported", debugInfo.GetToolchain())}
+	gdbPath, err := getGDBPath(debugInfo)
+	if err != nil {
+		return nil, nil, err
 	}
 	add(gdbPath.String())
 
@@ -149,27 +159,14 @@ func getCommandLine(req *dbg.DebugConfigRequest, pm *packagemanager.PackageManag
 	add("-ex")
 	add("set remotetimeout 5")
 
-	// Extract path to GDB Server
-	switch debugInfo.GetServer() {
-	case "openocd":
-		serverCmd := fmt.Sprintf(`target extended-remote | "%s"`, debugInfo.ServerPath)
-
-		if cfg := debugInfo.ServerConfiguration["scripts_dir"]; cfg != "" {
-			serverCmd += fmt.Sprintf(` -s "%s"`, cfg)
-		}
-
-		if script := debugInfo.ServerConfiguration["script"]; script != "" {
-			serverCmd += fmt.Sprintf(` --file "%s"`, script)
-		}
-
-		serverCmd += ` -c "gdb_port pipe"`
-		serverCmd += ` -c "telnet_port 0"`
-
+	// Connect to the GDB Server
+	server, err := getGDBServerConfig(debugInfo)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, targetCommand := range server.targetCommands {
 		add("-ex")
-		add(serverCmd)
-
-	default:
-		return nil, &commands.FailedDebugError{Message: tr("GDB server '%s' is not supported", debugInfo.GetServer())}
+		add(targetCommand)
 	}
 
 	// Add executable
@@ -180,6 +177,27 @@ func getCommandLine(req *dbg.DebugConfigRequest, pm *packagemanager.PackageManag
 	for i, param := range cmdArgs {
 		cmdArgs[i] = filepath.ToSlash(param)
 	}
+	for i, param := range server.commandLine {
+		server.commandLine[i] = filepath.ToSlash(param)
+	}
+
+	return cmdArgs, server, nil
+}
 
-	return cmdArgs, nil
+// getGDBPath returns the path to the GDB client of the toolchain. The
+// `debug.toolchain.<name>.gdb` property, if set, overrides the default path.
+func getGDBPath(debugInfo *dbg.GetDebugConfigResponse) (*paths.Path, error) {
+	if gdb := debugInfo.GetToolchainConfiguration()["gdb"]; gdb != "" {
+		return paths.New(gdb), nil
+	}
+	switch debugInfo.GetToolchain() {
+	case "gcc":
+		gdbexecutable := debugInfo.ToolchainPrefix + "gdb"
+		if runtime.GOOS == "windows" {
+			gdbexecutable += ".exe"
+		}
+		return paths.New(debugInfo.ToolchainPath).Join(gdbexecutable), nil
+	default:
+		return nil, &commands.FailedDebugError{Message: tr("Toolchain '%s' is not supported", debugInfo.GetToolchain())}
+	}
 }
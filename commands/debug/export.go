@@ -0,0 +1,350 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package debug
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/commands"
+	dbg "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/debug/v1"
+	"github.com/arduino/go-paths-helper"
+)
+
+// ExportFormats are the editor formats supported by ExportDebugConfig
+var ExportFormats = []string{"vscode", "clion", "gdbinit"}
+
+// ExportDebugConfig writes the debug configuration of a sketch into the sketch
+// folder, in the format used by an editor:
+// - "vscode": a cortex-debug or cppdbg configuration in .vscode/launch.json
+// - "clion": an Embedded GDB Server run configuration in .idea/runConfigurations
+// - "gdbinit": a GDB script in .gdbinit
+// It returns the path of the written file.
+func ExportDebugConfig(ctx context.Context, req *dbg.DebugConfigRequest, format string) (*paths.Path, error) {
+	pm := commands.GetPackageManager(req.GetInstance().GetId())
+	return exportDebugConfig(req, pm, format)
+}
+
+func exportDebugConfig(req *dbg.DebugConfigRequest, pm *packagemanager.PackageManager, format string) (*paths.Path, error) {
+	export, ok := map[string]func(*debugExport) (*paths.Path, error){
+		"vscode":  exportVSCode,
+		"clion":   exportCLion,
+		"gdbinit": exportGDBInit,
+	}[format]
+	if !ok {
+		return nil, &commands.InvalidArgumentError{Message: tr("Invalid export format '%[1]s', supported formats are: %[2]s", format, strings.Join(ExportFormats, ", "))}
+	}
+
+	debugInfo, err := getDebugProperties(req, pm)
+	if err != nil {
+		return nil, err
+	}
+	sk, err := sketch.New(paths.New(req.GetSketchPath()))
+	if err != nil {
+		return nil, &commands.CantOpenSketchError{Cause: err}
+	}
+	gdbPath, err := getGDBPath(debugInfo)
+	if err != nil {
+		return nil, err
+	}
+	server, err := getGDBServerConfig(debugInfo)
+	if err != nil {
+		return nil, err
+	}
+	// Use forward slashes as done for the debug session
+	for i, arg := range server.targetCommands {
+		server.targetCommands[i] = filepath.ToSlash(arg)
+	}
+	for i, arg := range server.commandLine {
+		server.commandLine[i] = filepath.ToSlash(arg)
+	}
+	return export(&debugExport{
+		name:      "Arduino: " + sk.Name,
+		sketchDir: sk.FullPath,
+		debugInfo: debugInfo,
+		gdbPath:   gdbPath,
+		server:    server,
+	})
+}
+
+type debugExport struct {
+	name      string
+	sketchDir *paths.Path
+	debugInfo *dbg.GetDebugConfigResponse
+	gdbPath   *paths.Path
+	server    *gdbServerConfig
+}
+
+func writeExportFile(file *paths.Path, data []byte) (*paths.Path, error) {
+	if err := file.Parent().MkdirAll(); err != nil {
+		return nil, &commands.PermissionDeniedError{Message: tr("Cannot create directory"), Cause: err}
+	}
+	if err := file.WriteFile(data); err != nil {
+		return nil, &commands.PermissionDeniedError{Message: tr("Cannot write file %s", file), Cause: err}
+	}
+	return file, nil
+}
+
+// exportVSCode adds the launch configuration to the .vscode/launch.json file,
+// replacing the configuration with the same name if already present
+func exportVSCode(e *debugExport) (*paths.Path, error) {
+	config, err := vscodeLaunchConfiguration(e)
+	if err != nil {
+		return nil, err
+	}
+
+	launchFile := e.sketchDir.Join(".vscode", "launch.json")
+	launch := map[string]interface{}{"version": "0.2.0"}
+	if launchFile.Exist() {
+		data, err := launchFile.ReadFile()
+		if err != nil {
+			return nil, &commands.PermissionDeniedError{Message: tr("Cannot read file %s", launchFile), Cause: err}
+		}
+		if err := json.Unmarshal(data, &launch); err != nil {
+			return nil, &commands.InvalidArgumentError{Message: tr("Cannot update %s, it's not a valid JSON file", launchFile), Cause: err}
+		}
+	}
+	configurations, _ := launch["configurations"].([]interface{})
+	replaced := false
+	for i, c := range configurations {
+		if existing, ok := c.(map[string]interface{}); ok && existing["name"] == e.name {
+			configurations[i] = config
+			replaced = true
+		}
+	}
+	if !replaced {
+		configurations = append(configurations, config)
+	}
+	launch["configurations"] = configurations
+
+	data, err := json.MarshalIndent(launch, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return writeExportFile(launchFile, append(data, '\n'))
+}
+
+// vscodeLaunchConfiguration returns a cortex-debug launch configuration for
+// the GDB servers supported by the extension, a cppdbg one otherwise
+func vscodeLaunchConfiguration(e *debugExport) (map[string]interface{}, error) {
+	info := e.debugInfo
+	cfg := info.GetServerConfiguration()
+	config := map[string]interface{}{
+		"name":    e.name,
+		"request": "launch",
+		"cwd":     "${workspaceFolder}",
+	}
+
+	cortexServerTypes := map[string]string{
+		"openocd":    "openocd",
+		"pyocd":      "pyocd",
+		"jlink":      "jlink",
+		"blackmagic": "bmp",
+	}
+	serverType, ok := cortexServerTypes[info.GetServer()]
+	if !ok {
+		config["type"] = "cppdbg"
+		config["program"] = info.GetExecutable()
+		config["MIMode"] = "gdb"
+		config["miDebuggerPath"] = e.gdbPath.String()
+		commands := []map[string]string{{"text": "file " + shellQuoteArgs([]string{info.GetExecutable()})}}
+		for _, targetCommand := range e.server.targetCommands {
+			commands = append(commands, map[string]string{"text": targetCommand})
+		}
+		config["customLaunchSetupCommands"] = commands
+		config["launchCompleteCommand"] = "None"
+		return config, nil
+	}
+
+	config["type"] = "cortex-debug"
+	config["executable"] = info.GetExecutable()
+	config["servertype"] = serverType
+	config["gdbPath"] = e.gdbPath.String()
+	if info.GetToolchain() == "gcc" {
+		config["armToolchainPath"] = info.GetToolchainPath()
+		config["toolchainPrefix"] = strings.TrimSuffix(info.GetToolchainPrefix(), "-")
+	}
+	if info.GetServerPath() != "" {
+		config["serverpath"] = info.GetServerPath()
+	}
+	serverArgs := []string{}
+	switch info.GetServer() {
+	case "openocd":
+		if script := cfg["script"]; script != "" {
+			config["configFiles"] = []string{script}
+		}
+		if scriptsDir := cfg["scripts_dir"]; scriptsDir != "" {
+			config["searchDir"] = []string{scriptsDir}
+		}
+	case "pyocd":
+		if target := cfg["target"]; target != "" {
+			config["targetId"] = target
+		}
+		if probe := cfg["probe"]; probe != "" {
+			config["boardId"] = probe
+		}
+	case "jlink":
+		config["device"] = cfg["device"]
+		if iface := cfg["interface"]; iface != "" {
+			config["interface"] = strings.ToLower(iface)
+		}
+		if speed := cfg["speed"]; speed != "" {
+			serverArgs = append(serverArgs, "-speed", speed)
+		}
+	case "blackmagic":
+		config["BMPGDBSerialPort"] = cfg["port"]
+		config["interface"] = "swd"
+		if cfg["scan"] == "jtag_scan" {
+			config["interface"] = "jtag"
+		}
+	}
+	if info.GetServer() != "blackmagic" {
+		args, err := serverExtraArgs(info)
+		if err != nil {
+			return nil, err
+		}
+		serverArgs = append(serverArgs, args...)
+	}
+	if len(serverArgs) > 0 {
+		config["serverArgs"] = serverArgs
+	}
+	return config, nil
+}
+
+type clionComponent struct {
+	XMLName       xml.Name           `xml:"component"`
+	Name          string             `xml:"name,attr"`
+	Configuration clionConfiguration `xml:"configuration"`
+}
+
+type clionConfiguration struct {
+	Default     bool            `xml:"default,attr"`
+	Name        string          `xml:"name,attr"`
+	Type        string          `xml:"type,attr"`
+	FactoryName string          `xml:"factoryName,attr"`
+	RunPath     string          `xml:"RUN_PATH,attr"`
+	GDBServer   clionGDBServer  `xml:"custom-gdb-server"`
+	Method      clionRunMethods `xml:"method"`
+}
+
+type clionGDBServer struct {
+	Version       string        `xml:"version,attr"`
+	GDBServer     string        `xml:"gdb-server,attr,omitempty"`
+	GDBServerArgs string        `xml:"gdb-server-args,attr,omitempty"`
+	RemoteCommand string        `xml:"remote-command,attr"`
+	WarmupMs      string        `xml:"warmup-ms,attr"`
+	DownloadType  string        `xml:"download-type,attr"`
+	ResetType     string        `xml:"reset-type,attr"`
+	Debugger      clionDebugger `xml:"debugger"`
+}
+
+type clionDebugger struct {
+	Kind      string `xml:"kind,attr"`
+	IsBundled bool   `xml:"isBundled,attr"`
+	Path      string `xml:"path,attr"`
+}
+
+type clionRunMethods struct {
+	V string `xml:"v,attr"`
+}
+
+// exportCLion writes an Embedded GDB Server run configuration in the
+// .idea/runConfigurations folder
+func exportCLion(e *debugExport) (*paths.Path, error) {
+	if len(e.server.targetCommands) != 1 {
+		return nil, &commands.FailedDebugError{Message: tr("The GDB server '%s' can't be configured in CLion", e.debugInfo.GetServer())}
+	}
+	// CLion wants the arguments of the "target remote" command
+	remote := e.server.targetCommands[0]
+	for _, prefix := range []string{"target extended-remote ", "target remote "} {
+		remote = strings.TrimPrefix(remote, prefix)
+	}
+	if strings.HasPrefix(remote, "localhost:") {
+		remote = "tcp:" + remote
+	}
+
+	server := clionGDBServer{
+		Version:       "1",
+		RemoteCommand: remote,
+		WarmupMs:      "0",
+		DownloadType:  "NONE",
+		ResetType:     "NONE",
+		Debugger:      clionDebugger{Kind: "GDB", Path: e.gdbPath.String()},
+	}
+	if cmd := e.server.commandLine; len(cmd) > 0 {
+		server.GDBServer = cmd[0]
+		server.GDBServerArgs = shellQuoteArgs(cmd[1:])
+	}
+
+	data, err := xml.MarshalIndent(&clionComponent{
+		Name: "ProjectRunConfigurationManager",
+		Configuration: clionConfiguration{
+			Name:        e.name,
+			Type:        "com.jetbrains.cidr.embedded.customgdbserver.type",
+			FactoryName: "com.jetbrains.cidr.embedded.customgdbserver.factory",
+			RunPath:     e.debugInfo.GetExecutable(),
+			GDBServer:   server,
+			Method:      clionRunMethods{V: "2"},
+		},
+	}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	fileName := strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			return r
+		}
+		return '_'
+	}, e.name) + ".xml"
+	return writeExportFile(e.sketchDir.Join(".idea", "runConfigurations", fileName), append(data, '\n'))
+}
+
+// exportGDBInit writes a .gdbinit script that loads the executable and
+// connects to the board
+func exportGDBInit(e *debugExport) (*paths.Path, error) {
+	var script strings.Builder
+	fmt.Fprintf(&script, "# %s\n", e.name)
+	fmt.Fprintf(&script, "# %s\n", tr("Start the debugger with: %s", shellQuoteArgs([]string{e.gdbPath.String(), "-x", ".gdbinit"})))
+	if cmd := e.server.commandLine; len(cmd) > 0 {
+		fmt.Fprintf(&script, "# %s\n", tr("after starting the GDB server with: %s", shellQuoteArgs(cmd)))
+	}
+	fmt.Fprintf(&script, "file %s\n", shellQuoteArgs([]string{e.debugInfo.GetExecutable()}))
+	fmt.Fprintf(&script, "set remotetimeout 5\n")
+	for _, targetCommand := range e.server.targetCommands {
+		fmt.Fprintf(&script, "%s\n", targetCommand)
+	}
+	return writeExportFile(e.sketchDir.Join(".gdbinit"), []byte(script.String()))
+}
+
+// shellQuoteArgs joins the arguments into a command line, quoting them if
+// needed
+func shellQuoteArgs(args []string) string {
+	res := []string{}
+	for _, arg := range args {
+		if arg == "" || strings.ContainsAny(arg, " \t\"'\\$") {
+			arg = `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, `$`, `\$`).Replace(arg) + `"`
+		}
+		res = append(res, arg)
+	}
+	return strings.Join(res, " ")
+}
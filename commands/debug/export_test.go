@@ -0,0 +1,191 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package debug
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	dbg "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/debug/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportDebugConfig(t *testing.T) {
+	pm := packagemanager.NewPackageManager(nil, nil, nil, nil)
+	pm.LoadHardwareFromDirectory(paths.New("testdata", "custom_hardware"))
+	pm.LoadHardwareFromDirectory(paths.New("testdata", "data_dir", "packages"))
+
+	// Export into a copy of the sketch to keep the testdata clean
+	tmp, err := paths.MkTempDir("", "debug_export")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	sketchPath := tmp.Join("hello")
+	require.NoError(t, paths.New("testdata", "hello").CopyDirTo(sketchPath))
+	importDir := sketchPath.Join("build", "arduino-test.samd.arduino_zero_edbg")
+	req := &dbg.DebugConfigRequest{
+		Instance:   &rpc.Instance{Id: 1},
+		Fqbn:       "arduino-test:samd:arduino_zero_edbg",
+		SketchPath: sketchPath.String(),
+		ImportDir:  importDir.String(),
+	}
+	debugInfo, err := getDebugProperties(req, pm)
+	require.NoError(t, err)
+
+	// VS Code
+	launchFile := sketchPath.Join(".vscode", "launch.json")
+	require.NoError(t, launchFile.Parent().MkdirAll())
+	require.NoError(t, launchFile.WriteFile([]byte(`{
+  "version": "0.2.0",
+  "configurations": [
+    {"name": "Other", "type": "node"},
+    {"name": "Arduino: hello", "type": "cortex-debug", "executable": "old.elf"}
+  ]
+}`)))
+	file, err := exportDebugConfig(req, pm, "vscode")
+	require.NoError(t, err)
+	require.Equal(t, launchFile, file)
+	data, err := file.ReadFile()
+	require.NoError(t, err)
+	var launch struct {
+		Version        string                   `json:"version"`
+		Configurations []map[string]interface{} `json:"configurations"`
+	}
+	require.NoError(t, json.Unmarshal(data, &launch))
+	require.Equal(t, "0.2.0", launch.Version)
+	require.Len(t, launch.Configurations, 2)
+	require.Equal(t, "Other", launch.Configurations[0]["name"])
+	config := launch.Configurations[1]
+	require.Equal(t, "Arduino: hello", config["name"])
+	require.Equal(t, "cortex-debug", config["type"])
+	require.Equal(t, "openocd", config["servertype"])
+	require.Equal(t, debugInfo.GetExecutable(), config["executable"])
+	require.Equal(t, debugInfo.GetServerPath(), config["serverpath"])
+	require.Equal(t, debugInfo.GetToolchainPath(), config["armToolchainPath"])
+	require.Equal(t, "arm-none-eabi", config["toolchainPrefix"])
+	require.Equal(t, []interface{}{debugInfo.GetServerConfiguration()["script"]}, config["configFiles"])
+	require.Equal(t, []interface{}{debugInfo.GetServerConfiguration()["scripts_dir"]}, config["searchDir"])
+
+	// An invalid launch.json is not overwritten
+	require.NoError(t, launchFile.WriteFile([]byte(`{ // comment`)))
+	_, err = exportDebugConfig(req, pm, "vscode")
+	require.Error(t, err)
+	data, err = launchFile.ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, `{ // comment`, string(data))
+
+	// CLion
+	file, err = exportDebugConfig(req, pm, "clion")
+	require.NoError(t, err)
+	require.Equal(t, sketchPath.Join(".idea", "runConfigurations", "Arduino__hello.xml"), file)
+	data, err = file.ReadFile()
+	require.NoError(t, err)
+	require.Contains(t, string(data), `type="com.jetbrains.cidr.embedded.customgdbserver.type"`)
+	require.Contains(t, string(data), `remote-command="| &#34;`)
+	require.Contains(t, string(data), `RUN_PATH="`+debugInfo.GetExecutable()+`"`)
+
+	// .gdbinit
+	file, err = exportDebugConfig(req, pm, "gdbinit")
+	require.NoError(t, err)
+	require.Equal(t, sketchPath.Join(".gdbinit"), file)
+	data, err = file.ReadFile()
+	require.NoError(t, err)
+	require.Contains(t, string(data), "\nfile ")
+	require.Contains(t, string(data), "\ntarget extended-remote | ")
+
+	_, err = exportDebugConfig(req, pm, "eclipse")
+	require.EqualError(t, err, "Invalid export format 'eclipse', supported formats are: vscode, clion, gdbinit")
+}
+
+func TestExportGDBServers(t *testing.T) {
+	newExport := func(server string, config map[string]string) *debugExport {
+		info := &dbg.GetDebugConfigResponse{
+			Executable:          "/build/Sketch.ino.elf",
+			Toolchain:           "gcc",
+			ToolchainPath:       "/tools/gcc/bin",
+			ToolchainPrefix:     "arm-none-eabi-",
+			Server:              server,
+			ServerPath:          config["path"],
+			ServerConfiguration: config,
+		}
+		serverConfig, err := getGDBServerConfig(info)
+		require.NoError(t, err)
+		return &debugExport{
+			name:      "Arduino: Sketch",
+			debugInfo: info,
+			gdbPath:   paths.New("/tools/gcc/bin/arm-none-eabi-gdb"),
+			server:    serverConfig,
+		}
+	}
+
+	jlink := newExport("jlink", map[string]string{"path": "/tools/JLinkGDBServerCLExe", "device": "ATSAMD21G18", "interface": "JTAG", "speed": "4000"})
+	config, err := vscodeLaunchConfiguration(jlink)
+	require.NoError(t, err)
+	require.Equal(t, "jlink", config["servertype"])
+	require.Equal(t, "ATSAMD21G18", config["device"])
+	require.Equal(t, "jtag", config["interface"])
+	require.Equal(t, []string{"-speed", "4000"}, config["serverArgs"])
+
+	bmp := newExport("blackmagic", map[string]string{"port": "/dev/ttyACM0"})
+	config, err = vscodeLaunchConfiguration(bmp)
+	require.NoError(t, err)
+	require.Equal(t, "bmp", config["servertype"])
+	require.Equal(t, "/dev/ttyACM0", config["BMPGDBSerialPort"])
+	require.NotContains(t, config, "serverpath")
+
+	// The servers not supported by cortex-debug use cppdbg
+	remote := newExport("gdbserver", map[string]string{"address": "localhost:2345"})
+	config, err = vscodeLaunchConfiguration(remote)
+	require.NoError(t, err)
+	require.Equal(t, "cppdbg", config["type"])
+	require.Equal(t, "/build/Sketch.ino.elf", config["program"])
+	require.Equal(t, []map[string]string{
+		{"text": "file /build/Sketch.ino.elf"},
+		{"text": "target extended-remote localhost:2345"},
+	}, config["customLaunchSetupCommands"])
+
+	// The CLion configuration needs a single target command
+	tmp, err := paths.MkTempDir("", "debug_export")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	bmp.sketchDir = tmp
+	_, err = exportCLion(bmp)
+	require.Error(t, err)
+
+	jlink.sketchDir = tmp
+	file, err := exportCLion(jlink)
+	require.NoError(t, err)
+	data, err := file.ReadFile()
+	require.NoError(t, err)
+	require.Contains(t, string(data), `gdb-server="/tools/JLinkGDBServerCLExe"`)
+	require.Contains(t, string(data), `gdb-server-args="-nogui -device ATSAMD21G18 -if JTAG -speed 4000 -port 2331"`)
+	require.Contains(t, string(data), `remote-command="tcp:localhost:2331"`)
+
+	file, err = exportGDBInit(jlink)
+	require.NoError(t, err)
+	data, err = file.ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, "# Arduino: Sketch\n"+
+		"# Start the debugger with: "+shellQuoteArgs([]string{jlink.gdbPath.String(), "-x", ".gdbinit"})+"\n"+
+		"# after starting the GDB server with: /tools/JLinkGDBServerCLExe -nogui -device ATSAMD21G18 -if JTAG -speed 4000 -port 2331\n"+
+		"file /build/Sketch.ino.elf\n"+
+		"set remotetimeout 5\n"+
+		"target remote localhost:2331\n", string(data))
+
+	require.Equal(t, `gdb -x "/my sketch/.gdbinit" "say \"hi\""`, shellQuoteArgs([]string{"gdb", "-x", "/my sketch/.gdbinit", `say "hi"`}))
+}
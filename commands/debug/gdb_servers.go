@@ -0,0 +1,276 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package debug
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/executils"
+	dbg "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/debug/v1"
+	"github.com/arduino/go-properties-orderedmap"
+	"github.com/sirupsen/logrus"
+)
+
+// gdbServerConfig describes how GDB connects to the board through a GDB server
+type gdbServerConfig struct {
+	// commandLine is the GDB server to run in background while debugging, it
+	// is nil if GDB runs the server through a pipe or if the server is not a
+	// process managed by the CLI
+	commandLine []string
+	// address is the host:port where the server run with commandLine accepts
+	// the GDB connections, GDB is started once the server is listening
+	address string
+	// targetCommands are the GDB commands that connect to the board
+	targetCommands []string
+}
+
+// gdbServerStartTimeout is how long to wait for a GDB server run in
+// background to accept connections
+var gdbServerStartTimeout = 30 * time.Second
+
+// gdbServerBackend returns the gdbServerConfig of a GDB server, the options of
+// the server are read from the `debug.server.<name>.*` properties
+type gdbServerBackend func(debugInfo *dbg.GetDebugConfigResponse) (*gdbServerConfig, error)
+
+// gdbServerBackends is the registry of the supported GDB servers, by name
+var gdbServerBackends = map[string]gdbServerBackend{
+	"openocd":    openocdServer,
+	"pyocd":      pyocdServer,
+	"jlink":      jlinkServer,
+	"blackmagic": blackmagicServer,
+	"gdbserver":  remoteGDBServer,
+	"qemu":       qemuServer,
+}
+
+// getGDBServerConfig returns the gdbServerConfig of the GDB server selected
+// with the `debug.server` property
+func getGDBServerConfig(debugInfo *dbg.GetDebugConfigResponse) (*gdbServerConfig, error) {
+	backend, ok := gdbServerBackends[debugInfo.GetServer()]
+	if !ok {
+		supported := []string{}
+		for name := range gdbServerBackends {
+			supported = append(supported, name)
+		}
+		sort.Strings(supported)
+		return nil, &commands.FailedDebugError{Message: tr("GDB server '%[1]s' is not supported, the supported servers are: %[2]s", debugInfo.GetServer(), strings.Join(supported, ", "))}
+	}
+	return backend(debugInfo)
+}
+
+// serverOption returns the value of the `debug.server.<name>.<option>`
+// property, or the default value if not set. If the default value is empty
+// the option is required.
+func serverOption(debugInfo *dbg.GetDebugConfigResponse, option, defaultValue string) (string, error) {
+	if value := debugInfo.GetServerConfiguration()[option]; value != "" {
+		return value, nil
+	}
+	if defaultValue == "" {
+		return "", &commands.FailedDebugError{Message: tr("Missing property '%s' required by the GDB server", "debug.server."+debugInfo.GetServer()+"."+option)}
+	}
+	return defaultValue, nil
+}
+
+// serverPath returns the path of the GDB server executable, from the
+// `debug.server.<name>.path` property
+func serverPath(debugInfo *dbg.GetDebugConfigResponse) (string, error) {
+	if debugInfo.GetServerPath() == "" {
+		return "", &commands.FailedDebugError{Message: tr("Missing property '%s' required by the GDB server", "debug.server."+debugInfo.GetServer()+".path")}
+	}
+	return debugInfo.GetServerPath(), nil
+}
+
+// serverExtraArgs returns the additional command line arguments of the GDB
+// server, from the `debug.server.<name>.args` property
+func serverExtraArgs(debugInfo *dbg.GetDebugConfigResponse) ([]string, error) {
+	args, err := properties.SplitQuotedString(debugInfo.GetServerConfiguration()["args"], `"'`, false)
+	if err != nil {
+		return nil, &commands.FailedDebugError{Message: tr("Invalid property '%s'", "debug.server."+debugInfo.GetServer()+".args"), Cause: err}
+	}
+	return args, nil
+}
+
+// openocdServer runs OpenOCD through a pipe, configured with the path,
+// scripts_dir and script options
+func openocdServer(debugInfo *dbg.GetDebugConfigResponse) (*gdbServerConfig, error) {
+	path, err := serverPath(debugInfo)
+	if err != nil {
+		return nil, err
+	}
+	serverCmd := fmt.Sprintf(`target extended-remote | "%s"`, path)
+
+	if cfg := debugInfo.ServerConfiguration["scripts_dir"]; cfg != "" {
+		serverCmd += fmt.Sprintf(` -s "%s"`, cfg)
+	}
+
+	if script := debugInfo.ServerConfiguration["script"]; script != "" {
+		serverCmd += fmt.Sprintf(` --file "%s"`, script)
+	}
+
+	serverCmd += ` -c "gdb_port pipe"`
+	serverCmd += ` -c "telnet_port 0"`
+	return &gdbServerConfig{targetCommands: []string{serverCmd}}, nil
+}
+
+// pyocdServer runs the pyOCD GDB server in background, configured with the
+// path, target, probe, port and args options. The server is kept running
+// after a connection is closed, it's terminated by the CLI.
+func pyocdServer(debugInfo *dbg.GetDebugConfigResponse) (*gdbServerConfig, error) {
+	path, err := serverPath(debugInfo)
+	if err != nil {
+		return nil, err
+	}
+	port, _ := serverOption(debugInfo, "port", "3333")
+	cmd := []string{path, "gdbserver", "--persist", "--port", port}
+	if target := debugInfo.ServerConfiguration["target"]; target != "" {
+		cmd = append(cmd, "--target", target)
+	}
+	if probe := debugInfo.ServerConfiguration["probe"]; probe != "" {
+		cmd = append(cmd, "--uid", probe)
+	}
+	args, err := serverExtraArgs(debugInfo)
+	if err != nil {
+		return nil, err
+	}
+	return &gdbServerConfig{
+		commandLine:    append(cmd, args...),
+		address:        "localhost:" + port,
+		targetCommands: []string{"target remote localhost:" + port},
+	}, nil
+}
+
+// jlinkServer runs the SEGGER J-Link GDB server in background, configured with
+// the path, device, interface, speed, port and args options. The server is
+// kept running after a connection is closed, it's terminated by the CLI.
+func jlinkServer(debugInfo *dbg.GetDebugConfigResponse) (*gdbServerConfig, error) {
+	path, err := serverPath(debugInfo)
+	if err != nil {
+		return nil, err
+	}
+	device, err := serverOption(debugInfo, "device", "")
+	if err != nil {
+		return nil, err
+	}
+	iface, _ := serverOption(debugInfo, "interface", "SWD")
+	speed, _ := serverOption(debugInfo, "speed", "auto")
+	port, _ := serverOption(debugInfo, "port", "2331")
+	args, err := serverExtraArgs(debugInfo)
+	if err != nil {
+		return nil, err
+	}
+	cmd := []string{path, "-nogui", "-device", device, "-if", iface, "-speed", speed, "-port", port}
+	return &gdbServerConfig{
+		commandLine:    append(cmd, args...),
+		address:        "localhost:" + port,
+		targetCommands: []string{"target remote localhost:" + port},
+	}, nil
+}
+
+// blackmagicServer connects to the GDB server embedded in the Black Magic
+// Probe, configured with the port and scan options
+func blackmagicServer(debugInfo *dbg.GetDebugConfigResponse) (*gdbServerConfig, error) {
+	port, err := serverOption(debugInfo, "port", "")
+	if err != nil {
+		return nil, err
+	}
+	scan, _ := serverOption(debugInfo, "scan", "swdp_scan")
+	return &gdbServerConfig{targetCommands: []string{
+		"target extended-remote " + port,
+		"monitor " + scan,
+		"attach 1",
+	}}, nil
+}
+
+// remoteGDBServer connects to a GDB server already running at the host:port
+// given with the address option
+func remoteGDBServer(debugInfo *dbg.GetDebugConfigResponse) (*gdbServerConfig, error) {
+	address, err := serverOption(debugInfo, "address", "")
+	if err != nil {
+		return nil, err
+	}
+	return &gdbServerConfig{targetCommands: []string{"target extended-remote " + address}}, nil
+}
+
+// qemuServer runs the executable in the QEMU emulator through a pipe,
+// configured with the path, machine, cpu and args options
+func qemuServer(debugInfo *dbg.GetDebugConfigResponse) (*gdbServerConfig, error) {
+	path, err := serverPath(debugInfo)
+	if err != nil {
+		return nil, err
+	}
+	machine, err := serverOption(debugInfo, "machine", "")
+	if err != nil {
+		return nil, err
+	}
+	serverCmd := fmt.Sprintf(`target remote | "%s" -machine "%s"`, path, machine)
+	if cpu := debugInfo.ServerConfiguration["cpu"]; cpu != "" {
+		serverCmd += fmt.Sprintf(` -cpu "%s"`, cpu)
+	}
+	serverCmd += fmt.Sprintf(` -kernel "%s" -S -gdb stdio -display none`, debugInfo.Executable)
+	if args := debugInfo.ServerConfiguration["args"]; args != "" {
+		serverCmd += " " + args
+	}
+	return &gdbServerConfig{targetCommands: []string{serverCmd}}, nil
+}
+
+// startGDBServer runs the GDB server of server in background, its output is
+// written to out. If the server listens on an address, startGDBServer returns
+// once the server accepts connections, so that GDB can connect right away.
+// The returned function terminates the server.
+func startGDBServer(server *gdbServerConfig, out io.Writer) (func(), error) {
+	logrus.WithField("cmdline", server.commandLine).Debug("Executing GDB server")
+	process, err := executils.NewProcess(server.commandLine...)
+	if err != nil {
+		return nil, &commands.FailedDebugError{Message: tr("Cannot execute GDB server"), Cause: err}
+	}
+	process.RedirectStdoutTo(out)
+	process.RedirectStderrTo(out)
+	if err := process.Start(); err != nil {
+		return nil, &commands.FailedDebugError{Message: tr("Cannot execute GDB server"), Cause: err}
+	}
+	exited := make(chan error, 1)
+	go func() { exited <- process.Wait() }()
+	stop := func() {
+		process.Kill()
+		<-exited
+	}
+	if server.address == "" {
+		return stop, nil
+	}
+
+	deadline := time.Now().Add(gdbServerStartTimeout)
+	for {
+		conn, err := net.DialTimeout("tcp", server.address, time.Second)
+		if err == nil {
+			conn.Close()
+			return stop, nil
+		}
+		if time.Now().After(deadline) {
+			stop()
+			return nil, &commands.FailedDebugError{Message: tr("GDB server is not accepting connections on %s", server.address), Cause: err}
+		}
+		select {
+		case err := <-exited:
+			return nil, &commands.FailedDebugError{Message: tr("GDB server terminated before accepting connections"), Cause: err}
+		case <-time.After(200 * time.Millisecond):
+		}
+	}
+}
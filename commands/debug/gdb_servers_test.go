@@ -0,0 +1,202 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package debug
+
+import (
+	"bytes"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	dbg "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/debug/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGDBServerBackends(t *testing.T) {
+	debugInfo := func(server string, config map[string]string) *dbg.GetDebugConfigResponse {
+		return &dbg.GetDebugConfigResponse{
+			Executable:          "/build/Sketch.ino.elf",
+			Server:              server,
+			ServerPath:          config["path"],
+			ServerConfiguration: config,
+		}
+	}
+
+	tests := []struct {
+		info           *dbg.GetDebugConfigResponse
+		commandLine    []string
+		address        string
+		targetCommands []string
+	}{
+		{
+			info: debugInfo("openocd", map[string]string{"path": "/tools/openocd", "script": "/variant/board.cfg"}),
+			targetCommands: []string{
+				`target extended-remote | "/tools/openocd" --file "/variant/board.cfg" -c "gdb_port pipe" -c "telnet_port 0"`,
+			},
+		},
+		{
+			info:           debugInfo("pyocd", map[string]string{"path": "/tools/pyocd"}),
+			commandLine:    []string{"/tools/pyocd", "gdbserver", "--persist", "--port", "3333"},
+			address:        "localhost:3333",
+			targetCommands: []string{"target remote localhost:3333"},
+		},
+		{
+			info:           debugInfo("pyocd", map[string]string{"path": "/tools/pyocd", "target": "nrf52840", "probe": "0123", "port": "4444", "args": `--frequency 4000000 -O "connect_mode=under-reset"`}),
+			address:        "localhost:4444",
+			commandLine:    []string{"/tools/pyocd", "gdbserver", "--persist", "--port", "4444", "--target", "nrf52840", "--uid", "0123", "--frequency", "4000000", "-O", "connect_mode=under-reset"},
+			targetCommands: []string{"target remote localhost:4444"},
+		},
+		{
+			info:           debugInfo("jlink", map[string]string{"path": "/tools/JLinkGDBServerCLExe", "device": "ATSAMD21G18"}),
+			commandLine:    []string{"/tools/JLinkGDBServerCLExe", "-nogui", "-device", "ATSAMD21G18", "-if", "SWD", "-speed", "auto", "-port", "2331"},
+			address:        "localhost:2331",
+			targetCommands: []string{"target remote localhost:2331"},
+		},
+		{
+			info:           debugInfo("jlink", map[string]string{"path": "/tools/JLinkGDBServerCLExe", "device": "STM32F401RE", "interface": "JTAG", "speed": "4000", "port": "3000"}),
+			commandLine:    []string{"/tools/JLinkGDBServerCLExe", "-nogui", "-device", "STM32F401RE", "-if", "JTAG", "-speed", "4000", "-port", "3000"},
+			address:        "localhost:3000",
+			targetCommands: []string{"target remote localhost:3000"},
+		},
+		{
+			info:           debugInfo("blackmagic", map[string]string{"port": "/dev/ttyACM0"}),
+			targetCommands: []string{"target extended-remote /dev/ttyACM0", "monitor swdp_scan", "attach 1"},
+		},
+		{
+			info:           debugInfo("blackmagic", map[string]string{"port": "/dev/ttyACM0", "scan": "jtag_scan"}),
+			targetCommands: []string{"target extended-remote /dev/ttyACM0", "monitor jtag_scan", "attach 1"},
+		},
+		{
+			info:           debugInfo("gdbserver", map[string]string{"address": "192.168.1.10:2345"}),
+			targetCommands: []string{"target extended-remote 192.168.1.10:2345"},
+		},
+		{
+			info: debugInfo("qemu", map[string]string{"path": "/tools/qemu-system-arm", "machine": "lm3s6965evb", "cpu": "cortex-m3", "args": "-semihosting"}),
+			targetCommands: []string{
+				`target remote | "/tools/qemu-system-arm" -machine "lm3s6965evb" -cpu "cortex-m3" -kernel "/build/Sketch.ino.elf" -S -gdb stdio -display none -semihosting`,
+			},
+		},
+	}
+	for _, test := range tests {
+		server, err := getGDBServerConfig(test.info)
+		require.NoError(t, err, test.info.Server)
+		require.Equal(t, test.commandLine, server.commandLine, test.info.Server)
+		require.Equal(t, test.address, server.address, test.info.Server)
+		require.Equal(t, test.targetCommands, server.targetCommands, test.info.Server)
+	}
+
+	// Missing required options
+	for _, info := range []*dbg.GetDebugConfigResponse{
+		debugInfo("openocd", map[string]string{}),
+		debugInfo("pyocd", map[string]string{"target": "nrf52840"}),
+		debugInfo("jlink", map[string]string{"path": "/tools/JLinkGDBServerCLExe"}),
+		debugInfo("blackmagic", map[string]string{}),
+		debugInfo("gdbserver", map[string]string{}),
+		debugInfo("qemu", map[string]string{"path": "/tools/qemu-system-arm"}),
+	} {
+		_, err := getGDBServerConfig(info)
+		require.Error(t, err, info.Server)
+		require.Contains(t, err.Error(), "debug.server."+info.Server+".", info.Server)
+	}
+
+	_, err := getGDBServerConfig(debugInfo("pyocd", map[string]string{"path": "/tools/pyocd", "args": `"unterminated`}))
+	require.Error(t, err)
+
+	_, err = getGDBServerConfig(debugInfo("stlink", map[string]string{}))
+	require.EqualError(t, err, "GDB server 'stlink' is not supported, the supported servers are: blackmagic, gdbserver, jlink, openocd, pyocd, qemu")
+}
+
+func TestGetGDBPath(t *testing.T) {
+	gdbPath, err := getGDBPath(&dbg.GetDebugConfigResponse{
+		Toolchain:              "gcc",
+		ToolchainPath:          "/tools/gcc/bin",
+		ToolchainPrefix:        "arm-none-eabi-",
+		ToolchainConfiguration: map[string]string{"gdb": "/usr/bin/gdb-multiarch"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, filepath.FromSlash("/usr/bin/gdb-multiarch"), gdbPath.String())
+
+	gdbPath, err = getGDBPath(&dbg.GetDebugConfigResponse{
+		Toolchain:              "xtensa",
+		ToolchainConfiguration: map[string]string{"gdb": "/tools/xtensa-esp32-elf-gdb"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, filepath.FromSlash("/tools/xtensa-esp32-elf-gdb"), gdbPath.String())
+
+	_, err = getGDBPath(&dbg.GetDebugConfigResponse{Toolchain: "xtensa"})
+	require.Error(t, err)
+}
+
+// TestGDBServerHelperProcess is run as a fake GDB server by TestStartGDBServer,
+// it starts listening on GDB_SERVER_HELPER_ADDRESS after a while
+func TestGDBServerHelperProcess(t *testing.T) {
+	address := os.Getenv("GDB_SERVER_HELPER_ADDRESS")
+	if address == "" {
+		return
+	}
+	time.Sleep(500 * time.Millisecond)
+	if address == "none" {
+		time.Sleep(time.Minute)
+		return
+	}
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		os.Exit(1)
+	}
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			os.Exit(1)
+		}
+		conn.Close()
+	}
+}
+
+func TestStartGDBServer(t *testing.T) {
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	listener.Close()
+
+	defer func(timeout time.Duration) { gdbServerStartTimeout = timeout }(gdbServerStartTimeout)
+	gdbServerStartTimeout = 10 * time.Second
+	helper := []string{os.Args[0], "-test.run=TestGDBServerHelperProcess"}
+	out := &bytes.Buffer{}
+
+	// The server is started once it accepts connections
+	os.Setenv("GDB_SERVER_HELPER_ADDRESS", address)
+	defer os.Unsetenv("GDB_SERVER_HELPER_ADDRESS")
+	stop, err := startGDBServer(&gdbServerConfig{commandLine: helper, address: address}, out)
+	require.NoError(t, err)
+	conn, err := net.Dial("tcp", address)
+	require.NoError(t, err)
+	conn.Close()
+	stop()
+
+	// The server terminated before accepting connections
+	start := time.Now()
+	_, err = startGDBServer(&gdbServerConfig{commandLine: []string{os.Args[0], "-test.run=NoTest"}, address: address}, out)
+	require.Error(t, err)
+	require.Less(t, int64(time.Since(start)), int64(gdbServerStartTimeout))
+
+	// The server doesn't accept connections in time
+	gdbServerStartTimeout = time.Second
+	os.Setenv("GDB_SERVER_HELPER_ADDRESS", "none")
+	_, err = startGDBServer(&gdbServerConfig{commandLine: helper, address: address}, out)
+	require.Error(t, err)
+}
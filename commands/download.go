@@ -16,13 +16,29 @@
 package commands
 
 import (
+	"errors"
+	"net/http"
+	"sync"
 	"time"
 
+	"github.com/arduino/arduino-cli/arduino/resources"
 	"github.com/arduino/arduino-cli/httpclient"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/sirupsen/logrus"
 	"go.bug.st/downloader/v2"
 )
 
+// downloadRetries is the number of times a failed download is started again
+const downloadRetries = 3
+
+// downloadRetryDelay is the time to wait before the first retry of a failed
+// download, it's doubled at each following retry
+var downloadRetryDelay = 2 * time.Second
+
+// parallelDownloads is the maximum number of downloads run at the same time
+// by DownloadParallel
+const parallelDownloads = 4
+
 // GetDownloaderConfig returns the downloader configuration based on
 // current settings.
 func GetDownloaderConfig() (*downloader.Config, error) {
@@ -64,3 +80,119 @@ func Download(d *downloader.Downloader, label string, downloadCB DownloadProgres
 	downloadCB(&rpc.DownloadProgress{Completed: true})
 	return nil
 }
+
+// DownloadWithRetry performs the download returned by start using Download. If
+// the download fails for a network or a server error it's started again, a few
+// times with an increasing delay: the downloads that support it resume from
+// where the previous attempt stopped.
+func DownloadWithRetry(start func() (*downloader.Downloader, error), label string, downloadCB DownloadProgressCB) error {
+	delay := downloadRetryDelay
+	for retry := 0; ; retry++ {
+		d, err := start()
+		if err == nil {
+			err = Download(d, label, downloadCB)
+		}
+		if err == nil || retry == downloadRetries || !isDownloadRetryable(d, err) {
+			return err
+		}
+		logrus.WithError(err).WithField("retry", retry+1).Warnf("Download of %s failed, retrying in %s", label, delay)
+		time.Sleep(delay)
+		delay *= 2
+	}
+}
+
+// isDownloadRetryable returns false if the server refused the download, in
+// this case retrying doesn't help
+func isDownloadRetryable(d *downloader.Downloader, err error) bool {
+	code := 0
+	var statusErr *resources.DownloadStatusError
+	if errors.As(err, &statusErr) {
+		code = statusErr.StatusCode
+	} else if d != nil && d.Resp != nil {
+		code = d.Resp.StatusCode
+	}
+	switch {
+	case code == http.StatusRequestTimeout, code == http.StatusTooManyRequests:
+		return true
+	case code >= 400 && code <= 499:
+		return false
+	}
+	return true
+}
+
+// DownloadParallel runs the given downloads, at most parallelDownloads at the
+// same time, and returns the error of the first one that failed. The progress
+// of the downloads is reported one download at a time, in the given order, so
+// downloadCB receives the same messages of downloads run one after another.
+func DownloadParallel(downloads []func(DownloadProgressCB) error, downloadCB DownloadProgressCB) error {
+	progress := newProgressSequencer(len(downloads), downloadCB)
+	errs := make([]error, len(downloads))
+	slots := make(chan bool, parallelDownloads)
+	var wg sync.WaitGroup
+	for i, download := range downloads {
+		wg.Add(1)
+		slots <- true
+		go func(i int, download func(DownloadProgressCB) error) {
+			defer wg.Done()
+			errs[i] = download(progress.callback(i))
+			progress.done(i)
+			<-slots
+		}(i, download)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// progressSequencer forwards the progress of parallel downloads one download at
+// a time: the messages of a download are held until all the previous downloads
+// are done.
+type progressSequencer struct {
+	mux      sync.Mutex
+	cb       DownloadProgressCB
+	current  int
+	pending  [][]*rpc.DownloadProgress
+	finished []bool
+}
+
+func newProgressSequencer(n int, cb DownloadProgressCB) *progressSequencer {
+	return &progressSequencer{
+		cb:       cb,
+		pending:  make([][]*rpc.DownloadProgress, n),
+		finished: make([]bool, n),
+	}
+}
+
+// callback returns the DownloadProgressCB of the i-th download
+func (s *progressSequencer) callback(i int) DownloadProgressCB {
+	return func(progress *rpc.DownloadProgress) {
+		s.mux.Lock()
+		defer s.mux.Unlock()
+		if i == s.current {
+			s.cb(progress)
+		} else {
+			s.pending[i] = append(s.pending[i], progress)
+		}
+	}
+}
+
+// done marks the i-th download as finished and forwards the held messages of
+// the downloads that follow it
+func (s *progressSequencer) done(i int) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.finished[i] = true
+	for s.current < len(s.finished) && s.finished[s.current] {
+		s.current++
+		if s.current < len(s.pending) {
+			for _, progress := range s.pending[s.current] {
+				s.cb(progress)
+			}
+			s.pending[s.current] = nil
+		}
+	}
+}
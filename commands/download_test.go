@@ -0,0 +1,168 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package commands
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino/resources"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+	"go.bug.st/downloader/v2"
+)
+
+func TestDownloadWithRetry(t *testing.T) {
+	defer func(delay time.Duration) { downloadRetryDelay = delay }(downloadRetryDelay)
+	downloadRetryDelay = time.Millisecond
+
+	failures := 0
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+		} else if requests <= failures {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		} else {
+			w.Write([]byte("archive"))
+		}
+	}))
+	defer srv.Close()
+
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	download := func(path string) error {
+		return DownloadWithRetry(func() (*downloader.Downloader, error) {
+			return downloader.Download(tmp.Join("archive").String(), srv.URL+path, downloader.NoResume)
+		}, "archive", func(*rpc.DownloadProgress) {})
+	}
+
+	// The server errors are retried
+	failures = 2
+	require.NoError(t, download("/archive"))
+	require.Equal(t, 3, requests)
+
+	// Until the retries are exhausted
+	requests = 0
+	failures = downloadRetries + 1
+	require.Error(t, download("/archive"))
+	require.Equal(t, downloadRetries+1, requests)
+
+	// The refused downloads are not retried
+	requests = 0
+	failures = 0
+	require.Error(t, download("/missing"))
+	require.Equal(t, 1, requests)
+}
+
+func TestDownloadWithRetryResume(t *testing.T) {
+	defer func(delay time.Duration) { downloadRetryDelay = delay }(downloadRetryDelay)
+	downloadRetryDelay = time.Millisecond
+
+	content := []byte(strings.Repeat("0123456789", 100))
+	failures := 0
+	ranges := []string{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ranges = append(ranges, r.Header.Get("Range"))
+		if len(ranges) <= failures {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte("error page"))
+			return
+		}
+		http.ServeContent(w, r, "archive.zip", time.Time{}, bytes.NewReader(content))
+	}))
+	defer srv.Close()
+
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	sum := sha256.Sum256(content)
+	r := &resources.DownloadResource{
+		ArchiveFileName: "archive.zip",
+		CachePath:       "cache",
+		Checksum:        "SHA-256:" + hex.EncodeToString(sum[:]),
+		Size:            int64(len(content)),
+		URL:             srv.URL + "/archive.zip",
+	}
+	archive := tmp.Join("cache", "archive.zip")
+	require.NoError(t, archive.Parent().MkdirAll())
+	require.NoError(t, archive.WriteFile(content[:300]))
+
+	// The server errors are retried, resuming the partial archive that is
+	// left untouched by the failed attempts
+	failures = 2
+	err = DownloadWithRetry(func() (*downloader.Downloader, error) {
+		return r.Download(tmp, &downloader.Config{HttpClient: *http.DefaultClient})
+	}, "archive", func(*rpc.DownloadProgress) {})
+	require.NoError(t, err)
+	require.Equal(t, []string{"bytes=300-", "bytes=300-", "bytes=300-"}, ranges)
+	data, err := archive.ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, content, data)
+}
+
+func TestDownloadParallel(t *testing.T) {
+	// The downloads complete in the opposite order they are given
+	n := parallelDownloads
+	release := make([]chan bool, n)
+	for i := range release {
+		release[i] = make(chan bool)
+	}
+	downloads := []func(DownloadProgressCB) error{}
+	for i := 0; i < n; i++ {
+		i := i
+		downloads = append(downloads, func(downloadCB DownloadProgressCB) error {
+			downloadCB(&rpc.DownloadProgress{File: fmt.Sprint(i)})
+			<-release[i]
+			downloadCB(&rpc.DownloadProgress{Completed: true})
+			if i > 0 {
+				release[i-1] <- true
+			}
+			if i == 1 {
+				return errors.New("failed")
+			}
+			return nil
+		})
+	}
+	go func() { release[n-1] <- true }()
+
+	progress := []string{}
+	err := DownloadParallel(downloads, func(p *rpc.DownloadProgress) {
+		if p.File != "" {
+			progress = append(progress, p.File)
+		} else {
+			progress = append(progress, "completed")
+		}
+	})
+	require.EqualError(t, err, "failed")
+	// The progress is reported one download at a time
+	expected := []string{}
+	for i := 0; i < n; i++ {
+		expected = append(expected, fmt.Sprint(i), "completed")
+	}
+	require.Equal(t, expected, progress)
+}
@@ -17,6 +17,7 @@ package commands
 
 import (
 	"fmt"
+	"strings"
 
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
 	"google.golang.org/grpc/codes"
@@ -150,6 +151,18 @@ func (e *UnknownFQBNError) ToRPCStatus() *status.Status {
 	return status.New(codes.NotFound, e.Error())
 }
 
+// MissingPortAddressError is returned when the port address is mandatory and not specified
+type MissingPortAddressError struct{}
+
+func (e *MissingPortAddressError) Error() string {
+	return tr("Missing port address")
+}
+
+// ToRPCStatus converts the error into a *status.Status
+func (e *MissingPortAddressError) ToRPCStatus() *status.Status {
+	return status.New(codes.InvalidArgument, e.Error())
+}
+
 // MissingPortProtocolError is returned when the port protocol is mandatory and not specified
 type MissingPortProtocolError struct{}
 
@@ -454,6 +467,56 @@ func (e *FailedDebugError) ToRPCStatus() *status.Status {
 	return status.New(codes.Internal, e.Error())
 }
 
+// FailedMonitorError is returned when opening the monitor port of a board fails
+type FailedMonitorError struct {
+	Cause error
+}
+
+func (e *FailedMonitorError) Error() string {
+	return composeErrorMsg(tr("Port monitor error"), e.Cause)
+}
+
+func (e *FailedMonitorError) Unwrap() error {
+	return e.Cause
+}
+
+// ToRPCStatus converts the error into a *status.Status
+func (e *FailedMonitorError) ToRPCStatus() *status.Status {
+	return status.New(codes.Internal, e.Error())
+}
+
+// FailedSketchEnvironmentError is returned when the sketch environment can't be loaded
+type FailedSketchEnvironmentError struct {
+	Cause error
+}
+
+func (e *FailedSketchEnvironmentError) Error() string {
+	return composeErrorMsg(tr("Error loading sketch environment"), e.Cause)
+}
+
+func (e *FailedSketchEnvironmentError) Unwrap() error {
+	return e.Cause
+}
+
+// ToRPCStatus converts the error into a *status.Status
+func (e *FailedSketchEnvironmentError) ToRPCStatus() *status.Status {
+	return status.New(codes.FailedPrecondition, e.Error())
+}
+
+// NoMonitorAvailableForProtocolError is returned when a monitor for the specified port protocol is not available
+type NoMonitorAvailableForProtocolError struct {
+	Protocol string
+}
+
+func (e *NoMonitorAvailableForProtocolError) Error() string {
+	return tr("No monitor available for the port protocol %s", e.Protocol)
+}
+
+// ToRPCStatus converts the error into a *status.Status
+func (e *NoMonitorAvailableForProtocolError) ToRPCStatus() *status.Status {
+	return status.New(codes.InvalidArgument, e.Error())
+}
+
 // CompileFailedError is returned when the compile fails
 type CompileFailedError struct {
 	Message string
@@ -473,6 +536,41 @@ func (e *CompileFailedError) ToRPCStatus() *status.Status {
 	return status.New(codes.Internal, e.Error())
 }
 
+// FailedTestError is returned when the tests of a sketch can't be run
+type FailedTestError struct {
+	Message string
+	Cause   error
+}
+
+func (e *FailedTestError) Error() string {
+	return composeErrorMsg(e.Message, e.Cause)
+}
+
+func (e *FailedTestError) Unwrap() error {
+	return e.Cause
+}
+
+// ToRPCStatus converts the error into a *status.Status
+func (e *FailedTestError) ToRPCStatus() *status.Status {
+	return status.New(codes.Internal, e.Error())
+}
+
+// SizeGrowthThresholdExceededError is returned when the memory usage of the
+// compiled sketch grew more than allowed with respect to the baseline
+type SizeGrowthThresholdExceededError struct {
+	Memories  []string
+	Threshold string
+}
+
+func (e *SizeGrowthThresholdExceededError) Error() string {
+	return tr("The growth of %[1]s usage exceeds the threshold of %[2]s", strings.Join(e.Memories, ", "), e.Threshold)
+}
+
+// ToRPCStatus converts the error into a *status.Status
+func (e *SizeGrowthThresholdExceededError) ToRPCStatus() *status.Status {
+	return status.New(codes.FailedPrecondition, e.Error())
+}
+
 // InvalidArgumentError is returned when an invalid argument is passed to the command
 type InvalidArgumentError struct {
 	Message string
@@ -19,10 +19,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"io/ioutil"
 	"net/url"
 	"os"
 	"path"
+	"time"
 
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/arduino/cores/packageindex"
@@ -30,6 +30,7 @@ import (
 	"github.com/arduino/arduino-cli/arduino/libraries"
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
+	"github.com/arduino/arduino-cli/arduino/resources"
 	"github.com/arduino/arduino-cli/arduino/security"
 	sk "github.com/arduino/arduino-cli/arduino/sketch"
 	"github.com/arduino/arduino-cli/arduino/utils"
@@ -54,6 +55,9 @@ var instancesCount int32 = 1
 type CoreInstance struct {
 	PackageManager *packagemanager.PackageManager
 	lm             *librariesmanager.LibrariesManager
+	// indexURLs are the package indexes loaded by this instance only, in
+	// addition to the ones of the board_manager.additional_urls setting
+	indexURLs []string
 }
 
 // InstanceContainer FIXMEDOC
@@ -86,6 +90,37 @@ func GetLibraryManager(instanceID int32) *librariesmanager.LibrariesManager {
 	return i.lm
 }
 
+// SetInstanceIndexURLs sets the URLs of the package indexes loaded by the
+// instance, on the next Init, in addition to the ones of the
+// board_manager.additional_urls setting.
+func SetInstanceIndexURLs(instanceID int32, urls []string) error {
+	instance, ok := instances[instanceID]
+	if !ok {
+		return &InvalidInstanceError{}
+	}
+	instance.indexURLs = urls
+	return nil
+}
+
+// packageIndexURLs returns the URLs of all the package indexes loaded by the instance
+func (instance *CoreInstance) packageIndexURLs() []string {
+	urls := []string{globals.DefaultIndexURL}
+	urls = append(urls, configuration.Settings.GetStringSlice("board_manager.additional_urls")...)
+	for _, u := range instance.indexURLs {
+		found := false
+		for _, existing := range urls {
+			if existing == u {
+				found = true
+				break
+			}
+		}
+		if !found {
+			urls = append(urls, u)
+		}
+	}
+	return urls
+}
+
 func (instance *CoreInstance) installToolIfMissing(tool *cores.ToolRelease, downloadCB DownloadProgressCB, taskCB TaskProgressCB) (bool, error) {
 	if tool.IsInstalled() {
 		return false, nil
@@ -184,8 +219,8 @@ func Init(req *rpc.InitRequest, responseCallback func(r *rpc.InitResponse)) erro
 	mdnsDiscoveryTool := getBuiltinMDNSDiscoveryTool(instance.PackageManager)
 
 	// Load Platforms
-	urls := []string{globals.DefaultIndexURL}
-	urls = append(urls, configuration.Settings.GetStringSlice("board_manager.additional_urls")...)
+	urls := instance.packageIndexURLs()
+	migrateLegacyIndexes(instance.PackageManager.IndexDir, urls)
 	for _, u := range urls {
 		URL, err := utils.URLParse(u)
 		if err != nil {
@@ -198,22 +233,7 @@ func Init(req *rpc.InitRequest, responseCallback func(r *rpc.InitResponse)) erro
 			continue
 		}
 
-		if URL.Scheme == "file" {
-			indexFile := paths.New(URL.Path)
-
-			_, err := instance.PackageManager.LoadPackageIndexFromFile(indexFile)
-			if err != nil {
-				s := status.Newf(codes.FailedPrecondition, tr("Loading index file: %v"), err)
-				responseCallback(&rpc.InitResponse{
-					Message: &rpc.InitResponse_Error{
-						Error: s.Proto(),
-					},
-				})
-			}
-			continue
-		}
-
-		if err := instance.PackageManager.LoadPackageIndex(URL); err != nil {
+		if err := loadPackageIndex(instance.PackageManager, URL); err != nil {
 			s := status.Newf(codes.FailedPrecondition, tr("Loading index file: %v"), err)
 			responseCallback(&rpc.InitResponse{
 				Message: &rpc.InitResponse_Error{
@@ -335,6 +355,19 @@ func Init(req *rpc.InitRequest, responseCallback func(r *rpc.InitResponse)) erro
 	return nil
 }
 
+// loadPackageIndex loads in pm the package index downloaded from URL, or the
+// local file for the file URLs. The indexes with a trusted key, or all of them
+// if required, are loaded only if their signature is valid.
+func loadPackageIndex(pm *packagemanager.PackageManager, URL *url.URL) error {
+	trustedKeys := indexTrustedKeys(URL)
+	requireSignature := len(trustedKeys) > 0 || configuration.Settings.GetBool("board_manager.require_signatures")
+	if URL.Scheme == "file" {
+		_, err := pm.LoadPackageIndexFromFileWithKeys(paths.New(URL.Path), trustedKeys, requireSignature)
+		return err
+	}
+	return pm.LoadPackageIndex(URL, trustedKeys, requireSignature)
+}
+
 // Destroy FIXMEDOC
 func Destroy(ctx context.Context, req *rpc.DestroyRequest) (*rpc.DestroyResponse, error) {
 	id := req.GetInstance().GetId()
@@ -362,6 +395,20 @@ func UpdateLibrariesIndex(ctx context.Context, req *rpc.UpdateLibrariesIndexRequ
 		return &PermissionDeniedError{Message: tr("Could not create index directory"), Cause: err}
 	}
 
+	// A local library index, like the one of an offline bundle, is used in place of the Arduino one
+	if indexURL := configuration.Settings.GetString("library.index_url"); indexURL != "" {
+		return updateLocalLibrariesIndex(lm, indexURL, downloadCB)
+	}
+
+	// The index is not downloaded again if it has been refreshed recently
+	var validators *resources.IndexValidators
+	if info, err := lm.IndexFile.Stat(); err == nil && lm.IndexFileSignature.Exist() {
+		if isIndexUpToDate(info.ModTime(), req.GetUpdateIfOlderThanSecs()) {
+			return Download(nil, tr("Updating index: library_index.json.gz"), downloadCB)
+		}
+		validators, _ = resources.LoadIndexValidators(lm.IndexFileValidators)
+	}
+
 	// Create a temp dir to stage all downloads
 	tmp, err := paths.MkTempDir("", "library_index_download")
 	if err != nil {
@@ -369,13 +416,17 @@ func UpdateLibrariesIndex(ctx context.Context, req *rpc.UpdateLibrariesIndexRequ
 	}
 	defer tmp.RemoveAll()
 
-	// Download gzipped library_index
+	// Download gzipped library_index, if it changed since the last download
 	tmpIndexGz := tmp.Join("library_index.json.gz")
-	if d, err := downloader.DownloadWithConfig(tmpIndexGz.String(), librariesmanager.LibraryIndexGZURL.String(), *config, downloader.NoResume); err == nil {
-		if err := Download(d, tr("Updating index: library_index.json.gz"), downloadCB); err != nil {
-			return &FailedDownloadError{Message: tr("Error downloading library_index.json.gz"), Cause: err}
-		}
-	} else {
+	d, err := resources.DownloadIndex(tmpIndexGz, librariesmanager.LibraryIndexGZURL, validators, config)
+	if err != nil {
+		return &FailedDownloadError{Message: tr("Error downloading library_index.json.gz"), Cause: err}
+	}
+	if resources.IndexNotModified(d) {
+		touchIndex(lm.IndexFile)
+		return Download(nil, tr("Updating index: library_index.json.gz"), downloadCB)
+	}
+	if err := Download(d, tr("Updating index: library_index.json.gz"), downloadCB); err != nil {
 		return &FailedDownloadError{Message: tr("Error downloading library_index.json.gz"), Cause: err}
 	}
 
@@ -391,7 +442,7 @@ func UpdateLibrariesIndex(ctx context.Context, req *rpc.UpdateLibrariesIndexRequ
 
 	// Extract the real library_index
 	tmpIndex := tmp.Join("library_index.json")
-	if err := paths.GUnzip(tmpIndexGz, tmpIndex); err != nil {
+	if err := resources.DecompressIndex(d, tmpIndexGz, tmpIndex); err != nil {
 		return &PermissionDeniedError{Message: tr("Error extracting library_index.json.gz"), Cause: err}
 	}
 
@@ -411,22 +462,86 @@ func UpdateLibrariesIndex(ctx context.Context, req *rpc.UpdateLibrariesIndexRequ
 	if err := tmpSignature.CopyTo(lm.IndexFileSignature); err != nil {
 		return &PermissionDeniedError{Message: tr("Error writing library_index.json.sig"), Cause: err}
 	}
+	if err := resources.IndexValidatorsFromResponse(d.Resp).Save(lm.IndexFileValidators); err != nil {
+		return &PermissionDeniedError{Message: tr("Error writing library_index.json"), Cause: err}
+	}
 
 	return nil
 }
 
+// updateLocalLibrariesIndex copies the library index at the file URL indexURL
+// in place of the downloaded one. The local indexes are not signed.
+func updateLocalLibrariesIndex(lm *librariesmanager.LibrariesManager, indexURL string, downloadCB DownloadProgressCB) error {
+	URL, err := utils.URLParse(indexURL)
+	if err != nil {
+		return &InvalidURLError{Cause: err}
+	}
+	if URL.Scheme != "file" {
+		return &InvalidArgumentError{Message: tr("Invalid library.index_url %s: only local file URLs are supported", indexURL)}
+	}
+	indexPath := paths.New(URL.Path)
+	if _, err := librariesindex.LoadIndex(indexPath); err != nil {
+		return &InvalidArgumentError{Message: tr("Invalid library index in %s", indexPath), Cause: err}
+	}
+
+	fi, _ := indexPath.Stat()
+	downloadCB(&rpc.DownloadProgress{
+		File:      tr("Updating index: %s", indexPath.Base()),
+		TotalSize: fi.Size(),
+	})
+	lm.IndexFile.Remove()
+	lm.IndexFileSignature.Remove()
+	lm.IndexFileValidators.Remove()
+	if err := indexPath.CopyTo(lm.IndexFile); err != nil {
+		return &PermissionDeniedError{Message: tr("Error writing library_index.json"), Cause: err}
+	}
+	downloadCB(&rpc.DownloadProgress{Completed: true})
+	return nil
+}
+
 // UpdateIndex FIXMEDOC
 func UpdateIndex(ctx context.Context, req *rpc.UpdateIndexRequest, downloadCB DownloadProgressCB) (*rpc.UpdateIndexResponse, error) {
-	id := req.GetInstance().GetId()
-	_, ok := instances[id]
+	instance, ok := instances[req.GetInstance().GetId()]
 	if !ok {
 		return nil, &InvalidInstanceError{}
 	}
 
+	// The database of the USB IDs of the boards is rebuilt from all the indexes
+	boardsDB := packageindex.NewBoardsDB()
+	if err := updatePackageIndexes(instance.packageIndexURLs(), req.GetUpdateIfOlderThanSecs(), boardsDB, downloadCB); err != nil {
+		return nil, err
+	}
+	return &rpc.UpdateIndexResponse{}, nil
+}
+
+// UpdatePackageIndexes updates only the package indexes with the given URLs, the
+// boards they contain are added to the database of the USB IDs of the boards.
+func UpdatePackageIndexes(ctx context.Context, req *rpc.UpdateIndexRequest, urls []string, downloadCB DownloadProgressCB) error {
+	if _, ok := instances[req.GetInstance().GetId()]; !ok {
+		return &InvalidInstanceError{}
+	}
+
+	indexpath := paths.New(configuration.Settings.GetString("directories.Data"))
+	boardsDB, err := packageindex.LoadBoardsDB(indexpath.Join(packageindex.BoardsDBFileName))
+	if err != nil {
+		boardsDB = packageindex.NewBoardsDB()
+	}
+	return updatePackageIndexes(urls, req.GetUpdateIfOlderThanSecs(), boardsDB, downloadCB)
+}
+
+// updatePackageIndexes downloads the package indexes with the given URLs, adding
+// their boards to boardsDB, that is then saved in the data directory
+func updatePackageIndexes(urls []string, updateIfOlderThanSecs int64, boardsDB *packageindex.BoardsDB, downloadCB DownloadProgressCB) error {
 	indexpath := paths.New(configuration.Settings.GetString("directories.Data"))
 
-	urls := []string{globals.DefaultIndexURL}
-	urls = append(urls, configuration.Settings.GetStringSlice("board_manager.additional_urls")...)
+	// Create a temp dir to stage all downloads
+	tmp, err := paths.MkTempDir("", "package_index_download")
+	if err != nil {
+		return &TempDirCreationFailedError{Cause: err}
+	}
+	defer tmp.RemoveAll()
+
+	migrateLegacyIndexes(indexpath, urls)
 	for _, u := range urls {
 		logrus.Info("URL: ", u)
 		URL, err := utils.URLParse(u)
@@ -436,115 +551,206 @@ func UpdateIndex(ctx context.Context, req *rpc.UpdateIndexRequest, downloadCB Do
 		}
 
 		logrus.WithField("url", URL).Print("Updating index")
+		index, err := updatePackageIndex(URL, indexpath, tmp, updateIfOlderThanSecs, downloadCB)
+		if err != nil {
+			return err
+		}
+		boardsDB.AddIndex(index)
+	}
 
-		if URL.Scheme == "file" {
-			path := paths.New(URL.Path)
-			if _, err := packageindex.LoadIndexNoSign(path); err != nil {
-				return nil, &InvalidArgumentError{Message: tr("Invalid package index in %s", path), Cause: err}
-			}
+	if err := indexpath.MkdirAll(); err != nil {
+		return &PermissionDeniedError{Message: tr("Can't create data directory %s", indexpath), Cause: err}
+	}
+	if err := boardsDB.Save(indexpath.Join(packageindex.BoardsDBFileName)); err != nil {
+		return &PermissionDeniedError{Message: tr("Error saving boards database"), Cause: err}
+	}
+	return nil
+}
 
-			fi, _ := os.Stat(path.String())
-			downloadCB(&rpc.DownloadProgress{
-				File:      tr("Updating index: %s", path.Base()),
-				TotalSize: fi.Size(),
-			})
-			downloadCB(&rpc.DownloadProgress{Completed: true})
-			continue
+// updatePackageIndex downloads in indexpath the package index at URL, unless it
+// is up to date, and returns its content. The downloads are staged in tmp.
+func updatePackageIndex(URL *url.URL, indexpath, tmp *paths.Path, updateIfOlderThanSecs int64, downloadCB DownloadProgressCB) (*packageindex.Index, error) {
+	if URL.Scheme == "file" {
+		path := paths.New(URL.Path)
+		if isIndexSignatureRequired(URL) {
+			if err := verifyIndexSignature(URL, path, path.Parent().Join(path.Base()+".sig")); err != nil {
+				return nil, err
+			}
+		}
+		index, err := packageindex.LoadIndexNoSign(path)
+		if err != nil {
+			return nil, &InvalidArgumentError{Message: tr("Invalid package index in %s", path), Cause: err}
 		}
+		fi, _ := os.Stat(path.String())
+		downloadCB(&rpc.DownloadProgress{
+			File:      tr("Updating index: %s", path.Base()),
+			TotalSize: fi.Size(),
+		})
+		downloadCB(&rpc.DownloadProgress{Completed: true})
+		return index, nil
+	}
 
-		var tmp *paths.Path
-		if tmpFile, err := ioutil.TempFile("", ""); err != nil {
-			return nil, &TempFileCreationFailedError{Cause: err}
-		} else if err := tmpFile.Close(); err != nil {
-			return nil, &TempFileCreationFailedError{Cause: err}
-		} else {
-			tmp = paths.New(tmpFile.Name())
+	coreIndexPath := packageindex.CachedIndexPath(indexpath, URL)
+	label := tr("Updating index: %s", coreIndexPath.Base())
+
+	// The index is not downloaded again if it has been refreshed recently
+	// or if it didn't change since the last download
+	var validators *resources.IndexValidators
+	cachedIndex, cachedContent := loadCachedPackageIndex(coreIndexPath, URL)
+	if cachedIndex != nil {
+		if isIndexUpToDate(cachedIndex.FetchedAt, updateIfOlderThanSecs) {
+			Download(nil, label, downloadCB)
+			return cachedContent, nil
 		}
-		defer tmp.Remove()
+		validators = cachedIndex.Validators()
+	}
 
-		config, err := GetDownloaderConfig()
-		if err != nil {
-			return nil, &FailedDownloadError{Message: tr("Error downloading index '%s'", URL), Cause: err}
+	config, err := GetDownloaderConfig()
+	if err != nil {
+		return nil, &FailedDownloadError{Message: tr("Error downloading index '%s'", URL), Cause: err}
+	}
+	tmpDownload := tmp.Join("download")
+	d, err := resources.DownloadIndex(tmpDownload, URL, validators, config)
+	if err != nil {
+		return nil, &FailedDownloadError{Message: tr("Error downloading index '%s'", URL), Cause: err}
+	}
+	if resources.IndexNotModified(d) {
+		Download(nil, label, downloadCB)
+		cachedIndex.FetchedAt = time.Now()
+		if err := cachedIndex.Save(); err != nil {
+			return nil, &PermissionDeniedError{Message: tr("Error saving downloaded index %s", URL), Cause: err}
 		}
-		d, err := downloader.DownloadWithConfig(tmp.String(), URL.String(), *config)
+		touchIndex(coreIndexPath)
+		return cachedContent, nil
+	}
+	err = Download(d, label, downloadCB)
+	if err != nil {
+		return nil, &FailedDownloadError{Message: tr("Error downloading index '%s'", URL), Cause: err}
+	}
+	tmpIndex := tmp.Join(coreIndexPath.Base())
+	if err := resources.DecompressIndex(d, tmpDownload, tmpIndex); err != nil {
+		return nil, &FailedDownloadError{Message: tr("Error downloading index '%s'", URL), Cause: err}
+	}
+
+	// Check for signature, published next to the uncompressed index
+	var tmpSig *paths.Path
+	var coreIndexSigPath *paths.Path
+	if isIndexSignatureRequired(URL) {
+		URLSig, err := url.Parse(URL.String())
 		if err != nil {
-			return nil, &FailedDownloadError{Message: tr("Error downloading index '%s'", URL), Cause: err}
+			return nil, &InvalidURLError{Cause: err}
 		}
-		coreIndexPath := indexpath.Join(path.Base(URL.Path))
-		err = Download(d, tr("Updating index: %s", coreIndexPath.Base()), downloadCB)
+		URLSig.Path = path.Join(path.Dir(URLSig.Path), coreIndexPath.Base()+".sig")
+
+		tmpSig = tmp.Join(coreIndexPath.Base() + ".sig")
+		d, err := downloader.DownloadWithConfig(tmpSig.String(), URLSig.String(), *config, downloader.NoResume)
 		if err != nil {
-			return nil, &FailedDownloadError{Message: tr("Error downloading index '%s'", URL), Cause: err}
+			return nil, &FailedDownloadError{Message: tr("Error downloading index signature '%s'", URLSig), Cause: err}
 		}
 
-		// Check for signature
-		var tmpSig *paths.Path
-		var coreIndexSigPath *paths.Path
-		if URL.Hostname() == "downloads.arduino.cc" {
-			URLSig, err := url.Parse(URL.String())
-			if err != nil {
-				return nil, &InvalidURLError{Cause: err}
-			}
-			URLSig.Path += ".sig"
-
-			if t, err := ioutil.TempFile("", ""); err != nil {
-				return nil, &TempFileCreationFailedError{Cause: err}
-			} else if err := t.Close(); err != nil {
-				return nil, &TempFileCreationFailedError{Cause: err}
-			} else {
-				tmpSig = paths.New(t.Name())
-			}
-			defer tmpSig.Remove()
+		coreIndexSigPath = coreIndexPath.Parent().Join(tmpSig.Base())
+		Download(d, tr("Updating index: %s", coreIndexSigPath.Base()), downloadCB)
+		if d.Error() != nil {
+			return nil, &FailedDownloadError{Message: tr("Error downloading index signature '%s'", URLSig), Cause: err}
+		}
 
-			d, err := downloader.DownloadWithConfig(tmpSig.String(), URLSig.String(), *config)
-			if err != nil {
-				return nil, &FailedDownloadError{Message: tr("Error downloading index signature '%s'", URLSig), Cause: err}
-			}
+		if err := verifyIndexSignature(URL, tmpIndex, tmpSig); err != nil {
+			return nil, err
+		}
+	}
 
-			coreIndexSigPath = indexpath.Join(path.Base(URLSig.Path))
-			Download(d, tr("Updating index: %s", coreIndexSigPath.Base()), downloadCB)
-			if d.Error() != nil {
-				return nil, &FailedDownloadError{Message: tr("Error downloading index signature '%s'", URLSig), Cause: err}
-			}
+	index, err := packageindex.LoadIndexWithKeys(tmpIndex, indexTrustedKeys(URL))
+	if err != nil {
+		return nil, &InvalidArgumentError{Message: tr("Invalid package index in %s", URL), Cause: err}
+	}
+	if err := coreIndexPath.Parent().MkdirAll(); err != nil {
+		return nil, &PermissionDeniedError{Message: tr("Can't create data directory %s", coreIndexPath.Parent()), Cause: err}
+	}
 
-			if valid, _, err := security.VerifyArduinoDetachedSignature(tmp, tmpSig); err != nil {
-				return nil, &PermissionDeniedError{Message: tr("Error verifying signature"), Cause: err}
-			} else if !valid {
-				return nil, &SignatureVerificationFailedError{File: URL.String()}
-			}
+	if err := tmpIndex.CopyTo(coreIndexPath); err != nil {
+		return nil, &PermissionDeniedError{Message: tr("Error saving downloaded index %s", URL), Cause: err}
+	}
+	if tmpSig != nil {
+		if err := tmpSig.CopyTo(coreIndexSigPath); err != nil {
+			return nil, &PermissionDeniedError{Message: tr("Error saving downloaded index signature"), Cause: err}
 		}
+	}
+	validators = resources.IndexValidatorsFromResponse(d.Resp)
+	cachedIndex = &packageindex.CachedIndex{
+		URL:          URL.String(),
+		ETag:         validators.ETag,
+		LastModified: validators.LastModified,
+		FetchedAt:    time.Now(),
+		Dir:          coreIndexPath.Parent(),
+	}
+	if err := cachedIndex.Save(); err != nil {
+		return nil, &PermissionDeniedError{Message: tr("Error saving downloaded index %s", URL), Cause: err}
+	}
+	return index, nil
+}
 
-		if _, err := packageindex.LoadIndex(tmp); err != nil {
-			return nil, &InvalidArgumentError{Message: tr("Invalid package index in %s", URL), Cause: err}
+// loadCachedPackageIndex returns the metadata and the content of the index
+// previously downloaded from URL, or nil if the index is missing or not valid
+func loadCachedPackageIndex(indexPath *paths.Path, URL *url.URL) (*packageindex.CachedIndex, *packageindex.Index) {
+	cachedIndex, err := packageindex.LoadCachedIndex(indexPath.Parent())
+	if err != nil || cachedIndex.URL != URL.String() {
+		return nil, nil
+	}
+	if isIndexSignatureRequired(URL) {
+		if err := verifyIndexSignature(URL, indexPath, indexPath.Parent().Join(indexPath.Base()+".sig")); err != nil {
+			return nil, nil
 		}
+	}
+	index, err := packageindex.LoadIndexWithKeys(indexPath, indexTrustedKeys(URL))
+	if err != nil {
+		return nil, nil
+	}
+	return cachedIndex, index
+}
 
-		if err := indexpath.MkdirAll(); err != nil {
-			return nil, &PermissionDeniedError{Message: tr("Can't create data directory %s", indexpath), Cause: err}
-		}
+// isIndexUpToDate returns true if the index fetched at the given time has been
+// refreshed less than maxAgeSecs seconds ago. A maxAgeSecs not greater than zero
+// means that the index must always be refreshed.
+func isIndexUpToDate(fetchedAt time.Time, maxAgeSecs int64) bool {
+	return maxAgeSecs > 0 && time.Since(fetchedAt) < time.Duration(maxAgeSecs)*time.Second
+}
 
-		if err := tmp.CopyTo(coreIndexPath); err != nil {
-			return nil, &PermissionDeniedError{Message: tr("Error saving downloaded index %s", URL), Cause: err}
-		}
-		if tmpSig != nil {
-			if err := tmpSig.CopyTo(coreIndexSigPath); err != nil {
-				return nil, &PermissionDeniedError{Message: tr("Error saving downloaded index signature"), Cause: err}
-			}
-		}
+// touchIndex sets the modification time of the index file to now, recording
+// that the index has been checked even if it has not been downloaded again
+func touchIndex(indexPath *paths.Path) {
+	now := time.Now()
+	if err := os.Chtimes(indexPath.String(), now, now); err != nil {
+		logrus.Warnf("Error updating modification time of %s: %s", indexPath, err)
 	}
+}
 
-	return &rpc.UpdateIndexResponse{}, nil
+// migrateLegacyIndexes moves the package indexes that previous versions
+// stored directly in the data directory into their own folders
+func migrateLegacyIndexes(indexDir *paths.Path, urls []string) {
+	parsedURLs := []*url.URL{}
+	for _, u := range urls {
+		if URL, err := utils.URLParse(u); err == nil {
+			parsedURLs = append(parsedURLs, URL)
+		}
+	}
+	if err := packageindex.MigrateLegacyIndexes(indexDir, parsedURLs); err != nil {
+		logrus.Warnf("Error migrating package indexes: %s", err)
+	}
 }
 
 // UpdateCoreLibrariesIndex updates both Cores and Libraries indexes
 func UpdateCoreLibrariesIndex(ctx context.Context, req *rpc.UpdateCoreLibrariesIndexRequest, downloadCB DownloadProgressCB) error {
 	_, err := UpdateIndex(ctx, &rpc.UpdateIndexRequest{
-		Instance: req.Instance,
+		Instance:              req.Instance,
+		UpdateIfOlderThanSecs: req.UpdateIfOlderThanSecs,
 	}, downloadCB)
 	if err != nil {
 		return err
 	}
 
 	err = UpdateLibrariesIndex(ctx, &rpc.UpdateLibrariesIndexRequest{
-		Instance: req.Instance,
+		Instance:              req.Instance,
+		UpdateIfOlderThanSecs: req.UpdateIfOlderThanSecs,
 	}, downloadCB)
 	if err != nil {
 		return err
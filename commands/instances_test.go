@@ -0,0 +1,152 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package commands
+
+import (
+	"bytes"
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packageindex"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/cli/globals"
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/openpgp"
+)
+
+func TestInstanceIndexURLs(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	configuration.Settings = configuration.Init("")
+	configuration.Settings.Set("directories.Data", tmp.Join("data").String())
+	configuration.Settings.Set("directories.Downloads", tmp.Join("staging").String())
+	configuration.Settings.Set("board_manager.additional_urls", []string{"https://example.com/package_example_index.json"})
+
+	res, err := Create(&rpc.CreateRequest{})
+	require.NoError(t, err)
+	defer Destroy(context.Background(), &rpc.DestroyRequest{Instance: res.GetInstance()})
+	instance := GetInstance(res.GetInstance().GetId())
+
+	require.Equal(t, []string{globals.DefaultIndexURL, "https://example.com/package_example_index.json"}, instance.packageIndexURLs())
+
+	// The instance URLs are loaded in addition to the configured ones, that are left untouched
+	projectURLs := []string{"https://example.com/package_example_index.json", "https://example.com/package_project_index.json"}
+	require.NoError(t, SetInstanceIndexURLs(res.GetInstance().GetId(), projectURLs))
+	require.Equal(t, []string{
+		globals.DefaultIndexURL,
+		"https://example.com/package_example_index.json",
+		"https://example.com/package_project_index.json",
+	}, instance.packageIndexURLs())
+	require.Equal(t, []string{"https://example.com/package_example_index.json"}, configuration.Settings.GetStringSlice("board_manager.additional_urls"))
+
+	require.NoError(t, SetInstanceIndexURLs(res.GetInstance().GetId(), nil))
+	require.Len(t, instance.packageIndexURLs(), 2)
+	require.Error(t, SetInstanceIndexURLs(-1, nil))
+}
+
+func TestUpdatePackageIndexes(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	dataDir := tmp.Join("data")
+	configuration.Settings = configuration.Init("")
+	configuration.Settings.Set("directories.Data", dataDir.String())
+	configuration.Settings.Set("directories.Downloads", tmp.Join("staging").String())
+
+	res, err := Create(&rpc.CreateRequest{})
+	require.NoError(t, err)
+	defer Destroy(context.Background(), &rpc.DestroyRequest{Instance: res.GetInstance()})
+
+	// The boards of the updated indexes are added to the ones already known
+	boardsDB := packageindex.NewBoardsDB()
+	boardsDB.Boards = append(boardsDB.Boards, &packageindex.BoardsDBEntry{VID: "0x2341", PID: "0x0043", Name: "Arduino Uno", Platform: "arduino:avr"})
+	require.NoError(t, boardsDB.Save(dataDir.Join(packageindex.BoardsDBFileName)))
+
+	index := tmp.Join("package_project_index.json")
+	require.NoError(t, index.WriteFile([]byte(`{"packages": [{"name": "project", "maintainer": "Project",
+		"platforms": [{"name": "Project Boards", "architecture": "avr", "version": "1.0.0",
+			"boards": [{"name": "Project Board", "id": [{"usb": "0x1234:0x0001"}]}]}]}]}`)))
+	err = UpdatePackageIndexes(context.Background(), &rpc.UpdateIndexRequest{Instance: res.GetInstance()},
+		[]string{"file://" + index.String()}, func(*rpc.DownloadProgress) {})
+	require.NoError(t, err)
+
+	boardsDB, err = packageindex.LoadBoardsDB(dataDir.Join(packageindex.BoardsDBFileName))
+	require.NoError(t, err)
+	require.Len(t, boardsDB.Lookup("0x2341", "0x0043"), 1)
+	require.Len(t, boardsDB.Lookup("0x1234", "0x0001"), 1)
+}
+
+func TestLoadFilePackageIndexWithTrustedKey(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	// A local index signed with a custom key
+	index := tmp.Join("package_custom_index.json")
+	require.NoError(t, index.WriteFile([]byte(`{"packages": [{"name": "custom", "maintainer": "Custom",
+		"platforms": [{"name": "Custom Boards", "architecture": "avr", "version": "1.0.0",
+			"url": "https://example.com/custom-1.0.0.tar.bz2", "archiveFileName": "custom-1.0.0.tar.bz2",
+			"checksum": "SHA-256:0000000000000000000000000000000000000000000000000000000000000000", "size": "1",
+			"boards": [{"name": "Custom Board"}]}]}]}`)))
+	signIndex := func(key *paths.Path) {
+		entity, err := openpgp.NewEntity("Custom", "", "custom@example.com", nil)
+		require.NoError(t, err)
+		publicKey := new(bytes.Buffer)
+		require.NoError(t, entity.Serialize(publicKey))
+		require.NoError(t, key.WriteFile(publicKey.Bytes()))
+		content, err := index.ReadFile()
+		require.NoError(t, err)
+		signature := new(bytes.Buffer)
+		require.NoError(t, openpgp.DetachSign(signature, entity, bytes.NewReader(content), nil))
+		require.NoError(t, index.Parent().Join(index.Base()+".sig").WriteFile(signature.Bytes()))
+	}
+	trustedKey := tmp.Join("custom.gpg.key")
+	signIndex(trustedKey)
+
+	URL, err := url.Parse("file://" + index.String())
+	require.NoError(t, err)
+	loadedPlatform := func() *cores.PlatformRelease {
+		pm := packagemanager.NewPackageManager(tmp, tmp, tmp, tmp)
+		if err := loadPackageIndex(pm, URL); err != nil {
+			return nil
+		}
+		return pm.Packages["custom"].Platforms["avr"].Releases["1.0.0"]
+	}
+
+	configuration.Settings = configuration.Init("")
+	configuration.SetTrustedKeys(configuration.Settings, []*configuration.TrustedKey{{URL: URL.String(), Key: trustedKey.String()}})
+
+	// The index signed with the trusted key is loaded and trusted
+	release := loadedPlatform()
+	require.NotNil(t, release)
+	require.True(t, release.IsTrusted)
+
+	// The index signed with another key is not loaded
+	signIndex(tmp.Join("other.gpg.key"))
+	require.Nil(t, loadedPlatform())
+
+	// unless the key is not required, but then it's not trusted
+	configuration.SetTrustedKeys(configuration.Settings, nil)
+	release = loadedPlatform()
+	require.NotNil(t, release)
+	require.False(t, release.IsTrusted)
+}
@@ -0,0 +1,64 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/commands"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+)
+
+// LibraryLint checks the library in req.Path and returns the issues found. The
+// architectures declared by the library are checked against the installed platforms.
+func LibraryLint(ctx context.Context, req *rpc.LibraryLintRequest) (*rpc.LibraryLintResponse, error) {
+	pm := commands.GetPackageManager(req.GetInstance().GetId())
+	if pm == nil {
+		return nil, &commands.InvalidInstanceError{}
+	}
+
+	libDir := paths.New(req.GetPath())
+	if libDir == nil || !libDir.IsDir() {
+		return nil, &commands.InvalidArgumentError{Message: fmt.Sprintf(tr("Invalid library path: %s"), req.GetPath())}
+	}
+	lib, err := libraries.Load(libDir, libraries.Unmanaged)
+	if err != nil {
+		return nil, &commands.InvalidLibraryError{Cause: err}
+	}
+
+	knownArchitectures := map[string]bool{}
+	for _, platformRelease := range pm.InstalledPlatformReleases() {
+		knownArchitectures[platformRelease.Platform.Architecture] = true
+	}
+
+	issues, err := lib.Lint(knownArchitectures)
+	if err != nil {
+		return nil, &commands.InvalidLibraryError{Cause: err}
+	}
+
+	res := &rpc.LibraryLintResponse{Issues: []*rpc.LibraryLintIssue{}}
+	for _, issue := range issues {
+		res.Issues = append(res.Issues, &rpc.LibraryLintIssue{
+			Rule:     issue.Rule,
+			Severity: string(issue.Severity),
+			Message:  issue.Message,
+		})
+	}
+	return res, nil
+}
@@ -0,0 +1,298 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	pluggableMonitor "github.com/arduino/arduino-cli/arduino/monitor"
+	"github.com/arduino/arduino-cli/arduino/monitors"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/i18n"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-properties-orderedmap"
+	"github.com/sirupsen/logrus"
+)
+
+var tr = i18n.Tr
+
+// PortProxy is an io.ReadWriteCloser that maps into the monitor port of the board
+type PortProxy struct {
+	rw              io.ReadWriter
+	changeSettingCb func(setting, value string) error
+	describeCb      func() (*pluggableMonitor.PortDescriptor, error)
+	closeCb         func() error
+}
+
+func (p *PortProxy) Read(buff []byte) (int, error) {
+	return p.rw.Read(buff)
+}
+
+func (p *PortProxy) Write(buff []byte) (int, error) {
+	return p.rw.Write(buff)
+}
+
+// Config sets the port configuration setting to the specified value
+func (p *PortProxy) Config(setting, value string) error {
+	return p.changeSettingCb(setting, value)
+}
+
+// Describe returns the configuration settings currently applied to the port
+func (p *PortProxy) Describe() (*pluggableMonitor.PortDescriptor, error) {
+	return p.describeCb()
+}
+
+// Close the port
+func (p *PortProxy) Close() error {
+	return p.closeCb()
+}
+
+// Monitor opens a communication port. It returns a PortProxy to communicate with the port and
+// a PortDescriptor that describes the available configuration settings of the port.
+// The settings in req.PortConfiguration are applied before opening the port.
+// If no pluggable monitor is available for a serial port the builtin serial monitor is used.
+func Monitor(ctx context.Context, req *rpc.MonitorRequest) (*PortProxy, *pluggableMonitor.PortDescriptor, error) {
+	pm := commands.GetPackageManager(req.GetInstance().GetId())
+	if pm == nil {
+		return nil, nil, &commands.InvalidInstanceError{}
+	}
+
+	port := req.GetPort()
+	if port.GetAddress() == "" {
+		return nil, nil, &commands.MissingPortAddressError{}
+	}
+	if port.GetProtocol() == "" {
+		return nil, nil, &commands.MissingPortProtocolError{}
+	}
+
+	m, err := findMonitorForProtocolAndBoard(pm, port.GetProtocol(), req.GetFqbn())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if m == nil {
+		// No pluggable monitor declared by the installed platforms, fallback
+		// to the builtin serial monitor if possible.
+		if port.GetProtocol() != "serial" {
+			return nil, nil, &commands.NoMonitorAvailableForProtocolError{Protocol: port.GetProtocol()}
+		}
+		settings, err := getSerialMonitorSettings(pm, req.GetFqbn())
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, setting := range req.GetPortConfiguration().GetSettings() {
+			if err := settings.Set(setting.GetSettingId(), setting.GetValue()); err != nil {
+				return nil, nil, &commands.InvalidArgumentError{Message: tr("Invalid monitor configuration"), Cause: err}
+			}
+		}
+		serial, err := monitors.OpenSerialMonitor(port.GetAddress(), settings)
+		if err != nil {
+			return nil, nil, &commands.FailedMonitorError{Cause: err}
+		}
+		describe := func() (*pluggableMonitor.PortDescriptor, error) {
+			return serial.Settings().Describe(), nil
+		}
+		return &PortProxy{
+			rw:              serial,
+			changeSettingCb: serial.Configure,
+			describeCb:      describe,
+			closeCb:         serial.Close,
+		}, serial.Settings().Describe(), nil
+	}
+
+	if err := m.Run(); err != nil {
+		return nil, nil, &commands.FailedMonitorError{Cause: err}
+	}
+
+	for _, setting := range req.GetPortConfiguration().GetSettings() {
+		if err := m.Configure(setting.GetSettingId(), setting.GetValue()); err != nil {
+			m.Quit()
+			return nil, nil, &commands.InvalidArgumentError{Message: tr("Invalid monitor configuration"), Cause: err}
+		}
+	}
+
+	descriptor, err := m.Describe()
+	if err != nil {
+		m.Quit()
+		return nil, nil, &commands.FailedMonitorError{Cause: err}
+	}
+
+	monIO, err := m.Open(port.GetAddress(), port.GetProtocol())
+	if err != nil {
+		m.Quit()
+		return nil, nil, &commands.FailedMonitorError{Cause: err}
+	}
+
+	logrus.Infof("Port %s successfully opened", port.GetAddress())
+	return &PortProxy{
+		rw:              monIO,
+		changeSettingCb: m.Configure,
+		describeCb:      m.Describe,
+		closeCb: func() error {
+			m.Close()
+			return m.Quit()
+		},
+	}, descriptor, nil
+}
+
+// EnumerateMonitorPortSettings returns a description of the configuration settings of a monitor port
+func EnumerateMonitorPortSettings(ctx context.Context, req *rpc.EnumerateMonitorPortSettingsRequest) (*rpc.EnumerateMonitorPortSettingsResponse, error) {
+	pm := commands.GetPackageManager(req.GetInstance().GetId())
+	if pm == nil {
+		return nil, &commands.InvalidInstanceError{}
+	}
+
+	if req.GetPortProtocol() == "" {
+		return nil, &commands.MissingPortProtocolError{}
+	}
+
+	m, err := findMonitorForProtocolAndBoard(pm, req.GetPortProtocol(), req.GetFqbn())
+	if err != nil {
+		return nil, err
+	}
+
+	if m == nil {
+		if req.GetPortProtocol() != "serial" {
+			return nil, &commands.NoMonitorAvailableForProtocolError{Protocol: req.GetPortProtocol()}
+		}
+		settings, err := getSerialMonitorSettings(pm, req.GetFqbn())
+		if err != nil {
+			return nil, err
+		}
+		return &rpc.EnumerateMonitorPortSettingsResponse{Settings: settings.Describe().ToRPC()}, nil
+	}
+
+	if err := m.Run(); err != nil {
+		return nil, &commands.FailedMonitorError{Cause: err}
+	}
+	defer m.Quit()
+
+	desc, err := m.Describe()
+	if err != nil {
+		return nil, &commands.FailedMonitorError{Cause: err}
+	}
+	return &rpc.EnumerateMonitorPortSettingsResponse{Settings: desc.ToRPC()}, nil
+}
+
+// getSerialMonitorSettings returns the default settings of the builtin serial monitor,
+// if a FQBN is specified the board's serial.disableDTR and serial.disableRTS properties
+// are honored.
+func getSerialMonitorSettings(pm *packagemanager.PackageManager, fqbnIn string) (*monitors.SerialMonitorSettings, error) {
+	settings := monitors.NewSerialMonitorSettings()
+	if fqbnIn == "" {
+		return settings, nil
+	}
+	fqbn, err := cores.ParseFQBN(fqbnIn)
+	if err != nil {
+		return nil, &commands.InvalidFQBNError{Cause: err}
+	}
+	_, _, board, _, _, err := pm.ResolveFQBN(fqbn)
+	if err != nil {
+		return nil, &commands.UnknownFQBNError{Cause: err}
+	}
+	settings.DTR = !board.Properties.GetBoolean("serial.disableDTR")
+	settings.RTS = !board.Properties.GetBoolean("serial.disableRTS")
+	return settings, nil
+}
+
+// findMonitorForProtocolAndBoard returns the pluggable monitor declared for the given protocol.
+// If a FQBN is specified only the platform of the board is searched, otherwise the first
+// installed platform declaring a monitor for the protocol is used.
+// A nil monitor without errors is returned if no pluggable monitor is available.
+func findMonitorForProtocolAndBoard(pm *packagemanager.PackageManager, protocol, fqbn string) (*pluggableMonitor.PluggableMonitor, error) {
+	platforms := []*cores.PlatformRelease{}
+	if fqbn != "" {
+		fqbn, err := cores.ParseFQBN(fqbn)
+		if err != nil {
+			return nil, &commands.InvalidFQBNError{Cause: err}
+		}
+		_, boardPlatform, _, _, _, err := pm.ResolveFQBN(fqbn)
+		if err != nil {
+			return nil, &commands.UnknownFQBNError{Cause: err}
+		}
+		platforms = append(platforms, boardPlatform)
+	} else {
+		platforms = pm.InstalledPlatformReleases()
+	}
+
+	for _, platform := range platforms {
+		if m, err := loadPlatformMonitor(pm, platform, protocol); err != nil {
+			return nil, err
+		} else if m != nil {
+			return m, nil
+		}
+	}
+	return nil, nil
+}
+
+// loadPlatformMonitor creates the pluggable monitor declared by the platform for the
+// given protocol. Monitors are declared in platform.txt like so:
+//
+//	"pluggable_monitor.required.PROTOCOL": "PLATFORM:MONITOR_NAME",
+//
+// or, using a command line recipe for development purposes:
+//
+//	"pluggable_monitor.pattern.PROTOCOL": "COMMAND_TO_EXECUTE",
+//
+// If both are found the pattern takes precedence.
+func loadPlatformMonitor(pm *packagemanager.PackageManager, platform *cores.PlatformRelease, protocol string) (*pluggableMonitor.PluggableMonitor, error) {
+	monitorProperties := platform.Properties.SubTree("pluggable_monitor")
+
+	if pattern, ok := monitorProperties.GetOk("pattern." + protocol); ok {
+		configuration := platform.Properties.Clone()
+		configuration.Merge(platform.RuntimeProperties())
+		tools, err := pm.FindToolsRequiredFromPlatformRelease(platform)
+		if err != nil {
+			return nil, &commands.FailedMonitorError{Cause: err}
+		}
+		for _, tool := range tools {
+			configuration.Merge(tool.RuntimeProperties())
+		}
+		cmd := configuration.ExpandPropsInString(pattern)
+		cmdArgs, err := properties.SplitQuotedString(cmd, `"'`, true)
+		if err != nil {
+			return nil, &commands.InvalidPlatformPropertyError{Property: "pluggable_monitor.pattern." + protocol, Value: pattern}
+		}
+		id := fmt.Sprintf("%s:%s", platform.Platform.String(), protocol)
+		m, err := pluggableMonitor.New(id, cmdArgs...)
+		if err != nil {
+			return nil, &commands.FailedMonitorError{Cause: err}
+		}
+		return m, nil
+	}
+
+	if id, ok := monitorProperties.GetOk("required." + protocol); ok {
+		tool := pm.GetTool(id)
+		if tool == nil {
+			return nil, &commands.FailedMonitorError{Cause: fmt.Errorf(tr("monitor not found: %s"), id)}
+		}
+		toolRelease := tool.GetLatestInstalled()
+		if toolRelease == nil {
+			return nil, &commands.FailedMonitorError{Cause: fmt.Errorf(tr("monitor not installed: %s"), id)}
+		}
+		m, err := pluggableMonitor.New(id, toolRelease.InstallDir.Join(tool.Name).String())
+		if err != nil {
+			return nil, &commands.FailedMonitorError{Cause: err}
+		}
+		return m, nil
+	}
+
+	return nil, nil
+}
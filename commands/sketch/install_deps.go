@@ -0,0 +1,344 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packageindex"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/arduino/utils"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/commands/core"
+	"github.com/arduino/arduino-cli/commands/lib"
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	paths "github.com/arduino/go-paths-helper"
+	semver "go.bug.st/relaxed-semver"
+)
+
+// InstallDependencies installs the platforms and libraries declared in the project file of
+// the sketch. If the sketch has a lock file that satisfies the project the exact releases
+// recorded there are installed, otherwise the dependencies are resolved again and a new
+// lock file is written.
+func InstallDependencies(ctx context.Context, req *rpc.SketchInstallDependenciesRequest,
+	downloadCB commands.DownloadProgressCB, taskCB commands.TaskProgressCB) error {
+
+	pm := commands.GetPackageManager(req.GetInstance().GetId())
+	if pm == nil {
+		return &commands.InvalidInstanceError{}
+	}
+	lm := commands.GetLibraryManager(req.GetInstance().GetId())
+	if lm == nil {
+		return &commands.InvalidInstanceError{}
+	}
+
+	sketchPath := paths.New(req.GetSketchPath())
+	if sketchPath == nil {
+		sketchPath = paths.New(".")
+	}
+	sk, err := sketch.New(sketchPath)
+	if err != nil {
+		return &commands.CantOpenSketchError{Cause: err}
+	}
+	project, err := sketch.LoadProject(sk.FullPath)
+	if err != nil {
+		return &commands.InvalidArgumentError{Message: tr("Invalid sketch project file"), Cause: err}
+	}
+	if project == nil {
+		return &commands.NotFoundError{Message: tr("Sketch project file %s not found", sk.FullPath.Join(sketch.ProjectFileName))}
+	}
+	if err := addBoardPlatform(project); err != nil {
+		return err
+	}
+
+	// The package indexes declared in the project must be loaded also by the
+	// re-initializations of the instance done by the platforms installation.
+	restoreURLs, err := addProjectIndexURLs(ctx, req.GetInstance(), project, downloadCB)
+	defer restoreURLs()
+	if err != nil {
+		return err
+	}
+
+	lock, err := sketch.LoadLockfile(sk.FullPath)
+	if err != nil {
+		return &commands.InvalidArgumentError{Message: tr("Invalid sketch lock file"), Cause: err}
+	}
+	updateLock := lock == nil || req.GetUpdateLock() || !lock.Satisfies(project)
+	if updateLock {
+		taskCB(&rpc.TaskProgress{Name: tr("Resolving sketch dependencies")})
+		if lock, err = resolveProjectDependencies(ctx, req.GetInstance(), pm, project); err != nil {
+			return err
+		}
+		taskCB(&rpc.TaskProgress{Completed: true})
+	} else if err := verifyLockfile(pm, lm, lock); err != nil {
+		return err
+	}
+
+	for _, locked := range lock.Platforms {
+		packageName, architecture, err := (&sketch.ProjectPlatformRef{Platform: locked.Platform}).PlatformID()
+		if err != nil {
+			return &commands.InvalidArgumentError{Message: tr("Invalid sketch lock file"), Cause: err}
+		}
+		_, err = core.PlatformInstall(ctx, &rpc.PlatformInstallRequest{
+			Instance:        req.GetInstance(),
+			PlatformPackage: packageName,
+			Architecture:    architecture,
+			Version:         locked.Version,
+			SkipPostInstall: req.GetSkipPostInstall(),
+		}, downloadCB, taskCB)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, locked := range lock.Libraries {
+		err := lib.LibraryInstall(ctx, &rpc.LibraryInstallRequest{
+			Instance: req.GetInstance(),
+			Name:     locked.Name,
+			Version:  locked.Version,
+			NoDeps:   true,
+		}, downloadCB, taskCB)
+		if err != nil {
+			return err
+		}
+	}
+
+	if updateLock {
+		if err := lock.Save(sk.FullPath); err != nil {
+			return &commands.PermissionDeniedError{Message: tr("Error saving sketch lock file"), Cause: err}
+		}
+	}
+	return nil
+}
+
+// addBoardPlatform adds the platform of the board declared in the project to the
+// required platforms, if not explicitly declared.
+func addBoardPlatform(project *sketch.Project) error {
+	if project.Fqbn == "" {
+		return nil
+	}
+	fqbn, err := cores.ParseFQBN(project.Fqbn)
+	if err != nil {
+		return &commands.InvalidFQBNError{Cause: err}
+	}
+	platformID := fqbn.Package + ":" + fqbn.PlatformArch
+	for _, platform := range project.Platforms {
+		if platform.Platform == platformID {
+			return nil
+		}
+	}
+	project.Platforms = append(project.Platforms, &sketch.ProjectPlatformRef{Platform: platformID})
+	return nil
+}
+
+// addProjectIndexURLs makes the instance load also the package indexes declared in
+// the project, downloading the ones not yet available, and reloads the instance. The
+// returned function makes the instance stop loading them.
+func addProjectIndexURLs(ctx context.Context, instance *rpc.Instance, project *sketch.Project, downloadCB commands.DownloadProgressCB) (func(), error) {
+	restore := func() {
+		commands.SetInstanceIndexURLs(instance.GetId(), nil)
+	}
+
+	configuredURLs := configuration.Settings.GetStringSlice("board_manager.additional_urls")
+	urls := []string{}
+	missingURLs := []string{}
+	indexDir := paths.New(configuration.Settings.GetString("directories.Data"))
+	for _, platform := range project.Platforms {
+		if platform.IndexURL == "" || containsString(configuredURLs, platform.IndexURL) || containsString(urls, platform.IndexURL) {
+			continue
+		}
+		URL, err := utils.URLParse(platform.IndexURL)
+		if err != nil {
+			return restore, &commands.InvalidURLError{Cause: err}
+		}
+		urls = append(urls, platform.IndexURL)
+		if URL.Scheme != "file" && packageindex.CachedIndexPath(indexDir, URL).NotExist() {
+			missingURLs = append(missingURLs, platform.IndexURL)
+		}
+	}
+	if len(urls) == 0 {
+		return restore, nil
+	}
+
+	if err := commands.SetInstanceIndexURLs(instance.GetId(), urls); err != nil {
+		return restore, err
+	}
+	if len(missingURLs) > 0 {
+		if err := commands.UpdatePackageIndexes(ctx, &rpc.UpdateIndexRequest{Instance: instance}, missingURLs, downloadCB); err != nil {
+			return restore, err
+		}
+	}
+	if err := commands.Init(&rpc.InitRequest{Instance: instance}, nil); err != nil {
+		return restore, err
+	}
+	return restore, nil
+}
+
+// resolveProjectDependencies finds the releases of the platforms, tools and libraries
+// needed to satisfy the project, libraries dependencies are included.
+func resolveProjectDependencies(ctx context.Context, instance *rpc.Instance, pm *packagemanager.PackageManager, project *sketch.Project) (*sketch.Lockfile, error) {
+	lock := &sketch.Lockfile{}
+
+	for _, required := range project.Platforms {
+		packageName, architecture, err := required.PlatformID()
+		if err != nil {
+			return nil, &commands.InvalidArgumentError{Message: tr("Invalid sketch project file"), Cause: err}
+		}
+		ref := &packagemanager.PlatformReference{
+			Package:              packageName,
+			PlatformArchitecture: architecture,
+		}
+		if required.Version != "" {
+			version, err := semver.Parse(required.Version)
+			if err != nil {
+				return nil, &commands.InvalidVersionError{Cause: err}
+			}
+			ref.PlatformVersion = version
+		}
+		platformRelease, tools, err := pm.FindPlatformReleaseDependencies(ref)
+		if err != nil {
+			return nil, &commands.PlatformNotFound{Platform: ref.String(), Cause: err}
+		}
+		locked := &sketch.LockedPlatform{
+			Platform: required.Platform,
+			Version:  platformRelease.Version.String(),
+			IndexURL: required.IndexURL,
+		}
+		if platformRelease.Resource != nil {
+			locked.Checksum = platformRelease.Resource.Checksum
+		}
+		for _, tool := range tools {
+			locked.Tools = append(locked.Tools, &sketch.LockedTool{
+				Tool:    tool.Tool.Package.Name + ":" + tool.Tool.Name,
+				Version: tool.Version.String(),
+			})
+		}
+		lock.Platforms = append(lock.Platforms, locked)
+	}
+
+	lm := commands.GetLibraryManager(instance.GetId())
+	for _, required := range project.Libraries {
+		deps, err := lib.LibraryResolveDependencies(ctx, &rpc.LibraryResolveDependenciesRequest{
+			Instance: instance,
+			Name:     required.Name,
+			Version:  required.Version,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, dep := range deps.GetDependencies() {
+			if locked := lock.FindLibrary(dep.GetName()); locked != nil {
+				if locked.Version != dep.GetVersionRequired() {
+					err := fmt.Errorf(tr("two different versions of the library %[1]s are required: %[2]s and %[3]s"),
+						dep.GetName(), dep.GetVersionRequired(), locked.Version)
+					return nil, &commands.LibraryDependenciesResolutionFailedError{Cause: err}
+				}
+				continue
+			}
+			release, err := findLibraryRelease(lm, dep.GetName(), dep.GetVersionRequired())
+			if err != nil {
+				return nil, err
+			}
+			lock.Libraries = append(lock.Libraries, &sketch.LockedLibrary{
+				Name:     dep.GetName(),
+				Version:  dep.GetVersionRequired(),
+				Checksum: release.Resource.Checksum,
+			})
+		}
+	}
+	return lock, nil
+}
+
+// verifyLockfile checks that the releases recorded in the lock file are available
+// in the indexes and that their checksums didn't change.
+func verifyLockfile(pm *packagemanager.PackageManager, lm *librariesmanager.LibrariesManager, lock *sketch.Lockfile) error {
+	for _, locked := range lock.Platforms {
+		packageName, architecture, err := (&sketch.ProjectPlatformRef{Platform: locked.Platform}).PlatformID()
+		if err != nil {
+			return &commands.InvalidArgumentError{Message: tr("Invalid sketch lock file"), Cause: err}
+		}
+		version, err := semver.Parse(locked.Version)
+		if err != nil {
+			return &commands.InvalidVersionError{Cause: err}
+		}
+		ref := &packagemanager.PlatformReference{
+			Package:              packageName,
+			PlatformArchitecture: architecture,
+			PlatformVersion:      version,
+		}
+		platformRelease, tools, err := pm.FindPlatformReleaseDependencies(ref)
+		if err != nil {
+			return &commands.PlatformNotFound{Platform: ref.String(), Cause: err}
+		}
+		if platformRelease.Resource != nil && locked.Checksum != "" && platformRelease.Resource.Checksum != locked.Checksum {
+			return &commands.InvalidArgumentError{Message: tr("Checksum of platform %s doesn't match the sketch lock file", ref)}
+		}
+		for _, tool := range tools {
+			toolID := tool.Tool.Package.Name + ":" + tool.Tool.Name
+			found := false
+			for _, lockedTool := range locked.Tools {
+				if lockedTool.Tool == toolID && lockedTool.Version == tool.Version.String() {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return &commands.InvalidArgumentError{Message: tr("Tool %[1]s required by platform %[2]s doesn't match the sketch lock file", tool, ref)}
+			}
+		}
+	}
+	for _, locked := range lock.Libraries {
+		release, err := findLibraryRelease(lm, locked.Name, locked.Version)
+		if err != nil {
+			return err
+		}
+		if locked.Checksum != "" && release.Resource.Checksum != locked.Checksum {
+			return &commands.InvalidArgumentError{Message: tr("Checksum of library %s doesn't match the sketch lock file", release)}
+		}
+	}
+	return nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func findLibraryRelease(lm *librariesmanager.LibrariesManager, name, version string) (*librariesindex.Release, error) {
+	ref := &librariesindex.Reference{Name: name}
+	if version != "" {
+		v, err := semver.Parse(version)
+		if err != nil {
+			return nil, &commands.InvalidVersionError{Cause: err}
+		}
+		ref.Version = v
+	}
+	release := lm.Index.FindRelease(ref)
+	if release == nil {
+		return nil, &commands.LibraryNotFound{Library: ref.String()}
+	}
+	return release, nil
+}
@@ -0,0 +1,103 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package commands
+
+import (
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+)
+
+// SketchEnvironment contains the platforms and libraries available to build or
+// upload a sketch
+type SketchEnvironment struct {
+	PackageManager *packagemanager.PackageManager
+	// HardwareDirs are the folders the platforms have been loaded from
+	HardwareDirs paths.PathList
+	// BuiltInToolsDirs are the folders containing the tools bundled with the IDE
+	BuiltInToolsDirs paths.PathList
+	// LibrariesDirs are the folders containing the globally installed libraries
+	LibrariesDirs paths.PathList
+	// Libraries are the libraries of the sketch environment, they take precedence
+	// over all the other libraries
+	Libraries paths.PathList
+	// Isolated is true if the globally installed platforms and libraries are not available
+	Isolated bool
+}
+
+// GetSketchEnvironment returns the platforms and libraries available to the sketch with the
+// given environment mode. If the environment is disabled the platforms loaded in pm and the
+// globally installed libraries are used, otherwise a new PackageManager is created, loading
+// the platforms installed in the environment folder of the sketch and, for the overlay mode,
+// the globally installed platforms with a lower priority.
+func GetSketchEnvironment(pm *packagemanager.PackageManager, sk *sketch.Sketch, mode rpc.SketchEnvironmentMode) (*SketchEnvironment, error) {
+	env := &SketchEnvironment{
+		PackageManager:   pm,
+		HardwareDirs:     configuration.HardwareDirectories(configuration.Settings),
+		BuiltInToolsDirs: configuration.BundleToolsDirectories(configuration.Settings),
+		LibrariesDirs:    paths.NewPathList(configuration.LibrariesDir(configuration.Settings).String()),
+		Libraries:        paths.NewPathList(),
+	}
+	if mode == rpc.SketchEnvironmentMode_SKETCH_ENVIRONMENT_MODE_DISABLED {
+		return env, nil
+	}
+	if sk == nil {
+		return nil, &MissingSketchPathError{}
+	}
+	if !sk.EnvironmentDir().IsDir() {
+		return nil, &NotFoundError{Message: tr("Sketch environment %s not found", sk.EnvironmentDir())}
+	}
+
+	packagesDir := sk.EnvironmentPackagesDir()
+	// The platforms of the environment are considered "managed", so they take precedence
+	// over the globally installed ones, see PackageManager.GetInstalledPlatformRelease
+	envPM := packagemanager.NewPackageManager(pm.IndexDir, packagesDir, pm.DownloadDir, pm.TempDir)
+	hardwareDirs := paths.NewPathList()
+	if packagesDir.IsDir() {
+		hardwareDirs.Add(packagesDir)
+	}
+	if mode == rpc.SketchEnvironmentMode_SKETCH_ENVIRONMENT_MODE_ISOLATED {
+		env.Isolated = true
+		env.BuiltInToolsDirs = paths.NewPathList()
+		env.LibrariesDirs = paths.NewPathList()
+		// The builtin tools (ctags, discoveries...) are part of the CLI and are always needed
+		if builtin, ok := pm.Packages["builtin"]; ok {
+			envPM.Packages["builtin"] = builtin
+		}
+	} else {
+		hardwareDirs.AddAll(env.HardwareDirs)
+	}
+	// Like in Init, the platforms that fail to load are skipped
+	for _, err := range envPM.LoadHardwareFromDirectories(hardwareDirs) {
+		logrus.WithError(err.Err()).Warn("Error loading sketch environment hardware")
+	}
+	env.PackageManager = envPM
+	env.HardwareDirs = hardwareDirs
+
+	if librariesDir := sk.EnvironmentLibrariesDir(); librariesDir.IsDir() {
+		libs, err := librariesDir.ReadDir()
+		if err != nil {
+			return nil, &FailedSketchEnvironmentError{Cause: err}
+		}
+		libs.FilterDirs()
+		libs.FilterOutHiddenFiles()
+		env.Libraries = libs
+	}
+	return env, nil
+}
@@ -0,0 +1,73 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package commands
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSketchEnvironment(t *testing.T) {
+	configuration.Settings = configuration.Init("")
+	configuration.Settings.Set("directories.user", paths.New("testdata").String())
+
+	pm := packagemanager.NewPackageManager(nil, nil, nil, nil)
+	require.Empty(t, pm.LoadHardwareFromDirectory(paths.New("testdata", "hardware")))
+	require.NotNil(t, pm.Packages["globalpkg"])
+
+	sk, err := sketch.New(paths.New("testdata", "SketchWithEnvironment"))
+	require.NoError(t, err)
+
+	env, err := GetSketchEnvironment(pm, sk, rpc.SketchEnvironmentMode_SKETCH_ENVIRONMENT_MODE_DISABLED)
+	require.NoError(t, err)
+	require.Equal(t, pm, env.PackageManager)
+	require.False(t, env.Isolated)
+	require.Empty(t, env.Libraries)
+
+	env, err = GetSketchEnvironment(pm, sk, rpc.SketchEnvironmentMode_SKETCH_ENVIRONMENT_MODE_OVERLAY)
+	require.NoError(t, err)
+	require.False(t, env.Isolated)
+	require.NotNil(t, env.PackageManager.Packages["envpkg"])
+	// The global hardware folders are loaded too
+	require.NotNil(t, env.PackageManager.Packages["globalpkg"])
+	require.NotEmpty(t, env.LibrariesDirs)
+	require.Len(t, env.Libraries, 1)
+	require.Equal(t, "EnvLib", env.Libraries[0].Base())
+
+	env, err = GetSketchEnvironment(pm, sk, rpc.SketchEnvironmentMode_SKETCH_ENVIRONMENT_MODE_ISOLATED)
+	require.NoError(t, err)
+	require.True(t, env.Isolated)
+	require.NotNil(t, env.PackageManager.Packages["envpkg"])
+	require.Nil(t, env.PackageManager.Packages["globalpkg"])
+	require.Empty(t, env.LibrariesDirs)
+	require.Empty(t, env.BuiltInToolsDirs)
+	require.Len(t, env.Libraries, 1)
+
+	// The environment folder is required when the environment is enabled
+	noEnv, err := sketch.New(paths.New("testdata", "SketchWithoutEnvironment"))
+	require.NoError(t, err)
+	_, err = GetSketchEnvironment(pm, noEnv, rpc.SketchEnvironmentMode_SKETCH_ENVIRONMENT_MODE_OVERLAY)
+	require.Error(t, err)
+	require.IsType(t, &NotFoundError{}, err)
+	_, err = GetSketchEnvironment(pm, nil, rpc.SketchEnvironmentMode_SKETCH_ENVIRONMENT_MODE_ISOLATED)
+	require.IsType(t, &MissingSketchPathError{}, err)
+}
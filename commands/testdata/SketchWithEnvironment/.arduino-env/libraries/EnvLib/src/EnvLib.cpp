@@ -0,0 +1 @@
+#include "EnvLib.h"
@@ -0,0 +1,53 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package commands
+
+import (
+	"net/url"
+
+	"github.com/arduino/arduino-cli/arduino/security"
+	"github.com/arduino/arduino-cli/configuration"
+	"github.com/arduino/go-paths-helper"
+)
+
+// indexTrustedKeys returns the files of the keys, in addition to the Arduino
+// one, trusted to sign the package index downloaded from URL
+func indexTrustedKeys(URL *url.URL) paths.PathList {
+	return configuration.TrustedKeysForURL(configuration.Settings, URL.String())
+}
+
+// isIndexSignatureRequired returns true if the package index downloaded from
+// URL must have a valid signature: it's the case of the Arduino indexes, of
+// the indexes with a trusted key and of all of them if the
+// board_manager.require_signatures setting is enabled
+func isIndexSignatureRequired(URL *url.URL) bool {
+	return URL.Hostname() == "downloads.arduino.cc" ||
+		len(indexTrustedKeys(URL)) > 0 ||
+		configuration.Settings.GetBool("board_manager.require_signatures")
+}
+
+// verifyIndexSignature checks that the signature of the package index
+// downloaded from URL is valid and made with a trusted key
+func verifyIndexSignature(URL *url.URL, indexPath, signaturePath *paths.Path) error {
+	if !signaturePath.Exist() {
+		return &SignatureVerificationFailedError{File: URL.String(), Cause: &NotFoundError{Message: tr("Signature %s not found", signaturePath)}}
+	}
+	valid, _, err := security.VerifyDetachedSignatureWithKeys(indexPath, signaturePath, indexTrustedKeys(URL))
+	if err != nil || !valid {
+		return &SignatureVerificationFailedError{File: URL.String(), Cause: err}
+	}
+	return nil
+}
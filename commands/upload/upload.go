@@ -51,6 +51,18 @@ func SupportedUserFields(ctx context.Context, req *rpc.SupportedUserFieldsReques
 		return nil, &commands.InvalidInstanceError{}
 	}
 
+	if req.GetSketchEnvironment() != rpc.SketchEnvironmentMode_SKETCH_ENVIRONMENT_MODE_DISABLED {
+		sk, err := sketch.New(paths.New(req.GetSketchPath()))
+		if err != nil {
+			return nil, &commands.CantOpenSketchError{Cause: err}
+		}
+		env, err := commands.GetSketchEnvironment(pm, sk, req.GetSketchEnvironment())
+		if err != nil {
+			return nil, err
+		}
+		pm = env.PackageManager
+	}
+
 	fqbn, err := cores.ParseFQBN(req.GetFqbn())
 	if err != nil {
 		return nil, &commands.InvalidFQBNError{Cause: err}
@@ -127,9 +139,16 @@ func Upload(ctx context.Context, req *rpc.UploadRequest, outStream io.Writer, er
 	}
 
 	pm := commands.GetPackageManager(req.GetInstance().GetId())
+	if pm == nil {
+		return nil, &commands.InvalidInstanceError{}
+	}
+	env, err := commands.GetSketchEnvironment(pm, sk, req.GetSketchEnvironment())
+	if err != nil {
+		return nil, err
+	}
 
 	if err := runProgramAction(
-		pm,
+		env.PackageManager,
 		sk,
 		req.GetImportFile(),
 		req.GetImportDir(),
@@ -158,16 +177,17 @@ func UsingProgrammer(ctx context.Context, req *rpc.UploadUsingProgrammerRequest,
 		return nil, &commands.MissingProgrammerError{}
 	}
 	_, err := Upload(ctx, &rpc.UploadRequest{
-		Instance:   req.GetInstance(),
-		SketchPath: req.GetSketchPath(),
-		ImportFile: req.GetImportFile(),
-		ImportDir:  req.GetImportDir(),
-		Fqbn:       req.GetFqbn(),
-		Port:       req.GetPort(),
-		Programmer: req.GetProgrammer(),
-		Verbose:    req.GetVerbose(),
-		Verify:     req.GetVerify(),
-		UserFields: req.GetUserFields(),
+		Instance:          req.GetInstance(),
+		SketchPath:        req.GetSketchPath(),
+		ImportFile:        req.GetImportFile(),
+		ImportDir:         req.GetImportDir(),
+		Fqbn:              req.GetFqbn(),
+		Port:              req.GetPort(),
+		Programmer:        req.GetProgrammer(),
+		Verbose:           req.GetVerbose(),
+		Verify:            req.GetVerify(),
+		UserFields:        req.GetUserFields(),
+		SketchEnvironment: req.GetSketchEnvironment(),
 	}, outStream, errStream)
 	return &rpc.UploadUsingProgrammerResponse{}, err
 }
@@ -186,8 +206,8 @@ func runProgramAction(pm *packagemanager.PackageManager,
 
 	logrus.WithField("port", port).Tracef("Upload port")
 
-	if fqbnIn == "" && sk != nil && sk.Metadata != nil {
-		fqbnIn = sk.Metadata.CPU.Fqbn
+	if fqbnIn == "" && sk != nil {
+		fqbnIn = sk.DefaultFqbn()
 	}
 	if fqbnIn == "" {
 		return &commands.MissingFQBNError{}
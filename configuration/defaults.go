@@ -30,9 +30,11 @@ func SetDefaults(settings *viper.Viper) {
 
 	// Libraries
 	settings.SetDefault("library.enable_unsafe_install", false)
+	settings.SetDefault("library.index_url", "")
 
 	// Boards Manager
 	settings.SetDefault("board_manager.additional_urls", []string{})
+	settings.SetDefault("board_manager.require_signatures", false)
 
 	// arduino directories
 	settings.SetDefault("directories.Data", getDefaultArduinoDataDir())
@@ -42,6 +44,13 @@ func SetDefaults(settings *viper.Viper) {
 	// Sketch compilation
 	settings.SetDefault("sketch.always_export_binaries", false)
 
+	// Compilation cache
+	settings.SetDefault("build_cache.enabled", false)
+	settings.SetDefault("build_cache.path", filepath.Join(getDefaultArduinoDataDir(), "build-cache"))
+	settings.SetDefault("build_cache.max_size", "5GB")
+	settings.SetDefault("build_cache.remote.url", "")
+	settings.SetDefault("build_cache.remote.read_only", false)
+
 	// daemon settings
 	settings.SetDefault("daemon.port", "50051")
 
@@ -90,3 +90,9 @@ func LibrariesDir(settings *viper.Viper) *paths.Path {
 func PackagesDir(settings *viper.Viper) *paths.Path {
 	return paths.New(settings.GetString("directories.Data")).Join("packages")
 }
+
+// BuildCacheDir returns the full path to the folder containing the compilation
+// cache shared between builds
+func BuildCacheDir(settings *viper.Viper) *paths.Path {
+	return paths.New(settings.GetString("build_cache.path"))
+}
@@ -0,0 +1,74 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package configuration
+
+import (
+	"strings"
+
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+// TrustedKey is an OpenPGP public key trusted to sign the package indexes
+// downloaded from an URL
+type TrustedKey struct {
+	// URL is the URL of the package index or, if it ends with a slash, the
+	// prefix of the URLs of the package indexes
+	URL string `mapstructure:"url"`
+	// Key is the path of the file containing the public key
+	Key string `mapstructure:"key"`
+}
+
+// Matches returns true if the key is trusted to sign the package index
+// downloaded from the given URL
+func (k *TrustedKey) Matches(indexURL string) bool {
+	if strings.HasSuffix(k.URL, "/") {
+		return strings.HasPrefix(indexURL, k.URL)
+	}
+	return indexURL == k.URL
+}
+
+// TrustedKeys returns the keys trusted to sign the package indexes
+func TrustedKeys(settings *viper.Viper) []*TrustedKey {
+	keys := []*TrustedKey{}
+	if err := settings.UnmarshalKey("board_manager.trusted_keys", &keys); err != nil {
+		logrus.WithError(err).Warn("Invalid board_manager.trusted_keys setting")
+		return []*TrustedKey{}
+	}
+	return keys
+}
+
+// TrustedKeysForURL returns the files of the keys trusted to sign the package
+// index downloaded from the given URL
+func TrustedKeysForURL(settings *viper.Viper, indexURL string) paths.PathList {
+	res := paths.NewPathList()
+	for _, k := range TrustedKeys(settings) {
+		if k.Matches(indexURL) {
+			res.Add(paths.New(k.Key))
+		}
+	}
+	return res
+}
+
+// SetTrustedKeys replaces the keys trusted to sign the package indexes
+func SetTrustedKeys(settings *viper.Viper, keys []*TrustedKey) {
+	value := []map[string]string{}
+	for _, k := range keys {
+		value = append(value, map[string]string{"url": k.URL, "key": k.Key})
+	}
+	settings.Set("board_manager.trusted_keys", value)
+}
@@ -0,0 +1,47 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package configuration
+
+import (
+	"testing"
+
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrustedKeys(t *testing.T) {
+	settings := viper.New()
+	SetDefaults(settings)
+	require.Empty(t, TrustedKeys(settings))
+
+	SetTrustedKeys(settings, []*TrustedKey{
+		{URL: "https://example.com/boards/", Key: "/keys/example.asc"},
+		{URL: "https://example.com/package_other_index.json", Key: "/keys/other.gpg"},
+	})
+	require.Equal(t, []*TrustedKey{
+		{URL: "https://example.com/boards/", Key: "/keys/example.asc"},
+		{URL: "https://example.com/package_other_index.json", Key: "/keys/other.gpg"},
+	}, TrustedKeys(settings))
+
+	require.Equal(t, paths.NewPathList("/keys/example.asc"),
+		TrustedKeysForURL(settings, "https://example.com/boards/package_example_index.json"))
+	require.Equal(t, paths.NewPathList("/keys/other.gpg"),
+		TrustedKeysForURL(settings, "https://example.com/package_other_index.json"))
+	// The URLs not ending with a slash are not prefixes
+	require.Empty(t, TrustedKeysForURL(settings, "https://example.com/package_other_index.json.old"))
+	require.Empty(t, TrustedKeysForURL(settings, "https://example.com/boardsX/package_example_index.json"))
+}
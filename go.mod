@@ -15,7 +15,9 @@ require (
 	github.com/fatih/color v1.7.0
 	github.com/fluxio/iohelpers v0.0.0-20160419043813-3a4dd67a94d2 // indirect
 	github.com/fluxio/multierror v0.0.0-20160419044231-9c68d39025e5 // indirect
+	github.com/fsnotify/fsnotify v1.4.9
 	github.com/gofrs/uuid v3.2.0+incompatible
+	github.com/google/go-dap v0.6.0
 	github.com/h2non/filetype v1.0.8 // indirect
 	github.com/juju/loggo v0.0.0-20190526231331-6e530bcce5d8 // indirect
 	github.com/kr/text v0.2.0 // indirect
@@ -16,6 +16,8 @@
 package httpclient
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 
 	"github.com/arduino/arduino-cli/i18n"
@@ -39,6 +41,19 @@ func NewWithConfig(config *Config) *http.Client {
 	transport := newHTTPClientTransport(config)
 
 	return &http.Client{
-		Transport: transport,
+		Transport:     transport,
+		CheckRedirect: checkRedirect,
 	}
 }
+
+// checkRedirect refuses the redirects to local files, that can be read only if
+// requested explicitly, and stops after 10 redirects like the default policy
+func checkRedirect(req *http.Request, via []*http.Request) error {
+	if req.URL.Scheme == "file" {
+		return fmt.Errorf(tr("redirect to local file %s not allowed"), req.URL)
+	}
+	if len(via) >= 10 {
+		return errors.New(tr("stopped after 10 redirects"))
+	}
+	return nil
+}
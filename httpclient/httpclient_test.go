@@ -21,8 +21,11 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"path/filepath"
+	"runtime"
 	"testing"
 
+	"github.com/arduino/go-paths-helper"
 	"github.com/stretchr/testify/require"
 )
 
@@ -68,3 +71,65 @@ func TestProxy(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, http.StatusNoContent, response.StatusCode)
 }
+
+func TestFileURL(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	require.NoError(t, tmp.Join("archive.zip").WriteFile([]byte("archive")))
+
+	client := NewWithConfig(&Config{})
+	fileURL := &url.URL{Scheme: "file", Path: filepath.ToSlash(tmp.Join("archive.zip").String())}
+	if runtime.GOOS == "windows" {
+		fileURL.Path = "/" + fileURL.Path
+	}
+
+	response, err := client.Get(fileURL.String())
+	require.NoError(t, err)
+	defer response.Body.Close()
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	b, err := ioutil.ReadAll(response.Body)
+	require.NoError(t, err)
+	require.Equal(t, "archive", string(b))
+
+	fileURL.Path += ".missing"
+	response, err = client.Get(fileURL.String())
+	require.NoError(t, err)
+	response.Body.Close()
+	require.Equal(t, http.StatusNotFound, response.StatusCode)
+}
+
+func TestRedirectToFileURL(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	require.NoError(t, tmp.Join("secret").WriteFile([]byte("secret")))
+	fileURL := &url.URL{Scheme: "file", Path: filepath.ToSlash(tmp.Join("secret").String())}
+	if runtime.GOOS == "windows" {
+		fileURL.Path = "/" + fileURL.Path
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/redirect" {
+			http.Redirect(w, r, "/archive.zip", http.StatusFound)
+		} else if r.URL.Path == "/archive.zip" {
+			fmt.Fprint(w, "archive")
+		} else {
+			http.Redirect(w, r, fileURL.String(), http.StatusFound)
+		}
+	}))
+	defer ts.Close()
+	client := NewWithConfig(&Config{})
+
+	// The redirects to the network are followed
+	response, err := client.Get(ts.URL + "/redirect")
+	require.NoError(t, err)
+	defer response.Body.Close()
+	b, err := ioutil.ReadAll(response.Body)
+	require.NoError(t, err)
+	require.Equal(t, "archive", string(b))
+
+	// while the ones to local files are refused
+	_, err = client.Get(ts.URL + "/file")
+	require.Error(t, err)
+}
@@ -15,7 +15,13 @@
 
 package httpclient
 
-import "net/http"
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
 
 type httpClientRoundTripper struct {
 	transport http.RoundTripper
@@ -28,6 +34,10 @@ func newHTTPClientTransport(config *Config) http.RoundTripper {
 	transport := &http.Transport{
 		Proxy: proxy,
 	}
+	// Local file URLs are served directly from the file system, this allows
+	// to install from indexes and archives available offline. The client
+	// doesn't follow the redirects to them.
+	transport.RegisterProtocol("file", http.NewFileTransport(localFileSystem{}))
 
 	return &httpClientRoundTripper{
 		transport: transport,
@@ -39,3 +49,14 @@ func (h *httpClientRoundTripper) RoundTrip(req *http.Request) (*http.Response, e
 	req.Header.Add("User-Agent", h.config.UserAgent)
 	return h.transport.RoundTrip(req)
 }
+
+// localFileSystem opens the files referenced by the path of file URLs
+type localFileSystem struct{}
+
+func (localFileSystem) Open(name string) (http.File, error) {
+	if runtime.GOOS == "windows" {
+		// The path of local file URLs on Windows starts with a / followed by the drive letter
+		name = strings.TrimPrefix(name, "/")
+	}
+	return os.Open(filepath.FromSlash(name))
+}
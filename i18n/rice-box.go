@@ -12,25 +12,25 @@ func init() {
 	// define files
 	file2 := &embedded.EmbeddedFile{
 		Filename:    ".gitkeep",
-		FileModTime: time.Unix(1591633479, 0),
+		FileModTime: time.Unix(1631544375, 0),
 
 		Content: string(""),
 	}
 	file3 := &embedded.EmbeddedFile{
 		Filename:    "en.po",
-		FileModTime: time.Unix(1631540712, 0),
+		FileModTime: time.Unix(1792219213, 0),
 
-		Content: string("msgid \"\"\nmsgstr \"\"\n\n#: version/version.go:53\nmsgid \"%[1]s %[2]s Version: %[3]s Commit: %[4]s Date: %[5]s\"\nmsgstr \"%[1]s %[2]s Version: %[3]s Commit: %[4]s Date: %[5]s\"\n\n#: legacy/builder/fail_if_imported_library_is_wrong.go:37\nmsgid \"%[1]s folder is no longer supported! See %[2]s for more information\"\nmsgstr \"%[1]s folder is no longer supported! See %[2]s for more information\"\n\n#: cli/lib/check_deps.go:96\nmsgid \"%[1]s is required but %[2]s is currently installed.\"\nmsgstr \"%[1]s is required but %[2]s is currently installed.\"\n\n#: arduino/discovery/discovery.go:74\nmsgid \"%[1]s, message: %[2]s\"\nmsgstr \"%[1]s, message: %[2]s\"\n\n#: arduino/discovery/discovery.go:83\nmsgid \"%[1]s, port: %[2]s\"\nmsgstr \"%[1]s, port: %[2]s\"\n\n#: arduino/discovery/discovery.go:80\nmsgid \"%[1]s, ports: %[2]s\"\nmsgstr \"%[1]s, ports: %[2]s\"\n\n#: arduino/discovery/discovery.go:77\nmsgid \"%[1]s, protocol version: %[2]d\"\nmsgstr \"%[1]s, protocol version: %[2]d\"\n\n#: cli/output/rpc_progress.go:64\nmsgid \"%s already downloaded\"\nmsgstr \"%s already downloaded\"\n\n#: commands/upload/upload.go:529\nmsgid \"%s and %s cannot be used together\"\nmsgstr \"%s and %s cannot be used together\"\n\n#: cli/output/rpc_progress.go:76\nmsgid \"%s downloaded\"\nmsgstr \"%s downloaded\"\n\n#: commands/bundled_tools.go:55\nmsgid \"%s installed\"\nmsgstr \"%s installed\"\n\n#: cli/lib/check_deps.go:93\nmsgid \"%s is already installed.\"\nmsgstr \"%s is already installed.\"\n\n#: arduino/cores/packagemanager/loader.go:71\nmsgid \"%s is not a directory\"\nmsgstr \"%s is not a directory\"\n\n#: arduino/cores/packagemanager/install_uninstall.go:113\nmsgid \"%s is not managed by package manager\"\nmsgstr \"%s is not managed by package manager\"\n\n#: cli/lib/check_deps.go:90\nmsgid \"%s must be installed.\"\nmsgstr \"%s must be installed.\"\n\n#: legacy/builder/builder_utils/utils.go:530\n#: legacy/builder/ctags_runner.go:41\nmsgid \"%s pattern is missing\"\nmsgstr \"%s pattern is missing\"\n\n#: commands/instances.go:839\nmsgid \"%s uninstalled\"\nmsgstr \"%s uninstalled\"\n\n#: commands/errors.go:595\nmsgid \"'%s' has an invalid signature\"\nmsgstr \"'%s' has an invalid signature\"\n\n#: cli/board/listall.go:88\n#: cli/board/search.go:90\nmsgid \"(hidden)\"\nmsgstr \"(hidden)\"\n\n#: legacy/builder/print_used_libraries_if_verbose.go:38\nmsgid \"(legacy)\"\nmsgstr \"(legacy)\"\n\n#: cli/lib/install.go:71\nmsgid \"--git-url and --zip-path are disabled by default, for more information see: %v\"\nmsgstr \"--git-url and --zip-path are disabled by default, for more information see: %v\"\n\n#: cli/lib/install.go:74\nmsgid \"--git-url and --zip-path flags allow installing untrusted files, use it at your own risk.\"\nmsgstr \"--git-url and --zip-path flags allow installing untrusted files, use it at your own risk.\"\n\n#: cli/updater/updater.go:70\nmsgid \"A new release of Arduino CLI is available:\"\nmsgstr \"A new release of Arduino CLI is available:\"\n\n#: commands/errors.go:181\nmsgid \"A programmer is required to upload\"\nmsgstr \"A programmer is required to upload\"\n\n#: cli/core/download.go:36\n#: cli/core/install.go:37\n#: cli/core/uninstall.go:36\n#: cli/core/upgrade.go:38\nmsgid \"ARCH\"\nmsgstr \"ARCH\"\n\n#: cli/generatedocs/generatedocs.go:79\nmsgid \"ARDUINO COMMAND LINE MANUAL\"\nmsgstr \"ARDUINO COMMAND LINE MANUAL\"\n\n#: cli/usage.go:32\nmsgid \"Additional help topics:\"\nmsgstr \"Additional help topics:\"\n\n#: cli/config/add.go:31\n#: cli/config/add.go:32\nmsgid \"Adds one or more values to a setting.\"\nmsgstr \"Adds one or more values to a setting.\"\n\n#: cli/usage.go:27\nmsgid \"Aliases:\"\nmsgstr \"Aliases:\"\n\n#: cli/core/upgrade.go:68\nmsgid \"All the cores are already at the latest version\"\nmsgstr \"All the cores are already at the latest version\"\n\n#: commands/instances.go:708\n#: commands/lib/install.go:96\nmsgid \"Already installed %s\"\nmsgstr \"Already installed %s\"\n\n#: legacy/builder/resolve_library.go:34\nmsgid \"Alternatives for %[1]s: %[2]s\"\nmsgstr \"Alternatives for %[1]s: %[2]s\"\n\n#: cli/lib/search.go:171\nmsgid \"Architecture: %s\"\nmsgstr \"Architecture: %s\"\n\n#: commands/sketch/archive.go:70\nmsgid \"Archive already exists\"\nmsgstr \"Archive already exists\"\n\n#: legacy/builder/phases/core_builder.go:128\nmsgid \"Archiving built core (caching) in: {0}\"\nmsgstr \"Archiving built core (caching) in: {0}\"\n\n#: cli/sketch/sketch.go:31\n#: cli/sketch/sketch.go:32\nmsgid \"Arduino CLI sketch commands.\"\nmsgstr \"Arduino CLI sketch commands.\"\n\n#: cli/cli.go:71\nmsgid \"Arduino CLI.\"\nmsgstr \"Arduino CLI.\"\n\n#: cli/cli.go:72\nmsgid \"Arduino Command Line Interface (arduino-cli).\"\nmsgstr \"Arduino Command Line Interface (arduino-cli).\"\n\n#: cli/board/board.go:28\n#: cli/board/board.go:29\nmsgid \"Arduino board commands.\"\nmsgstr \"Arduino board commands.\"\n\n#: cli/cache/cache.go:31\n#: cli/cache/cache.go:32\nmsgid \"Arduino cache commands.\"\nmsgstr \"Arduino cache commands.\"\n\n#: cli/lib/lib.go:31\n#: cli/lib/lib.go:32\nmsgid \"Arduino commands about libraries.\"\nmsgstr \"Arduino commands about libraries.\"\n\n#: cli/config/config.go:31\nmsgid \"Arduino configuration commands.\"\nmsgstr \"Arduino configuration commands.\"\n\n#: cli/core/core.go:31\n#: cli/core/core.go:32\nmsgid \"Arduino core operations.\"\nmsgstr \"Arduino core operations.\"\n\n#: cli/lib/check_deps.go:50\n#: cli/lib/install.go:117\nmsgid \"Arguments error: %v\"\nmsgstr \"Arguments error: %v\"\n\n#: cli/board/attach.go:68\nmsgid \"Attach board error: %v\"\nmsgstr \"Attach board error: %v\"\n\n#: cli/board/attach.go:36\n#: cli/board/attach.go:37\n#: cli/board/board.go:32\nmsgid \"Attaches a sketch to a board.\"\nmsgstr \"Attaches a sketch to a board.\"\n\n#: cli/lib/search.go:162\nmsgid \"Author: %s\"\nmsgstr \"Author: %s\"\n\n#: cli/lib/list.go:125\nmsgid \"Available\"\nmsgstr \"Available\"\n\n#: cli/usage.go:29\nmsgid \"Available Commands:\"\nmsgstr \"Available Commands:\"\n\n#: cli/upload/upload.go:60\nmsgid \"Binary file to upload.\"\nmsgstr \"Binary file to upload.\"\n\n#: cli/board/list.go:87\n#: cli/board/list.go:125\n#: cli/board/listall.go:84\n#: cli/board/search.go:86\nmsgid \"Board Name\"\nmsgstr \"Board Name\"\n\n#: commands/board/attach.go:93\nmsgid \"Board found: %s\"\nmsgstr \"Board found: %s\"\n\n#: cli/board/details.go:120\nmsgid \"Board name:\"\nmsgstr \"Board name:\"\n\n#: cli/board/details.go:122\nmsgid \"Board version:\"\nmsgstr \"Board version:\"\n\n#: legacy/builder/merge_sketch_with_bootloader.go:69\nmsgid \"Bootloader file specified but missing: {0}\"\nmsgstr \"Bootloader file specified but missing: {0}\"\n\n#: cli/compile/compile.go:91\nmsgid \"Builds of 'core.a' are saved into this path to be cached and reused.\"\nmsgstr \"Builds of 'core.a' are saved into this path to be cached and reused.\"\n\n#: commands/instances.go:521\nmsgid \"Can't create data directory %s\"\nmsgstr \"Can't create data directory %s\"\n\n#: commands/lib/download.go:60\n#: commands/lib/download.go:63\n#: commands/lib/download.go:65\nmsgid \"Can't download library\"\nmsgstr \"Can't download library\"\n\n#: commands/core/install.go:126\n#: commands/core/uninstall.go:52\n#: commands/instances.go:747\n#: commands/instances.go:759\nmsgid \"Can't find dependencies for platform %s\"\nmsgstr \"Can't find dependencies for platform %s\"\n\n#: commands/errors.go:332\nmsgid \"Can't open sketch\"\nmsgstr \"Can't open sketch\"\n\n#: cli/config/set.go:54\nmsgid \"Can't set multiple values in key %v\"\nmsgstr \"Can't set multiple values in key %v\"\n\n#: cli/config/init.go:59\nmsgid \"Can't use both --dest-file and --dest-dir flags at the same time.\"\nmsgstr \"Can't use both --dest-file and --dest-dir flags at the same time.\"\n\n#: cli/config/add.go:60\n#: cli/config/delete.go:67\n#: cli/config/remove.go:69\nmsgid \"Can't write config file: %v\"\nmsgstr \"Can't write config file: %v\"\n\n#: commands/compile/compile.go:180\nmsgid \"Cannot create build cache directory\"\nmsgstr \"Cannot create build cache directory\"\n\n#: commands/compile/compile.go:150\nmsgid \"Cannot create build directory\"\nmsgstr \"Cannot create build directory\"\n\n#: cli/config/init.go:96\nmsgid \"Cannot create config file directory: %v\"\nmsgstr \"Cannot create config file directory: %v\"\n\n#: cli/config/init.go:105\nmsgid \"Cannot create config file: %v\"\nmsgstr \"Cannot create config file: %v\"\n\n#: commands/errors.go:558\nmsgid \"Cannot create temp dir\"\nmsgstr \"Cannot create temp dir\"\n\n#: commands/errors.go:576\nmsgid \"Cannot create temp file\"\nmsgstr \"Cannot create temp file\"\n\n#: commands/debug/debug.go:66\nmsgid \"Cannot execute debug tool\"\nmsgstr \"Cannot execute debug tool\"\n\n#: commands/board/attach.go:106\nmsgid \"Cannot export sketch metadata\"\nmsgstr \"Cannot export sketch metadata\"\n\n#: cli/config/init.go:71\n#: cli/config/init.go:82\nmsgid \"Cannot find absolute path: %v\"\nmsgstr \"Cannot find absolute path: %v\"\n\n#: configuration/configuration.go:148\n#: configuration/configuration.go:154\nmsgid \"Cannot get executable path: %v\"\nmsgstr \"Cannot get executable path: %v\"\n\n#: commands/core/install.go:133\nmsgid \"Cannot install platform\"\nmsgstr \"Cannot install platform\"\n\n#: commands/bundled_tools.go:52\nmsgid \"Cannot install tool %s\"\nmsgstr \"Cannot install tool %s\"\n\n#: commands/upload/upload.go:420\nmsgid \"Cannot perform port reset: %s\"\nmsgstr \"Cannot perform port reset: %s\"\n\n#: commands/core/install.go:151\nmsgid \"Cannot upgrade platform\"\nmsgstr \"Cannot upgrade platform\"\n\n#: cli/lib/search.go:170\nmsgid \"Category: %s\"\nmsgstr \"Category: %s\"\n\n#: cli/lib/check_deps.go:35\n#: cli/lib/check_deps.go:36\nmsgid \"Check dependencies status for the specified library.\"\nmsgstr \"Check dependencies status for the specified library.\"\n\n#: commands/lib/install.go:101\nmsgid \"Checking lib install prerequisites\"\nmsgstr \"Checking lib install prerequisites\"\n\n#: legacy/builder/builder_utils/utils.go:280\nmsgid \"Checking previous results for {0} (result = {1}, dep = {2})\"\nmsgstr \"Checking previous results for {0} (result = {1}, dep = {2})\"\n\n#: arduino/resources/checksums.go:182\nmsgid \"Checksum differs from checksum in package.json\"\nmsgstr \"Checksum differs from checksum in package.json\"\n\n#: cli/board/details.go:168\nmsgid \"Checksum:\"\nmsgstr \"Checksum:\"\n\n#: cli/cache/cache.go:33\nmsgid \"Clean caches.\"\nmsgstr \"Clean caches.\"\n\n#: cli/cli.go:111\nmsgid \"Comma-separated list of additional URLs for the Boards Manager.\"\nmsgstr \"Comma-separated list of additional URLs for the Boards Manager.\"\n\n#: cli/board/list.go:47\nmsgid \"Command keeps running and prints list of connected boards whenever there is a change.\"\nmsgstr \"Command keeps running and prints list of connected boards whenever there is a change.\"\n\n#: commands/debug/debug_info.go:118\n#: commands/upload/upload.go:358\nmsgid \"Compiled sketch not found in %s\"\nmsgstr \"Compiled sketch not found in %s\"\n\n#: cli/compile/compile.go:74\n#: cli/compile/compile.go:75\nmsgid \"Compiles Arduino sketches.\"\nmsgstr \"Compiles Arduino sketches.\"\n\n#: legacy/builder/builder.go:81\nmsgid \"Compiling core...\"\nmsgstr \"Compiling core...\"\n\n#: legacy/builder/builder.go:75\nmsgid \"Compiling libraries...\"\nmsgstr \"Compiling libraries...\"\n\n#: legacy/builder/phases/libraries_builder.go:135\nmsgid \"Compiling library \\\"{0}\\\"\"\nmsgstr \"Compiling library \\\"{0}\\\"\"\n\n#: legacy/builder/builder.go:70\nmsgid \"Compiling sketch...\"\nmsgstr \"Compiling sketch...\"\n\n#: cli/config/init.go:89\nmsgid \"Config file already exists, use --overwrite to discard the existing one.\"\nmsgstr \"Config file already exists, use --overwrite to discard the existing one.\"\n\n#: cli/config/init.go:109\nmsgid \"Config file written to: %s\"\nmsgstr \"Config file written to: %s\"\n\n#: cli/debug/debug.go:153\nmsgid \"Configuration options for %s\"\nmsgstr \"Configuration options for %s\"\n\n#: commands/instances.go:846\nmsgid \"Configuring platform\"\nmsgstr \"Configuring platform\"\n\n#: commands/core/install.go:166\nmsgid \"Configuring platform.\"\nmsgstr \"Configuring platform.\"\n\n#: cli/board/list.go:183\nmsgid \"Connected\"\nmsgstr \"Connected\"\n\n#: cli/board/list.go:87\n#: cli/board/list.go:125\nmsgid \"Core\"\nmsgstr \"Core\"\n\n#: cli/update/update.go:99\nmsgid \"Core name\"\nmsgstr \"Core name\"\n\n#: commands/download.go:31\nmsgid \"Could not connect via HTTP\"\nmsgstr \"Could not connect via HTTP\"\n\n#: commands/instances.go:362\nmsgid \"Could not create index directory\"\nmsgstr \"Could not create index directory\"\n\n#: cli/sketch/new.go:58\nmsgid \"Could not create sketch directory: %v\"\nmsgstr \"Could not create sketch directory: %v\"\n\n#: legacy/builder/phases/core_builder.go:48\nmsgid \"Couldn't deeply cache core build: {0}\"\nmsgstr \"Couldn't deeply cache core build: {0}\"\n\n#: legacy/builder/phases/sizer.go:81\nmsgid \"Couldn't determine program size\"\nmsgstr \"Couldn't determine program size\"\n\n#: cli/arguments/sketch.go:36\n#: cli/lib/install.go:97\nmsgid \"Couldn't get current working directory: %v\"\nmsgstr \"Couldn't get current working directory: %v\"\n\n#: cli/sketch/new.go:32\n#: cli/sketch/new.go:33\nmsgid \"Create a new Sketch\"\nmsgstr \"Create a new Sketch\"\n\n#: cli/sketch/archive.go:39\n#: cli/sketch/archive.go:40\nmsgid \"Creates a zip file containing all sketch files.\"\nmsgstr \"Creates a zip file containing all sketch files.\"\n\n#: cli/config/init.go:42\nmsgid \"Creates or updates the configuration file in the data directory or custom directory with the current configuration settings.\"\nmsgstr \"Creates or updates the configuration file in the data directory or custom directory with the current configuration settings.\"\n\n#: cli/core/list.go:88\n#: cli/core/search.go:118\nmsgid \"DEPRECATED\"\nmsgstr \"DEPRECATED\"\n\n#: cli/debug/debug.go:54\nmsgid \"Debug Arduino sketches.\"\nmsgstr \"Debug Arduino sketches.\"\n\n#: cli/debug/debug.go:55\nmsgid \"Debug Arduino sketches. (this command opens an interactive gdb session)\"\nmsgstr \"Debug Arduino sketches. (this command opens an interactive gdb session)\"\n\n#: cli/debug/debug.go:64\nmsgid \"Debug interpreter e.g.: %s\"\nmsgstr \"Debug interpreter e.g.: %s\"\n\n#: commands/debug/debug_info.go:141\nmsgid \"Debugging not supported for board %s\"\nmsgstr \"Debugging not supported for board %s\"\n\n#: cli/board/details.go:124\nmsgid \"Debugging supported:\"\nmsgstr \"Debugging supported:\"\n\n#: cli/cache/clean.go:31\nmsgid \"Delete Boards/Library Manager download cache.\"\nmsgstr \"Delete Boards/Library Manager download cache.\"\n\n#: cli/cache/clean.go:32\nmsgid \"Delete contents of the `directories.downloads` folder, where archive files are staged during installation of libraries and boards platforms.\"\nmsgstr \"Delete contents of the `directories.downloads` folder, where archive files are staged during installation of libraries and boards platforms.\"\n\n#: cli/config/delete.go:32\n#: cli/config/delete.go:33\nmsgid \"Deletes a settings key and all its sub keys.\"\nmsgstr \"Deletes a settings key and all its sub keys.\"\n\n#: cli/lib/search.go:178\nmsgid \"Dependencies: %s\"\nmsgstr \"Dependencies: %s\"\n\n#: legacy/builder/builder_utils/utils.go:358\nmsgid \"Depfile is about different file: {0}\"\nmsgstr \"Depfile is about different file: {0}\"\n\n#: cli/lib/list.go:125\nmsgid \"Description\"\nmsgstr \"Description\"\n\n#: legacy/builder/builder.go:62\nmsgid \"Detecting libraries used...\"\nmsgstr \"Detecting libraries used...\"\n\n#: cli/board/list.go:38\nmsgid \"Detects and displays a list of boards connected to the current computer.\"\nmsgstr \"Detects and displays a list of boards connected to the current computer.\"\n\n#: cli/debug/debug.go:65\nmsgid \"Directory containing binaries for debug.\"\nmsgstr \"Directory containing binaries for debug.\"\n\n#: cli/upload/upload.go:59\nmsgid \"Directory containing binaries to upload.\"\nmsgstr \"Directory containing binaries to upload.\"\n\n#: cli/generatedocs/generatedocs.go:45\nmsgid \"Directory where to save generated files. Default is './docs', the directory must exist.\"\nmsgstr \"Directory where to save generated files. Default is './docs', the directory must exist.\"\n\n#: cli/completion/completion.go:44\nmsgid \"Disable completion description for shells that support it\"\nmsgstr \"Disable completion description for shells that support it\"\n\n#: cli/board/list.go:184\nmsgid \"Disconnected\"\nmsgstr \"Disconnected\"\n\n#: cli/daemon/daemon.go:60\nmsgid \"Display only the provided gRPC calls\"\nmsgstr \"Display only the provided gRPC calls\"\n\n#: cli/lib/install.go:49\nmsgid \"Do not install dependencies.\"\nmsgstr \"Do not install dependencies.\"\n\n#: cli/burnbootloader/burnbootloader.go:58\n#: cli/upload/upload.go:64\nmsgid \"Do not perform the actual upload, just log out actions\"\nmsgstr \"Do not perform the actual upload, just log out actions\"\n\n#: cli/daemon/daemon.go:58\nmsgid \"Do not terminate daemon process if the parent process dies\"\nmsgstr \"Do not terminate daemon process if the parent process dies\"\n\n#: commands/instances.go:697\n#: commands/instances.go:756\n#: commands/lib/download.go:57\nmsgid \"Downloading %s\"\nmsgstr \"Downloading %s\"\n\n#: commands/instances.go:93\nmsgid \"Downloading missing tool %s\"\nmsgstr \"Downloading missing tool %s\"\n\n#: commands/core/install.go:86\nmsgid \"Downloading packages\"\nmsgstr \"Downloading packages\"\n\n#: cli/core/download.go:37\n#: cli/core/download.go:38\nmsgid \"Downloads one or more cores and corresponding tool dependencies.\"\nmsgstr \"Downloads one or more cores and corresponding tool dependencies.\"\n\n#: cli/lib/download.go:35\n#: cli/lib/download.go:36\nmsgid \"Downloads one or more libraries without installing them.\"\nmsgstr \"Downloads one or more libraries without installing them.\"\n\n#: cli/daemon/daemon.go:59\nmsgid \"Enable debug logging of gRPC calls\"\nmsgstr \"Enable debug logging of gRPC calls\"\n\n#: cli/lib/install.go:51\nmsgid \"Enter a path to zip file\"\nmsgstr \"Enter a path to zip file\"\n\n#: cli/lib/install.go:50\nmsgid \"Enter git url for libraries hosted on repositories\"\nmsgstr \"Enter git url for libraries hosted on repositories\"\n\n#: commands/sketch/archive.go:105\nmsgid \"Error adding file to sketch archive\"\nmsgstr \"Error adding file to sketch archive\"\n\n#: legacy/builder/phases/core_builder.go:136\nmsgid \"Error archiving built core (caching) in {0}: {1}\"\nmsgstr \"Error archiving built core (caching) in {0}: {1}\"\n\n#: cli/sketch/archive.go:85\nmsgid \"Error archiving: %v\"\nmsgstr \"Error archiving: %v\"\n\n#: commands/sketch/archive.go:93\nmsgid \"Error calculating relative file path\"\nmsgstr \"Error calculating relative file path\"\n\n#: cli/cache/clean.go:46\nmsgid \"Error cleaning caches: %v\"\nmsgstr \"Error cleaning caches: %v\"\n\n#: commands/compile/compile.go:280\nmsgid \"Error copying output file %s\"\nmsgstr \"Error copying output file %s\"\n\n#: cli/core/search.go:66\n#: cli/core/update_index.go:52\n#: cli/instance/instance.go:42\n#: cli/lib/search.go:57\n#: cli/lib/update_index.go:45\n#: cli/update/update.go:62\nmsgid \"Error creating instance: %v\"\nmsgstr \"Error creating instance: %v\"\n\n#: commands/compile/compile.go:260\nmsgid \"Error creating output dir\"\nmsgstr \"Error creating output dir\"\n\n#: commands/sketch/archive.go:81\nmsgid \"Error creating sketch archive\"\nmsgstr \"Error creating sketch archive\"\n\n#: cli/sketch/new.go:54\n#: cli/sketch/new.go:64\nmsgid \"Error creating sketch: %v\"\nmsgstr \"Error creating sketch: %v\"\n\n#: cli/board/list.go:71\n#: cli/board/list.go:80\nmsgid \"Error detecting boards: %v\"\nmsgstr \"Error detecting boards: %v\"\n\n#: cli/core/download.go:68\n#: cli/lib/download.go:62\nmsgid \"Error downloading %[1]s: %[2]v\"\nmsgstr \"Error downloading %[1]s: %[2]v\"\n\n#: commands/instances.go:467\n#: commands/instances.go:471\n#: commands/instances.go:476\nmsgid \"Error downloading index '%s'\"\nmsgstr \"Error downloading index '%s'\"\n\n#: commands/instances.go:500\n#: commands/instances.go:506\nmsgid \"Error downloading index signature '%s'\"\nmsgstr \"Error downloading index signature '%s'\"\n\n#: commands/instances.go:699\n#: commands/instances.go:701\nmsgid \"Error downloading library\"\nmsgstr \"Error downloading library\"\n\n#: commands/instances.go:376\n#: commands/instances.go:379\nmsgid \"Error downloading library_index.json.gz\"\nmsgstr \"Error downloading library_index.json.gz\"\n\n#: commands/instances.go:386\n#: commands/instances.go:389\nmsgid \"Error downloading library_index.json.sig\"\nmsgstr \"Error downloading library_index.json.sig\"\n\n#: commands/core/download.go:70\n#: commands/core/download.go:74\n#: commands/instances.go:782\n#: commands/instances.go:784\nmsgid \"Error downloading platform %s\"\nmsgstr \"Error downloading platform %s\"\n\n#: commands/core/download.go:83\n#: commands/core/download.go:88\n#: commands/instances.go:775\n#: commands/instances.go:776\nmsgid \"Error downloading tool %s\"\nmsgstr \"Error downloading tool %s\"\n\n#: cli/debug/debug.go:81\n#: cli/debug/debug.go:86\n#: cli/debug/debug.go:115\nmsgid \"Error during Debug: %v\"\nmsgstr \"Error during Debug: %v\"\n\n#: cli/feedback/feedback.go:144\nmsgid \"Error during JSON encoding of the output: %v\"\nmsgstr \"Error during JSON encoding of the output: %v\"\n\n#: cli/burnbootloader/burnbootloader.go:70\n#: cli/burnbootloader/burnbootloader.go:83\n#: cli/compile/compile.go:199\n#: cli/compile/compile.go:205\n#: cli/compile/compile.go:215\n#: cli/compile/compile.go:247\n#: cli/upload/upload.go:95\n#: cli/upload/upload.go:101\n#: cli/upload/upload.go:117\n#: cli/upload/upload.go:144\nmsgid \"Error during Upload: %v\"\nmsgstr \"Error during Upload: %v\"\n\n#: cli/compile/compile.go:259\nmsgid \"Error during build: %v\"\nmsgstr \"Error during build: %v\"\n\n#: cli/core/install.go:106\nmsgid \"Error during install: %v\"\nmsgstr \"Error during install: %v\"\n\n#: cli/core/uninstall.go:68\nmsgid \"Error during uninstall: %v\"\nmsgstr \"Error during uninstall: %v\"\n\n#: cli/core/upgrade.go:105\nmsgid \"Error during upgrade: %v\"\nmsgstr \"Error during upgrade: %v\"\n\n#: commands/instances.go:395\nmsgid \"Error extracting library_index.json.gz\"\nmsgstr \"Error extracting library_index.json.gz\"\n\n#: commands/upload/upload.go:355\nmsgid \"Error finding build artifacts\"\nmsgstr \"Error finding build artifacts\"\n\n#: cli/debug/debug.go:102\nmsgid \"Error getting Debug info: %v\"\nmsgstr \"Error getting Debug info: %v\"\n\n#: commands/sketch/archive.go:59\nmsgid \"Error getting absolute path of sketch archive\"\nmsgstr \"Error getting absolute path of sketch archive\"\n\n#: cli/board/details.go:71\nmsgid \"Error getting board details: %v\"\nmsgstr \"Error getting board details: %v\"\n\n#: commands/board/list.go:146\nmsgid \"Error getting board info from Arduino Cloud\"\nmsgstr \"Error getting board info from Arduino Cloud\"\n\n#: commands/board/list.go:211\nmsgid \"Error getting board list\"\nmsgstr \"Error getting board list\"\n\n#: arduino/builder/compilation_database.go:78\nmsgid \"Error getting current directory for compilation database: %s\"\nmsgstr \"Error getting current directory for compilation database: %s\"\n\n#: commands/compile/compile.go:289\n#: commands/lib/list.go:106\nmsgid \"Error getting information for library %s\"\nmsgstr \"Error getting information for library %s\"\n\n#: cli/lib/examples.go:69\nmsgid \"Error getting libraries info: %v\"\nmsgstr \"Error getting libraries info: %v\"\n\n#: legacy/builder/types/context.go:239\nmsgid \"Error in FQBN: %s\"\nmsgstr \"Error in FQBN: %s\"\n\n#: cli/core/search.go:81\n#: cli/instance/instance.go:46\n#: cli/lib/search.go:71\n#: cli/update/update.go:87\nmsgid \"Error initializing instance: %v\"\nmsgstr \"Error initializing instance: %v\"\n\n#: cli/lib/install.go:130\nmsgid \"Error installing %s: %v\"\nmsgstr \"Error installing %s: %v\"\n\n#: cli/lib/install.go:108\nmsgid \"Error installing Git Library: %v\"\nmsgstr \"Error installing Git Library: %v\"\n\n#: cli/lib/install.go:85\nmsgid \"Error installing Zip Library: %v\"\nmsgstr \"Error installing Zip Library: %v\"\n\n#: commands/instances.go:803\nmsgid \"Error installing platform %s\"\nmsgstr \"Error installing platform %s\"\n\n#: commands/instances.go:793\nmsgid \"Error installing tool %s\"\nmsgstr \"Error installing tool %s\"\n\n#: cli/lib/list.go:77\nmsgid \"Error listing Libraries: %v\"\nmsgstr \"Error listing Libraries: %v\"\n\n#: cli/board/listall.go:61\nmsgid \"Error listing boards: %v\"\nmsgstr \"Error listing boards: %v\"\n\n#: cli/core/list.go:61\nmsgid \"Error listing platforms: %v\"\nmsgstr \"Error listing platforms: %v\"\n\n#: cli/compile/compile.go:150\nmsgid \"Error opening source code overrides data file: %v\"\nmsgstr \"Error opening source code overrides data file: %v\"\n\n#: commands/compile/compile.go:270\nmsgid \"Error reading build directory\"\nmsgstr \"Error reading build directory\"\n\n#: configuration/configuration.go:69\nmsgid \"Error reading config file: %v\"\nmsgstr \"Error reading config file: %v\"\n\n#: commands/sketch/archive.go:75\nmsgid \"Error reading sketch files\"\nmsgstr \"Error reading sketch files\"\n\n#: legacy/builder/target_board_resolver.go:33\nmsgid \"Error resolving FQBN: {0}\"\nmsgstr \"Error resolving FQBN: {0}\"\n\n#: cli/lib/check_deps.go:60\nmsgid \"Error resolving dependencies for %[1]s: %[2]s\"\nmsgstr \"Error resolving dependencies for %[1]s: %[2]s\"\n\n#: cli/core/upgrade.go:63\nmsgid \"Error retrieving core list: %v\"\nmsgstr \"Error retrieving core list: %v\"\n\n#: cli/outdated/outdated.go:57\n#: cli/update/update.go:94\nmsgid \"Error retrieving outdated cores and libraries: %v\"\nmsgstr \"Error retrieving outdated cores and libraries: %v\"\n\n#: commands/instances.go:819\nmsgid \"Error rolling-back changes\"\nmsgstr \"Error rolling-back changes\"\n\n#: commands/core/install.go:148\nmsgid \"Error rolling-back changes: %s\"\nmsgstr \"Error rolling-back changes: %s\"\n\n#: commands/instances.go:525\nmsgid \"Error saving downloaded index %s\"\nmsgstr \"Error saving downloaded index %s\"\n\n#: commands/instances.go:529\nmsgid \"Error saving downloaded index signature\"\nmsgstr \"Error saving downloaded index signature\"\n\n#: cli/board/search.go:63\nmsgid \"Error searching boards: %v\"\nmsgstr \"Error searching boards: %v\"\n\n#: cli/lib/search.go:80\nmsgid \"Error searching for Library: %v\"\nmsgstr \"Error searching for Library: %v\"\n\n#: cli/core/search.go:93\nmsgid \"Error searching for platforms: %v\"\nmsgstr \"Error searching for platforms: %v\"\n\n#: arduino/builder/compilation_database.go:63\nmsgid \"Error serializing compilation database: %s\"\nmsgstr \"Error serializing compilation database: %s\"\n\n#: commands/board/list.go:196\n#: commands/board/list.go:199\n#: commands/board/list.go:240\nmsgid \"Error starting board discoveries\"\nmsgstr \"Error starting board discoveries\"\n\n#: cli/lib/uninstall.go:62\nmsgid \"Error uninstalling %[1]s: %[2]v\"\nmsgstr \"Error uninstalling %[1]s: %[2]v\"\n\n#: commands/core/uninstall.go:80\nmsgid \"Error uninstalling platform %s\"\nmsgstr \"Error uninstalling platform %s\"\n\n#: commands/core/uninstall.go:96\n#: commands/instances.go:835\nmsgid \"Error uninstalling tool %s\"\nmsgstr \"Error uninstalling tool %s\"\n\n#: cli/update/update.go:79\nmsgid \"Error updating core and libraries index: %v\"\nmsgstr \"Error updating core and libraries index: %v\"\n\n#: cli/core/search.go:75\n#: cli/core/update_index.go:69\nmsgid \"Error updating index: %v\"\nmsgstr \"Error updating index: %v\"\n\n#: cli/core/update_index.go:61\n#: cli/lib/update_index.go:54\n#: cli/update/update.go:71\nmsgid \"Error updating indexes: %v\"\nmsgstr \"Error updating indexes: %v\"\n\n#: cli/lib/search.go:66\n#: cli/lib/update_index.go:62\nmsgid \"Error updating library index: %v\"\nmsgstr \"Error updating library index: %v\"\n\n#: cli/lib/upgrade.go:50\n#: cli/lib/upgrade.go:56\nmsgid \"Error upgrading libraries: %v\"\nmsgstr \"Error upgrading libraries: %v\"\n\n#: commands/core/install.go:143\n#: commands/instances.go:814\nmsgid \"Error upgrading platform: %s\"\nmsgstr \"Error upgrading platform: %s\"\n\n#: cli/upgrade/upgrade.go:60\nmsgid \"Error upgrading: %v\"\nmsgstr \"Error upgrading: %v\"\n\n#: commands/instances.go:400\n#: commands/instances.go:510\nmsgid \"Error verifying signature\"\nmsgstr \"Error verifying signature\"\n\n#: legacy/builder/container_find_includes.go:354\nmsgid \"Error while detecting libraries included by {0}\"\nmsgstr \"Error while detecting libraries included by {0}\"\n\n#: legacy/builder/phases/sizer.go:147\n#: legacy/builder/phases/sizer.go:153\nmsgid \"Error while determining sketch size: %s\"\nmsgstr \"Error while determining sketch size: %s\"\n\n#: arduino/builder/compilation_database.go:66\nmsgid \"Error writing compilation database: %s\"\nmsgstr \"Error writing compilation database: %s\"\n\n#: commands/instances.go:409\nmsgid \"Error writing library_index.json\"\nmsgstr \"Error writing library_index.json\"\n\n#: commands/instances.go:412\nmsgid \"Error writing library_index.json.sig\"\nmsgstr \"Error writing library_index.json.sig\"\n\n#: cli/completion/completion.go:51\nmsgid \"Error: command description is not supported by %v\"\nmsgstr \"Error: command description is not supported by %v\"\n\n#: cli/compile/compile.go:157\nmsgid \"Error: invalid source code overrides data file: %v\"\nmsgstr \"Error: invalid source code overrides data file: %v\"\n\n#: cli/board/list.go:87\nmsgid \"Event\"\nmsgstr \"Event\"\n\n#: cli/lib/examples.go:118\nmsgid \"Examples for library %s\"\nmsgstr \"Examples for library %s\"\n\n#: cli/usage.go:28\nmsgid \"Examples:\"\nmsgstr \"Examples:\"\n\n#: cli/debug/debug.go:134\nmsgid \"Executable to debug\"\nmsgstr \"Executable to debug\"\n\n#: commands/debug/debug_info.go:121\n#: commands/upload/upload.go:361\nmsgid \"Expected compiled sketch in directory %s, but is a file instead\"\nmsgstr \"Expected compiled sketch in directory %s, but is a file instead\"\n\n#: cli/board/attach.go:35\n#: cli/board/details.go:41\n#: cli/board/list.go:87\n#: cli/board/list.go:125\n#: cli/board/listall.go:84\n#: cli/board/search.go:86\nmsgid \"FQBN\"\nmsgstr \"FQBN\"\n\n#: cli/board/details.go:121\nmsgid \"FQBN:\"\nmsgstr \"FQBN:\"\n\n#: commands/upload/upload.go:450\nmsgid \"Failed chip erase\"\nmsgstr \"Failed chip erase\"\n\n#: commands/upload/upload.go:457\nmsgid \"Failed programming\"\nmsgstr \"Failed programming\"\n\n#: commands/upload/upload.go:453\nmsgid \"Failed to burn bootloader\"\nmsgstr \"Failed to burn bootloader\"\n\n#: commands/instances.go:123\nmsgid \"Failed to create data directory\"\nmsgstr \"Failed to create data directory\"\n\n#: commands/instances.go:113\nmsgid \"Failed to create downloads directory\"\nmsgstr \"Failed to create downloads directory\"\n\n#: cli/daemon/daemon.go:124\nmsgid \"Failed to listen on TCP port: %[1]s. %[2]s is an invalid port.\"\nmsgstr \"Failed to listen on TCP port: %[1]s. %[2]s is an invalid port.\"\n\n#: cli/daemon/daemon.go:118\nmsgid \"Failed to listen on TCP port: %[1]s. %[2]s is unknown name.\"\nmsgstr \"Failed to listen on TCP port: %[1]s. %[2]s is unknown name.\"\n\n#: cli/daemon/daemon.go:133\nmsgid \"Failed to listen on TCP port: %[1]s. Unexpected error: %[2]v\"\nmsgstr \"Failed to listen on TCP port: %[1]s. Unexpected error: %[2]v\"\n\n#: cli/daemon/daemon.go:130\nmsgid \"Failed to listen on TCP port: %s. Address already in use.\"\nmsgstr \"Failed to listen on TCP port: %s. Address already in use.\"\n\n#: legacy/builder/builder_utils/utils.go:380\nmsgid \"Failed to read: {0}\"\nmsgstr \"Failed to read: {0}\"\n\n#: commands/upload/upload.go:461\nmsgid \"Failed uploading\"\nmsgstr \"Failed uploading\"\n\n#: cli/board/details.go:166\nmsgid \"File:\"\nmsgstr \"File:\"\n\n#: commands/daemon/debug.go:47\nmsgid \"First message must contain debug request, not data\"\nmsgstr \"First message must contain debug request, not data\"\n\n#: cli/usage.go:30\nmsgid \"Flags:\"\nmsgstr \"Flags:\"\n\n#: cli/core/install.go:59\nmsgid \"Force run of post-install scripts (if the CLI is not running interactively).\"\nmsgstr \"Force run of post-install scripts (if the CLI is not running interactively).\"\n\n#: cli/core/install.go:60\nmsgid \"Force skip of post-install scripts (if the CLI is running interactively).\"\nmsgstr \"Force skip of post-install scripts (if the CLI is running interactively).\"\n\n#: cli/board/details.go:50\n#: cli/burnbootloader/burnbootloader.go:53\n#: cli/compile/compile.go:85\n#: cli/debug/debug.go:61\n#: cli/upload/upload.go:57\nmsgid \"Fully Qualified Board Name, e.g.: arduino:avr:uno\"\nmsgstr \"Fully Qualified Board Name, e.g.: arduino:avr:uno\"\n\n#: cli/debug/debug.go:148\nmsgid \"GDB Server path\"\nmsgstr \"GDB Server path\"\n\n#: cli/debug/debug.go:147\nmsgid \"GDB Server type\"\nmsgstr \"GDB Server type\"\n\n#: commands/debug/debug.go:172\nmsgid \"GDB server '%s' is not supported\"\nmsgstr \"GDB server '%s' is not supported\"\n\n#: cli/generatedocs/generatedocs.go:38\n#: cli/generatedocs/generatedocs.go:39\nmsgid \"Generates bash completion and command manpages.\"\nmsgstr \"Generates bash completion and command manpages.\"\n\n#: cli/completion/completion.go:38\nmsgid \"Generates completion scripts\"\nmsgstr \"Generates completion scripts\"\n\n#: cli/completion/completion.go:39\nmsgid \"Generates completion scripts for various shells\"\nmsgstr \"Generates completion scripts for various shells\"\n\n#: legacy/builder/builder.go:67\nmsgid \"Generating function prototypes...\"\nmsgstr \"Generating function prototypes...\"\n\n#: cli/usage.go:31\nmsgid \"Global Flags:\"\nmsgstr \"Global Flags:\"\n\n#: legacy/builder/phases/sizer.go:93\nmsgid \"Global variables use {0} bytes ({2}%%) of dynamic memory, leaving {3} bytes for local variables. Maximum is {1} bytes.\"\nmsgstr \"Global variables use {0} bytes ({2}%%) of dynamic memory, leaving {3} bytes for local variables. Maximum is {1} bytes.\"\n\n#: legacy/builder/phases/sizer.go:100\nmsgid \"Global variables use {0} bytes of dynamic memory.\"\nmsgstr \"Global variables use {0} bytes of dynamic memory.\"\n\n#: cli/core/list.go:84\n#: cli/core/search.go:114\n#: cli/outdated/outdated.go:62\nmsgid \"ID\"\nmsgstr \"ID\"\n\n#: cli/board/details.go:93\n#: cli/board/details.go:194\nmsgid \"Id\"\nmsgstr \"Id\"\n\n#: cli/board/details.go:135\nmsgid \"Identification properties:\"\nmsgstr \"Identification properties:\"\n\n#: cli/compile/compile.go:118\nmsgid \"If set built binaries will be exported to the sketch folder.\"\nmsgstr \"If set built binaries will be exported to the sketch folder.\"\n\n#: cli/core/list.go:42\nmsgid \"If set return all installable and installed cores, including manually installed.\"\nmsgstr \"If set return all installable and installed cores, including manually installed.\"\n\n#: cli/lib/list.go:48\nmsgid \"Include built-in libraries (from platforms and IDE) in listing.\"\nmsgstr \"Include built-in libraries (from platforms and IDE) in listing.\"\n\n#: cli/sketch/archive.go:51\nmsgid \"Includes %s directory in the archive.\"\nmsgstr \"Includes %s directory in the archive.\"\n\n#: cli/core/list.go:84\n#: cli/lib/list.go:125\nmsgid \"Installed\"\nmsgstr \"Installed\"\n\n#: commands/instances.go:722\n#: commands/lib/install.go:112\nmsgid \"Installed %s\"\nmsgstr \"Installed %s\"\n\n#: cli/outdated/outdated.go:62\n#: cli/outdated/outdated.go:72\n#: cli/update/update.go:99\n#: cli/update/update.go:109\nmsgid \"Installed version\"\nmsgstr \"Installed version\"\n\n#: commands/bundled_tools.go:48\n#: commands/instances.go:705\n#: commands/lib/install.go:92\nmsgid \"Installing %s\"\nmsgstr \"Installing %s\"\n\n#: commands/core/install.go:109\nmsgid \"Installing platform %s\"\nmsgstr \"Installing platform %s\"\n\n#: cli/core/install.go:38\n#: cli/core/install.go:39\nmsgid \"Installs one or more cores and corresponding tool dependencies.\"\nmsgstr \"Installs one or more cores and corresponding tool dependencies.\"\n\n#: cli/lib/install.go:39\n#: cli/lib/install.go:40\nmsgid \"Installs one or more specified libraries into the system.\"\nmsgstr \"Installs one or more specified libraries into the system.\"\n\n#: legacy/builder/container_find_includes.go:378\nmsgid \"Internal error in cache\"\nmsgstr \"Internal error in cache\"\n\n#: commands/errors.go:218\nmsgid \"Invalid '%[1]s' property: %[2]s\"\nmsgstr \"Invalid '%[1]s' property: %[2]s\"\n\n#: cli/cli.go:252\nmsgid \"Invalid Call : should show Help, but it is available only in TEXT mode.\"\nmsgstr \"Invalid Call : should show Help, but it is available only in TEXT mode.\"\n\n#: commands/board/attach.go:65\nmsgid \"Invalid Device URL format\"\nmsgstr \"Invalid Device URL format\"\n\n#: commands/errors.go:57\nmsgid \"Invalid FQBN\"\nmsgstr \"Invalid FQBN\"\n\n#: commands/errors.go:75\nmsgid \"Invalid URL\"\nmsgstr \"Invalid URL\"\n\n#: commands/instances.go:192\nmsgid \"Invalid additional URL: %v\"\nmsgstr \"Invalid additional URL: %v\"\n\n#: cli/core/download.go:55\n#: cli/core/install.go:92\n#: cli/core/uninstall.go:51\n#: cli/core/upgrade.go:81\n#: cli/lib/download.go:50\n#: cli/lib/uninstall.go:51\nmsgid \"Invalid argument passed: %v\"\nmsgstr \"Invalid argument passed: %v\"\n\n#: legacy/builder/phases/sizer.go:172\nmsgid \"Invalid data size regexp: %s\"\nmsgstr \"Invalid data size regexp: %s\"\n\n#: commands/board/attach.go:75\nmsgid \"Invalid device port type provided\"\nmsgstr \"Invalid device port type provided\"\n\n#: legacy/builder/phases/sizer.go:178\nmsgid \"Invalid eeprom size regexp: %s\"\nmsgstr \"Invalid eeprom size regexp: %s\"\n\n#: commands/errors.go:43\nmsgid \"Invalid instance\"\nmsgstr \"Invalid instance\"\n\n#: cli/core/upgrade.go:87\nmsgid \"Invalid item %s\"\nmsgstr \"Invalid item %s\"\n\n#: commands/errors.go:93\nmsgid \"Invalid library\"\nmsgstr \"Invalid library\"\n\n#: httpclient/httpclient_config.go:44\nmsgid \"Invalid network.proxy '%[1]s': %[2]s\"\nmsgstr \"Invalid network.proxy '%[1]s': %[2]s\"\n\n#: cli/cli.go:213\nmsgid \"Invalid option for --log-level: %s\"\nmsgstr \"Invalid option for --log-level: %s\"\n\n#: cli/cli.go:230\nmsgid \"Invalid output format: %s\"\nmsgstr \"Invalid output format: %s\"\n\n#: commands/instances.go:443\n#: commands/instances.go:517\nmsgid \"Invalid package index in %s\"\nmsgstr \"Invalid package index in %s\"\n\n#: cli/core/uninstall.go:57\nmsgid \"Invalid parameter %s: version not allowed\"\nmsgstr \"Invalid parameter %s: version not allowed\"\n\n#: commands/board/list.go:57\nmsgid \"Invalid pid value: '%s'\"\nmsgstr \"Invalid pid value: '%s'\"\n\n#: legacy/builder/phases/sizer.go:162\nmsgid \"Invalid size regexp: %s\"\nmsgstr \"Invalid size regexp: %s\"\n\n#: commands/errors.go:111\nmsgid \"Invalid version\"\nmsgstr \"Invalid version\"\n\n#: commands/board/list.go:54\nmsgid \"Invalid vid value: '%s'\"\nmsgstr \"Invalid vid value: '%s'\"\n\n#: cli/compile/compile.go:113\nmsgid \"Just produce the compilation database, without actually compiling.\"\nmsgstr \"Just produce the compilation database, without actually compiling.\"\n\n#: cli/lib/list.go:37\nmsgid \"LIBNAME\"\nmsgstr \"LIBNAME\"\n\n#: cli/lib/check_deps.go:34\n#: cli/lib/install.go:38\nmsgid \"LIBRARY\"\nmsgstr \"LIBRARY\"\n\n#: cli/lib/download.go:34\n#: cli/lib/examples.go:38\n#: cli/lib/search.go:39\n#: cli/lib/uninstall.go:35\nmsgid \"LIBRARY_NAME\"\nmsgstr \"LIBRARY_NAME\"\n\n#: cli/core/list.go:84\nmsgid \"Latest\"\nmsgstr \"Latest\"\n\n#: commands/lib/uninstall.go:36\nmsgid \"Library %s is not installed\"\nmsgstr \"Library %s is not installed\"\n\n#: commands/errors.go:266\nmsgid \"Library '%s' not found\"\nmsgstr \"Library '%s' not found\"\n\n#: legacy/builder/fail_if_imported_library_is_wrong.go:46\nmsgid \"Library can't use both '%[1]s' and '%[2]s' folders. Double check {0}\"\nmsgstr \"Library can't use both '%[1]s' and '%[2]s' folders. Double check {0}\"\n\n#: commands/errors.go:369\nmsgid \"Library install failed\"\nmsgstr \"Library install failed\"\n\n#: commands/lib/install.go:122\n#: commands/lib/install.go:132\nmsgid \"Library installed\"\nmsgstr \"Library installed\"\n\n#: cli/outdated/outdated.go:72\n#: cli/update/update.go:109\nmsgid \"Library name\"\nmsgstr \"Library name\"\n\n#: legacy/builder/phases/libraries_builder.go:91\nmsgid \"Library {0} has been declared precompiled:\"\nmsgstr \"Library {0} has been declared precompiled:\"\n\n#: cli/lib/search.go:168\nmsgid \"License: %s\"\nmsgstr \"License: %s\"\n\n#: legacy/builder/builder.go:86\nmsgid \"Linking everything together...\"\nmsgstr \"Linking everything together...\"\n\n#: cli/board/listall.go:37\n#: cli/board/search.go:38\nmsgid \"List all boards that have the support platform installed. You can search\\n\"\n\"for a specific board if you specify the board name\"\nmsgstr \"List all boards that have the support platform installed. You can search\\n\"\n\"for a specific board if you specify the board name\"\n\n#: cli/board/listall.go:36\n#: cli/board/search.go:37\nmsgid \"List all known boards and their corresponding FQBN.\"\nmsgstr \"List all known boards and their corresponding FQBN.\"\n\n#: cli/board/list.go:37\nmsgid \"List connected boards.\"\nmsgstr \"List connected boards.\"\n\n#: cli/compile/compile.go:96\nmsgid \"List of custom build properties separated by commas. Or can be used multiple times for multiple properties.\"\nmsgstr \"List of custom build properties separated by commas. Or can be used multiple times for multiple properties.\"\n\n#: cli/compile/compile.go:110\nmsgid \"List of custom libraries dir paths separated by commas. Or can be used multiple times for multiple libraries dir paths.\"\nmsgstr \"List of custom libraries dir paths separated by commas. Or can be used multiple times for multiple libraries dir paths.\"\n\n#: cli/compile/compile.go:108\nmsgid \"List of paths to libraries root folders. Libraries set this way have top priority in case of conflicts. Can be used multiple times for different libraries.\"\nmsgstr \"List of paths to libraries root folders. Libraries set this way have top priority in case of conflicts. Can be used multiple times for different libraries.\"\n\n#: cli/lib/list.go:50\nmsgid \"List updatable libraries.\"\nmsgstr \"List updatable libraries.\"\n\n#: cli/core/list.go:41\nmsgid \"List updatable platforms.\"\nmsgstr \"List updatable platforms.\"\n\n#: cli/board/board.go:30\nmsgid \"Lists all connected boards.\"\nmsgstr \"Lists all connected boards.\"\n\n#: cli/outdated/outdated.go:38\nmsgid \"Lists cores and libraries that can be upgraded\"\nmsgstr \"Lists cores and libraries that can be upgraded\"\n\n#: commands/instances.go:206\n#: commands/instances.go:217\n#: commands/instances.go:318\nmsgid \"Loading index file: %v\"\nmsgstr \"Loading index file: %v\"\n\n#: commands/instances.go:327\nmsgid \"Loading libraries: %v\"\nmsgstr \"Loading libraries: %v\"\n\n#: cli/lib/list.go:125\nmsgid \"Location\"\nmsgstr \"Location\"\n\n#: legacy/builder/recipe_runner.go:39\nmsgid \"Looking for recipes like {0}*{1}\"\nmsgstr \"Looking for recipes like {0}*{1}\"\n\n#: legacy/builder/phases/sizer.go:137\nmsgid \"Low memory available, stability problems may occur.\"\nmsgstr \"Low memory available, stability problems may occur.\"\n\n#: cli/lib/search.go:163\nmsgid \"Maintainer: %s\"\nmsgstr \"Maintainer: %s\"\n\n#: cli/arguments/port.go:46\nmsgid \"Max time to wait for port discovery, e.g.: 30s, 1m\"\nmsgstr \"Max time to wait for port discovery, e.g.: 30s, 1m\"\n\n#: cli/cli.go:106\nmsgid \"Messages with this level and above will be logged. Valid levels are: %s\"\nmsgstr \"Messages with this level and above will be logged. Valid levels are: %s\"\n\n#: legacy/builder/fail_if_imported_library_is_wrong.go:41\nmsgid \"Missing '{0}' from library in {1}\"\nmsgstr \"Missing '{0}' from library in {1}\"\n\n#: commands/errors.go:127\nmsgid \"Missing FQBN (Fully Qualified Board Name)\"\nmsgstr \"Missing FQBN (Fully Qualified Board Name)\"\n\n#: commands/errors.go:157\nmsgid \"Missing port protocol\"\nmsgstr \"Missing port protocol\"\n\n#: commands/errors.go:169\nmsgid \"Missing programmer\"\nmsgstr \"Missing programmer\"\n\n#: legacy/builder/phases/sizer.go:166\nmsgid \"Missing size regexp\"\nmsgstr \"Missing size regexp\"\n\n#: commands/errors.go:318\nmsgid \"Missing sketch path\"\nmsgstr \"Missing sketch path\"\n\n#: legacy/builder/print_used_and_not_used_libraries.go:50\nmsgid \"Multiple libraries were found for \\\"{0}\\\"\"\nmsgstr \"Multiple libraries were found for \\\"{0}\\\"\"\n\n#: cli/board/details.go:194\n#: cli/core/list.go:84\n#: cli/core/search.go:114\n#: cli/lib/list.go:125\n#: cli/outdated/outdated.go:62\nmsgid \"Name\"\nmsgstr \"Name\"\n\n#: cli/lib/search.go:142\nmsgid \"Name: \\\"%s\\\"\"\nmsgstr \"Name: \\\"%s\\\"\"\n\n#: cli/outdated/outdated.go:62\n#: cli/outdated/outdated.go:72\n#: cli/update/update.go:99\n#: cli/update/update.go:109\nmsgid \"New version\"\nmsgstr \"New version\"\n\n#: cli/board/list.go:115\nmsgid \"No boards found.\"\nmsgstr \"No boards found.\"\n\n#: legacy/builder/builder_utils/utils.go:351\nmsgid \"No colon in first line of depfile\"\nmsgstr \"No colon in first line of depfile\"\n\n#: cli/lib/examples.go:103\nmsgid \"No libraries found.\"\nmsgstr \"No libraries found.\"\n\n#: cli/lib/list.go:117\nmsgid \"No libraries installed.\"\nmsgstr \"No libraries installed.\"\n\n#: cli/lib/search.go:126\nmsgid \"No libraries matching your search.\"\nmsgstr \"No libraries matching your search.\"\n\n#: cli/lib/search.go:137\nmsgid \"No libraries matching your search.\\n\"\n\"Did you mean...\\n\"\n\"\"\nmsgstr \"No libraries matching your search.\\n\"\n\"Did you mean...\\n\"\n\"\"\n\n#: cli/core/search.go:124\nmsgid \"No platforms matching your search.\"\nmsgstr \"No platforms matching your search.\"\n\n#: commands/board/attach.go:91\nmsgid \"No supported board found at %s\"\nmsgstr \"No supported board found at %s\"\n\n#: cli/lib/list.go:115\nmsgid \"No updates available.\"\nmsgstr \"No updates available.\"\n\n#: commands/upload/upload.go:410\nmsgid \"No upload port found, using %s as fallback\"\nmsgstr \"No upload port found, using %s as fallback\"\n\n#: commands/errors.go:285\nmsgid \"No valid dependencies solution found\"\nmsgstr \"No valid dependencies solution found\"\n\n#: legacy/builder/phases/sizer.go:127\nmsgid \"Not enough memory; see %s for tips on reducing your footprint.\"\nmsgstr \"Not enough memory; see %s for tips on reducing your footprint.\"\n\n#: legacy/builder/builder_utils/utils.go:284\nmsgid \"Not found: nil\"\nmsgstr \"Not found: nil\"\n\n#: legacy/builder/builder_utils/utils.go:300\n#: legacy/builder/builder_utils/utils.go:313\n#: legacy/builder/builder_utils/utils.go:387\nmsgid \"Not found: {0}\"\nmsgstr \"Not found: {0}\"\n\n#: legacy/builder/print_used_and_not_used_libraries.go:53\nmsgid \"Not used: {0}\"\nmsgstr \"Not used: {0}\"\n\n#: cli/board/details.go:165\nmsgid \"OS:\"\nmsgstr \"OS:\"\n\n#: cli/board/details.go:129\nmsgid \"Official Arduino board:\"\nmsgstr \"Official Arduino board:\"\n\n#: cli/board/details.go:177\nmsgid \"Option:\"\nmsgstr \"Option:\"\n\n#: cli/compile/compile.go:100\nmsgid \"Optional, can be: %s. Used to tell gcc which warning level to use (-W flag).\"\nmsgstr \"Optional, can be: %s. Used to tell gcc which warning level to use (-W flag).\"\n\n#: cli/compile/compile.go:114\nmsgid \"Optional, cleanup the build folder and do not use any cached build.\"\nmsgstr \"Optional, cleanup the build folder and do not use any cached build.\"\n\n#: cli/compile/compile.go:111\nmsgid \"Optional, optimize compile output for debugging, rather than for release.\"\nmsgstr \"Optional, optimize compile output for debugging, rather than for release.\"\n\n#: cli/compile/compile.go:102\nmsgid \"Optional, suppresses almost every output.\"\nmsgstr \"Optional, suppresses almost every output.\"\n\n#: cli/compile/compile.go:101\n#: cli/upload/upload.go:62\nmsgid \"Optional, turns on verbose mode.\"\nmsgstr \"Optional, turns on verbose mode.\"\n\n#: cli/compile/compile.go:112\n#: cli/upload/upload.go:63\nmsgid \"Optional, use the specified programmer to upload.\"\nmsgstr \"Optional, use the specified programmer to upload.\"\n\n#: cli/compile/compile.go:119\nmsgid \"Optional. Path to a .json file that contains a set of replacements of the sketch source code.\"\nmsgstr \"Optional. Path to a .json file that contains a set of replacements of the sketch source code.\"\n\n#: commands/daemon/monitor.go:72\nmsgid \"OutputRate in Null monitor must be a float64\"\nmsgstr \"OutputRate in Null monitor must be a float64\"\n\n#: cli/compile/compile.go:98\nmsgid \"Override a build property with a custom value. Can be used multiple times for multiple properties.\"\nmsgstr \"Override a build property with a custom value. Can be used multiple times for multiple properties.\"\n\n#: cli/config/init.go:53\nmsgid \"Overwrite existing config file.\"\nmsgstr \"Overwrite existing config file.\"\n\n#: cli/core/download.go:36\n#: cli/core/install.go:37\n#: cli/core/uninstall.go:36\n#: cli/core/upgrade.go:38\nmsgid \"PACKAGER\"\nmsgstr \"PACKAGER\"\n\n#: cli/board/details.go:145\nmsgid \"Package URL:\"\nmsgstr \"Package URL:\"\n\n#: cli/board/details.go:144\nmsgid \"Package maintainer:\"\nmsgstr \"Package maintainer:\"\n\n#: cli/board/details.go:143\nmsgid \"Package name:\"\nmsgstr \"Package name:\"\n\n#: cli/board/details.go:147\nmsgid \"Package online help:\"\nmsgstr \"Package online help:\"\n\n#: cli/board/details.go:146\nmsgid \"Package website:\"\nmsgstr \"Package website:\"\n\n#: cli/lib/search.go:165\nmsgid \"Paragraph: %s\"\nmsgstr \"Paragraph: %s\"\n\n#: cli/cli.go:107\nmsgid \"Path to the file where logs will be written.\"\nmsgstr \"Path to the file where logs will be written.\"\n\n#: cli/compile/compile.go:94\nmsgid \"Path where to save compiled files. If omitted, a directory will be created in the default temporary path of your OS.\"\nmsgstr \"Path where to save compiled files. If omitted, a directory will be created in the default temporary path of your OS.\"\n\n#: commands/upload/upload.go:391\nmsgid \"Performing 1200-bps touch reset on serial port %s\"\nmsgstr \"Performing 1200-bps touch reset on serial port %s\"\n\n#: commands/core/install.go:72\nmsgid \"Platform %s already installed\"\nmsgstr \"Platform %s already installed\"\n\n#: commands/core/install.go:176\nmsgid \"Platform %s installed\"\nmsgstr \"Platform %s installed\"\n\n#: commands/core/uninstall.go:84\nmsgid \"Platform %s uninstalled\"\nmsgstr \"Platform %s uninstalled\"\n\n#: commands/errors.go:303\nmsgid \"Platform '%s' is already at the latest version\"\nmsgstr \"Platform '%s' is already at the latest version\"\n\n#: commands/errors.go:247\nmsgid \"Platform '%s' not found\"\nmsgstr \"Platform '%s' not found\"\n\n#: cli/board/search.go:86\nmsgid \"Platform ID\"\nmsgstr \"Platform ID\"\n\n#: cli/board/details.go:153\nmsgid \"Platform URL:\"\nmsgstr \"Platform URL:\"\n\n#: cli/board/details.go:152\nmsgid \"Platform architecture:\"\nmsgstr \"Platform architecture:\"\n\n#: cli/board/details.go:151\nmsgid \"Platform category:\"\nmsgstr \"Platform category:\"\n\n#: cli/board/details.go:158\nmsgid \"Platform checksum:\"\nmsgstr \"Platform checksum:\"\n\n#: cli/board/details.go:154\nmsgid \"Platform file name:\"\nmsgstr \"Platform file name:\"\n\n#: cli/board/details.go:150\nmsgid \"Platform name:\"\nmsgstr \"Platform name:\"\n\n#: cli/board/details.go:156\nmsgid \"Platform size (bytes):\"\nmsgstr \"Platform size (bytes):\"\n\n#: cli/board/list.go:87\n#: cli/board/list.go:125\nmsgid \"Port\"\nmsgstr \"Port\"\n\n#: legacy/builder/phases/libraries_builder.go:101\n#: legacy/builder/phases/libraries_builder.go:109\nmsgid \"Precompiled library in \\\"{0}\\\" not found\"\nmsgstr \"Precompiled library in \\\"{0}\\\" not found\"\n\n#: cli/board/details.go:42\nmsgid \"Print details about a board.\"\nmsgstr \"Print details about a board.\"\n\n#: cli/compile/compile.go:90\nmsgid \"Print preprocessed code to stdout instead of compiling.\"\nmsgstr \"Print preprocessed code to stdout instead of compiling.\"\n\n#: cli/cli.go:105\nmsgid \"Print the logs on the standard output.\"\nmsgstr \"Print the logs on the standard output.\"\n\n#: cli/config/dump.go:31\nmsgid \"Prints the current configuration\"\nmsgstr \"Prints the current configuration\"\n\n#: cli/config/dump.go:32\nmsgid \"Prints the current configuration.\"\nmsgstr \"Prints the current configuration.\"\n\n#: commands/errors.go:199\nmsgid \"Programmer '%s' not found\"\nmsgstr \"Programmer '%s' not found\"\n\n#: cli/board/details.go:93\nmsgid \"Programmer name\"\nmsgstr \"Programmer name\"\n\n#: cli/debug/debug.go:63\nmsgid \"Programmer to use for debugging\"\nmsgstr \"Programmer to use for debugging\"\n\n#: cli/board/details.go:194\nmsgid \"Programmers:\"\nmsgstr \"Programmers:\"\n\n#: legacy/builder/builder_utils/utils.go:46\nmsgid \"Progress {0}\"\nmsgstr \"Progress {0}\"\n\n#: commands/errors.go:232\nmsgid \"Property '%s' is undefined\"\nmsgstr \"Property '%s' is undefined\"\n\n#: cli/board/list.go:125\nmsgid \"Protocol\"\nmsgstr \"Protocol\"\n\n#: cli/lib/search.go:175\nmsgid \"Provides includes: %s\"\nmsgstr \"Provides includes: %s\"\n\n#: cli/config/remove.go:31\n#: cli/config/remove.go:32\nmsgid \"Removes one or more values from a setting.\"\nmsgstr \"Removes one or more values from a setting.\"\n\n#: commands/instances.go:715\n#: commands/lib/install.go:105\nmsgid \"Replacing %[1]s with %[2]s\"\nmsgstr \"Replacing %[1]s with %[2]s\"\n\n#: cli/board/details.go:162\nmsgid \"Required tool:\"\nmsgstr \"Required tool:\"\n\n#: cli/daemon/daemon.go:50\nmsgid \"Run as a daemon on port: %s\"\nmsgstr \"Run as a daemon on port: %s\"\n\n#: cli/daemon/daemon.go:51\nmsgid \"Running as a daemon the initialization of cores and libraries is done only once.\"\nmsgstr \"Running as a daemon the initialization of cores and libraries is done only once.\"\n\n#: legacy/builder/phases/core_builder.go:49\nmsgid \"Running normal build of the core...\"\nmsgstr \"Running normal build of the core...\"\n\n#: legacy/builder/recipe_runner.go:48\nmsgid \"Running recipe: {0}\"\nmsgstr \"Running recipe: {0}\"\n\n#: cli/compile/compile.go:92\nmsgid \"Save build artifacts in this directory.\"\nmsgstr \"Save build artifacts in this directory.\"\n\n#: cli/core/search.go:50\nmsgid \"Search for a core in Boards Manager using the specified keywords.\"\nmsgstr \"Search for a core in Boards Manager using the specified keywords.\"\n\n#: cli/core/search.go:49\nmsgid \"Search for a core in Boards Manager.\"\nmsgstr \"Search for a core in Boards Manager.\"\n\n#: cli/lib/search.go:41\nmsgid \"Search for one or more libraries data (case insensitive search).\"\nmsgstr \"Search for one or more libraries data (case insensitive search).\"\n\n#: cli/lib/search.go:40\nmsgid \"Searches for one or more libraries data.\"\nmsgstr \"Searches for one or more libraries data.\"\n\n#: commands/board/attach.go:108\nmsgid \"Selected fqbn: %s\"\nmsgstr \"Selected fqbn: %s\"\n\n#: cli/lib/search.go:164\nmsgid \"Sentence: %s\"\nmsgstr \"Sentence: %s\"\n\n#: commands/download.go:62\nmsgid \"Server responded with: %s\"\nmsgstr \"Server responded with: %s\"\n\n#: cli/config/set.go:32\n#: cli/config/set.go:33\nmsgid \"Sets a setting value.\"\nmsgstr \"Sets a setting value.\"\n\n#: cli/config/init.go:51\n#: cli/config/init.go:52\nmsgid \"Sets where to save the configuration file.\"\nmsgstr \"Sets where to save the configuration file.\"\n\n#: cli/config/delete.go:57\n#: cli/config/validate.go:45\nmsgid \"Settings key doesn't exist\"\nmsgstr \"Settings key doesn't exist\"\n\n#: cli/core/search.go:55\nmsgid \"Show all available core versions.\"\nmsgstr \"Show all available core versions.\"\n\n#: cli/compile/compile.go:89\nmsgid \"Show all build properties used instead of compiling.\"\nmsgstr \"Show all build properties used instead of compiling.\"\n\n#: cli/board/listall.go:45\n#: cli/board/search.go:46\nmsgid \"Show also boards marked as 'hidden' in the platform\"\nmsgstr \"Show also boards marked as 'hidden' in the platform\"\n\n#: cli/board/details.go:49\nmsgid \"Show full board details\"\nmsgstr \"Show full board details\"\n\n#: cli/board/details.go:43\nmsgid \"Show information about a board, in particular if the board has options to be specified in the FQBN.\"\nmsgstr \"Show information about a board, in particular if the board has options to be specified in the FQBN.\"\n\n#: cli/lib/examples.go:45\n#: cli/lib/list.go:49\nmsgid \"Show libraries for the specified board FQBN.\"\nmsgstr \"Show libraries for the specified board FQBN.\"\n\n#: cli/lib/search.go:46\nmsgid \"Show library names only.\"\nmsgstr \"Show library names only.\"\n\n#: cli/board/details.go:51\nmsgid \"Show list of available programmers\"\nmsgstr \"Show list of available programmers\"\n\n#: cli/debug/debug.go:66\nmsgid \"Show metadata about the debug session instead of starting the debugger.\"\nmsgstr \"Show metadata about the debug session instead of starting the debugger.\"\n\n#: cli/update/update.go:46\nmsgid \"Show outdated cores and libraries after index update\"\nmsgstr \"Show outdated cores and libraries after index update\"\n\n#: cli/lib/list.go:38\nmsgid \"Shows a list of installed libraries.\"\nmsgstr \"Shows a list of installed libraries.\"\n\n#: cli/lib/list.go:39\nmsgid \"Shows a list of installed libraries.\\n\"\n\"\\n\"\n\"If the LIBNAME parameter is specified the listing is limited to that specific\\n\"\n\"library. By default the libraries provided as built-in by platforms/core are\\n\"\n\"not listed, they can be listed by adding the --all flag.\"\nmsgstr \"Shows a list of installed libraries.\\n\"\n\"\\n\"\n\"If the LIBNAME parameter is specified the listing is limited to that specific\\n\"\n\"library. By default the libraries provided as built-in by platforms/core are\\n\"\n\"not listed, they can be listed by adding the --all flag.\"\n\n#: cli/core/list.go:35\n#: cli/core/list.go:36\nmsgid \"Shows the list of installed platforms.\"\nmsgstr \"Shows the list of installed platforms.\"\n\n#: cli/lib/examples.go:39\nmsgid \"Shows the list of the examples for libraries.\"\nmsgstr \"Shows the list of the examples for libraries.\"\n\n#: cli/lib/examples.go:40\nmsgid \"Shows the list of the examples for libraries. A name may be given as argument to search a specific library.\"\nmsgstr \"Shows the list of the examples for libraries. A name may be given as argument to search a specific library.\"\n\n#: cli/version/version.go:38\nmsgid \"Shows the version number of Arduino CLI which is installed on your system.\"\nmsgstr \"Shows the version number of Arduino CLI which is installed on your system.\"\n\n#: cli/version/version.go:37\nmsgid \"Shows version number of Arduino CLI.\"\nmsgstr \"Shows version number of Arduino CLI.\"\n\n#: cli/board/details.go:167\nmsgid \"Size (bytes):\"\nmsgstr \"Size (bytes):\"\n\n#: legacy/builder/fail_if_buildpath_equals_sketchpath.go:44\nmsgid \"Sketch cannot be located in build path. Please specify a different build path\"\nmsgstr \"Sketch cannot be located in build path. Please specify a different build path\"\n\n#: cli/sketch/new.go:68\nmsgid \"Sketch created in: %s\"\nmsgstr \"Sketch created in: %s\"\n\n#: legacy/builder/phases/sizer.go:121\nmsgid \"Sketch too big; see %s for tips on reducing it.\"\nmsgstr \"Sketch too big; see %s for tips on reducing it.\"\n\n#: legacy/builder/phases/sizer.go:86\nmsgid \"Sketch uses {0} bytes ({2}%%) of program storage space. Maximum is {1} bytes.\"\nmsgstr \"Sketch uses {0} bytes ({2}%%) of program storage space. Maximum is {1} bytes.\"\n\n#: cli/compile/compile.go:140\n#: cli/sketch/archive.go:66\n#: cli/upload/upload.go:87\nmsgid \"Sketches with .pde extension are deprecated, please rename the following files to .ino:\"\nmsgstr \"Sketches with .pde extension are deprecated, please rename the following files to .ino:\"\n\n#: legacy/builder/phases/linker.go:35\nmsgid \"Skip linking of final executable.\"\nmsgstr \"Skip linking of final executable.\"\n\n#: commands/upload/upload.go:384\nmsgid \"Skipping 1200-bps touch reset: no serial port selected!\"\nmsgstr \"Skipping 1200-bps touch reset: no serial port selected!\"\n\n#: legacy/builder/builder_utils/utils.go:476\nmsgid \"Skipping archive creation of: {0}\"\nmsgstr \"Skipping archive creation of: {0}\"\n\n#: legacy/builder/builder_utils/utils.go:269\nmsgid \"Skipping compile of: {0}\"\nmsgstr \"Skipping compile of: {0}\"\n\n#: legacy/builder/container_find_includes.go:325\nmsgid \"Skipping dependencies detection for precompiled library {0}\"\nmsgstr \"Skipping dependencies detection for precompiled library {0}\"\n\n#: commands/instances.go:852\nmsgid \"Skipping platform configuration\"\nmsgstr \"Skipping platform configuration\"\n\n#: commands/core/install.go:172\nmsgid \"Skipping platform configuration.\"\nmsgstr \"Skipping platform configuration.\"\n\n#: legacy/builder/recipe_runner.go:58\nmsgid \"Skipping: {0}\"\nmsgstr \"Skipping: {0}\"\n\n#: arduino/serialutils/serialutils.go:133\nmsgid \"TOUCH: error during reset: %s\"\nmsgstr \"TOUCH: error during reset: %s\"\n\n#: cli/daemon/daemon.go:56\nmsgid \"The TCP port the daemon will listen to\"\nmsgstr \"The TCP port the daemon will listen to\"\n\n#: cli/board/attach.go:45\nmsgid \"The connected devices search timeout, raise it if your board doesn't show up (e.g. to %s).\"\nmsgstr \"The connected devices search timeout, raise it if your board doesn't show up (e.g. to %s).\"\n\n#: cli/board/list.go:45\nmsgid \"The connected devices search timeout, raise it if your board doesn't show up e.g.: 10s\"\nmsgstr \"The connected devices search timeout, raise it if your board doesn't show up e.g.: 10s\"\n\n#: cli/cli.go:110\nmsgid \"The custom config file (if not specified the default will be used).\"\nmsgstr \"The custom config file (if not specified the default will be used).\"\n\n#: cli/core/install.go:66\nmsgid \"The flags --run-post-install and --skip-post-install can't be both set at the same time.\"\nmsgstr \"The flags --run-post-install and --skip-post-install can't be both set at the same time.\"\n\n#: cli/config/add.go:51\nmsgid \"The key '%[1]v' is not a list of items, can't add to it.\\n\"\n\"Maybe use '%[2]s'?\"\nmsgstr \"The key '%[1]v' is not a list of items, can't add to it.\\n\"\n\"Maybe use '%[2]s'?\"\n\n#: cli/config/remove.go:51\nmsgid \"The key '%[1]v' is not a list of items, can't remove from it.\\n\"\n\"Maybe use '%[2]s'?\"\nmsgstr \"The key '%[1]v' is not a list of items, can't remove from it.\\n\"\n\"Maybe use '%[2]s'?\"\n\n#: cli/cli.go:108\n#: cli/cli.go:109\nmsgid \"The output format for the logs, can be: %s\"\nmsgstr \"The output format for the logs, can be: %s\"\n\n#: legacy/builder/phases/libraries_builder.go:151\nmsgid \"The platform does not support '{0}' for precompiled libraries.\"\nmsgstr \"The platform does not support '{0}' for precompiled libraries.\"\n\n#: cli/lib/upgrade.go:34\nmsgid \"This command upgrades an installed library to the latest available version. Multiple libraries can be passed separated by a space. If no arguments are provided, the command will upgrade all the installed libraries where an update is available.\"\nmsgstr \"This command upgrades an installed library to the latest available version. Multiple libraries can be passed separated by a space. If no arguments are provided, the command will upgrade all the installed libraries where an update is available.\"\n\n#: cli/outdated/outdated.go:39\nmsgid \"This commands shows a list of installed cores and/or libraries\\n\"\n\"that can be upgraded. If nothing needs to be updated the output is empty.\"\nmsgstr \"This commands shows a list of installed cores and/or libraries\\n\"\n\"that can be upgraded. If nothing needs to be updated the output is empty.\"\n\n#: commands/bundled_tools.go:43\n#: commands/core/install.go:79\n#: commands/instances.go:766\nmsgid \"Tool %s already installed\"\nmsgstr \"Tool %s already installed\"\n\n#: commands/core/uninstall.go:100\nmsgid \"Tool %s uninstalled\"\nmsgstr \"Tool %s uninstalled\"\n\n#: commands/debug/debug.go:133\nmsgid \"Toolchain '%s' is not supported\"\nmsgstr \"Toolchain '%s' is not supported\"\n\n#: cli/debug/debug.go:142\nmsgid \"Toolchain custom configurations\"\nmsgstr \"Toolchain custom configurations\"\n\n#: cli/debug/debug.go:136\nmsgid \"Toolchain path\"\nmsgstr \"Toolchain path\"\n\n#: cli/debug/debug.go:137\nmsgid \"Toolchain prefix\"\nmsgstr \"Toolchain prefix\"\n\n#: cli/debug/debug.go:135\nmsgid \"Toolchain type\"\nmsgstr \"Toolchain type\"\n\n#: cli/burnbootloader/burnbootloader.go:56\nmsgid \"Turns on verbose mode.\"\nmsgstr \"Turns on verbose mode.\"\n\n#: cli/board/list.go:87\n#: cli/board/list.go:125\nmsgid \"Type\"\nmsgstr \"Type\"\n\n#: cli/lib/search.go:172\nmsgid \"Types: %s\"\nmsgstr \"Types: %s\"\n\n#: cli/board/details.go:169\nmsgid \"URL:\"\nmsgstr \"URL:\"\n\n#: legacy/builder/phases/core_builder.go:132\nmsgid \"Unable to cache built core, please tell {0} maintainers to follow %s\"\nmsgstr \"Unable to cache built core, please tell {0} maintainers to follow %s\"\n\n#: configuration/configuration.go:126\nmsgid \"Unable to get Documents Folder: %v\"\nmsgstr \"Unable to get Documents Folder: %v\"\n\n#: configuration/configuration.go:101\nmsgid \"Unable to get Local App Data Folder: %v\"\nmsgstr \"Unable to get Local App Data Folder: %v\"\n\n#: configuration/configuration.go:89\n#: configuration/configuration.go:114\nmsgid \"Unable to get user home dir: %v\"\nmsgstr \"Unable to get user home dir: %v\"\n\n#: cli/cli.go:199\nmsgid \"Unable to open file for logging: %s\"\nmsgstr \"Unable to open file for logging: %s\"\n\n#: commands/core/uninstall.go:76\n#: commands/lib/uninstall.go:38\nmsgid \"Uninstalling %s\"\nmsgstr \"Uninstalling %s\"\n\n#: commands/core/uninstall.go:92\nmsgid \"Uninstalling %s, tool is no more required\"\nmsgstr \"Uninstalling %s, tool is no more required\"\n\n#: commands/instances.go:831\nmsgid \"Uninstalling %s: tool is no more required\"\nmsgstr \"Uninstalling %s: tool is no more required\"\n\n#: cli/core/uninstall.go:37\n#: cli/core/uninstall.go:38\nmsgid \"Uninstalls one or more cores and corresponding tool dependencies if no longer used.\"\nmsgstr \"Uninstalls one or more cores and corresponding tool dependencies if no longer used.\"\n\n#: cli/lib/uninstall.go:36\n#: cli/lib/uninstall.go:37\nmsgid \"Uninstalls one or more libraries.\"\nmsgstr \"Uninstalls one or more libraries.\"\n\n#: cli/board/list.go:157\nmsgid \"Unknown\"\nmsgstr \"Unknown\"\n\n#: commands/errors.go:141\nmsgid \"Unknown FQBN\"\nmsgstr \"Unknown FQBN\"\n\n#: cli/update/update.go:40\nmsgid \"Updates the index of cores and libraries\"\nmsgstr \"Updates the index of cores and libraries\"\n\n#: cli/update/update.go:41\nmsgid \"Updates the index of cores and libraries to the latest versions.\"\nmsgstr \"Updates the index of cores and libraries to the latest versions.\"\n\n#: cli/core/update_index.go:36\nmsgid \"Updates the index of cores to the latest version.\"\nmsgstr \"Updates the index of cores to the latest version.\"\n\n#: cli/core/update_index.go:35\nmsgid \"Updates the index of cores.\"\nmsgstr \"Updates the index of cores.\"\n\n#: cli/lib/update_index.go:35\nmsgid \"Updates the libraries index to the latest version.\"\nmsgstr \"Updates the libraries index to the latest version.\"\n\n#: cli/lib/update_index.go:34\nmsgid \"Updates the libraries index.\"\nmsgstr \"Updates the libraries index.\"\n\n#: commands/instances.go:448\n#: commands/instances.go:474\n#: commands/instances.go:504\nmsgid \"Updating index: %s\"\nmsgstr \"Updating index: %s\"\n\n#: commands/instances.go:375\nmsgid \"Updating index: library_index.json.gz\"\nmsgstr \"Updating index: library_index.json.gz\"\n\n#: commands/instances.go:385\nmsgid \"Updating index: library_index.json.sig\"\nmsgstr \"Updating index: library_index.json.sig\"\n\n#: commands/instances.go:788\nmsgid \"Updating platform %s\"\nmsgstr \"Updating platform %s\"\n\n#: commands/core/upgrade.go:54\nmsgid \"Upgrade doesn't accept parameters with version\"\nmsgstr \"Upgrade doesn't accept parameters with version\"\n\n#: cli/upgrade/upgrade.go:40\nmsgid \"Upgrades installed cores and libraries to latest version.\"\nmsgstr \"Upgrades installed cores and libraries to latest version.\"\n\n#: cli/upgrade/upgrade.go:39\nmsgid \"Upgrades installed cores and libraries.\"\nmsgstr \"Upgrades installed cores and libraries.\"\n\n#: cli/lib/upgrade.go:33\nmsgid \"Upgrades installed libraries.\"\nmsgstr \"Upgrades installed libraries.\"\n\n#: cli/core/upgrade.go:39\n#: cli/core/upgrade.go:40\nmsgid \"Upgrades one or all installed platforms to the latest version.\"\nmsgstr \"Upgrades one or all installed platforms to the latest version.\"\n\n#: commands/core/install.go:113\nmsgid \"Upgrading platform %[1]s with %[2]s\"\nmsgstr \"Upgrading platform %[1]s with %[2]s\"\n\n#: cli/upload/upload.go:49\nmsgid \"Upload Arduino sketches.\"\nmsgstr \"Upload Arduino sketches.\"\n\n#: cli/upload/upload.go:50\nmsgid \"Upload Arduino sketches. This does NOT compile the sketch prior to upload.\"\nmsgstr \"Upload Arduino sketches. This does NOT compile the sketch prior to upload.\"\n\n#: cli/arguments/port.go:44\nmsgid \"Upload port address, e.g.: COM3 or /dev/ttyACM2\"\nmsgstr \"Upload port address, e.g.: COM3 or /dev/ttyACM2\"\n\n#: commands/upload/upload.go:408\nmsgid \"Upload port found on %s\"\nmsgstr \"Upload port found on %s\"\n\n#: cli/arguments/port.go:45\nmsgid \"Upload port protocol, e.g: serial\"\nmsgstr \"Upload port protocol, e.g: serial\"\n\n#: cli/compile/compile.go:103\nmsgid \"Upload the binary after the compilation.\"\nmsgstr \"Upload the binary after the compilation.\"\n\n#: cli/burnbootloader/burnbootloader.go:47\nmsgid \"Upload the bootloader on the board using an external programmer.\"\nmsgstr \"Upload the bootloader on the board using an external programmer.\"\n\n#: cli/burnbootloader/burnbootloader.go:46\nmsgid \"Upload the bootloader.\"\nmsgstr \"Upload the bootloader.\"\n\n#: cli/compile/compile.go:221\n#: cli/upload/upload.go:123\nmsgid \"Uploading to specified board using %s protocol requires the following info:\"\nmsgstr \"Uploading to specified board using %s protocol requires the following info:\"\n\n#: cli/usage.go:26\nmsgid \"Usage:\"\nmsgstr \"Usage:\"\n\n#: cli/usage.go:33\nmsgid \"Use %s for more information about a command.\"\nmsgstr \"Use %s for more information about a command.\"\n\n#: cli/burnbootloader/burnbootloader.go:57\nmsgid \"Use the specified programmer to upload.\"\nmsgstr \"Use the specified programmer to upload.\"\n\n#: legacy/builder/print_used_and_not_used_libraries.go:51\nmsgid \"Used: {0}\"\nmsgstr \"Used: {0}\"\n\n#: arduino/libraries/librariesmanager/install.go:67\n#: arduino/libraries/librariesmanager/install.go:83\n#: arduino/libraries/librariesmanager/install.go:105\n#: arduino/libraries/librariesmanager/install.go:189\nmsgid \"User directory not set\"\nmsgstr \"User directory not set\"\n\n#: legacy/builder/target_board_resolver.go:47\nmsgid \"Using board '{0}' from platform in folder: {1}\"\nmsgstr \"Using board '{0}' from platform in folder: {1}\"\n\n#: legacy/builder/container_find_includes.go:337\nmsgid \"Using cached library dependencies for file: {0}\"\nmsgstr \"Using cached library dependencies for file: {0}\"\n\n#: legacy/builder/target_board_resolver.go:51\nmsgid \"Using core '{0}' from platform in folder: {1}\"\nmsgstr \"Using core '{0}' from platform in folder: {1}\"\n\n#: legacy/builder/print_used_libraries_if_verbose.go:48\nmsgid \"Using library {0} at version {1} in folder: {2} {3}\"\nmsgstr \"Using library {0} at version {1} in folder: {2} {3}\"\n\n#: legacy/builder/print_used_libraries_if_verbose.go:42\nmsgid \"Using library {0} in folder: {1} {2}\"\nmsgstr \"Using library {0} in folder: {1} {2}\"\n\n#: legacy/builder/phases/core_builder.go:107\nmsgid \"Using precompiled core: {0}\"\nmsgstr \"Using precompiled core: {0}\"\n\n#: legacy/builder/phases/libraries_builder.go:98\n#: legacy/builder/phases/libraries_builder.go:106\nmsgid \"Using precompiled library in {0}\"\nmsgstr \"Using precompiled library in {0}\"\n\n#: legacy/builder/builder_utils/utils.go:267\n#: legacy/builder/builder_utils/utils.go:499\nmsgid \"Using previously compiled file: {0}\"\nmsgstr \"Using previously compiled file: {0}\"\n\n#: cli/core/download.go:36\n#: cli/core/install.go:37\nmsgid \"VERSION\"\nmsgstr \"VERSION\"\n\n#: cli/lib/check_deps.go:34\n#: cli/lib/install.go:38\nmsgid \"VERSION_NUMBER\"\nmsgstr \"VERSION_NUMBER\"\n\n#: cli/burnbootloader/burnbootloader.go:55\n#: cli/compile/compile.go:105\n#: cli/upload/upload.go:61\nmsgid \"Verify uploaded binary after the upload.\"\nmsgstr \"Verify uploaded binary after the upload.\"\n\n#: cli/core/search.go:114\nmsgid \"Version\"\nmsgstr \"Version\"\n\n#: cli/lib/search.go:173\nmsgid \"Versions: %s\"\nmsgstr \"Versions: %s\"\n\n#: commands/core/install.go:168\nmsgid \"WARNING cannot configure platform: %s\"\nmsgstr \"WARNING cannot configure platform: %s\"\n\n#: commands/instances.go:848\nmsgid \"WARNING: cannot run post install: %s\"\nmsgstr \"WARNING: cannot run post install: %s\"\n\n#: legacy/builder/warn_about_arch_incompatible_libraries.go:46\nmsgid \"WARNING: library {0} claims to run on {1} architecture(s) and may be incompatible with your current board which runs on {2} architecture(s).\"\nmsgstr \"WARNING: library {0} claims to run on {1} architecture(s) and may be incompatible with your current board which runs on {2} architecture(s).\"\n\n#: commands/upload/upload.go:397\nmsgid \"Waiting for upload port...\"\nmsgstr \"Waiting for upload port...\"\n\n#: legacy/builder/add_build_board_property_if_missing.go:41\nmsgid \"Warning: Board {0}:{1}:{2} doesn''t define a %s preference. Auto-set to: {3}\"\nmsgstr \"Warning: Board {0}:{1}:{2} doesn''t define a %s preference. Auto-set to: {3}\"\n\n#: legacy/builder/warn_about_platform_rewrites.go:47\nmsgid \"Warning: platform.txt from core '{0}' contains deprecated {1}, automatically converted to {2}. Consider upgrading this core.\"\nmsgstr \"Warning: platform.txt from core '{0}' contains deprecated {1}, automatically converted to {2}. Consider upgrading this core.\"\n\n#: commands/upload/upload.go:293\nmsgid \"Warning: tool '%s' is not installed. It might not be available for your OS.\"\nmsgstr \"Warning: tool '%s' is not installed. It might not be available for your OS.\"\n\n#: cli/lib/search.go:166\nmsgid \"Website: %s\"\nmsgstr \"Website: %s\"\n\n#: cli/compile/compile.go:106\nmsgid \"When specified, VID/PID specific build properties are used, if board supports them.\"\nmsgstr \"When specified, VID/PID specific build properties are used, if board supports them.\"\n\n#: cli/config/init.go:41\nmsgid \"Writes current configuration to a configuration file.\"\nmsgstr \"Writes current configuration to a configuration file.\"\n\n#: cli/config/init.go:44\nmsgid \"Writes current configuration to the configuration file in the data directory.\"\nmsgstr \"Writes current configuration to the configuration file in the data directory.\"\n\n#: cli/config/set.go:76\nmsgid \"Writing config file: %v\"\nmsgstr \"Writing config file: %v\"\n\n#: arduino/resources/checksums.go:80\nmsgid \"archive hash differs from hash in index\"\nmsgstr \"archive hash differs from hash in index\"\n\n#: arduino/libraries/librariesmanager/install.go:136\nmsgid \"archive is not valid: multiple files found in zip file top level\"\nmsgstr \"archive is not valid: multiple files found in zip file top level\"\n\n#: cli/sketch/archive.go:38\nmsgid \"archivePath\"\nmsgstr \"archivePath\"\n\n#: legacy/builder/preprocess_sketch.go:103\nmsgid \"arduino-preprocessor pattern is missing\"\nmsgstr \"arduino-preprocessor pattern is missing\"\n\n#: commands/upload/upload.go:554\nmsgid \"autodetect build artifact: %s\"\nmsgstr \"autodetect build artifact: %s\"\n\n#: commands/upload/upload.go:539\nmsgid \"binary file not found in %s\"\nmsgstr \"binary file not found in %s\"\n\n#: arduino/cores/packagemanager/package_manager.go:189\nmsgid \"board %s:%s not found\"\nmsgstr \"board %s:%s not found\"\n\n#: commands/board/list.go:41\nmsgid \"board not found\"\nmsgstr \"board not found\"\n\n#: cli/board/listall.go:35\n#: cli/board/search.go:36\nmsgid \"boardname\"\nmsgstr \"boardname\"\n\n#: arduino/discovery/discovery.go:297\n#: arduino/discovery/discovery.go:318\n#: arduino/discovery/discovery.go:338\n#: arduino/discovery/discovery.go:361\n#: arduino/discovery/discovery.go:384\n#: arduino/discovery/discovery.go:407\nmsgid \"calling %[1]s: %[2]w\"\nmsgstr \"calling %[1]s: %[2]w\"\n\n#: arduino/cores/status.go:123\nmsgid \"can't find latest release of %s\"\nmsgstr \"can't find latest release of %s\"\n\n#: arduino/sketch/sketch.go:105\nmsgid \"can't find main Sketch file in %s\"\nmsgstr \"can't find main Sketch file in %s\"\n\n#: arduino/cores/packagemanager/loader.go:768\nmsgid \"can't find pattern for discovery with id %s\"\nmsgstr \"can't find pattern for discovery with id %s\"\n\n#: executils/output.go:52\nmsgid \"can't retrieve standard error stream: %s\"\nmsgstr \"can't retrieve standard error stream: %s\"\n\n#: executils/output.go:34\nmsgid \"can't retrieve standard output stream: %s\"\nmsgstr \"can't retrieve standard output stream: %s\"\n\n#: legacy/builder/resolve_library.go:36\nmsgid \"candidates\"\nmsgstr \"candidates\"\n\n#: commands/upload/upload.go:496\n#: commands/upload/upload.go:503\nmsgid \"cannot execute upload tool: %s\"\nmsgstr \"cannot execute upload tool: %s\"\n\n#: arduino/resources/install.go:39\nmsgid \"checking local archive integrity\"\nmsgstr \"checking local archive integrity\"\n\n#: legacy/builder/wipeout_build_path_if_build_options_changed.go:85\n#: legacy/builder/wipeout_build_path_if_build_options_changed.go:89\nmsgid \"cleaning build path\"\nmsgstr \"cleaning build path\"\n\n#: cli/cli.go:73\nmsgid \"command\"\nmsgstr \"command\"\n\n#: arduino/discovery/discovery.go:301\n#: arduino/discovery/discovery.go:322\n#: arduino/discovery/discovery.go:342\n#: arduino/discovery/discovery.go:365\n#: arduino/discovery/discovery.go:388\n#: arduino/discovery/discovery.go:411\nmsgid \"command failed: %s\"\nmsgstr \"command failed: %s\"\n\n#: arduino/discovery/discovery.go:299\nmsgid \"communication out of sync, expected 'hello', received '%s'\"\nmsgstr \"communication out of sync, expected 'hello', received '%s'\"\n\n#: arduino/discovery/discovery.go:386\nmsgid \"communication out of sync, expected 'list', received '%s'\"\nmsgstr \"communication out of sync, expected 'list', received '%s'\"\n\n#: arduino/discovery/discovery.go:363\nmsgid \"communication out of sync, expected 'quit', received '%s'\"\nmsgstr \"communication out of sync, expected 'quit', received '%s'\"\n\n#: arduino/discovery/discovery.go:320\nmsgid \"communication out of sync, expected 'start', received '%s'\"\nmsgstr \"communication out of sync, expected 'start', received '%s'\"\n\n#: arduino/discovery/discovery.go:409\nmsgid \"communication out of sync, expected 'start_sync', received '%s'\"\nmsgstr \"communication out of sync, expected 'start_sync', received '%s'\"\n\n#: arduino/discovery/discovery.go:340\nmsgid \"communication out of sync, expected 'stop', received '%s'\"\nmsgstr \"communication out of sync, expected 'stop', received '%s'\"\n\n#: arduino/resources/checksums.go:76\nmsgid \"computing hash: %s\"\nmsgstr \"computing hash: %s\"\n\n#: commands/upload/upload.go:611\nmsgid \"could not find a valid build artifact\"\nmsgstr \"could not find a valid build artifact\"\n\n#: arduino/cores/packagemanager/loader.go:705\nmsgid \"creating discovery: %s\"\nmsgstr \"creating discovery: %s\"\n\n#: arduino/cores/packagemanager/install_uninstall.go:45\nmsgid \"creating installed.json in %[1]s: %[2]s\"\nmsgstr \"creating installed.json in %[1]s: %[2]s\"\n\n#: arduino/resources/install.go:44\n#: arduino/resources/install.go:48\nmsgid \"creating temp dir for extraction: %s\"\nmsgstr \"creating temp dir for extraction: %s\"\n\n#: legacy/builder/phases/sizer.go:128\nmsgid \"data section exceeds available space in board\"\nmsgstr \"data section exceeds available space in board\"\n\n#: arduino/sketch/sketch.go:211\nmsgid \"decoding sketch metadata: %s\"\nmsgstr \"decoding sketch metadata: %s\"\n\n#: commands/lib/resolve_deps.go:54\nmsgid \"dependency '%s' is not available\"\nmsgstr \"dependency '%s' is not available\"\n\n#: legacy/builder/utils/utils.go:471\nmsgid \"destination already exists\"\nmsgstr \"destination already exists\"\n\n#: arduino/libraries/librariesmanager/install.go:74\nmsgid \"destination dir %s already exists, cannot install\"\nmsgstr \"destination dir %s already exists, cannot install\"\n\n#: arduino/discovery/discoverymanager/discoverymanager.go:112\nmsgid \"discovery %[1]s process not started: %[2]w\"\nmsgstr \"discovery %[1]s process not started: %[2]w\"\n\n#: arduino/cores/packagemanager/loader.go:696\nmsgid \"discovery not found: %s\"\nmsgstr \"discovery not found: %s\"\n\n#: arduino/cores/packagemanager/loader.go:700\nmsgid \"discovery not installed: %s\"\nmsgstr \"discovery not installed: %s\"\n\n#: arduino/cores/packagemanager/package_manager.go:478\nmsgid \"discovery release not found: %s\"\nmsgstr \"discovery release not found: %s\"\n\n#: cli/core/download.go:41\n#: cli/core/install.go:42\nmsgid \"download a specific version (in this case 1.6.9).\"\nmsgstr \"download a specific version (in this case 1.6.9).\"\n\n#: cli/core/download.go:40\n#: cli/core/install.go:40\nmsgid \"download the latest version of Arduino SAMD core.\"\nmsgstr \"download the latest version of Arduino SAMD core.\"\n\n#: commands/instances.go:95\nmsgid \"downloading %[1]s tool: %[2]s\"\nmsgstr \"downloading %[1]s tool: %[2]s\"\n\n#: arduino/cores/fqbn.go:48\nmsgid \"empty board identifier\"\nmsgstr \"empty board identifier\"\n\n#: arduino/sketch/sketch.go:200\nmsgid \"encoding sketch metadata: %s\"\nmsgstr \"encoding sketch metadata: %s\"\n\n#: arduino/monitors/serial.go:44\nmsgid \"error opening serial monitor\"\nmsgstr \"error opening serial monitor\"\n\n#: cli/config/set.go:68\nmsgid \"error parsing value: %v\"\nmsgstr \"error parsing value: %v\"\n\n#: commands/board/list.go:87\nmsgid \"error processing response from server\"\nmsgstr \"error processing response from server\"\n\n#: commands/board/list.go:102\nmsgid \"error querying Arduino Cloud Api\"\nmsgstr \"error querying Arduino Cloud Api\"\n\n#: cli/upload/upload.go:71\nmsgid \"error: %s and %s flags cannot be used together\"\nmsgstr \"error: %s and %s flags cannot be used together\"\n\n#: arduino/resources/install.go:67\nmsgid \"extracting archive: %s\"\nmsgstr \"extracting archive: %s\"\n\n#: arduino/libraries/librariesmanager/install.go:124\nmsgid \"extracting archive: %w\"\nmsgstr \"extracting archive: %w\"\n\n#: arduino/resources/checksums.go:145\nmsgid \"failed to compute hash of file \\\"%s\\\"\"\nmsgstr \"failed to compute hash of file \\\"%s\\\"\"\n\n#: commands/board/list.go:70\nmsgid \"failed to initialize http client\"\nmsgstr \"failed to initialize http client\"\n\n#: arduino/resources/checksums.go:97\nmsgid \"fetched archive size differs from size specified in index\"\nmsgstr \"fetched archive size differs from size specified in index\"\n\n#: arduino/resources/install.go:132\nmsgid \"files in archive must be placed in a subdirectory\"\nmsgstr \"files in archive must be placed in a subdirectory\"\n\n#: arduino/cores/packagemanager/loader.go:66\nmsgid \"find abs path: %s\"\nmsgstr \"find abs path: %s\"\n\n#: commands/daemon/monitor.go:45\nmsgid \"first message must contain monitor configuration, not data\"\nmsgstr \"first message must contain monitor configuration, not data\"\n\n#: cli/cli.go:73\nmsgid \"flags\"\nmsgstr \"flags\"\n\n#: arduino/cores/packagemanager/loader.go:108\nmsgid \"following possible symlink %[1]s: %[2]s\"\nmsgstr \"following possible symlink %[1]s: %[2]s\"\n\n#: cli/lib/download.go:39\nmsgid \"for a specific version.\"\nmsgstr \"for a specific version.\"\n\n#: cli/lib/check_deps.go:38\n#: cli/lib/download.go:38\n#: cli/lib/install.go:42\nmsgid \"for the latest version.\"\nmsgstr \"for the latest version.\"\n\n#: cli/lib/check_deps.go:39\n#: cli/lib/install.go:43\nmsgid \"for the specific version.\"\nmsgstr \"for the specific version.\"\n\n#: inventory/inventory.go:68\nmsgid \"generating installation.id: %w\"\nmsgstr \"generating installation.id: %w\"\n\n#: inventory/inventory.go:74\nmsgid \"generating installation.secret: %w\"\nmsgstr \"generating installation.secret: %w\"\n\n#: arduino/resources/helpers.go:68\nmsgid \"getting archive file info: %s\"\nmsgstr \"getting archive file info: %s\"\n\n#: arduino/resources/checksums.go:94\nmsgid \"getting archive info: %s\"\nmsgstr \"getting archive info: %s\"\n\n#: arduino/resources/checksums.go:67\n#: arduino/resources/checksums.go:90\n#: arduino/resources/helpers.go:40\n#: arduino/resources/helpers.go:49\n#: arduino/resources/install.go:55\nmsgid \"getting archive path: %s\"\nmsgstr \"getting archive path: %s\"\n\n#: arduino/cores/packagemanager/package_manager.go:195\nmsgid \"getting build properties for board %[1]s: %[2]s\"\nmsgstr \"getting build properties for board %[1]s: %[2]s\"\n\n#: arduino/cores/packagemanager/download.go:103\nmsgid \"getting discovery dependencies for platform %[1]s: %[2]s\"\nmsgstr \"getting discovery dependencies for platform %[1]s: %[2]s\"\n\n#: arduino/cores/packagemanager/loader.go:649\nmsgid \"getting parent dir of %[1]s: %[2]s\"\nmsgstr \"getting parent dir of %[1]s: %[2]s\"\n\n#: arduino/cores/packagemanager/download.go:96\nmsgid \"getting tool dependencies for platform %[1]s: %[2]s\"\nmsgstr \"getting tool dependencies for platform %[1]s: %[2]s\"\n\n#: arduino/sketch/sketch.go:155\nmsgid \"importing sketch metadata: %s\"\nmsgstr \"importing sketch metadata: %s\"\n\n#: arduino/libraries/librariesmanager/install.go:91\nmsgid \"install directory not set\"\nmsgstr \"install directory not set\"\n\n#: commands/instances.go:99\nmsgid \"installing %[1]s tool: %[2]s\"\nmsgstr \"installing %[1]s tool: %[2]s\"\n\n#: arduino/cores/packagemanager/install_uninstall.go:37\nmsgid \"installing platform %[1]s: %[2]s\"\nmsgstr \"installing platform %[1]s: %[2]s\"\n\n#: arduino/discovery/discovery.go:184\nmsgid \"invalid 'add' message: missing port\"\nmsgstr \"invalid 'add' message: missing port\"\n\n#: arduino/discovery/discovery.go:195\nmsgid \"invalid 'remove' message: missing port\"\nmsgstr \"invalid 'remove' message: missing port\"\n\n#: arduino/resources/checksums.go:45\nmsgid \"invalid checksum format: %s\"\nmsgstr \"invalid checksum format: %s\"\n\n#: arduino/cores/fqbn.go:54\n#: arduino/cores/fqbn.go:59\nmsgid \"invalid config option: %s\"\nmsgstr \"invalid config option: %s\"\n\n#: cli/arguments/reference.go:82\nmsgid \"invalid empty core architecture '%s'\"\nmsgstr \"invalid empty core architecture '%s'\"\n\n#: cli/arguments/reference.go:58\nmsgid \"invalid empty core argument\"\nmsgstr \"invalid empty core argument\"\n\n#: cli/arguments/reference.go:78\nmsgid \"invalid empty core name '%s'\"\nmsgstr \"invalid empty core name '%s'\"\n\n#: cli/arguments/reference.go:62\nmsgid \"invalid empty core reference '%s'\"\nmsgstr \"invalid empty core reference '%s'\"\n\n#: cli/arguments/reference.go:67\nmsgid \"invalid empty core version: '%s'\"\nmsgstr \"invalid empty core version: '%s'\"\n\n#: cli/lib/args.go:49\nmsgid \"invalid empty library name\"\nmsgstr \"invalid empty library name\"\n\n#: cli/lib/args.go:54\nmsgid \"invalid empty library version: %s\"\nmsgstr \"invalid empty library version: %s\"\n\n#: arduino/cores/board.go:123\nmsgid \"invalid empty option found\"\nmsgstr \"invalid empty option found\"\n\n#: arduino/libraries/librariesmanager/install.go:255\nmsgid \"invalid git url\"\nmsgstr \"invalid git url\"\n\n#: arduino/resources/checksums.go:49\nmsgid \"invalid hash '%[1]s': %[2]s\"\nmsgstr \"invalid hash '%[1]s': %[2]s\"\n\n#: cli/arguments/reference.go:75\nmsgid \"invalid item %s\"\nmsgstr \"invalid item %s\"\n\n#: arduino/libraries/libraries_layout.go:53\nmsgid \"invalid library layout value: %d\"\nmsgstr \"invalid library layout value: %d\"\n\n#: arduino/libraries/libraries_layout.go:68\nmsgid \"invalid library layout: %s\"\nmsgstr \"invalid library layout: %s\"\n\n#: arduino/libraries/libraries_location.go:73\nmsgid \"invalid library location value: %d\"\nmsgstr \"invalid library location value: %d\"\n\n#: arduino/libraries/libraries_location.go:94\nmsgid \"invalid library location: %s\"\nmsgstr \"invalid library location: %s\"\n\n#: arduino/cores/board.go:125\nmsgid \"invalid option '%s'\"\nmsgstr \"invalid option '%s'\"\n\n#: inventory/inventory.go:88\nmsgid \"invalid path creating config dir: %[1]s error: %[2]w\"\nmsgstr \"invalid path creating config dir: %[1]s error: %[2]w\"\n\n#: inventory/inventory.go:94\nmsgid \"invalid path writing inventory file: %[1]s error: %[2]w\"\nmsgstr \"invalid path writing inventory file: %[1]s error: %[2]w\"\n\n#: arduino/cores/packageindex/index.go:239\nmsgid \"invalid platform archive size: %s\"\nmsgstr \"invalid platform archive size: %s\"\n\n#: commands/upload/upload.go:483\nmsgid \"invalid recipe '%[1]s': %[2]s\"\nmsgstr \"invalid recipe '%[1]s': %[2]s\"\n\n#: arduino/cores/board.go:109\nmsgid \"invalid value '%[1]s' for option '%[2]s'\"\nmsgstr \"invalid value '%[1]s' for option '%[2]s'\"\n\n#: arduino/cores/packagemanager/loader.go:280\nmsgid \"invalid version dir %[1]s: %[2]s\"\nmsgstr \"invalid version dir %[1]s: %[2]s\"\n\n#: commands/daemon/settings.go:108\nmsgid \"key not found in settings\"\nmsgstr \"key not found in settings\"\n\n#: cli/core/search.go:48\nmsgid \"keywords\"\nmsgstr \"keywords\"\n\n#: arduino/libraries/librariesmanager/install.go:162\n#: arduino/libraries/librariesmanager/install.go:205\nmsgid \"library %s already installed\"\nmsgstr \"library %s already installed\"\n\n#: arduino/libraries/librariesmanager/install.go:37\nmsgid \"library already installed\"\nmsgstr \"library already installed\"\n\n#: arduino/libraries/librariesmanager/install.go:274\nmsgid \"library is not valid: missing file \\\"library.properties\\\"\"\nmsgstr \"library is not valid: missing file \\\"library.properties\\\"\"\n\n#: arduino/libraries/librariesmanager/install.go:269\nmsgid \"library is not valid: missing header file \\\"%s\\\"\"\nmsgstr \"library is not valid: missing header file \\\"%s\\\"\"\n\n#: arduino/libraries/librariesmanager/librariesmanager.go:226\nmsgid \"library path does not exist: %s\"\nmsgstr \"library path does not exist: %s\"\n\n#: arduino/discovery/discoverymanager/discoverymanager.go:222\nmsgid \"listing ports from discovery %[1]s: %[2]w\"\nmsgstr \"listing ports from discovery %[1]s: %[2]w\"\n\n#: arduino/serialutils/serialutils.go:61\nmsgid \"listing serial ports\"\nmsgstr \"listing serial ports\"\n\n#: arduino/cores/packagemanager/loader.go:307\n#: arduino/cores/packagemanager/loader.go:316\n#: arduino/cores/packagemanager/loader.go:321\nmsgid \"loading %[1]s: %[2]s\"\nmsgstr \"loading %[1]s: %[2]s\"\n\n#: arduino/cores/packagemanager/loader.go:356\nmsgid \"loading boards: %s\"\nmsgstr \"loading boards: %s\"\n\n#: arduino/cores/packagemanager/loader.go:604\nmsgid \"loading bundled tools from %[1]s: %[2]s\"\nmsgstr \"loading bundled tools from %[1]s: %[2]s\"\n\n#: arduino/cores/packagemanager/package_manager.go:230\n#: arduino/cores/packagemanager/package_manager.go:245\nmsgid \"loading json index file %[1]s: %[2]s\"\nmsgstr \"loading json index file %[1]s: %[2]s\"\n\n#: arduino/libraries/librariesmanager/librariesmanager.go:205\n#: arduino/libraries/librariesmanager/librariesmanager.go:231\nmsgid \"loading library from %[1]s: %[2]s\"\nmsgstr \"loading library from %[1]s: %[2]s\"\n\n#: arduino/libraries/loader.go:47\nmsgid \"loading library.properties: %s\"\nmsgstr \"loading library.properties: %s\"\n\n#: arduino/cores/packagemanager/loader.go:256\n#: arduino/cores/packagemanager/loader.go:284\nmsgid \"loading platform release %[1]s: %[2]s\"\nmsgstr \"loading platform release %[1]s: %[2]s\"\n\n#: arduino/cores/packagemanager/loader.go:207\nmsgid \"loading platform.txt: %v\"\nmsgstr \"loading platform.txt: %v\"\n\n#: arduino/cores/packagemanager/loader.go:571\nmsgid \"loading tool release in %[1]s: %[2]s\"\nmsgstr \"loading tool release in %[1]s: %[2]s\"\n\n#: arduino/cores/packagemanager/loader.go:200\nmsgid \"looking for boards.txt in %[1]s: %[2]s\"\nmsgstr \"looking for boards.txt in %[1]s: %[2]s\"\n\n#: legacy/builder/container_setup.go:74\nmsgid \"main file missing from sketch\"\nmsgstr \"main file missing from sketch\"\n\n#: arduino/resources/checksums.go:41\nmsgid \"missing checksum for: %s\"\nmsgstr \"missing checksum for: %s\"\n\n#: arduino/cores/packagemanager/package_manager.go:207\nmsgid \"missing package %[1]s referenced by board %[2]s\"\nmsgstr \"missing package %[1]s referenced by board %[2]s\"\n\n#: arduino/cores/packagemanager/package_manager.go:212\nmsgid \"missing platform %[1]s:%[2]s referenced by board %[3]s\"\nmsgstr \"missing platform %[1]s:%[2]s referenced by board %[3]s\"\n\n#: arduino/cores/packagemanager/package_manager.go:217\nmsgid \"missing platform release %[1]s:%[2]s referenced by board %[3]s\"\nmsgstr \"missing platform release %[1]s:%[2]s referenced by board %[3]s\"\n\n#: arduino/libraries/librariesmanager/install.go:179\n#: arduino/resources/install.go:94\nmsgid \"moving extracted archive to destination dir: %s\"\nmsgstr \"moving extracted archive to destination dir: %s\"\n\n#: commands/upload/upload.go:606\nmsgid \"multiple build artifacts found: '%[1]s' and '%[2]s'\"\nmsgstr \"multiple build artifacts found: '%[1]s' and '%[2]s'\"\n\n#: arduino/sketch/sketch.go:77\nmsgid \"multiple main sketch files found (%[1]v, %[2]v)\"\nmsgstr \"multiple main sketch files found (%[1]v, %[2]v)\"\n\n#: arduino/cores/packagemanager/install_uninstall.go:127\nmsgid \"no compatible version of %s tools found for the current os\"\nmsgstr \"no compatible version of %s tools found for the current os\"\n\n#: executils/process.go:37\nmsgid \"no executable specified\"\nmsgstr \"no executable specified\"\n\n#: commands/daemon/daemon.go:94\nmsgid \"no instance specified\"\nmsgstr \"no instance specified\"\n\n#: commands/upload/upload.go:561\nmsgid \"no sketch or build directory/file specified\"\nmsgstr \"no sketch or build directory/file specified\"\n\n#: arduino/resources/install.go:128\nmsgid \"no unique root dir in archive, found '%[1]s' and '%[2]s'\"\nmsgstr \"no unique root dir in archive, found '%[1]s' and '%[2]s'\"\n\n#: commands/upload/upload.go:478\nmsgid \"no upload port provided\"\nmsgstr \"no upload port provided\"\n\n#: arduino/sketch/sketch.go:263\nmsgid \"no valid sketch found in %[1]s: missing %[2]s\"\nmsgstr \"no valid sketch found in %[1]s: missing %[2]s\"\n\n#: commands/core/download.go:84\nmsgid \"no versions available for the current OS\"\nmsgstr \"no versions available for the current OS\"\n\n#: arduino/resources/checksums.go:72\n#: arduino/resources/install.go:59\nmsgid \"opening archive file: %s\"\nmsgstr \"opening archive file: %s\"\n\n#: arduino/cores/packagemanager/loader.go:273\nmsgid \"opening boards.txt: %s\"\nmsgstr \"opening boards.txt: %s\"\n\n#: arduino/serialutils/serialutils.go:37\nmsgid \"opening port at 1200bps\"\nmsgstr \"opening port at 1200bps\"\n\n#: arduino/security/signatures.go:81\nmsgid \"opening signature file: %s\"\nmsgstr \"opening signature file: %s\"\n\n#: arduino/security/signatures.go:76\nmsgid \"opening target file: %s\"\nmsgstr \"opening target file: %s\"\n\n#: arduino/cores/packagemanager/download.go:73\n#: arduino/cores/status.go:88\n#: arduino/cores/status.go:113\nmsgid \"package %s not found\"\nmsgstr \"package %s not found\"\n\n#: arduino/cores/packagemanager/package_manager.go:259\nmsgid \"package '%s' not found\"\nmsgstr \"package '%s' not found\"\n\n#: arduino/cores/status.go:167\nmsgid \"package not found\"\nmsgstr \"package not found\"\n\n#: arduino/cores/packagemanager/loader.go:227\nmsgid \"parsing IDE bundled index: %s\"\nmsgstr \"parsing IDE bundled index: %s\"\n\n#: arduino/cores/board.go:139\n#: arduino/cores/packagemanager/package_manager.go:136\nmsgid \"parsing fqbn: %s\"\nmsgstr \"parsing fqbn: %s\"\n\n#: arduino/libraries/librariesindex/json.go:69\nmsgid \"parsing library_index.json: %s\"\nmsgstr \"parsing library_index.json: %s\"\n\n#: arduino/cores/packagemanager/loader.go:189\nmsgid \"path is not a platform directory: %s\"\nmsgstr \"path is not a platform directory: %s\"\n\n#: arduino/cores/packagemanager/download.go:77\nmsgid \"platform %[1]s not found in package %[2]s\"\nmsgstr \"platform %[1]s not found in package %[2]s\"\n\n#: arduino/cores/packagemanager/download.go:89\nmsgid \"platform %s has no available releases\"\nmsgstr \"platform %s has no available releases\"\n\n#: arduino/cores/packagemanager/package_manager.go:182\nmsgid \"platform %s is not installed\"\nmsgstr \"platform %s is not installed\"\n\n#: arduino/cores/packagemanager/install_uninstall.go:65\n#: arduino/cores/packagemanager/install_uninstall.go:108\n#: arduino/cores/packagemanager/loader.go:433\n#: commands/compile/compile.go:127\nmsgid \"platform not installed\"\nmsgstr \"platform not installed\"\n\n#: cli/compile/compile.go:124\nmsgid \"please use --build-property instead.\"\nmsgstr \"please use --build-property instead.\"\n\n#: arduino/discovery/discoverymanager/discoverymanager.go:67\nmsgid \"pluggable discovery already added: %s\"\nmsgstr \"pluggable discovery already added: %s\"\n\n#: cli/board/attach.go:35\nmsgid \"port\"\nmsgstr \"port\"\n\n#: cli/arguments/port.go:122\nmsgid \"port not found: %[1]s %[2]s\"\nmsgstr \"port not found: %[1]s %[2]s\"\n\n#: arduino/discovery/discovery.go:303\nmsgid \"protocol version not supported: requested 1, got %d\"\nmsgstr \"protocol version not supported: requested 1, got %d\"\n\n#: arduino/discovery/discoverymanager/discoverymanager.go:188\nmsgid \"quitting discovery %[1]s: %[2]w\"\nmsgstr \"quitting discovery %[1]s: %[2]w\"\n\n#: arduino/cores/packagemanager/loader.go:78\nmsgid \"reading %[1]s directory: %[2]s\"\nmsgstr \"reading %[1]s directory: %[2]s\"\n\n#: arduino/cores/packagemanager/loader.go:654\nmsgid \"reading %[1]s: %[2]s\"\nmsgstr \"reading %[1]s: %[2]s\"\n\n#: arduino/cores/packagemanager/loader.go:267\n#: arduino/libraries/librariesmanager/librariesmanager.go:196\nmsgid \"reading dir %[1]s: %[2]s\"\nmsgstr \"reading dir %[1]s: %[2]s\"\n\n#: arduino/cores/packagemanager/loader.go:162\n#: arduino/cores/packagemanager/loader.go:562\nmsgid \"reading directory %[1]s: %[2]s\"\nmsgstr \"reading directory %[1]s: %[2]s\"\n\n#: arduino/builder/sketch.go:76\nmsgid \"reading file %[1]s: %[2]s\"\nmsgstr \"reading file %[1]s: %[2]s\"\n\n#: arduino/sketch/sketch.go:233\nmsgid \"reading files: %v\"\nmsgstr \"reading files: %v\"\n\n#: inventory/inventory.go:58\nmsgid \"reading inventory file: %w\"\nmsgstr \"reading inventory file: %w\"\n\n#: arduino/libraries/librariesresolver/cpp.go:60\nmsgid \"reading lib headers: %s\"\nmsgstr \"reading lib headers: %s\"\n\n#: arduino/libraries/libraries.go:234\nmsgid \"reading lib src dir: %s\"\nmsgstr \"reading lib src dir: %s\"\n\n#: arduino/libraries/libraries.go:114\nmsgid \"reading library headers: %w\"\nmsgstr \"reading library headers: %w\"\n\n#: arduino/libraries/librariesindex/json.go:63\nmsgid \"reading library_index.json: %s\"\nmsgstr \"reading library_index.json: %s\"\n\n#: arduino/resources/install.go:118\nmsgid \"reading package root dir: %s\"\nmsgstr \"reading package root dir: %s\"\n\n#: arduino/sketch/sketch.go:192\nmsgid \"reading sketch metadata %[1]s: %[2]s\"\nmsgstr \"reading sketch metadata %[1]s: %[2]s\"\n\n#: commands/upload/upload.go:472\nmsgid \"recipe not found '%s'\"\nmsgstr \"recipe not found '%s'\"\n\n#: arduino/cores/packagemanager/package_manager.go:335\nmsgid \"release %[1]s not found for tool %[2]s\"\nmsgstr \"release %[1]s not found for tool %[2]s\"\n\n#: arduino/cores/status.go:82\n#: arduino/cores/status.go:106\nmsgid \"release cannot be nil\"\nmsgstr \"release cannot be nil\"\n\n#: arduino/cores/status.go:183\nmsgid \"release not found\"\nmsgstr \"release not found\"\n\n#: arduino/resources/helpers.go:59\nmsgid \"removing corrupted archive file: %s\"\nmsgstr \"removing corrupted archive file: %s\"\n\n#: arduino/libraries/librariesmanager/install.go:94\nmsgid \"removing lib directory: %s\"\nmsgstr \"removing lib directory: %s\"\n\n#: arduino/cores/packagemanager/install_uninstall.go:117\nmsgid \"removing platform files: %s\"\nmsgstr \"removing platform files: %s\"\n\n#: arduino/cores/packagemanager/install_uninstall.go:169\nmsgid \"removing tool files: %s\"\nmsgstr \"removing tool files: %s\"\n\n#: arduino/cores/packagemanager/download.go:84\nmsgid \"required version %[1]s not found for platform %[2]s\"\nmsgstr \"required version %[1]s not found for platform %[2]s\"\n\n#: arduino/security/signatures.go:72\nmsgid \"retrieving Arduino public keys: %s\"\nmsgstr \"retrieving Arduino public keys: %s\"\n\n#: arduino/libraries/loader.go:109\n#: arduino/libraries/loader.go:140\nmsgid \"scanning examples: %s\"\nmsgstr \"scanning examples: %s\"\n\n#: arduino/cores/packagemanager/loader.go:640\nmsgid \"searching for builtin_tools_versions.txt in %[1]s: %[2]s\"\nmsgstr \"searching for builtin_tools_versions.txt in %[1]s: %[2]s\"\n\n#: arduino/resources/install.go:73\nmsgid \"searching package root dir: %s\"\nmsgstr \"searching package root dir: %s\"\n\n#: arduino/serialutils/serialutils.go:43\nmsgid \"setting DTR to OFF\"\nmsgstr \"setting DTR to OFF\"\n\n#: arduino/sketch/sketch.go:62\nmsgid \"sketch path is not valid\"\nmsgstr \"sketch path is not valid\"\n\n#: cli/board/attach.go:35\n#: cli/sketch/archive.go:38\nmsgid \"sketchPath\"\nmsgstr \"sketchPath\"\n\n#: arduino/cores/packagemanager/loader.go:496\nmsgid \"skipping loading of boards %s: malformed custom board options\"\nmsgstr \"skipping loading of boards %s: malformed custom board options\"\n\n#: legacy/builder/utils/utils.go:463\nmsgid \"source is not a directory\"\nmsgstr \"source is not a directory\"\n\n#: arduino/discovery/discoverymanager/discoverymanager.go:149\nmsgid \"start syncing discovery %[1]s: %[2]w\"\nmsgstr \"start syncing discovery %[1]s: %[2]w\"\n\n#: arduino/discovery/discoverymanager/discoverymanager.go:128\nmsgid \"starting discovery %[1]s: %[2]w\"\nmsgstr \"starting discovery %[1]s: %[2]w\"\n\n#: commands/board/list.go:283\nmsgid \"stopping discoveries: %s\"\nmsgstr \"stopping discoveries: %s\"\n\n#: arduino/discovery/discoverymanager/discoverymanager.go:172\nmsgid \"stopping discovery %[1]s: %[2]w\"\nmsgstr \"stopping discovery %[1]s: %[2]w\"\n\n#: arduino/resources/checksums.go:119\nmsgid \"testing archive checksum: %s\"\nmsgstr \"testing archive checksum: %s\"\n\n#: arduino/resources/checksums.go:112\nmsgid \"testing archive size: %s\"\nmsgstr \"testing archive size: %s\"\n\n#: arduino/resources/checksums.go:106\nmsgid \"testing if archive is cached: %s\"\nmsgstr \"testing if archive is cached: %s\"\n\n#: arduino/resources/install.go:37\nmsgid \"testing local archive integrity: %s\"\nmsgstr \"testing local archive integrity: %s\"\n\n#: legacy/builder/phases/sizer.go:122\nmsgid \"text section exceeds available space in board\"\nmsgstr \"text section exceeds available space in board\"\n\n#: commands/core/list.go:57\nmsgid \"the platform has no releases\"\nmsgstr \"the platform has no releases\"\n\n#: commands/board/list.go:78\nmsgid \"the server responded with status %s\"\nmsgstr \"the server responded with status %s\"\n\n#: arduino/discovery/discovery.go:228\nmsgid \"timeout waiting for message from %s\"\nmsgstr \"timeout waiting for message from %s\"\n\n#: arduino/cores/packagemanager/install_uninstall.go:165\nmsgid \"tool %s is not managed by package manager\"\nmsgstr \"tool %s is not managed by package manager\"\n\n#: arduino/cores/status.go:92\n#: arduino/cores/status.go:117\nmsgid \"tool %s not found\"\nmsgstr \"tool %s not found\"\n\n#: arduino/cores/packagemanager/package_manager.go:285\nmsgid \"tool '%[1]s' not found in package '%[2]s'\"\nmsgstr \"tool '%[1]s' not found in package '%[2]s'\"\n\n#: arduino/cores/packagemanager/download.go:114\nmsgid \"tool not available for your OS\"\nmsgstr \"tool not available for your OS\"\n\n#: arduino/cores/status.go:171\nmsgid \"tool not found\"\nmsgstr \"tool not found\"\n\n#: arduino/cores/packagemanager/install_uninstall.go:160\nmsgid \"tool not installed\"\nmsgstr \"tool not installed\"\n\n#: arduino/cores/packagemanager/package_manager.go:467\n#: arduino/cores/packagemanager/package_manager.go:533\nmsgid \"tool release not found: %s\"\nmsgstr \"tool release not found: %s\"\n\n#: arduino/cores/status.go:96\nmsgid \"tool version %s not found\"\nmsgstr \"tool version %s not found\"\n\n#: commands/lib/install.go:58\nmsgid \"two different versions of the library %[1]s are required: %[2]s and %[3]s\"\nmsgstr \"two different versions of the library %[1]s are required: %[2]s and %[3]s\"\n\n#: arduino/builder/sketch.go:69\n#: arduino/builder/sketch.go:117\nmsgid \"unable to compute relative path to the sketch for the item\"\nmsgstr \"unable to compute relative path to the sketch for the item\"\n\n#: arduino/builder/sketch.go:49\nmsgid \"unable to create a folder to save the sketch\"\nmsgstr \"unable to create a folder to save the sketch\"\n\n#: arduino/builder/sketch.go:111\nmsgid \"unable to create a folder to save the sketch files\"\nmsgstr \"unable to create a folder to save the sketch files\"\n\n#: arduino/builder/sketch.go:123\nmsgid \"unable to create the folder containing the item\"\nmsgstr \"unable to create the folder containing the item\"\n\n#: cli/config/dump.go:53\nmsgid \"unable to marshal config to YAML: %v\"\nmsgstr \"unable to marshal config to YAML: %v\"\n\n#: arduino/builder/sketch.go:161\nmsgid \"unable to read contents of the destination item\"\nmsgstr \"unable to read contents of the destination item\"\n\n#: arduino/builder/sketch.go:134\nmsgid \"unable to read contents of the source item\"\nmsgstr \"unable to read contents of the source item\"\n\n#: arduino/builder/sketch.go:55\nmsgid \"unable to save the sketch on disk\"\nmsgstr \"unable to save the sketch on disk\"\n\n#: arduino/builder/sketch.go:144\nmsgid \"unable to write to destination file\"\nmsgstr \"unable to write to destination file\"\n\n#: arduino/cores/packagemanager/package_manager.go:170\nmsgid \"unknown package %s\"\nmsgstr \"unknown package %s\"\n\n#: arduino/cores/packagemanager/package_manager.go:177\nmsgid \"unknown platform %s:%s\"\nmsgstr \"unknown platform %s:%s\"\n\n#: arduino/sketch/sketch.go:146\nmsgid \"unknown sketch file extension '%s'\"\nmsgstr \"unknown sketch file extension '%s'\"\n\n#: arduino/resources/checksums.go:62\nmsgid \"unsupported hash algorithm: %s\"\nmsgstr \"unsupported hash algorithm: %s\"\n\n#: cli/core/upgrade.go:44\nmsgid \"upgrade arduino:samd to the latest version\"\nmsgstr \"upgrade arduino:samd to the latest version\"\n\n#: cli/core/upgrade.go:42\nmsgid \"upgrade everything to the latest version\"\nmsgstr \"upgrade everything to the latest version\"\n\n#: commands/upload/upload.go:507\nmsgid \"uploading error: %s\"\nmsgstr \"uploading error: %s\"\n\n#: arduino/sketch/sketch.go:216\nmsgid \"writing sketch metadata %[1]s: %[2]s\"\nmsgstr \"writing sketch metadata %[1]s: %[2]s\"\n\n#: commands/board/list.go:94\nmsgid \"wrong format in server response\"\nmsgstr \"wrong format in server response\"\n\n#: legacy/builder/wipeout_build_path_if_build_options_changed.go:49\nmsgid \"{0} invalid, rebuilding all\"\nmsgstr \"{0} invalid, rebuilding all\"\n\n#: legacy/builder/builder_utils/utils.go:323\n#: legacy/builder/builder_utils/utils.go:329\n#: legacy/builder/builder_utils/utils.go:393\nmsgid \"{0} newer than {1}\"\nmsgstr \"{0} newer than {1}\"\n\n"),
+		Content: string("msgid \"\"\nmsgstr \"\"\n\n#: cli/compile/matrix.go:93\nmsgid \"%[1]d of %[2]d builds failed\"\nmsgstr \"%[1]d of %[2]d builds failed\"\n\n#: cli/test/test.go:277\nmsgid \"%[1]d tests: %[2]d passed, %[3]d failed, %[4]d errors\"\nmsgstr \"%[1]d tests: %[2]d passed, %[3]d failed, %[4]d errors\"\n\n#: version/version.go:53\nmsgid \"%[1]s %[2]s Version: %[3]s Commit: %[4]s Date: %[5]s\"\nmsgstr \"%[1]s %[2]s Version: %[3]s Commit: %[4]s Date: %[5]s\"\n\n#: legacy/builder/fail_if_imported_library_is_wrong.go:37\nmsgid \"%[1]s folder is no longer supported! See %[2]s for more information\"\nmsgstr \"%[1]s folder is no longer supported! See %[2]s for more information\"\n\n#: cli/lib/check_deps.go:96\nmsgid \"%[1]s is required but %[2]s is currently installed.\"\nmsgstr \"%[1]s is required but %[2]s is currently installed.\"\n\n#: arduino/discovery/discovery.go:74\n#: arduino/monitor/monitor.go:72\nmsgid \"%[1]s, message: %[2]s\"\nmsgstr \"%[1]s, message: %[2]s\"\n\n#: arduino/monitor/monitor.go:78\nmsgid \"%[1]s, port description: %[2]s\"\nmsgstr \"%[1]s, port description: %[2]s\"\n\n#: arduino/discovery/discovery.go:83\nmsgid \"%[1]s, port: %[2]s\"\nmsgstr \"%[1]s, port: %[2]s\"\n\n#: arduino/discovery/discovery.go:80\nmsgid \"%[1]s, ports: %[2]s\"\nmsgstr \"%[1]s, ports: %[2]s\"\n\n#: arduino/discovery/discovery.go:77\n#: arduino/monitor/monitor.go:75\nmsgid \"%[1]s, protocol version: %[2]d\"\nmsgstr \"%[1]s, protocol version: %[2]d\"\n\n#: cli/compile/size_report.go:60\nmsgid \"%[1]s: %[2]d bytes\"\nmsgstr \"%[1]s: %[2]d bytes\"\n\n#: cli/compile/size_report.go:58\nmsgid \"%[1]s: %[2]d bytes (%[3]d%%) of %[4]d bytes\"\nmsgstr \"%[1]s: %[2]d bytes (%[3]d%%) of %[4]d bytes\"\n\n#: cli/compile/size_report.go:117\nmsgid \"%[1]s: %[2]d bytes, was %[3]d bytes (%[4]s)\"\nmsgstr \"%[1]s: %[2]d bytes, was %[3]d bytes (%[4]s)\"\n\n#: cli/compile/size_report.go:119\nmsgid \"%[1]s: %[2]d bytes, was %[3]d bytes (%[4]s, %+.2f%%)\"\nmsgstr \"%[1]s: %[2]d bytes, was %[3]d bytes (%[4]s, %+.2f%%)\"\n\n#: cli/compile/library_resolutions.go:48\nmsgid \"%[1]s: selected %[2]s, %[3]s\"\nmsgstr \"%[1]s: selected %[2]s, %[3]s\"\n\n#: cli/output/rpc_progress.go:64\nmsgid \"%s already downloaded\"\nmsgstr \"%s already downloaded\"\n\n#: commands/upload/upload.go:549\nmsgid \"%s and %s cannot be used together\"\nmsgstr \"%s and %s cannot be used together\"\n\n#: cli/output/rpc_progress.go:76\nmsgid \"%s downloaded\"\nmsgstr \"%s downloaded\"\n\n#: commands/bundled_tools.go:54\nmsgid \"%s installed\"\nmsgstr \"%s installed\"\n\n#: cli/lib/check_deps.go:93\nmsgid \"%s is already installed.\"\nmsgstr \"%s is already installed.\"\n\n#: arduino/cores/packagemanager/loader.go:71\nmsgid \"%s is not a directory\"\nmsgstr \"%s is not a directory\"\n\n#: arduino/cores/packagemanager/install_uninstall.go:113\nmsgid \"%s is not managed by package manager\"\nmsgstr \"%s is not managed by package manager\"\n\n#: cli/lib/check_deps.go:90\nmsgid \"%s must be installed.\"\nmsgstr \"%s must be installed.\"\n\n#: legacy/builder/builder_utils/utils.go:545\n#: legacy/builder/ctags_runner.go:41\nmsgid \"%s pattern is missing\"\nmsgstr \"%s pattern is missing\"\n\n#: commands/instances.go:1045\nmsgid \"%s uninstalled\"\nmsgstr \"%s uninstalled\"\n\n#: commands/errors.go:693\nmsgid \"'%s' has an invalid signature\"\nmsgstr \"'%s' has an invalid signature\"\n\n#: cli/board/listall.go:88\n#: cli/board/search.go:90\nmsgid \"(hidden)\"\nmsgstr \"(hidden)\"\n\n#: legacy/builder/print_used_libraries_if_verbose.go:38\nmsgid \"(legacy)\"\nmsgstr \"(legacy)\"\n\n#: cli/lib/install.go:71\nmsgid \"--git-url and --zip-path are disabled by default, for more information see: %v\"\nmsgstr \"--git-url and --zip-path are disabled by default, for more information see: %v\"\n\n#: cli/lib/install.go:74\nmsgid \"--git-url and --zip-path flags allow installing untrusted files, use it at your own risk.\"\nmsgstr \"--git-url and --zip-path flags allow installing untrusted files, use it at your own risk.\"\n\n#: cli/updater/updater.go:70\nmsgid \"A new release of Arduino CLI is available:\"\nmsgstr \"A new release of Arduino CLI is available:\"\n\n#: commands/errors.go:194\nmsgid \"A programmer is required to upload\"\nmsgstr \"A programmer is required to upload\"\n\n#: cli/core/download.go:36\n#: cli/core/install.go:37\n#: cli/core/uninstall.go:36\n#: cli/core/upgrade.go:38\nmsgid \"ARCH\"\nmsgstr \"ARCH\"\n\n#: cli/generatedocs/generatedocs.go:79\nmsgid \"ARDUINO COMMAND LINE MANUAL\"\nmsgstr \"ARDUINO COMMAND LINE MANUAL\"\n\n#: cli/usage.go:32\nmsgid \"Additional help topics:\"\nmsgstr \"Additional help topics:\"\n\n#: cli/compile/size_report.go:67\nmsgid \"Address\"\nmsgstr \"Address\"\n\n#: cli/config/add.go:31\n#: cli/config/add.go:32\nmsgid \"Adds one or more values to a setting.\"\nmsgstr \"Adds one or more values to a setting.\"\n\n#: cli/usage.go:27\nmsgid \"Aliases:\"\nmsgstr \"Aliases:\"\n\n#: cli/core/upgrade.go:68\nmsgid \"All the cores are already at the latest version\"\nmsgstr \"All the cores are already at the latest version\"\n\n#: commands/instances.go:914\n#: commands/lib/install.go:96\nmsgid \"Already installed %s\"\nmsgstr \"Already installed %s\"\n\n#: legacy/builder/resolve_library.go:35\nmsgid \"Alternatives for %[1]s: %[2]s\"\nmsgstr \"Alternatives for %[1]s: %[2]s\"\n\n#: arduino/libraries/lint.go:188\nmsgid \"Architecture '%[1]s' of library %[2]s doesn't match any installed platform\"\nmsgstr \"Architecture '%[1]s' of library %[2]s doesn't match any installed platform\"\n\n#: cli/compile/library_resolutions.go:51\nmsgid \"Architecture priority\"\nmsgstr \"Architecture priority\"\n\n#: cli/lib/search.go:171\nmsgid \"Architecture: %s\"\nmsgstr \"Architecture: %s\"\n\n#: commands/sketch/archive.go:70\nmsgid \"Archive already exists\"\nmsgstr \"Archive already exists\"\n\n#: legacy/builder/phases/core_builder.go:128\nmsgid \"Archiving built core (caching) in: {0}\"\nmsgstr \"Archiving built core (caching) in: {0}\"\n\n#: cli/sketch/sketch.go:31\n#: cli/sketch/sketch.go:32\nmsgid \"Arduino CLI sketch commands.\"\nmsgstr \"Arduino CLI sketch commands.\"\n\n#: cli/cli.go:74\nmsgid \"Arduino CLI.\"\nmsgstr \"Arduino CLI.\"\n\n#: cli/cli.go:75\nmsgid \"Arduino Command Line Interface (arduino-cli).\"\nmsgstr \"Arduino Command Line Interface (arduino-cli).\"\n\n#: cli/board/board.go:28\n#: cli/board/board.go:29\nmsgid \"Arduino board commands.\"\nmsgstr \"Arduino board commands.\"\n\n#: cli/cache/cache.go:31\n#: cli/cache/cache.go:32\nmsgid \"Arduino cache commands.\"\nmsgstr \"Arduino cache commands.\"\n\n#: cli/lib/lib.go:31\n#: cli/lib/lib.go:32\nmsgid \"Arduino commands about libraries.\"\nmsgstr \"Arduino commands about libraries.\"\n\n#: cli/config/config.go:31\nmsgid \"Arduino configuration commands.\"\nmsgstr \"Arduino configuration commands.\"\n\n#: cli/core/core.go:31\n#: cli/core/core.go:32\nmsgid \"Arduino core operations.\"\nmsgstr \"Arduino core operations.\"\n\n#: cli/bundle/export.go:72\n#: cli/lib/check_deps.go:50\n#: cli/lib/install.go:117\nmsgid \"Arguments error: %v\"\nmsgstr \"Arguments error: %v\"\n\n#: cli/bundle/export.go:65\nmsgid \"At least a board or a library to export is required\"\nmsgstr \"At least a board or a library to export is required\"\n\n#: cli/board/attach.go:68\nmsgid \"Attach board error: %v\"\nmsgstr \"Attach board error: %v\"\n\n#: cli/board/attach.go:36\n#: cli/board/attach.go:37\n#: cli/board/board.go:32\nmsgid \"Attaches a sketch to a board.\"\nmsgstr \"Attaches a sketch to a board.\"\n\n#: cli/lib/search.go:162\nmsgid \"Author: %s\"\nmsgstr \"Author: %s\"\n\n#: cli/lib/list.go:125\nmsgid \"Available\"\nmsgstr \"Available\"\n\n#: cli/usage.go:29\nmsgid \"Available Commands:\"\nmsgstr \"Available Commands:\"\n\n#: cli/bundle/import.go:37\nmsgid \"BUNDLE\"\nmsgstr \"BUNDLE\"\n\n#: arduino/monitors/serial.go:167\nmsgid \"Baudrate\"\nmsgstr \"Baudrate\"\n\n#: cli/upload/upload.go:62\nmsgid \"Binary file to upload.\"\nmsgstr \"Binary file to upload.\"\n\n#: cli/board/list.go:87\n#: cli/board/list.go:125\n#: cli/board/listall.go:84\n#: cli/board/search.go:86\nmsgid \"Board Name\"\nmsgstr \"Board Name\"\n\n#: commands/board/attach.go:93\nmsgid \"Board found: %s\"\nmsgstr \"Board found: %s\"\n\n#: cli/board/details.go:120\nmsgid \"Board name:\"\nmsgstr \"Board name:\"\n\n#: cli/board/details.go:122\nmsgid \"Board version:\"\nmsgstr \"Board version:\"\n\n#: legacy/builder/merge_sketch_with_bootloader.go:69\nmsgid \"Bootloader file specified but missing: {0}\"\nmsgstr \"Bootloader file specified but missing: {0}\"\n\n#: cli/compile/matrix.go:50\nmsgid \"Build of %s failed\"\nmsgstr \"Build of %s failed\"\n\n#: cli/compile/matrix.go:48\nmsgid \"Build of %s succeeded\"\nmsgstr \"Build of %s succeeded\"\n\n#: cli/compile/matrix.go:128\nmsgid \"Build path\"\nmsgstr \"Build path\"\n\n#: cli/compile/matrix.go:128\nmsgid \"Build properties\"\nmsgstr \"Build properties\"\n\n#: cli/compile/compile.go:212\nmsgid \"Building the sketch for more boards can't be used with --size-report, --size-report-out, --compare-size or --explain-libraries\"\nmsgstr \"Building the sketch for more boards can't be used with --size-report, --size-report-out, --compare-size or --explain-libraries\"\n\n#: cli/compile/compile.go:208\nmsgid \"Building the sketch for more boards can't be used with --upload, --watch, --show-properties or --preprocess\"\nmsgstr \"Building the sketch for more boards can't be used with --upload, --watch, --show-properties or --preprocess\"\n\n#: cli/compile/compile.go:108\nmsgid \"Builds of 'core.a' are saved into this path to be cached and reused.\"\nmsgstr \"Builds of 'core.a' are saved into this path to be cached and reused.\"\n\n#: cli/test/test.go:59\nmsgid \"Builds the sketch together with the unit tests in its test folder using the compiler of this computer and a mock Arduino core, then runs the tests. Each test runs in its own process, so a crash affects only the test that caused it.\"\nmsgstr \"Builds the sketch together with the unit tests in its test folder using the compiler of this computer and a mock Arduino core, then runs the tests. Each test runs in its own process, so a crash affects only the test that caused it.\"\n\n#: commands/bundle/import.go:68\nmsgid \"Bundle %s not found\"\nmsgstr \"Bundle %s not found\"\n\n#: cli/bundle/export.go:116\nmsgid \"Bundle:\"\nmsgstr \"Bundle:\"\n\n#: commands/compile/matrix.go:48\nmsgid \"Can't build more targets while showing the build properties or preprocessing the sketch\"\nmsgstr \"Can't build more targets while showing the build properties or preprocessing the sketch\"\n\n#: commands/compile/matrix.go:51\nmsgid \"Can't compare the size of more targets with the same baseline\"\nmsgstr \"Can't compare the size of more targets with the same baseline\"\n\n#: commands/bundle/export.go:217\nmsgid \"Can't create bundle directory %s\"\nmsgstr \"Can't create bundle directory %s\"\n\n#: commands/bundle/import.go:74\n#: commands/instances.go:562\n#: commands/instances.go:667\nmsgid \"Can't create data directory %s\"\nmsgstr \"Can't create data directory %s\"\n\n#: commands/lib/download.go:60\n#: commands/lib/download.go:63\n#: commands/lib/download.go:65\nmsgid \"Can't download library\"\nmsgstr \"Can't download library\"\n\n#: commands/core/install.go:121\n#: commands/core/uninstall.go:52\n#: commands/instances.go:953\n#: commands/instances.go:965\nmsgid \"Can't find dependencies for platform %s\"\nmsgstr \"Can't find dependencies for platform %s\"\n\n#: commands/errors.go:345\nmsgid \"Can't open sketch\"\nmsgstr \"Can't open sketch\"\n\n#: commands/compile/test.go:65\nmsgid \"Can't run the tests while showing the build properties or preprocessing the sketch\"\nmsgstr \"Can't run the tests while showing the build properties or preprocessing the sketch\"\n\n#: cli/config/set.go:54\nmsgid \"Can't set multiple values in key %v\"\nmsgstr \"Can't set multiple values in key %v\"\n\n#: cli/config/init.go:59\nmsgid \"Can't use both --dest-file and --dest-dir flags at the same time.\"\nmsgstr \"Can't use both --dest-file and --dest-dir flags at the same time.\"\n\n#: commands/compile/watch.go:58\nmsgid \"Can't watch the sketch while showing the build properties or preprocessing it\"\nmsgstr \"Can't watch the sketch while showing the build properties or preprocessing it\"\n\n#: cli/bundle/import.go:69\n#: cli/config/add.go:60\n#: cli/config/delete.go:67\n#: cli/config/remove.go:69\n#: cli/core/trust.go:86\n#: cli/core/trust.go:153\nmsgid \"Can't write config file: %v\"\nmsgstr \"Can't write config file: %v\"\n\n#: commands/compile/compile.go:225\nmsgid \"Cannot create build cache directory\"\nmsgstr \"Cannot create build cache directory\"\n\n#: commands/compile/compile.go:195\n#: commands/compile/test.go:135\nmsgid \"Cannot create build directory\"\nmsgstr \"Cannot create build directory\"\n\n#: cli/config/init.go:96\nmsgid \"Cannot create config file directory: %v\"\nmsgstr \"Cannot create config file directory: %v\"\n\n#: cli/config/init.go:105\nmsgid \"Cannot create config file: %v\"\nmsgstr \"Cannot create config file: %v\"\n\n#: commands/debug/export.go:99\nmsgid \"Cannot create directory\"\nmsgstr \"Cannot create directory\"\n\n#: commands/errors.go:656\nmsgid \"Cannot create temp dir\"\nmsgstr \"Cannot create temp dir\"\n\n#: commands/errors.go:674\nmsgid \"Cannot create temp file\"\nmsgstr \"Cannot create temp file\"\n\n#: commands/debug/gdb_servers.go:242\n#: commands/debug/gdb_servers.go:247\nmsgid \"Cannot execute GDB server\"\nmsgstr \"Cannot execute GDB server\"\n\n#: commands/debug/dap.go:153\n#: commands/debug/dap.go:168\n#: commands/debug/debug.go:81\nmsgid \"Cannot execute debug tool\"\nmsgstr \"Cannot execute debug tool\"\n\n#: commands/board/attach.go:106\nmsgid \"Cannot export sketch metadata\"\nmsgstr \"Cannot export sketch metadata\"\n\n#: cli/config/init.go:71\n#: cli/config/init.go:82\nmsgid \"Cannot find absolute path: %v\"\nmsgstr \"Cannot find absolute path: %v\"\n\n#: configuration/configuration.go:148\n#: configuration/configuration.go:154\nmsgid \"Cannot get executable path: %v\"\nmsgstr \"Cannot get executable path: %v\"\n\n#: commands/core/install.go:128\nmsgid \"Cannot install platform\"\nmsgstr \"Cannot install platform\"\n\n#: commands/bundled_tools.go:51\nmsgid \"Cannot install tool %s\"\nmsgstr \"Cannot install tool %s\"\n\n#: commands/upload/upload.go:440\nmsgid \"Cannot perform port reset: %s\"\nmsgstr \"Cannot perform port reset: %s\"\n\n#: commands/debug/export.go:120\nmsgid \"Cannot read file %s\"\nmsgstr \"Cannot read file %s\"\n\n#: commands/debug/export.go:123\nmsgid \"Cannot update %s, it's not a valid JSON file\"\nmsgstr \"Cannot update %s, it's not a valid JSON file\"\n\n#: commands/core/install.go:146\nmsgid \"Cannot upgrade platform\"\nmsgstr \"Cannot upgrade platform\"\n\n#: commands/debug/export.go:102\nmsgid \"Cannot write file %s\"\nmsgstr \"Cannot write file %s\"\n\n#: arduino/libraries/lint.go:156\nmsgid \"Category '%[1]s' in library %[2]s is not valid\"\nmsgstr \"Category '%[1]s' in library %[2]s is not valid\"\n\n#: cli/lib/search.go:170\nmsgid \"Category: %s\"\nmsgstr \"Category: %s\"\n\n#: cli/compile/size_report.go:128\n#: cli/compile/size_report.go:128\n#: cli/compile/size_report.go:142\n#: cli/compile/size_report.go:142\nmsgid \"Change\"\nmsgstr \"Change\"\n\n#: cli/compile/watch.go:82\nmsgid \"Changes detected in %s, building the sketch again...\"\nmsgstr \"Changes detected in %s, building the sketch again...\"\n\n#: cli/lib/check_deps.go:35\n#: cli/lib/check_deps.go:36\nmsgid \"Check dependencies status for the specified library.\"\nmsgstr \"Check dependencies status for the specified library.\"\n\n#: commands/lib/install.go:101\nmsgid \"Checking lib install prerequisites\"\nmsgstr \"Checking lib install prerequisites\"\n\n#: legacy/builder/builder_utils/utils.go:286\nmsgid \"Checking previous results for {0} (result = {1}, dep = {2})\"\nmsgstr \"Checking previous results for {0} (result = {1}, dep = {2})\"\n\n#: cli/lib/lint.go:37\nmsgid \"Checks the formal correctness of a library.\"\nmsgstr \"Checks the formal correctness of a library.\"\n\n#: cli/lib/lint.go:38\nmsgid \"Checks the formal correctness of the library in the given folder. The command fails if any error is found.\"\nmsgstr \"Checks the formal correctness of the library in the given folder. The command fails if any error is found.\"\n\n#: arduino/resources/checksums.go:182\nmsgid \"Checksum differs from checksum in package.json\"\nmsgstr \"Checksum differs from checksum in package.json\"\n\n#: commands/sketch/install_deps.go:315\nmsgid \"Checksum of library %s doesn't match the sketch lock file\"\nmsgstr \"Checksum of library %s doesn't match the sketch lock file\"\n\n#: commands/sketch/install_deps.go:293\nmsgid \"Checksum of platform %s doesn't match the sketch lock file\"\nmsgstr \"Checksum of platform %s doesn't match the sketch lock file\"\n\n#: cli/board/details.go:168\nmsgid \"Checksum:\"\nmsgstr \"Checksum:\"\n\n#: cli/cache/cache.go:33\nmsgid \"Clean caches.\"\nmsgstr \"Clean caches.\"\n\n#: cli/cli.go:117\nmsgid \"Comma-separated list of additional URLs for the Boards Manager.\"\nmsgstr \"Comma-separated list of additional URLs for the Boards Manager.\"\n\n#: cli/board/list.go:47\nmsgid \"Command keeps running and prints list of connected boards whenever there is a change.\"\nmsgstr \"Command keeps running and prints list of connected boards whenever there is a change.\"\n\n#: cli/cache/info.go:97\nmsgid \"Compilation cache:\"\nmsgstr \"Compilation cache:\"\n\n#: legacy/builder/builder_utils/compilation_cache.go:258\nmsgid \"Compilation of {0} can't be cached: {1}\"\nmsgstr \"Compilation of {0} can't be cached: {1}\"\n\n#: commands/debug/debug_info.go:103\n#: commands/upload/upload.go:378\nmsgid \"Compiled sketch not found in %s\"\nmsgstr \"Compiled sketch not found in %s\"\n\n#: cli/compile/compile.go:90\n#: cli/compile/compile.go:91\nmsgid \"Compiles Arduino sketches.\"\nmsgstr \"Compiles Arduino sketches.\"\n\n#: legacy/builder/builder.go:81\nmsgid \"Compiling core...\"\nmsgstr \"Compiling core...\"\n\n#: legacy/builder/builder.go:75\nmsgid \"Compiling libraries...\"\nmsgstr \"Compiling libraries...\"\n\n#: legacy/builder/phases/libraries_builder.go:135\nmsgid \"Compiling library \\\"{0}\\\"\"\nmsgstr \"Compiling library \\\"{0}\\\"\"\n\n#: legacy/builder/builder.go:70\nmsgid \"Compiling sketch...\"\nmsgstr \"Compiling sketch...\"\n\n#: cli/config/init.go:89\nmsgid \"Config file already exists, use --overwrite to discard the existing one.\"\nmsgstr \"Config file already exists, use --overwrite to discard the existing one.\"\n\n#: cli/config/init.go:109\nmsgid \"Config file written to: %s\"\nmsgstr \"Config file written to: %s\"\n\n#: cli/compile/compile.go:138\nmsgid \"Configuration of the monitor port, e.g.: %s\"\nmsgstr \"Configuration of the monitor port, e.g.: %s\"\n\n#: cli/monitor/monitor.go:63\nmsgid \"Configuration of the port, e.g.: %s\"\nmsgstr \"Configuration of the port, e.g.: %s\"\n\n#: cli/debug/debug.go:208\nmsgid \"Configuration options for %s\"\nmsgstr \"Configuration options for %s\"\n\n#: commands/instances.go:1052\nmsgid \"Configuring platform\"\nmsgstr \"Configuring platform\"\n\n#: commands/core/install.go:161\nmsgid \"Configuring platform.\"\nmsgstr \"Configuring platform.\"\n\n#: cli/board/list.go:198\nmsgid \"Connected\"\nmsgstr \"Connected\"\n\n#: cli/compile/watch.go:147\nmsgid \"Connected to %s!\"\nmsgstr \"Connected to %s!\"\n\n#: cli/monitor/monitor.go:149\nmsgid \"Connected to %s! Press CTRL-C to exit.\"\nmsgstr \"Connected to %s! Press CTRL-C to exit.\"\n\n#: cli/board/list.go:87\n#: cli/board/list.go:125\nmsgid \"Core\"\nmsgstr \"Core\"\n\n#: cli/update/update.go:105\nmsgid \"Core name\"\nmsgstr \"Core name\"\n\n#: commands/download.go:47\nmsgid \"Could not connect via HTTP\"\nmsgstr \"Could not connect via HTTP\"\n\n#: commands/instances.go:395\nmsgid \"Could not create index directory\"\nmsgstr \"Could not create index directory\"\n\n#: cli/sketch/new.go:58\nmsgid \"Could not create sketch directory: %v\"\nmsgstr \"Could not create sketch directory: %v\"\n\n#: legacy/builder/phases/sizer.go:214\nmsgid \"Couldn't compute the size report: {0}\"\nmsgstr \"Couldn't compute the size report: {0}\"\n\n#: legacy/builder/phases/sizer.go:164\nmsgid \"Couldn't compute the size report: {0} not found\"\nmsgstr \"Couldn't compute the size report: {0} not found\"\n\n#: legacy/builder/phases/core_builder.go:48\nmsgid \"Couldn't deeply cache core build: {0}\"\nmsgstr \"Couldn't deeply cache core build: {0}\"\n\n#: legacy/builder/phases/sizer.go:96\nmsgid \"Couldn't determine program size\"\nmsgstr \"Couldn't determine program size\"\n\n#: cli/arguments/sketch.go:36\n#: cli/lib/install.go:97\nmsgid \"Couldn't get current working directory: %v\"\nmsgstr \"Couldn't get current working directory: %v\"\n\n#: cli/sketch/new.go:32\n#: cli/sketch/new.go:33\nmsgid \"Create a new Sketch\"\nmsgstr \"Create a new Sketch\"\n\n#: cli/sketch/archive.go:39\n#: cli/sketch/archive.go:40\nmsgid \"Creates a zip file containing all sketch files.\"\nmsgstr \"Creates a zip file containing all sketch files.\"\n\n#: cli/config/init.go:42\nmsgid \"Creates or updates the configuration file in the data directory or custom directory with the current configuration settings.\"\nmsgstr \"Creates or updates the configuration file in the data directory or custom directory with the current configuration settings.\"\n\n#: cli/monitor/monitor.go:166\nmsgid \"Current\"\nmsgstr \"Current\"\n\n#: cli/core/list.go:88\n#: cli/core/search.go:118\nmsgid \"DEPRECATED\"\nmsgstr \"DEPRECATED\"\n\n#: arduino/monitors/serial.go:191\nmsgid \"DTR\"\nmsgstr \"DTR\"\n\n#: arduino/monitors/serial.go:173\nmsgid \"Data bits\"\nmsgstr \"Data bits\"\n\n#: cli/debug/debug.go:62\nmsgid \"Debug Arduino sketches.\"\nmsgstr \"Debug Arduino sketches.\"\n\n#: cli/debug/debug.go:63\nmsgid \"Debug Arduino sketches. (this command opens an interactive gdb session)\"\nmsgstr \"Debug Arduino sketches. (this command opens an interactive gdb session)\"\n\n#: cli/debug/debug.go:130\nmsgid \"Debug configuration written to %s\"\nmsgstr \"Debug configuration written to %s\"\n\n#: cli/debug/debug.go:72\nmsgid \"Debug interpreter e.g.: %s\"\nmsgstr \"Debug interpreter e.g.: %s\"\n\n#: commands/debug/debug_info.go:126\nmsgid \"Debugging not supported for board %s\"\nmsgstr \"Debugging not supported for board %s\"\n\n#: cli/board/details.go:124\nmsgid \"Debugging supported:\"\nmsgstr \"Debugging supported:\"\n\n#: cli/cache/clean.go:31\nmsgid \"Delete Boards/Library Manager download cache.\"\nmsgstr \"Delete Boards/Library Manager download cache.\"\n\n#: cli/cache/clean.go:32\nmsgid \"Delete contents of the `directories.downloads` folder, where archive files are staged during installation of libraries and boards platforms.\"\nmsgstr \"Delete contents of the `directories.downloads` folder, where archive files are staged during installation of libraries and boards platforms.\"\n\n#: cli/config/delete.go:32\n#: cli/config/delete.go:33\nmsgid \"Deletes a settings key and all its sub keys.\"\nmsgstr \"Deletes a settings key and all its sub keys.\"\n\n#: cli/lib/search.go:178\nmsgid \"Dependencies: %s\"\nmsgstr \"Dependencies: %s\"\n\n#: legacy/builder/builder_utils/utils.go:364\nmsgid \"Depfile is about different file: {0}\"\nmsgstr \"Depfile is about different file: {0}\"\n\n#: cli/lib/list.go:125\nmsgid \"Description\"\nmsgstr \"Description\"\n\n#: legacy/builder/builder.go:62\nmsgid \"Detecting libraries used...\"\nmsgstr \"Detecting libraries used...\"\n\n#: cli/board/list.go:38\nmsgid \"Detects and displays a list of boards connected to the current computer.\"\nmsgstr \"Detects and displays a list of boards connected to the current computer.\"\n\n#: cli/debug/debug.go:73\nmsgid \"Directory containing binaries for debug.\"\nmsgstr \"Directory containing binaries for debug.\"\n\n#: cli/upload/upload.go:61\nmsgid \"Directory containing binaries to upload.\"\nmsgstr \"Directory containing binaries to upload.\"\n\n#: cli/generatedocs/generatedocs.go:45\nmsgid \"Directory where to save generated files. Default is './docs', the directory must exist.\"\nmsgstr \"Directory where to save generated files. Default is './docs', the directory must exist.\"\n\n#: cli/completion/completion.go:44\nmsgid \"Disable completion description for shells that support it\"\nmsgstr \"Disable completion description for shells that support it\"\n\n#: cli/board/list.go:199\nmsgid \"Disconnected\"\nmsgstr \"Disconnected\"\n\n#: cli/daemon/daemon.go:60\nmsgid \"Display only the provided gRPC calls\"\nmsgstr \"Display only the provided gRPC calls\"\n\n#: cli/lib/install.go:49\nmsgid \"Do not install dependencies.\"\nmsgstr \"Do not install dependencies.\"\n\n#: cli/burnbootloader/burnbootloader.go:58\n#: cli/upload/upload.go:66\nmsgid \"Do not perform the actual upload, just log out actions\"\nmsgstr \"Do not perform the actual upload, just log out actions\"\n\n#: cli/daemon/daemon.go:58\nmsgid \"Do not terminate daemon process if the parent process dies\"\nmsgstr \"Do not terminate daemon process if the parent process dies\"\n\n#: cli/core/update_index.go:49\n#: cli/lib/update_index.go:71\n#: cli/update/update.go:49\nmsgid \"Download again only the indexes refreshed more than the given time ago, for example 30m or 24h.\"\nmsgstr \"Download again only the indexes refreshed more than the given time ago, for example 30m or 24h.\"\n\n#: commands/instances.go:903\n#: commands/instances.go:962\n#: commands/lib/download.go:57\nmsgid \"Downloading %s\"\nmsgstr \"Downloading %s\"\n\n#: commands/instances.go:128\nmsgid \"Downloading missing tool %s\"\nmsgstr \"Downloading missing tool %s\"\n\n#: commands/core/install.go:86\nmsgid \"Downloading packages\"\nmsgstr \"Downloading packages\"\n\n#: cli/core/download.go:37\n#: cli/core/download.go:38\nmsgid \"Downloads one or more cores and corresponding tool dependencies.\"\nmsgstr \"Downloads one or more cores and corresponding tool dependencies.\"\n\n#: cli/lib/download.go:35\n#: cli/lib/download.go:36\nmsgid \"Downloads one or more libraries without installing them.\"\nmsgstr \"Downloads one or more libraries without installing them.\"\n\n#: cli/daemon/daemon.go:59\nmsgid \"Enable debug logging of gRPC calls\"\nmsgstr \"Enable debug logging of gRPC calls\"\n\n#: cli/lib/install.go:51\nmsgid \"Enter a path to zip file\"\nmsgstr \"Enter a path to zip file\"\n\n#: cli/lib/install.go:50\nmsgid \"Enter git url for libraries hosted on repositories\"\nmsgstr \"Enter git url for libraries hosted on repositories\"\n\n#: cli/cache/info.go:98\nmsgid \"Entries:\"\nmsgstr \"Entries:\"\n\n#: commands/sketch/archive.go:105\nmsgid \"Error adding file to sketch archive\"\nmsgstr \"Error adding file to sketch archive\"\n\n#: legacy/builder/phases/core_builder.go:136\nmsgid \"Error archiving built core (caching) in {0}: {1}\"\nmsgstr \"Error archiving built core (caching) in {0}: {1}\"\n\n#: cli/sketch/archive.go:85\nmsgid \"Error archiving: %v\"\nmsgstr \"Error archiving: %v\"\n\n#: commands/sketch/archive.go:93\nmsgid \"Error calculating relative file path\"\nmsgstr \"Error calculating relative file path\"\n\n#: cli/lib/lint.go:56\nmsgid \"Error checking library: %v\"\nmsgstr \"Error checking library: %v\"\n\n#: commands/bundle/export.go:223\nmsgid \"Error checking the integrity of %s\"\nmsgstr \"Error checking the integrity of %s\"\n\n#: cli/cache/clean.go:46\nmsgid \"Error cleaning caches: %v\"\nmsgstr \"Error cleaning caches: %v\"\n\n#: commands/bundle/export.go:229\n#: commands/bundle/export.go:232\nmsgid \"Error copying %s in the bundle\"\nmsgstr \"Error copying %s in the bundle\"\n\n#: commands/compile/compile.go:405\nmsgid \"Error copying output file %s\"\nmsgstr \"Error copying output file %s\"\n\n#: commands/bundle/export.go:256\nmsgid \"Error copying the Arduino package index in the bundle\"\nmsgstr \"Error copying the Arduino package index in the bundle\"\n\n#: cli/bundle/import.go:76\n#: cli/core/search.go:66\n#: cli/core/update_index.go:72\n#: cli/instance/instance.go:46\n#: cli/lib/search.go:57\n#: cli/lib/update_index.go:48\n#: cli/update/update.go:67\nmsgid \"Error creating instance: %v\"\nmsgstr \"Error creating instance: %v\"\n\n#: commands/compile/compile.go:385\nmsgid \"Error creating output dir\"\nmsgstr \"Error creating output dir\"\n\n#: commands/sketch/archive.go:81\nmsgid \"Error creating sketch archive\"\nmsgstr \"Error creating sketch archive\"\n\n#: cli/sketch/new.go:54\n#: cli/sketch/new.go:64\nmsgid \"Error creating sketch: %v\"\nmsgstr \"Error creating sketch: %v\"\n\n#: cli/board/list.go:71\n#: cli/board/list.go:80\nmsgid \"Error detecting boards: %v\"\nmsgstr \"Error detecting boards: %v\"\n\n#: cli/core/download.go:68\n#: cli/lib/download.go:62\nmsgid \"Error downloading %[1]s: %[2]v\"\nmsgstr \"Error downloading %[1]s: %[2]v\"\n\n#: commands/bundle/export.go:189\nmsgid \"Error downloading %s\"\nmsgstr \"Error downloading %s\"\n\n#: commands/instances.go:610\n#: commands/instances.go:615\n#: commands/instances.go:628\n#: commands/instances.go:632\nmsgid \"Error downloading index '%s'\"\nmsgstr \"Error downloading index '%s'\"\n\n#: commands/instances.go:648\n#: commands/instances.go:654\nmsgid \"Error downloading index signature '%s'\"\nmsgstr \"Error downloading index signature '%s'\"\n\n#: commands/instances.go:905\n#: commands/instances.go:907\nmsgid \"Error downloading library\"\nmsgstr \"Error downloading library\"\n\n#: commands/instances.go:423\n#: commands/instances.go:430\nmsgid \"Error downloading library_index.json.gz\"\nmsgstr \"Error downloading library_index.json.gz\"\n\n#: commands/instances.go:437\n#: commands/instances.go:440\nmsgid \"Error downloading library_index.json.sig\"\nmsgstr \"Error downloading library_index.json.sig\"\n\n#: commands/core/download.go:65\n#: commands/core/download.go:71\n#: commands/instances.go:988\n#: commands/instances.go:990\nmsgid \"Error downloading platform %s\"\nmsgstr \"Error downloading platform %s\"\n\n#: commands/core/download.go:104\n#: commands/core/download.go:109\n#: commands/instances.go:981\n#: commands/instances.go:982\nmsgid \"Error downloading tool %s\"\nmsgstr \"Error downloading tool %s\"\n\n#: cli/debug/debug.go:96\n#: cli/debug/debug.go:101\n#: cli/debug/debug.go:140\n#: cli/debug/debug.go:148\n#: cli/debug/debug.go:159\nmsgid \"Error during Debug: %v\"\nmsgstr \"Error during Debug: %v\"\n\n#: cli/feedback/feedback.go:144\nmsgid \"Error during JSON encoding of the output: %v\"\nmsgstr \"Error during JSON encoding of the output: %v\"\n\n#: cli/burnbootloader/burnbootloader.go:70\n#: cli/burnbootloader/burnbootloader.go:83\n#: cli/compile/compile.go:334\n#: cli/compile/watch.go:99\n#: cli/upload/upload.go:97\n#: cli/upload/upload.go:103\n#: cli/upload/upload.go:121\n#: cli/upload/upload.go:149\nmsgid \"Error during Upload: %v\"\nmsgstr \"Error during Upload: %v\"\n\n#: cli/compile/compile.go:347\n#: cli/compile/matrix.go:55\n#: cli/compile/matrix.go:86\n#: cli/compile/watch.go:94\nmsgid \"Error during build: %v\"\nmsgstr \"Error during build: %v\"\n\n#: cli/core/install.go:106\nmsgid \"Error during install: %v\"\nmsgstr \"Error during install: %v\"\n\n#: cli/core/uninstall.go:68\nmsgid \"Error during uninstall: %v\"\nmsgstr \"Error during uninstall: %v\"\n\n#: cli/core/upgrade.go:105\nmsgid \"Error during upgrade: %v\"\nmsgstr \"Error during upgrade: %v\"\n\n#: cli/bundle/export.go:89\nmsgid \"Error exporting bundle: %v\"\nmsgstr \"Error exporting bundle: %v\"\n\n#: cli/debug/debug.go:127\nmsgid \"Error exporting debug configuration: %v\"\nmsgstr \"Error exporting debug configuration: %v\"\n\n#: commands/bundle/import.go:64\nmsgid \"Error extracting bundle %s\"\nmsgstr \"Error extracting bundle %s\"\n\n#: commands/instances.go:446\nmsgid \"Error extracting library_index.json.gz\"\nmsgstr \"Error extracting library_index.json.gz\"\n\n#: commands/upload/upload.go:375\nmsgid \"Error finding build artifacts\"\nmsgstr \"Error finding build artifacts\"\n\n#: cli/debug/debug.go:117\nmsgid \"Error getting Debug info: %v\"\nmsgstr \"Error getting Debug info: %v\"\n\n#: commands/sketch/archive.go:59\nmsgid \"Error getting absolute path of sketch archive\"\nmsgstr \"Error getting absolute path of sketch archive\"\n\n#: cli/board/details.go:71\nmsgid \"Error getting board details: %v\"\nmsgstr \"Error getting board details: %v\"\n\n#: commands/board/list.go:218\nmsgid \"Error getting board info from Arduino Cloud\"\nmsgstr \"Error getting board info from Arduino Cloud\"\n\n#: commands/board/list.go:283\nmsgid \"Error getting board list\"\nmsgstr \"Error getting board list\"\n\n#: arduino/builder/compilation_database.go:78\nmsgid \"Error getting current directory for compilation database: %s\"\nmsgstr \"Error getting current directory for compilation database: %s\"\n\n#: commands/compile/compile.go:414\n#: commands/lib/list.go:106\nmsgid \"Error getting information for library %s\"\nmsgstr \"Error getting information for library %s\"\n\n#: cli/lib/examples.go:69\nmsgid \"Error getting libraries info: %v\"\nmsgstr \"Error getting libraries info: %v\"\n\n#: cli/monitor/monitor.go:74\nmsgid \"Error getting port metadata: %v\"\nmsgstr \"Error getting port metadata: %v\"\n\n#: cli/monitor/monitor.go:85\nmsgid \"Error getting port settings details: %v\"\nmsgstr \"Error getting port settings details: %v\"\n\n#: cli/bundle/import.go:55\nmsgid \"Error importing bundle: %v\"\nmsgstr \"Error importing bundle: %v\"\n\n#: legacy/builder/types/context.go:262\nmsgid \"Error in FQBN: %s\"\nmsgstr \"Error in FQBN: %s\"\n\n#: cli/core/search.go:81\n#: cli/instance/instance.go:50\n#: cli/lib/search.go:71\n#: cli/update/update.go:93\nmsgid \"Error initializing instance: %v\"\nmsgstr \"Error initializing instance: %v\"\n\n#: cli/lib/install.go:130\nmsgid \"Error installing %s: %v\"\nmsgstr \"Error installing %s: %v\"\n\n#: cli/lib/install.go:108\nmsgid \"Error installing Git Library: %v\"\nmsgstr \"Error installing Git Library: %v\"\n\n#: cli/lib/install.go:85\nmsgid \"Error installing Zip Library: %v\"\nmsgstr \"Error installing Zip Library: %v\"\n\n#: commands/instances.go:1009\nmsgid \"Error installing platform %s\"\nmsgstr \"Error installing platform %s\"\n\n#: cli/sketch/install_deps.go:79\nmsgid \"Error installing sketch dependencies: %v\"\nmsgstr \"Error installing sketch dependencies: %v\"\n\n#: commands/compile/test.go:98\nmsgid \"Error installing the host platform\"\nmsgstr \"Error installing the host platform\"\n\n#: commands/instances.go:999\nmsgid \"Error installing tool %s\"\nmsgstr \"Error installing tool %s\"\n\n#: cli/lib/list.go:77\nmsgid \"Error listing Libraries: %v\"\nmsgstr \"Error listing Libraries: %v\"\n\n#: cli/board/listall.go:61\nmsgid \"Error listing boards: %v\"\nmsgstr \"Error listing boards: %v\"\n\n#: cli/core/update_index.go:100\nmsgid \"Error listing package indexes: %v\"\nmsgstr \"Error listing package indexes: %v\"\n\n#: cli/core/list.go:61\nmsgid \"Error listing platforms: %v\"\nmsgstr \"Error listing platforms: %v\"\n\n#: commands/compile/test.go:130\nmsgid \"Error listing the tests\"\nmsgstr \"Error listing the tests\"\n\n#: cli/compile/compile.go:221\nmsgid \"Error loading build matrix: %v\"\nmsgstr \"Error loading build matrix: %v\"\n\n#: commands/errors.go:494\nmsgid \"Error loading sketch environment\"\nmsgstr \"Error loading sketch environment\"\n\n#: commands/compile/test.go:175\nmsgid \"Error loading the host platform\"\nmsgstr \"Error loading the host platform\"\n\n#: cli/compile/watch.go:102\n#: cli/monitor/monitor.go:112\nmsgid \"Error opening monitor: %v\"\nmsgstr \"Error opening monitor: %v\"\n\n#: cli/compile/compile.go:269\nmsgid \"Error opening source code overrides data file: %v\"\nmsgstr \"Error opening source code overrides data file: %v\"\n\n#: cli/cache/prune.go:69\nmsgid \"Error pruning compilation cache: %v\"\nmsgstr \"Error pruning compilation cache: %v\"\n\n#: commands/compile/compile.go:395\nmsgid \"Error reading build directory\"\nmsgstr \"Error reading build directory\"\n\n#: cli/cache/info.go:55\nmsgid \"Error reading compilation cache: %v\"\nmsgstr \"Error reading compilation cache: %v\"\n\n#: legacy/builder/builder_utils/compilation_cache.go:267\nmsgid \"Error reading compilation cache: {0}\"\nmsgstr \"Error reading compilation cache: {0}\"\n\n#: configuration/configuration.go:69\nmsgid \"Error reading config file: %v\"\nmsgstr \"Error reading config file: %v\"\n\n#: cli/compile/compile.go:237\nmsgid \"Error reading size report: %v\"\nmsgstr \"Error reading size report: %v\"\n\n#: commands/sketch/archive.go:75\nmsgid \"Error reading sketch files\"\nmsgstr \"Error reading sketch files\"\n\n#: legacy/builder/target_board_resolver.go:33\nmsgid \"Error resolving FQBN: {0}\"\nmsgstr \"Error resolving FQBN: {0}\"\n\n#: cli/lib/check_deps.go:60\nmsgid \"Error resolving dependencies for %[1]s: %[2]s\"\nmsgstr \"Error resolving dependencies for %[1]s: %[2]s\"\n\n#: cli/core/upgrade.go:63\nmsgid \"Error retrieving core list: %v\"\nmsgstr \"Error retrieving core list: %v\"\n\n#: cli/outdated/outdated.go:57\n#: cli/update/update.go:100\nmsgid \"Error retrieving outdated cores and libraries: %v\"\nmsgstr \"Error retrieving outdated cores and libraries: %v\"\n\n#: commands/instances.go:1025\nmsgid \"Error rolling-back changes\"\nmsgstr \"Error rolling-back changes\"\n\n#: commands/core/install.go:143\nmsgid \"Error rolling-back changes: %s\"\nmsgstr \"Error rolling-back changes: %s\"\n\n#: cli/test/test.go:158\nmsgid \"Error running tests: %v\"\nmsgstr \"Error running tests: %v\"\n\n#: cli/test/test.go:148\nmsgid \"Error saving JUnit report: %v\"\nmsgstr \"Error saving JUnit report: %v\"\n\n#: commands/instances.go:565\nmsgid \"Error saving boards database\"\nmsgstr \"Error saving boards database\"\n\n#: commands/instances.go:621\n#: commands/instances.go:671\n#: commands/instances.go:687\nmsgid \"Error saving downloaded index %s\"\nmsgstr \"Error saving downloaded index %s\"\n\n#: commands/instances.go:675\nmsgid \"Error saving downloaded index signature\"\nmsgstr \"Error saving downloaded index signature\"\n\n#: cli/compile/compile.go:363\nmsgid \"Error saving size report: %v\"\nmsgstr \"Error saving size report: %v\"\n\n#: commands/sketch/install_deps.go:127\nmsgid \"Error saving sketch lock file\"\nmsgstr \"Error saving sketch lock file\"\n\n#: commands/bundle/import.go:129\nmsgid \"Error saving the Arduino package index of the bundle\"\nmsgstr \"Error saving the Arduino package index of the bundle\"\n\n#: cli/board/search.go:63\nmsgid \"Error searching boards: %v\"\nmsgstr \"Error searching boards: %v\"\n\n#: cli/lib/search.go:80\nmsgid \"Error searching for Library: %v\"\nmsgstr \"Error searching for Library: %v\"\n\n#: cli/core/search.go:93\nmsgid \"Error searching for platforms: %v\"\nmsgstr \"Error searching for platforms: %v\"\n\n#: arduino/builder/compilation_database.go:63\nmsgid \"Error serializing compilation database: %s\"\nmsgstr \"Error serializing compilation database: %s\"\n\n#: commands/board/list.go:268\n#: commands/board/list.go:271\n#: commands/board/list.go:313\nmsgid \"Error starting board discoveries\"\nmsgstr \"Error starting board discoveries\"\n\n#: cli/lib/uninstall.go:62\nmsgid \"Error uninstalling %[1]s: %[2]v\"\nmsgstr \"Error uninstalling %[1]s: %[2]v\"\n\n#: commands/core/uninstall.go:80\nmsgid \"Error uninstalling platform %s\"\nmsgstr \"Error uninstalling platform %s\"\n\n#: commands/core/uninstall.go:96\n#: commands/instances.go:1041\nmsgid \"Error uninstalling tool %s\"\nmsgstr \"Error uninstalling tool %s\"\n\n#: cli/update/update.go:85\nmsgid \"Error updating core and libraries index: %v\"\nmsgstr \"Error updating core and libraries index: %v\"\n\n#: cli/core/search.go:75\n#: cli/core/update_index.go:90\nmsgid \"Error updating index: %v\"\nmsgstr \"Error updating index: %v\"\n\n#: cli/core/update_index.go:81\n#: cli/lib/update_index.go:57\n#: cli/update/update.go:76\nmsgid \"Error updating indexes: %v\"\nmsgstr \"Error updating indexes: %v\"\n\n#: cli/bundle/import.go:83\n#: cli/lib/search.go:66\n#: cli/lib/update_index.go:66\nmsgid \"Error updating library index: %v\"\nmsgstr \"Error updating library index: %v\"\n\n#: cli/lib/upgrade.go:50\n#: cli/lib/upgrade.go:56\nmsgid \"Error upgrading libraries: %v\"\nmsgstr \"Error upgrading libraries: %v\"\n\n#: commands/core/install.go:138\n#: commands/instances.go:1020\nmsgid \"Error upgrading platform: %s\"\nmsgstr \"Error upgrading platform: %s\"\n\n#: cli/upgrade/upgrade.go:60\nmsgid \"Error upgrading: %v\"\nmsgstr \"Error upgrading: %v\"\n\n#: commands/instances.go:451\nmsgid \"Error verifying signature\"\nmsgstr \"Error verifying signature\"\n\n#: commands/compile/watch.go:74\n#: commands/compile/watch.go:89\n#: commands/compile/watch.go:98\nmsgid \"Error watching sketch\"\nmsgstr \"Error watching sketch\"\n\n#: cli/compile/watch.go:114\nmsgid \"Error watching sketch: %v\"\nmsgstr \"Error watching sketch: %v\"\n\n#: legacy/builder/container_find_includes.go:458\nmsgid \"Error while detecting libraries included by {0}\"\nmsgstr \"Error while detecting libraries included by {0}\"\n\n#: legacy/builder/phases/sizer.go:229\n#: legacy/builder/phases/sizer.go:235\nmsgid \"Error while determining sketch size: %s\"\nmsgstr \"Error while determining sketch size: %s\"\n\n#: legacy/builder/builder_utils/compilation_cache.go:294\nmsgid \"Error writing compilation cache: {0}\"\nmsgstr \"Error writing compilation cache: {0}\"\n\n#: arduino/builder/compilation_database.go:66\nmsgid \"Error writing compilation database: %s\"\nmsgstr \"Error writing compilation database: %s\"\n\n#: commands/instances.go:460\n#: commands/instances.go:466\n#: commands/instances.go:496\nmsgid \"Error writing library_index.json\"\nmsgstr \"Error writing library_index.json\"\n\n#: commands/instances.go:463\nmsgid \"Error writing library_index.json.sig\"\nmsgstr \"Error writing library_index.json.sig\"\n\n#: commands/bundle/export.go:261\nmsgid \"Error writing the bundle\"\nmsgstr \"Error writing the bundle\"\n\n#: commands/bundle/export.go:253\n#: commands/bundle/import.go:125\nmsgid \"Error writing the library index of the bundle\"\nmsgstr \"Error writing the library index of the bundle\"\n\n#: commands/bundle/export.go:247\n#: commands/bundle/export.go:250\n#: commands/bundle/import.go:104\n#: commands/bundle/import.go:107\nmsgid \"Error writing the package index of the bundle\"\nmsgstr \"Error writing the package index of the bundle\"\n\n#: cli/completion/completion.go:51\nmsgid \"Error: command description is not supported by %v\"\nmsgstr \"Error: command description is not supported by %v\"\n\n#: cli/compile/compile.go:242\nmsgid \"Error: invalid size report %[1]s: %[2]v\"\nmsgstr \"Error: invalid size report %[1]s: %[2]v\"\n\n#: cli/compile/compile.go:276\nmsgid \"Error: invalid source code overrides data file: %v\"\nmsgstr \"Error: invalid source code overrides data file: %v\"\n\n#: cli/board/list.go:87\nmsgid \"Event\"\nmsgstr \"Event\"\n\n#: cli/lib/examples.go:118\nmsgid \"Examples for library %s\"\nmsgstr \"Examples for library %s\"\n\n#: cli/usage.go:28\nmsgid \"Examples:\"\nmsgstr \"Examples:\"\n\n#: cli/debug/debug.go:189\nmsgid \"Executable to debug\"\nmsgstr \"Executable to debug\"\n\n#: commands/debug/debug_info.go:106\n#: commands/upload/upload.go:381\nmsgid \"Expected compiled sketch in directory %s, but is a file instead\"\nmsgstr \"Expected compiled sketch in directory %s, but is a file instead\"\n\n#: cli/bundle/bundle.go:33\nmsgid \"Export the platform of a board and a library.\"\nmsgstr \"Export the platform of a board and a library.\"\n\n#: cli/bundle/export.go:46\nmsgid \"Exports a bundle to install platforms and libraries offline.\"\nmsgstr \"Exports a bundle to install platforms and libraries offline.\"\n\n#: cli/bundle/bundle.go:32\nmsgid \"Exports and imports bundles of platforms, tools and libraries to install them on machines without network access.\"\nmsgstr \"Exports and imports bundles of platforms, tools and libraries to install them on machines without network access.\"\n\n#: cli/bundle/export.go:47\nmsgid \"Exports in the OUTPUT folder, or in a tarball if OUTPUT ends with .tar.gz, a bundle containing the platforms of the given boards, with the tools they need for the given hosts, and the given libraries with their dependencies, together with the indexes referencing them. The latest versions of the platforms are exported, the libraries are exported at the given version or at the latest one.\"\nmsgstr \"Exports in the OUTPUT folder, or in a tarball if OUTPUT ends with .tar.gz, a bundle containing the platforms of the given boards, with the tools they need for the given hosts, and the given libraries with their dependencies, together with the indexes referencing them. The latest versions of the platforms are exported, the libraries are exported at the given version or at the latest one.\"\n\n#: cli/compile/matrix.go:132\nmsgid \"FAILED\"\nmsgstr \"FAILED\"\n\n#: cli/board/attach.go:35\n#: cli/board/details.go:41\n#: cli/board/list.go:87\n#: cli/board/list.go:125\n#: cli/board/listall.go:84\n#: cli/board/search.go:86\n#: cli/compile/matrix.go:128\nmsgid \"FQBN\"\nmsgstr \"FQBN\"\n\n#: cli/board/details.go:121\nmsgid \"FQBN:\"\nmsgstr \"FQBN:\"\n\n#: commands/upload/upload.go:470\nmsgid \"Failed chip erase\"\nmsgstr \"Failed chip erase\"\n\n#: commands/upload/upload.go:477\nmsgid \"Failed programming\"\nmsgstr \"Failed programming\"\n\n#: commands/upload/upload.go:473\nmsgid \"Failed to burn bootloader\"\nmsgstr \"Failed to burn bootloader\"\n\n#: commands/instances.go:158\nmsgid \"Failed to create data directory\"\nmsgstr \"Failed to create data directory\"\n\n#: commands/instances.go:148\nmsgid \"Failed to create downloads directory\"\nmsgstr \"Failed to create downloads directory\"\n\n#: cli/daemon/daemon.go:124\nmsgid \"Failed to listen on TCP port: %[1]s. %[2]s is an invalid port.\"\nmsgstr \"Failed to listen on TCP port: %[1]s. %[2]s is an invalid port.\"\n\n#: cli/daemon/daemon.go:118\nmsgid \"Failed to listen on TCP port: %[1]s. %[2]s is unknown name.\"\nmsgstr \"Failed to listen on TCP port: %[1]s. %[2]s is unknown name.\"\n\n#: cli/daemon/daemon.go:133\nmsgid \"Failed to listen on TCP port: %[1]s. Unexpected error: %[2]v\"\nmsgstr \"Failed to listen on TCP port: %[1]s. Unexpected error: %[2]v\"\n\n#: cli/daemon/daemon.go:130\nmsgid \"Failed to listen on TCP port: %s. Address already in use.\"\nmsgstr \"Failed to listen on TCP port: %s. Address already in use.\"\n\n#: legacy/builder/builder_utils/utils.go:386\nmsgid \"Failed to read: {0}\"\nmsgstr \"Failed to read: {0}\"\n\n#: commands/upload/upload.go:481\nmsgid \"Failed uploading\"\nmsgstr \"Failed uploading\"\n\n#: cli/board/details.go:166\nmsgid \"File:\"\nmsgstr \"File:\"\n\n#: commands/daemon/debug.go:47\nmsgid \"First message must contain debug request, not data\"\nmsgstr \"First message must contain debug request, not data\"\n\n#: cli/usage.go:30\nmsgid \"Flags:\"\nmsgstr \"Flags:\"\n\n#: cli/compile/matrix.go:128\n#: cli/compile/size_report.go:63\n#: cli/compile/size_report.go:67\n#: cli/compile/size_report.go:75\n#: cli/compile/size_report.go:84\n#: cli/compile/size_report.go:93\n#: cli/compile/size_report.go:123\n#: cli/compile/size_report.go:128\n#: cli/compile/size_report.go:142\nmsgid \"Flash\"\nmsgstr \"Flash\"\n\n#: cli/core/install.go:59\nmsgid \"Force run of post-install scripts (if the CLI is not running interactively).\"\nmsgstr \"Force run of post-install scripts (if the CLI is not running interactively).\"\n\n#: cli/core/install.go:60\nmsgid \"Force skip of post-install scripts (if the CLI is running interactively).\"\nmsgstr \"Force skip of post-install scripts (if the CLI is running interactively).\"\n\n#: cli/bundle/export.go:56\nmsgid \"Fully Qualified Board Name of a board whose platform is exported, can be used multiple times.\"\nmsgstr \"Fully Qualified Board Name of a board whose platform is exported, can be used multiple times.\"\n\n#: cli/board/details.go:50\n#: cli/burnbootloader/burnbootloader.go:53\n#: cli/debug/debug.go:69\n#: cli/monitor/monitor.go:61\n#: cli/upload/upload.go:58\nmsgid \"Fully Qualified Board Name, e.g.: arduino:avr:uno\"\nmsgstr \"Fully Qualified Board Name, e.g.: arduino:avr:uno\"\n\n#: cli/compile/compile.go:102\nmsgid \"Fully Qualified Board Name, e.g.: arduino:avr:uno. Can be used multiple times to build the sketch for more boards.\"\nmsgstr \"Fully Qualified Board Name, e.g.: arduino:avr:uno. Can be used multiple times to build the sketch for more boards.\"\n\n#: cli/debug/debug.go:203\nmsgid \"GDB Server path\"\nmsgstr \"GDB Server path\"\n\n#: cli/debug/debug.go:202\nmsgid \"GDB Server type\"\nmsgstr \"GDB Server type\"\n\n#: commands/debug/gdb_servers.go:74\nmsgid \"GDB server '%[1]s' is not supported, the supported servers are: %[2]s\"\nmsgstr \"GDB server '%[1]s' is not supported, the supported servers are: %[2]s\"\n\n#: commands/debug/gdb_servers.go:268\nmsgid \"GDB server is not accepting connections on %s\"\nmsgstr \"GDB server is not accepting connections on %s\"\n\n#: commands/debug/gdb_servers.go:272\nmsgid \"GDB server terminated before accepting connections\"\nmsgstr \"GDB server terminated before accepting connections\"\n\n#: cli/generatedocs/generatedocs.go:38\n#: cli/generatedocs/generatedocs.go:39\nmsgid \"Generates bash completion and command manpages.\"\nmsgstr \"Generates bash completion and command manpages.\"\n\n#: cli/completion/completion.go:38\nmsgid \"Generates completion scripts\"\nmsgstr \"Generates completion scripts\"\n\n#: cli/completion/completion.go:39\nmsgid \"Generates completion scripts for various shells\"\nmsgstr \"Generates completion scripts for various shells\"\n\n#: legacy/builder/builder.go:67\nmsgid \"Generating function prototypes...\"\nmsgstr \"Generating function prototypes...\"\n\n#: cli/usage.go:31\nmsgid \"Global Flags:\"\nmsgstr \"Global Flags:\"\n\n#: legacy/builder/phases/sizer.go:108\nmsgid \"Global variables use {0} bytes ({2}%%) of dynamic memory, leaving {3} bytes for local variables. Maximum is {1} bytes.\"\nmsgstr \"Global variables use {0} bytes ({2}%%) of dynamic memory, leaving {3} bytes for local variables. Maximum is {1} bytes.\"\n\n#: legacy/builder/phases/sizer.go:115\nmsgid \"Global variables use {0} bytes of dynamic memory.\"\nmsgstr \"Global variables use {0} bytes of dynamic memory.\"\n\n#: arduino/libraries/lint.go:200\nmsgid \"Header %[1]s declared in 'includes' not found in library %[2]s\"\nmsgstr \"Header %[1]s declared in 'includes' not found in library %[2]s\"\n\n#: cli/bundle/export.go:117\nmsgid \"Hosts:\"\nmsgstr \"Hosts:\"\n\n#: cli/core/list.go:84\n#: cli/core/search.go:114\n#: cli/monitor/monitor.go:166\n#: cli/outdated/outdated.go:62\nmsgid \"ID\"\nmsgstr \"ID\"\n\n#: cli/board/details.go:93\n#: cli/board/details.go:194\nmsgid \"Id\"\nmsgstr \"Id\"\n\n#: cli/board/details.go:135\nmsgid \"Identification properties:\"\nmsgstr \"Identification properties:\"\n\n#: cli/compile/compile.go:150\nmsgid \"If set built binaries will be exported to the sketch folder.\"\nmsgstr \"If set built binaries will be exported to the sketch folder.\"\n\n#: cli/core/list.go:42\nmsgid \"If set return all installable and installed cores, including manually installed.\"\nmsgstr \"If set return all installable and installed cores, including manually installed.\"\n\n#: cli/bundle/bundle.go:35\nmsgid \"Import the bundle on a machine without network access.\"\nmsgstr \"Import the bundle on a machine without network access.\"\n\n#: cli/bundle/import.go:38\nmsgid \"Imports a bundle to install platforms and libraries offline.\"\nmsgstr \"Imports a bundle to install platforms and libraries offline.\"\n\n#: cli/bundle/import.go:39\nmsgid \"Imports a bundle, a folder or a tarball, exported with the bundle export command. The package index of the bundle is added to the board_manager.additional_urls setting and its library index is set as the library.index_url setting, so that the platforms and the libraries of the bundle can be installed with core install and lib install without network access.\"\nmsgstr \"Imports a bundle, a folder or a tarball, exported with the bundle export command. The package index of the bundle is added to the board_manager.additional_urls setting and its library index is set as the library.index_url setting, so that the platforms and the libraries of the bundle can be installed with core install and lib install without network access.\"\n\n#: cli/lib/list.go:48\nmsgid \"Include built-in libraries (from platforms and IDE) in listing.\"\nmsgstr \"Include built-in libraries (from platforms and IDE) in listing.\"\n\n#: cli/sketch/archive.go:51\nmsgid \"Includes %s directory in the archive.\"\nmsgstr \"Includes %s directory in the archive.\"\n\n#: cli/board/list.go:176\nmsgid \"Install the platform %[1]s to use the board %[2]s: %[3]s\"\nmsgstr \"Install the platform %[1]s to use the board %[2]s: %[3]s\"\n\n#: cli/core/list.go:84\n#: cli/lib/list.go:125\nmsgid \"Installed\"\nmsgstr \"Installed\"\n\n#: commands/instances.go:928\n#: commands/lib/install.go:112\nmsgid \"Installed %s\"\nmsgstr \"Installed %s\"\n\n#: cli/outdated/outdated.go:62\n#: cli/outdated/outdated.go:72\n#: cli/update/update.go:105\n#: cli/update/update.go:115\nmsgid \"Installed version\"\nmsgstr \"Installed version\"\n\n#: commands/bundled_tools.go:47\n#: commands/instances.go:911\n#: commands/lib/install.go:92\nmsgid \"Installing %s\"\nmsgstr \"Installing %s\"\n\n#: commands/core/install.go:104\nmsgid \"Installing platform %s\"\nmsgstr \"Installing platform %s\"\n\n#: cli/core/install.go:38\n#: cli/core/install.go:39\nmsgid \"Installs one or more cores and corresponding tool dependencies.\"\nmsgstr \"Installs one or more cores and corresponding tool dependencies.\"\n\n#: cli/lib/install.go:39\n#: cli/lib/install.go:40\nmsgid \"Installs one or more specified libraries into the system.\"\nmsgstr \"Installs one or more specified libraries into the system.\"\n\n#: cli/sketch/install_deps.go:43\nmsgid \"Installs the platforms and libraries declared in the %[1]s file of a sketch. The exact releases recorded in the %[2]s file are installed, if the file is missing or outdated the dependencies are resolved again and the file is updated.\"\nmsgstr \"Installs the platforms and libraries declared in the %[1]s file of a sketch. The exact releases recorded in the %[2]s file are installed, if the file is missing or outdated the dependencies are resolved again and the file is updated.\"\n\n#: cli/sketch/install_deps.go:42\nmsgid \"Installs the platforms and libraries required by a sketch.\"\nmsgstr \"Installs the platforms and libraries required by a sketch.\"\n\n#: legacy/builder/container_find_includes.go:485\nmsgid \"Internal error in cache\"\nmsgstr \"Internal error in cache\"\n\n#: commands/errors.go:231\nmsgid \"Invalid '%[1]s' property: %[2]s\"\nmsgstr \"Invalid '%[1]s' property: %[2]s\"\n\n#: cli/cli.go:258\nmsgid \"Invalid Call : should show Help, but it is available only in TEXT mode.\"\nmsgstr \"Invalid Call : should show Help, but it is available only in TEXT mode.\"\n\n#: commands/board/attach.go:65\nmsgid \"Invalid Device URL format\"\nmsgstr \"Invalid Device URL format\"\n\n#: commands/errors.go:58\nmsgid \"Invalid FQBN\"\nmsgstr \"Invalid FQBN\"\n\n#: commands/errors.go:76\nmsgid \"Invalid URL\"\nmsgstr \"Invalid URL\"\n\n#: cli/core/trust.go:63\nmsgid \"Invalid URL: %v\"\nmsgstr \"Invalid URL: %v\"\n\n#: commands/instances.go:227\nmsgid \"Invalid additional URL: %v\"\nmsgstr \"Invalid additional URL: %v\"\n\n#: cli/core/download.go:55\n#: cli/core/install.go:92\n#: cli/core/uninstall.go:51\n#: cli/core/upgrade.go:81\n#: cli/lib/download.go:50\n#: cli/lib/uninstall.go:51\nmsgid \"Invalid argument passed: %v\"\nmsgstr \"Invalid argument passed: %v\"\n\n#: commands/compile/compile.go:234\nmsgid \"Invalid build_cache.max_size setting\"\nmsgstr \"Invalid build_cache.max_size setting\"\n\n#: cli/cache/info.go:49\nmsgid \"Invalid build_cache.max_size setting: %v\"\nmsgstr \"Invalid build_cache.max_size setting: %v\"\n\n#: commands/compile/compile.go:241\nmsgid \"Invalid build_cache.remote.url setting\"\nmsgstr \"Invalid build_cache.remote.url setting\"\n\n#: commands/bundle/import.go:53\nmsgid \"Invalid bundle %s\"\nmsgstr \"Invalid bundle %s\"\n\n#: commands/bundle/import.go:61\nmsgid \"Invalid bundle %s: it must be a folder or a .tar.gz tarball\"\nmsgstr \"Invalid bundle %s: it must be a folder or a .tar.gz tarball\"\n\n#: commands/bundle/import.go:71\nmsgid \"Invalid bundle %s: the indexes are missing\"\nmsgstr \"Invalid bundle %s: the indexes are missing\"\n\n#: cli/cache/prune.go:59\nmsgid \"Invalid cache size: %v\"\nmsgstr \"Invalid cache size: %v\"\n\n#: legacy/builder/phases/sizer.go:254\nmsgid \"Invalid data size regexp: %s\"\nmsgstr \"Invalid data size regexp: %s\"\n\n#: commands/board/attach.go:75\nmsgid \"Invalid device port type provided\"\nmsgstr \"Invalid device port type provided\"\n\n#: legacy/builder/phases/sizer.go:260\nmsgid \"Invalid eeprom size regexp: %s\"\nmsgstr \"Invalid eeprom size regexp: %s\"\n\n#: commands/debug/export.go:54\nmsgid \"Invalid export format '%[1]s', supported formats are: %[2]s\"\nmsgstr \"Invalid export format '%[1]s', supported formats are: %[2]s\"\n\n#: commands/debug/dap.go:613\nmsgid \"Invalid frame\"\nmsgstr \"Invalid frame\"\n\n#: commands/bundle/export.go:76\nmsgid \"Invalid host %s, it must be in the os/arch form\"\nmsgstr \"Invalid host %s, it must be in the os/arch form\"\n\n#: commands/errors.go:44\nmsgid \"Invalid instance\"\nmsgstr \"Invalid instance\"\n\n#: cli/core/upgrade.go:87\nmsgid \"Invalid item %s\"\nmsgstr \"Invalid item %s\"\n\n#: cli/core/trust.go:68\n#: cli/core/trust.go:73\n#: cli/core/trust.go:133\nmsgid \"Invalid key file: %v\"\nmsgstr \"Invalid key file: %v\"\n\n#: commands/errors.go:94\nmsgid \"Invalid library\"\nmsgstr \"Invalid library\"\n\n#: commands/bundle/import.go:112\n#: commands/bundle/import.go:118\n#: commands/instances.go:484\nmsgid \"Invalid library index in %s\"\nmsgstr \"Invalid library index in %s\"\n\n#: commands/compile/compile.go:186\nmsgid \"Invalid library override\"\nmsgstr \"Invalid library override\"\n\n#: cli/compile/compile.go:259\nmsgid \"Invalid library override '%[1]s': %[2]v\"\nmsgstr \"Invalid library override '%[1]s': %[2]v\"\n\n#: commands/lib/lint.go:38\nmsgid \"Invalid library path: %s\"\nmsgstr \"Invalid library path: %s\"\n\n#: commands/instances.go:480\nmsgid \"Invalid library.index_url %s: only local file URLs are supported\"\nmsgstr \"Invalid library.index_url %s: only local file URLs are supported\"\n\n#: commands/monitor/monitor.go:102\n#: commands/monitor/monitor.go:127\nmsgid \"Invalid monitor configuration\"\nmsgstr \"Invalid monitor configuration\"\n\n#: httpclient/httpclient_config.go:44\nmsgid \"Invalid network.proxy '%[1]s': %[2]s\"\nmsgstr \"Invalid network.proxy '%[1]s': %[2]s\"\n\n#: cli/cli.go:219\nmsgid \"Invalid option for --log-level: %s\"\nmsgstr \"Invalid option for --log-level: %s\"\n\n#: cli/cli.go:236\nmsgid \"Invalid output format: %s\"\nmsgstr \"Invalid output format: %s\"\n\n#: commands/bundle/import.go:87\n#: commands/bundle/import.go:92\n#: commands/bundle/import.go:98\n#: commands/instances.go:582\n#: commands/instances.go:664\nmsgid \"Invalid package index in %s\"\nmsgstr \"Invalid package index in %s\"\n\n#: cli/core/uninstall.go:57\nmsgid \"Invalid parameter %s: version not allowed\"\nmsgstr \"Invalid parameter %s: version not allowed\"\n\n#: commands/board/list.go:58\nmsgid \"Invalid pid value: '%s'\"\nmsgstr \"Invalid pid value: '%s'\"\n\n#: cli/compile/watch.go:46\n#: cli/monitor/monitor.go:96\nmsgid \"Invalid port configuration %s, expected a %s value\"\nmsgstr \"Invalid port configuration %s, expected a %s value\"\n\n#: commands/debug/gdb_servers.go:106\nmsgid \"Invalid property '%s'\"\nmsgstr \"Invalid property '%s'\"\n\n#: commands/compile/compile.go:301\nmsgid \"Invalid size growth threshold\"\nmsgstr \"Invalid size growth threshold\"\n\n#: legacy/builder/phases/sizer.go:244\nmsgid \"Invalid size regexp: %s\"\nmsgstr \"Invalid size regexp: %s\"\n\n#: cli/compile/compile.go:189\nmsgid \"Invalid size report format: %s\"\nmsgstr \"Invalid size report format: %s\"\n\n#: cli/arguments/sketch_environment.go:52\nmsgid \"Invalid sketch environment mode: %s\"\nmsgstr \"Invalid sketch environment mode: %s\"\n\n#: commands/sketch/install_deps.go:83\n#: commands/sketch/install_deps.go:99\n#: commands/sketch/install_deps.go:277\nmsgid \"Invalid sketch lock file\"\nmsgstr \"Invalid sketch lock file\"\n\n#: commands/sketch/install_deps.go:64\n#: commands/sketch/install_deps.go:204\nmsgid \"Invalid sketch project file\"\nmsgstr \"Invalid sketch project file\"\n\n#: commands/compile/test.go:71\nmsgid \"Invalid test filter\"\nmsgstr \"Invalid test filter\"\n\n#: cli/test/test.go:98\nmsgid \"Invalid timeout: %s\"\nmsgstr \"Invalid timeout: %s\"\n\n#: commands/debug/dap.go:638\nmsgid \"Invalid variables reference\"\nmsgstr \"Invalid variables reference\"\n\n#: commands/errors.go:112\nmsgid \"Invalid version\"\nmsgstr \"Invalid version\"\n\n#: commands/board/list.go:55\nmsgid \"Invalid vid value: '%s'\"\nmsgstr \"Invalid vid value: '%s'\"\n\n#: cli/compile/compile.go:145\nmsgid \"Just produce the compilation database, without actually compiling.\"\nmsgstr \"Just produce the compilation database, without actually compiling.\"\n\n#: cli/core/trust.go:52\n#: cli/core/trust.go:119\nmsgid \"KEY_FILE\"\nmsgstr \"KEY_FILE\"\n\n#: cli/core/trust.go:89\nmsgid \"Key %[1]s trusted for %[2]s\"\nmsgstr \"Key %[1]s trusted for %[2]s\"\n\n#: cli/core/trust.go:187\nmsgid \"Key ID\"\nmsgstr \"Key ID\"\n\n#: cli/core/trust.go:187\nmsgid \"Key file\"\nmsgstr \"Key file\"\n\n#: cli/lib/list.go:37\nmsgid \"LIBNAME\"\nmsgstr \"LIBNAME\"\n\n#: cli/lib/check_deps.go:34\n#: cli/lib/install.go:38\nmsgid \"LIBRARY\"\nmsgstr \"LIBRARY\"\n\n#: cli/lib/download.go:34\n#: cli/lib/examples.go:38\n#: cli/lib/search.go:39\n#: cli/lib/uninstall.go:35\nmsgid \"LIBRARY_NAME\"\nmsgstr \"LIBRARY_NAME\"\n\n#: cli/lib/lint.go:36\nmsgid \"LIBRARY_PATH\"\nmsgstr \"LIBRARY_PATH\"\n\n#: cli/core/list.go:84\nmsgid \"Latest\"\nmsgstr \"Latest\"\n\n#: cli/compile/library_resolutions.go:51\n#: cli/compile/size_report.go:75\n#: cli/compile/size_report.go:84\n#: cli/compile/size_report.go:93\n#: cli/compile/size_report.go:128\n#: cli/compile/size_report.go:142\nmsgid \"Library\"\nmsgstr \"Library\"\n\n#: commands/lib/uninstall.go:36\nmsgid \"Library %s is not installed\"\nmsgstr \"Library %s is not installed\"\n\n#: commands/errors.go:279\nmsgid \"Library '%s' not found\"\nmsgstr \"Library '%s' not found\"\n\n#: legacy/builder/fail_if_imported_library_is_wrong.go:46\nmsgid \"Library can't use both '%[1]s' and '%[2]s' folders. Double check {0}\"\nmsgstr \"Library can't use both '%[1]s' and '%[2]s' folders. Double check {0}\"\n\n#: cli/bundle/import.go:105\nmsgid \"Library index:\"\nmsgstr \"Library index:\"\n\n#: commands/errors.go:382\nmsgid \"Library install failed\"\nmsgstr \"Library install failed\"\n\n#: commands/lib/install.go:122\n#: commands/lib/install.go:132\nmsgid \"Library installed\"\nmsgstr \"Library installed\"\n\n#: cli/outdated/outdated.go:72\n#: cli/update/update.go:115\nmsgid \"Library name\"\nmsgstr \"Library name\"\n\n#: arduino/libraries/lint.go:174\nmsgid \"Library name '%[1]s' doesn't match the library folder name '%[2]s'\"\nmsgstr \"Library name '%[1]s' doesn't match the library folder name '%[2]s'\"\n\n#: cli/bundle/export.go:57\nmsgid \"Library to export, in the LIBRARY_NAME[@VERSION] form, can be used multiple times.\"\nmsgstr \"Library to export, in the LIBRARY_NAME[@VERSION] form, can be used multiple times.\"\n\n#: legacy/builder/phases/libraries_builder.go:91\nmsgid \"Library {0} has been declared precompiled:\"\nmsgstr \"Library {0} has been declared precompiled:\"\n\n#: cli/bundle/export.go:125\nmsgid \"Library:\"\nmsgstr \"Library:\"\n\n#: cli/lib/search.go:168\nmsgid \"License: %s\"\nmsgstr \"License: %s\"\n\n#: legacy/builder/builder.go:86\nmsgid \"Linking everything together...\"\nmsgstr \"Linking everything together...\"\n\n#: cli/board/listall.go:37\n#: cli/board/search.go:38\nmsgid \"List all boards that have the support platform installed. You can search\\n\"\n\"for a specific board if you specify the board name\"\nmsgstr \"List all boards that have the support platform installed. You can search\\n\"\n\"for a specific board if you specify the board name\"\n\n#: cli/board/listall.go:36\n#: cli/board/search.go:37\nmsgid \"List all known boards and their corresponding FQBN.\"\nmsgstr \"List all known boards and their corresponding FQBN.\"\n\n#: cli/board/list.go:37\nmsgid \"List connected boards.\"\nmsgstr \"List connected boards.\"\n\n#: cli/compile/compile.go:113\nmsgid \"List of custom build properties separated by commas. Or can be used multiple times for multiple properties.\"\nmsgstr \"List of custom build properties separated by commas. Or can be used multiple times for multiple properties.\"\n\n#: cli/compile/compile.go:128\n#: cli/test/test.go:79\nmsgid \"List of custom libraries dir paths separated by commas. Or can be used multiple times for multiple libraries dir paths.\"\nmsgstr \"List of custom libraries dir paths separated by commas. Or can be used multiple times for multiple libraries dir paths.\"\n\n#: cli/compile/compile.go:126\n#: cli/test/test.go:77\nmsgid \"List of paths to libraries root folders. Libraries set this way have top priority in case of conflicts. Can be used multiple times for different libraries.\"\nmsgstr \"List of paths to libraries root folders. Libraries set this way have top priority in case of conflicts. Can be used multiple times for different libraries.\"\n\n#: cli/lib/list.go:50\nmsgid \"List updatable libraries.\"\nmsgstr \"List updatable libraries.\"\n\n#: cli/core/list.go:41\nmsgid \"List updatable platforms.\"\nmsgstr \"List updatable platforms.\"\n\n#: cli/board/board.go:30\nmsgid \"Lists all connected boards.\"\nmsgstr \"Lists all connected boards.\"\n\n#: cli/outdated/outdated.go:38\nmsgid \"Lists cores and libraries that can be upgraded\"\nmsgstr \"Lists cores and libraries that can be upgraded\"\n\n#: commands/instances.go:237\n#: commands/instances.go:338\nmsgid \"Loading index file: %v\"\nmsgstr \"Loading index file: %v\"\n\n#: commands/instances.go:347\nmsgid \"Loading libraries: %v\"\nmsgstr \"Loading libraries: %v\"\n\n#: commands/debug/dap.go:629\nmsgid \"Locals\"\nmsgstr \"Locals\"\n\n#: cli/compile/library_resolutions.go:51\n#: cli/lib/list.go:125\nmsgid \"Location\"\nmsgstr \"Location\"\n\n#: cli/compile/library_resolutions.go:51\nmsgid \"Location priority\"\nmsgstr \"Location priority\"\n\n#: legacy/builder/recipe_runner.go:39\nmsgid \"Looking for recipes like {0}*{1}\"\nmsgstr \"Looking for recipes like {0}*{1}\"\n\n#: legacy/builder/phases/sizer.go:152\nmsgid \"Low memory available, stability problems may occur.\"\nmsgstr \"Low memory available, stability problems may occur.\"\n\n#: cli/lib/search.go:163\nmsgid \"Maintainer: %s\"\nmsgstr \"Maintainer: %s\"\n\n#: cli/core/trust.go:38\nmsgid \"Manages the OpenPGP public keys trusted to sign the package indexes. The signature of the indexes with a trusted key is verified each time they are updated or loaded, the indexes with an invalid or missing signature are refused. A key can be trusted for a single index URL or, if the URL ends with a slash, for all the indexes whose URL starts with it.\"\nmsgstr \"Manages the OpenPGP public keys trusted to sign the package indexes. The signature of the indexes with a trusted key is verified each time they are updated or loaded, the indexes with an invalid or missing signature are refused. A key can be trusted for a single index URL or, if the URL ends with a slash, for all the indexes whose URL starts with it.\"\n\n#: cli/core/trust.go:37\nmsgid \"Manages the keys trusted to sign the package indexes.\"\nmsgstr \"Manages the keys trusted to sign the package indexes.\"\n\n#: cli/arguments/port.go:46\nmsgid \"Max time to wait for port discovery, e.g.: 30s, 1m\"\nmsgstr \"Max time to wait for port discovery, e.g.: 30s, 1m\"\n\n#: cli/test/test.go:83\nmsgid \"Maximum duration of each test, the tests running longer are stopped.\"\nmsgstr \"Maximum duration of each test, the tests running longer are stopped.\"\n\n#: cli/lib/lint.go:85\nmsgid \"Message\"\nmsgstr \"Message\"\n\n#: cli/cli.go:112\nmsgid \"Messages with this level and above will be logged. Valid levels are: %s\"\nmsgstr \"Messages with this level and above will be logged. Valid levels are: %s\"\n\n#: arduino/libraries/lint.go:141\nmsgid \"Missing '%[1]s' from library in %[2]s\"\nmsgstr \"Missing '%[1]s' from library in %[2]s\"\n\n#: legacy/builder/fail_if_imported_library_is_wrong.go:41\nmsgid \"Missing '{0}' from library in {1}\"\nmsgstr \"Missing '{0}' from library in {1}\"\n\n#: commands/compile/matrix.go:58\n#: commands/errors.go:128\nmsgid \"Missing FQBN (Fully Qualified Board Name)\"\nmsgstr \"Missing FQBN (Fully Qualified Board Name)\"\n\n#: commands/bundle/import.go:49\nmsgid \"Missing bundle path\"\nmsgstr \"Missing bundle path\"\n\n#: commands/compile/matrix.go:45\n#: commands/compile/test.go:62\n#: commands/compile/watch.go:54\nmsgid \"Missing compile request\"\nmsgstr \"Missing compile request\"\n\n#: commands/bundle/export.go:63\nmsgid \"Missing output of the bundle\"\nmsgstr \"Missing output of the bundle\"\n\n#: commands/errors.go:158\nmsgid \"Missing port address\"\nmsgstr \"Missing port address\"\n\n#: commands/errors.go:170\nmsgid \"Missing port protocol\"\nmsgstr \"Missing port protocol\"\n\n#: commands/errors.go:182\nmsgid \"Missing programmer\"\nmsgstr \"Missing programmer\"\n\n#: commands/debug/gdb_servers.go:87\n#: commands/debug/gdb_servers.go:96\nmsgid \"Missing property '%s' required by the GDB server\"\nmsgstr \"Missing property '%s' required by the GDB server\"\n\n#: legacy/builder/phases/sizer.go:248\nmsgid \"Missing size regexp\"\nmsgstr \"Missing size regexp\"\n\n#: commands/errors.go:331\nmsgid \"Missing sketch path\"\nmsgstr \"Missing sketch path\"\n\n#: cli/monitor/monitor.go:147\nmsgid \"Monitor port settings: %s\"\nmsgstr \"Monitor port settings: %s\"\n\n#: legacy/builder/print_used_and_not_used_libraries.go:50\nmsgid \"Multiple libraries were found for \\\"{0}\\\"\"\nmsgstr \"Multiple libraries were found for \\\"{0}\\\"\"\n\n#: cli/board/details.go:194\n#: cli/core/list.go:84\n#: cli/core/search.go:114\n#: cli/lib/list.go:125\n#: cli/outdated/outdated.go:62\nmsgid \"Name\"\nmsgstr \"Name\"\n\n#: cli/compile/library_resolutions.go:51\nmsgid \"Name priority\"\nmsgstr \"Name priority\"\n\n#: cli/lib/search.go:142\nmsgid \"Name: \\\"%s\\\"\"\nmsgstr \"Name: \\\"%s\\\"\"\n\n#: cli/outdated/outdated.go:62\n#: cli/outdated/outdated.go:72\n#: cli/update/update.go:105\n#: cli/update/update.go:115\nmsgid \"New version\"\nmsgstr \"New version\"\n\n#: cli/board/list.go:115\nmsgid \"No boards found.\"\nmsgstr \"No boards found.\"\n\n#: commands/bundle/export.go:60\nmsgid \"No boards or libraries to export\"\nmsgstr \"No boards or libraries to export\"\n\n#: legacy/builder/builder_utils/utils.go:357\nmsgid \"No colon in first line of depfile\"\nmsgstr \"No colon in first line of depfile\"\n\n#: cli/lib/lint.go:81\nmsgid \"No issues found.\"\nmsgstr \"No issues found.\"\n\n#: cli/lib/examples.go:103\nmsgid \"No libraries found.\"\nmsgstr \"No libraries found.\"\n\n#: cli/compile/library_resolutions.go:38\nmsgid \"No libraries have been resolved.\"\nmsgstr \"No libraries have been resolved.\"\n\n#: cli/lib/list.go:117\nmsgid \"No libraries installed.\"\nmsgstr \"No libraries installed.\"\n\n#: cli/lib/search.go:126\nmsgid \"No libraries matching your search.\"\nmsgstr \"No libraries matching your search.\"\n\n#: cli/lib/search.go:137\nmsgid \"No libraries matching your search.\\n\"\n\"Did you mean...\\n\"\n\"\"\nmsgstr \"No libraries matching your search.\\n\"\n\"Did you mean...\\n\"\n\"\"\n\n#: commands/errors.go:512\nmsgid \"No monitor available for the port protocol %s\"\nmsgstr \"No monitor available for the port protocol %s\"\n\n#: cli/core/update_index.go:132\nmsgid \"No package indexes downloaded.\"\nmsgstr \"No package indexes downloaded.\"\n\n#: cli/core/search.go:124\nmsgid \"No platforms matching your search.\"\nmsgstr \"No platforms matching your search.\"\n\n#: commands/board/attach.go:91\nmsgid \"No supported board found at %s\"\nmsgstr \"No supported board found at %s\"\n\n#: commands/compile/matrix.go:54\nmsgid \"No targets to build\"\nmsgstr \"No targets to build\"\n\n#: cli/test/test.go:275\nmsgid \"No tests run\"\nmsgstr \"No tests run\"\n\n#: cli/core/trust.go:148\nmsgid \"No trusted keys found for %s\"\nmsgstr \"No trusted keys found for %s\"\n\n#: cli/core/trust.go:184\nmsgid \"No trusted keys.\"\nmsgstr \"No trusted keys.\"\n\n#: cli/lib/list.go:115\nmsgid \"No updates available.\"\nmsgstr \"No updates available.\"\n\n#: commands/upload/upload.go:430\nmsgid \"No upload port found, using %s as fallback\"\nmsgstr \"No upload port found, using %s as fallback\"\n\n#: commands/errors.go:298\nmsgid \"No valid dependencies solution found\"\nmsgstr \"No valid dependencies solution found\"\n\n#: legacy/builder/phases/sizer.go:142\nmsgid \"Not enough memory; see %s for tips on reducing your footprint.\"\nmsgstr \"Not enough memory; see %s for tips on reducing your footprint.\"\n\n#: legacy/builder/builder_utils/utils.go:290\nmsgid \"Not found: nil\"\nmsgstr \"Not found: nil\"\n\n#: legacy/builder/builder_utils/utils.go:306\n#: legacy/builder/builder_utils/utils.go:319\n#: legacy/builder/builder_utils/utils.go:393\nmsgid \"Not found: {0}\"\nmsgstr \"Not found: {0}\"\n\n#: legacy/builder/print_used_and_not_used_libraries.go:53\nmsgid \"Not used: {0}\"\nmsgstr \"Not used: {0}\"\n\n#: cli/compile/compile.go:142\nmsgid \"Number of targets built in parallel when building for more boards, 0 to use the number of available CPUs.\"\nmsgstr \"Number of targets built in parallel when building for more boards, 0 to use the number of available CPUs.\"\n\n#: cli/compile/compile.go:156\nmsgid \"Number of the biggest symbols included in the size report, 0 to include all of them.\"\nmsgstr \"Number of the biggest symbols included in the size report, 0 to include all of them.\"\n\n#: cli/compile/matrix.go:130\nmsgid \"OK\"\nmsgstr \"OK\"\n\n#: cli/board/details.go:165\nmsgid \"OS:\"\nmsgstr \"OS:\"\n\n#: cli/bundle/export.go:45\nmsgid \"OUTPUT\"\nmsgstr \"OUTPUT\"\n\n#: cli/compile/size_report.go:84\n#: cli/compile/size_report.go:93\nmsgid \"Object\"\nmsgstr \"Object\"\n\n#: cli/board/details.go:129\nmsgid \"Official Arduino board:\"\nmsgstr \"Official Arduino board:\"\n\n#: cli/bundle/bundle.go:31\nmsgid \"Offline bundle commands.\"\nmsgstr \"Offline bundle commands.\"\n\n#: cli/monitor/monitor.go:50\n#: cli/monitor/monitor.go:51\nmsgid \"Open a communication port with a board.\"\nmsgstr \"Open a communication port with a board.\"\n\n#: cli/bundle/export.go:58\nmsgid \"Operating system and architecture, in the os/arch form, the tools are exported for, can be used multiple times.\"\nmsgstr \"Operating system and architecture, in the os/arch form, the tools are exported for, can be used multiple times.\"\n\n#: cli/board/details.go:177\nmsgid \"Option:\"\nmsgstr \"Option:\"\n\n#: cli/compile/compile.go:140\nmsgid \"Optional, build the sketch for the boards, board options and build properties listed in the given build matrix file.\"\nmsgstr \"Optional, build the sketch for the boards, board options and build properties listed in the given build matrix file.\"\n\n#: cli/compile/compile.go:117\n#: cli/test/test.go:72\nmsgid \"Optional, can be: %s. Used to tell gcc which warning level to use (-W flag).\"\nmsgstr \"Optional, can be: %s. Used to tell gcc which warning level to use (-W flag).\"\n\n#: cli/compile/compile.go:146\n#: cli/test/test.go:74\nmsgid \"Optional, cleanup the build folder and do not use any cached build.\"\nmsgstr \"Optional, cleanup the build folder and do not use any cached build.\"\n\n#: cli/compile/compile.go:160\nmsgid \"Optional, compare the memory usage of the executable with the report saved by a previous build with --size-report-out.\"\nmsgstr \"Optional, compare the memory usage of the executable with the report saved by a previous build with --size-report-out.\"\n\n#: cli/compile/compile.go:132\nmsgid \"Optional, explain how the library that provides each included header has been selected among the installed ones.\"\nmsgstr \"Optional, explain how the library that provides each included header has been selected among the installed ones.\"\n\n#: cli/compile/compile.go:162\nmsgid \"Optional, fail if the flash or RAM usage grew more than the given number of bytes (e.g. 512) or percentage (e.g. 1.5%) with respect to the report passed with --compare-size.\"\nmsgstr \"Optional, fail if the flash or RAM usage grew more than the given number of bytes (e.g. 512) or percentage (e.g. 1.5%) with respect to the report passed with --compare-size.\"\n\n#: cli/compile/compile.go:134\nmsgid \"Optional, keep running and build the sketch again each time the sketch, its src folder or the unmanaged libraries it uses change.\"\nmsgstr \"Optional, keep running and build the sketch again each time the sketch, its src folder or the unmanaged libraries it uses change.\"\n\n#: cli/compile/compile.go:136\nmsgid \"Optional, open the monitor after each upload, requires %s.\"\nmsgstr \"Optional, open the monitor after each upload, requires %s.\"\n\n#: cli/compile/compile.go:143\nmsgid \"Optional, optimize compile output for debugging, rather than for release.\"\nmsgstr \"Optional, optimize compile output for debugging, rather than for release.\"\n\n#: cli/compile/compile.go:154\nmsgid \"Optional, print the detailed memory usage of the executable, can be: %s.\"\nmsgstr \"Optional, print the detailed memory usage of the executable, can be: %s.\"\n\n#: cli/test/test.go:81\nmsgid \"Optional, run only the tests matching the given regular expression, in the SUITE/NAME format. The suite is the path of the test file in the test folder, without extension.\"\nmsgstr \"Optional, run only the tests matching the given regular expression, in the SUITE/NAME format. The suite is the path of the test file in the test folder, without extension.\"\n\n#: cli/compile/compile.go:158\nmsgid \"Optional, save the detailed memory usage of the executable, with all the symbols, in the given JSON file.\"\nmsgstr \"Optional, save the detailed memory usage of the executable, with all the symbols, in the given JSON file.\"\n\n#: cli/test/test.go:84\nmsgid \"Optional, save the results of the tests in the given file in the JUnit XML format.\"\nmsgstr \"Optional, save the results of the tests in the given file in the JUnit XML format.\"\n\n#: cli/compile/compile.go:119\nmsgid \"Optional, suppresses almost every output.\"\nmsgstr \"Optional, suppresses almost every output.\"\n\n#: cli/compile/compile.go:118\n#: cli/test/test.go:73\n#: cli/upload/upload.go:64\nmsgid \"Optional, turns on verbose mode.\"\nmsgstr \"Optional, turns on verbose mode.\"\n\n#: cli/compile/compile.go:144\n#: cli/upload/upload.go:65\nmsgid \"Optional, use the specified programmer to upload.\"\nmsgstr \"Optional, use the specified programmer to upload.\"\n\n#: cli/compile/compile.go:151\nmsgid \"Optional. Path to a .json file that contains a set of replacements of the sketch source code.\"\nmsgstr \"Optional. Path to a .json file that contains a set of replacements of the sketch source code.\"\n\n#: commands/bundle/export.go:67\nmsgid \"Output %s already exists\"\nmsgstr \"Output %s already exists\"\n\n#: cli/compile/matrix.go:82\nmsgid \"Output of the build of %s:\"\nmsgstr \"Output of the build of %s:\"\n\n#: commands/daemon/monitor.go:69\nmsgid \"OutputRate in Null monitor must be a float64\"\nmsgstr \"OutputRate in Null monitor must be a float64\"\n\n#: cli/compile/compile.go:115\n#: cli/test/test.go:70\nmsgid \"Override a build property with a custom value. Can be used multiple times for multiple properties.\"\nmsgstr \"Override a build property with a custom value. Can be used multiple times for multiple properties.\"\n\n#: cli/config/init.go:53\nmsgid \"Overwrite existing config file.\"\nmsgstr \"Overwrite existing config file.\"\n\n#: cli/core/download.go:36\n#: cli/core/install.go:37\n#: cli/core/uninstall.go:36\n#: cli/core/upgrade.go:38\nmsgid \"PACKAGER\"\nmsgstr \"PACKAGER\"\n\n#: cli/board/details.go:145\nmsgid \"Package URL:\"\nmsgstr \"Package URL:\"\n\n#: cli/bundle/import.go:104\nmsgid \"Package index:\"\nmsgstr \"Package index:\"\n\n#: cli/board/details.go:144\nmsgid \"Package maintainer:\"\nmsgstr \"Package maintainer:\"\n\n#: cli/board/details.go:143\nmsgid \"Package name:\"\nmsgstr \"Package name:\"\n\n#: cli/board/details.go:147\nmsgid \"Package online help:\"\nmsgstr \"Package online help:\"\n\n#: cli/board/details.go:146\nmsgid \"Package website:\"\nmsgstr \"Package website:\"\n\n#: cli/lib/search.go:165\nmsgid \"Paragraph: %s\"\nmsgstr \"Paragraph: %s\"\n\n#: arduino/monitors/serial.go:179\nmsgid \"Parity\"\nmsgstr \"Parity\"\n\n#: cli/compile/library_resolutions.go:51\n#: cli/core/update_index.go:135\nmsgid \"Path\"\nmsgstr \"Path\"\n\n#: cli/cli.go:113\nmsgid \"Path to the file where logs will be written.\"\nmsgstr \"Path to the file where logs will be written.\"\n\n#: cli/compile/compile.go:111\n#: cli/test/test.go:68\nmsgid \"Path where to save compiled files. If omitted, a directory will be created in the default temporary path of your OS.\"\nmsgstr \"Path where to save compiled files. If omitted, a directory will be created in the default temporary path of your OS.\"\n\n#: commands/upload/upload.go:411\nmsgid \"Performing 1200-bps touch reset on serial port %s\"\nmsgstr \"Performing 1200-bps touch reset on serial port %s\"\n\n#: cli/compile/compile.go:130\nmsgid \"Pin the library that provides a header, in the HEADER=LIBRARY[@VERSION][:PATH] format (e.g. Servo.h=Servo@1.1.8). The compilation fails if the library is not available. Can be used multiple times for different headers.\"\nmsgstr \"Pin the library that provides a header, in the HEADER=LIBRARY[@VERSION][:PATH] format (e.g. Servo.h=Servo@1.1.8). The compilation fails if the library is not available. Can be used multiple times for different headers.\"\n\n#: commands/core/install.go:72\nmsgid \"Platform %s already installed\"\nmsgstr \"Platform %s already installed\"\n\n#: commands/core/install.go:171\nmsgid \"Platform %s installed\"\nmsgstr \"Platform %s installed\"\n\n#: commands/core/uninstall.go:84\nmsgid \"Platform %s uninstalled\"\nmsgstr \"Platform %s uninstalled\"\n\n#: commands/errors.go:316\nmsgid \"Platform '%s' is already at the latest version\"\nmsgstr \"Platform '%s' is already at the latest version\"\n\n#: commands/errors.go:260\nmsgid \"Platform '%s' not found\"\nmsgstr \"Platform '%s' not found\"\n\n#: cli/board/search.go:86\nmsgid \"Platform ID\"\nmsgstr \"Platform ID\"\n\n#: cli/board/details.go:153\nmsgid \"Platform URL:\"\nmsgstr \"Platform URL:\"\n\n#: cli/board/details.go:152\nmsgid \"Platform architecture:\"\nmsgstr \"Platform architecture:\"\n\n#: cli/board/details.go:151\nmsgid \"Platform category:\"\nmsgstr \"Platform category:\"\n\n#: cli/board/details.go:158\nmsgid \"Platform checksum:\"\nmsgstr \"Platform checksum:\"\n\n#: cli/board/details.go:154\nmsgid \"Platform file name:\"\nmsgstr \"Platform file name:\"\n\n#: cli/board/details.go:150\nmsgid \"Platform name:\"\nmsgstr \"Platform name:\"\n\n#: cli/board/details.go:156\nmsgid \"Platform size (bytes):\"\nmsgstr \"Platform size (bytes):\"\n\n#: cli/bundle/export.go:119\nmsgid \"Platform:\"\nmsgstr \"Platform:\"\n\n#: cli/board/list.go:87\n#: cli/board/list.go:125\nmsgid \"Port\"\nmsgstr \"Port\"\n\n#: cli/monitor/monitor.go:121\n#: cli/monitor/monitor.go:128\nmsgid \"Port closed: %v\"\nmsgstr \"Port closed: %v\"\n\n#: commands/errors.go:476\nmsgid \"Port monitor error\"\nmsgstr \"Port monitor error\"\n\n#: legacy/builder/phases/libraries_builder.go:101\n#: legacy/builder/phases/libraries_builder.go:109\nmsgid \"Precompiled library in \\\"{0}\\\" not found\"\nmsgstr \"Precompiled library in \\\"{0}\\\" not found\"\n\n#: cli/board/details.go:42\nmsgid \"Print details about a board.\"\nmsgstr \"Print details about a board.\"\n\n#: cli/compile/compile.go:107\nmsgid \"Print preprocessed code to stdout instead of compiling.\"\nmsgstr \"Print preprocessed code to stdout instead of compiling.\"\n\n#: cli/cli.go:111\nmsgid \"Print the logs on the standard output.\"\nmsgstr \"Print the logs on the standard output.\"\n\n#: cli/config/dump.go:31\nmsgid \"Prints the current configuration\"\nmsgstr \"Prints the current configuration\"\n\n#: cli/config/dump.go:32\nmsgid \"Prints the current configuration.\"\nmsgstr \"Prints the current configuration.\"\n\n#: cli/compile/library_resolutions.go:51\nmsgid \"Priority\"\nmsgstr \"Priority\"\n\n#: commands/errors.go:212\nmsgid \"Programmer '%s' not found\"\nmsgstr \"Programmer '%s' not found\"\n\n#: cli/board/details.go:93\nmsgid \"Programmer name\"\nmsgstr \"Programmer name\"\n\n#: cli/debug/debug.go:71\nmsgid \"Programmer to use for debugging\"\nmsgstr \"Programmer to use for debugging\"\n\n#: cli/board/details.go:194\nmsgid \"Programmers:\"\nmsgstr \"Programmers:\"\n\n#: legacy/builder/builder_utils/utils.go:46\nmsgid \"Progress {0}\"\nmsgstr \"Progress {0}\"\n\n#: commands/errors.go:245\nmsgid \"Property '%s' is undefined\"\nmsgstr \"Property '%s' is undefined\"\n\n#: cli/board/list.go:125\nmsgid \"Protocol\"\nmsgstr \"Protocol\"\n\n#: cli/lib/search.go:175\nmsgid \"Provides includes: %s\"\nmsgstr \"Provides includes: %s\"\n\n#: cli/compile/matrix.go:128\n#: cli/compile/size_report.go:64\n#: cli/compile/size_report.go:67\n#: cli/compile/size_report.go:75\n#: cli/compile/size_report.go:84\n#: cli/compile/size_report.go:93\n#: cli/compile/size_report.go:124\n#: cli/compile/size_report.go:128\n#: cli/compile/size_report.go:142\nmsgid \"RAM\"\nmsgstr \"RAM\"\n\n#: arduino/monitors/serial.go:197\nmsgid \"RTS\"\nmsgstr \"RTS\"\n\n#: cli/cache/info.go:102\n#: cli/cache/info.go:104\nmsgid \"Remote cache:\"\nmsgstr \"Remote cache:\"\n\n#: cli/cache/prune.go:46\nmsgid \"Remove all the entries of the compilation cache.\"\nmsgstr \"Remove all the entries of the compilation cache.\"\n\n#: cli/cache/prune.go:38\nmsgid \"Remove the least recently used entries of the compilation cache until its size is within the limit set by the `build_cache.max_size` setting or by the --max-size flag.\"\nmsgstr \"Remove the least recently used entries of the compilation cache until its size is within the limit set by the `build_cache.max_size` setting or by the --max-size flag.\"\n\n#: cli/cache/prune.go:37\nmsgid \"Remove the least recently used entries of the compilation cache.\"\nmsgstr \"Remove the least recently used entries of the compilation cache.\"\n\n#: cli/cache/prune.go:85\nmsgid \"Removed %[1]d entries (%[2]s) from the compilation cache.\"\nmsgstr \"Removed %[1]d entries (%[2]s) from the compilation cache.\"\n\n#: cli/config/remove.go:31\n#: cli/config/remove.go:32\nmsgid \"Removes one or more values from a setting.\"\nmsgstr \"Removes one or more values from a setting.\"\n\n#: commands/instances.go:921\n#: commands/lib/install.go:105\nmsgid \"Replacing %[1]s with %[2]s\"\nmsgstr \"Replacing %[1]s with %[2]s\"\n\n#: commands/debug/dap.go:221\n#: commands/debug/dap.go:300\nmsgid \"Request not supported\"\nmsgstr \"Request not supported\"\n\n#: cli/board/details.go:162\nmsgid \"Required tool:\"\nmsgstr \"Required tool:\"\n\n#: cli/sketch/install_deps.go:53\nmsgid \"Resolves again the dependencies ignoring the releases recorded in the %s file.\"\nmsgstr \"Resolves again the dependencies ignoring the releases recorded in the %s file.\"\n\n#: commands/sketch/install_deps.go:87\nmsgid \"Resolving sketch dependencies\"\nmsgstr \"Resolving sketch dependencies\"\n\n#: cli/compile/matrix.go:128\nmsgid \"Result\"\nmsgstr \"Result\"\n\n#: cli/lib/lint.go:85\nmsgid \"Rule\"\nmsgstr \"Rule\"\n\n#: cli/debug/debug.go:75\nmsgid \"Run a Debug Adapter Protocol session on stdin/stdout instead of the interactive gdb session.\"\nmsgstr \"Run a Debug Adapter Protocol session on stdin/stdout instead of the interactive gdb session.\"\n\n#: cli/debug/debug.go:76\nmsgid \"Run a Debug Adapter Protocol session with a client connecting to the given TCP port on localhost.\"\nmsgstr \"Run a Debug Adapter Protocol session with a client connecting to the given TCP port on localhost.\"\n\n#: cli/daemon/daemon.go:50\nmsgid \"Run as a daemon on port: %s\"\nmsgstr \"Run as a daemon on port: %s\"\n\n#: cli/monitor/monitor.go:64\nmsgid \"Run in silent mode, show only monitor input and output.\"\nmsgstr \"Run in silent mode, show only monitor input and output.\"\n\n#: cli/daemon/daemon.go:51\nmsgid \"Running as a daemon the initialization of cores and libraries is done only once.\"\nmsgstr \"Running as a daemon the initialization of cores and libraries is done only once.\"\n\n#: legacy/builder/phases/core_builder.go:49\nmsgid \"Running normal build of the core...\"\nmsgstr \"Running normal build of the core...\"\n\n#: legacy/builder/recipe_runner.go:48\nmsgid \"Running recipe: {0}\"\nmsgstr \"Running recipe: {0}\"\n\n#: cli/test/test.go:58\nmsgid \"Runs the unit tests of a sketch on this computer.\"\nmsgstr \"Runs the unit tests of a sketch on this computer.\"\n\n#: cli/compile/compile.go:109\nmsgid \"Save build artifacts in this directory.\"\nmsgstr \"Save build artifacts in this directory.\"\n\n#: cli/core/search.go:50\nmsgid \"Search for a core in Boards Manager using the specified keywords.\"\nmsgstr \"Search for a core in Boards Manager using the specified keywords.\"\n\n#: cli/core/search.go:49\nmsgid \"Search for a core in Boards Manager.\"\nmsgstr \"Search for a core in Boards Manager.\"\n\n#: cli/lib/search.go:41\nmsgid \"Search for one or more libraries data (case insensitive search).\"\nmsgstr \"Search for one or more libraries data (case insensitive search).\"\n\n#: cli/lib/search.go:40\nmsgid \"Searches for one or more libraries data.\"\nmsgstr \"Searches for one or more libraries data.\"\n\n#: cli/compile/size_report.go:67\n#: cli/compile/size_report.go:93\nmsgid \"Section\"\nmsgstr \"Section\"\n\n#: commands/board/attach.go:108\nmsgid \"Selected fqbn: %s\"\nmsgstr \"Selected fqbn: %s\"\n\n#: cli/lib/search.go:164\nmsgid \"Sentence: %s\"\nmsgstr \"Sentence: %s\"\n\n#: commands/download.go:78\nmsgid \"Server responded with: %s\"\nmsgstr \"Server responded with: %s\"\n\n#: cli/config/set.go:32\n#: cli/config/set.go:33\nmsgid \"Sets a setting value.\"\nmsgstr \"Sets a setting value.\"\n\n#: cli/config/init.go:51\n#: cli/config/init.go:52\nmsgid \"Sets where to save the configuration file.\"\nmsgstr \"Sets where to save the configuration file.\"\n\n#: cli/monitor/monitor.go:166\nmsgid \"Setting\"\nmsgstr \"Setting\"\n\n#: cli/config/delete.go:57\n#: cli/config/validate.go:52\nmsgid \"Settings key doesn't exist\"\nmsgstr \"Settings key doesn't exist\"\n\n#: cli/lib/lint.go:85\nmsgid \"Severity\"\nmsgstr \"Severity\"\n\n#: cli/core/search.go:55\nmsgid \"Show all available core versions.\"\nmsgstr \"Show all available core versions.\"\n\n#: cli/compile/compile.go:106\nmsgid \"Show all build properties used instead of compiling.\"\nmsgstr \"Show all build properties used instead of compiling.\"\n\n#: cli/monitor/monitor.go:62\nmsgid \"Show all the settings of the communication port.\"\nmsgstr \"Show all the settings of the communication port.\"\n\n#: cli/board/listall.go:45\n#: cli/board/search.go:46\nmsgid \"Show also boards marked as 'hidden' in the platform\"\nmsgstr \"Show also boards marked as 'hidden' in the platform\"\n\n#: cli/board/details.go:49\nmsgid \"Show full board details\"\nmsgstr \"Show full board details\"\n\n#: cli/board/details.go:43\nmsgid \"Show information about a board, in particular if the board has options to be specified in the FQBN.\"\nmsgstr \"Show information about a board, in particular if the board has options to be specified in the FQBN.\"\n\n#: cli/cache/info.go:35\nmsgid \"Show information about the compilation cache.\"\nmsgstr \"Show information about the compilation cache.\"\n\n#: cli/lib/examples.go:45\n#: cli/lib/list.go:49\nmsgid \"Show libraries for the specified board FQBN.\"\nmsgstr \"Show libraries for the specified board FQBN.\"\n\n#: cli/lib/search.go:46\nmsgid \"Show library names only.\"\nmsgstr \"Show library names only.\"\n\n#: cli/board/details.go:51\nmsgid \"Show list of available programmers\"\nmsgstr \"Show list of available programmers\"\n\n#: cli/debug/debug.go:74\nmsgid \"Show metadata about the debug session instead of starting the debugger.\"\nmsgstr \"Show metadata about the debug session instead of starting the debugger.\"\n\n#: cli/update/update.go:50\nmsgid \"Show outdated cores and libraries after index update\"\nmsgstr \"Show outdated cores and libraries after index update\"\n\n#: cli/cache/info.go:36\nmsgid \"Show the location, the number of entries and the size of the compilation cache shared between builds.\"\nmsgstr \"Show the location, the number of entries and the size of the compilation cache shared between builds.\"\n\n#: cli/core/update_index.go:50\nmsgid \"Show the package indexes downloaded, with their source URL and the time they were refreshed, without updating them.\"\nmsgstr \"Show the package indexes downloaded, with their source URL and the time they were refreshed, without updating them.\"\n\n#: cli/cache/cache.go:35\nmsgid \"Show the size of the compilation cache.\"\nmsgstr \"Show the size of the compilation cache.\"\n\n#: cli/lib/list.go:38\nmsgid \"Shows a list of installed libraries.\"\nmsgstr \"Shows a list of installed libraries.\"\n\n#: cli/lib/list.go:39\nmsgid \"Shows a list of installed libraries.\\n\"\n\"\\n\"\n\"If the LIBNAME parameter is specified the listing is limited to that specific\\n\"\n\"library. By default the libraries provided as built-in by platforms/core are\\n\"\n\"not listed, they can be listed by adding the --all flag.\"\nmsgstr \"Shows a list of installed libraries.\\n\"\n\"\\n\"\n\"If the LIBNAME parameter is specified the listing is limited to that specific\\n\"\n\"library. By default the libraries provided as built-in by platforms/core are\\n\"\n\"not listed, they can be listed by adding the --all flag.\"\n\n#: cli/core/trust.go:96\nmsgid \"Shows the keys trusted to sign the package indexes, together with the URLs they are trusted for.\"\nmsgstr \"Shows the keys trusted to sign the package indexes, together with the URLs they are trusted for.\"\n\n#: cli/core/trust.go:95\nmsgid \"Shows the keys trusted to sign the package indexes.\"\nmsgstr \"Shows the keys trusted to sign the package indexes.\"\n\n#: cli/core/list.go:35\n#: cli/core/list.go:36\nmsgid \"Shows the list of installed platforms.\"\nmsgstr \"Shows the list of installed platforms.\"\n\n#: cli/lib/examples.go:39\nmsgid \"Shows the list of the examples for libraries.\"\nmsgstr \"Shows the list of the examples for libraries.\"\n\n#: cli/lib/examples.go:40\nmsgid \"Shows the list of the examples for libraries. A name may be given as argument to search a specific library.\"\nmsgstr \"Shows the list of the examples for libraries. A name may be given as argument to search a specific library.\"\n\n#: cli/version/version.go:38\nmsgid \"Shows the version number of Arduino CLI which is installed on your system.\"\nmsgstr \"Shows the version number of Arduino CLI which is installed on your system.\"\n\n#: cli/version/version.go:37\nmsgid \"Shows version number of Arduino CLI.\"\nmsgstr \"Shows version number of Arduino CLI.\"\n\n#: commands/trusted_keys.go:46\nmsgid \"Signature %s not found\"\nmsgstr \"Signature %s not found\"\n\n#: cli/compile/size_report.go:67\nmsgid \"Size\"\nmsgstr \"Size\"\n\n#: cli/board/details.go:167\nmsgid \"Size (bytes):\"\nmsgstr \"Size (bytes):\"\n\n#: cli/cache/prune.go:45\nmsgid \"Size limit of the compilation cache, e.g.: 500MB, 2GB\"\nmsgstr \"Size limit of the compilation cache, e.g.: 500MB, 2GB\"\n\n#: cli/cache/info.go:99\nmsgid \"Size:\"\nmsgstr \"Size:\"\n\n#: legacy/builder/fail_if_buildpath_equals_sketchpath.go:44\nmsgid \"Sketch cannot be located in build path. Please specify a different build path\"\nmsgstr \"Sketch cannot be located in build path. Please specify a different build path\"\n\n#: cli/sketch/new.go:68\nmsgid \"Sketch created in: %s\"\nmsgstr \"Sketch created in: %s\"\n\n#: commands/sketch_environment.go:64\nmsgid \"Sketch environment %s not found\"\nmsgstr \"Sketch environment %s not found\"\n\n#: commands/sketch/install_deps.go:67\nmsgid \"Sketch project file %s not found\"\nmsgstr \"Sketch project file %s not found\"\n\n#: legacy/builder/phases/sizer.go:136\nmsgid \"Sketch too big; see %s for tips on reducing it.\"\nmsgstr \"Sketch too big; see %s for tips on reducing it.\"\n\n#: legacy/builder/phases/sizer.go:101\nmsgid \"Sketch uses {0} bytes ({2}%%) of program storage space. Maximum is {1} bytes.\"\nmsgstr \"Sketch uses {0} bytes ({2}%%) of program storage space. Maximum is {1} bytes.\"\n\n#: cli/compile/compile.go:182\n#: cli/sketch/archive.go:66\n#: cli/upload/upload.go:89\nmsgid \"Sketches with .pde extension are deprecated, please rename the following files to .ino:\"\nmsgstr \"Sketches with .pde extension are deprecated, please rename the following files to .ino:\"\n\n#: legacy/builder/phases/linker.go:35\nmsgid \"Skip linking of final executable.\"\nmsgstr \"Skip linking of final executable.\"\n\n#: commands/upload/upload.go:404\nmsgid \"Skipping 1200-bps touch reset: no serial port selected!\"\nmsgstr \"Skipping 1200-bps touch reset: no serial port selected!\"\n\n#: legacy/builder/builder_utils/utils.go:491\nmsgid \"Skipping archive creation of: {0}\"\nmsgstr \"Skipping archive creation of: {0}\"\n\n#: legacy/builder/builder_utils/utils.go:275\nmsgid \"Skipping compile of: {0}\"\nmsgstr \"Skipping compile of: {0}\"\n\n#: legacy/builder/container_find_includes.go:425\nmsgid \"Skipping dependencies detection for precompiled library {0}\"\nmsgstr \"Skipping dependencies detection for precompiled library {0}\"\n\n#: commands/instances.go:1058\nmsgid \"Skipping platform configuration\"\nmsgstr \"Skipping platform configuration\"\n\n#: commands/core/install.go:167\nmsgid \"Skipping platform configuration.\"\nmsgstr \"Skipping platform configuration.\"\n\n#: legacy/builder/recipe_runner.go:58\nmsgid \"Skipping: {0}\"\nmsgstr \"Skipping: {0}\"\n\n#: arduino/libraries/lint.go:128\nmsgid \"Spurious %[1]s directory in '%[2]s' library\"\nmsgstr \"Spurious %[1]s directory in '%[2]s' library\"\n\n#: commands/debug/export.go:327\nmsgid \"Start the debugger with: %s\"\nmsgstr \"Start the debugger with: %s\"\n\n#: arduino/monitors/serial.go:185\nmsgid \"Stop bits\"\nmsgstr \"Stop bits\"\n\n#: cli/core/trust.go:121\nmsgid \"Stops trusting the keys for the package indexes downloaded from an URL, all of them unless a key file is given.\"\nmsgstr \"Stops trusting the keys for the package indexes downloaded from an URL, all of them unless a key file is given.\"\n\n#: cli/core/trust.go:120\nmsgid \"Stops trusting the keys for the package indexes downloaded from an URL.\"\nmsgstr \"Stops trusting the keys for the package indexes downloaded from an URL.\"\n\n#: cli/compile/size_report.go:93\n#: cli/compile/size_report.go:142\nmsgid \"Symbol\"\nmsgstr \"Symbol\"\n\n#: arduino/serialutils/serialutils.go:133\nmsgid \"TOUCH: error during reset: %s\"\nmsgstr \"TOUCH: error during reset: %s\"\n\n#: commands/compile/test.go:91\nmsgid \"Tests folder %s not found\"\nmsgstr \"Tests folder %s not found\"\n\n#: cli/compile/compile.go:198\nmsgid \"The --monitor flag requires --watch and --upload\"\nmsgstr \"The --monitor flag requires --watch and --upload\"\n\n#: cli/compile/compile.go:246\nmsgid \"The --size-growth-threshold flag requires --compare-size\"\nmsgstr \"The --size-growth-threshold flag requires --compare-size\"\n\n#: cli/compile/compile.go:194\nmsgid \"The --watch flag can't be used with --show-properties or --preprocess\"\nmsgstr \"The --watch flag can't be used with --show-properties or --preprocess\"\n\n#: commands/debug/export.go:274\nmsgid \"The GDB server '%s' can't be configured in CLion\"\nmsgstr \"The GDB server '%s' can't be configured in CLion\"\n\n#: cli/daemon/daemon.go:56\nmsgid \"The TCP port the daemon will listen to\"\nmsgstr \"The TCP port the daemon will listen to\"\n\n#: commands/bundle/export.go:225\nmsgid \"The archive of %s is corrupted\"\nmsgstr \"The archive of %s is corrupted\"\n\n#: commands/debug/dap.go:505\nmsgid \"The breakpoint is pending\"\nmsgstr \"The breakpoint is pending\"\n\n#: cli/bundle/export.go:48\nmsgid \"The bundle can be imported with the bundle import command on machines without network access.\"\nmsgstr \"The bundle can be imported with the bundle import command on machines without network access.\"\n\n#: cli/board/attach.go:45\nmsgid \"The connected devices search timeout, raise it if your board doesn't show up (e.g. to %s).\"\nmsgstr \"The connected devices search timeout, raise it if your board doesn't show up (e.g. to %s).\"\n\n#: cli/board/list.go:45\nmsgid \"The connected devices search timeout, raise it if your board doesn't show up e.g.: 10s\"\nmsgstr \"The connected devices search timeout, raise it if your board doesn't show up e.g.: 10s\"\n\n#: cli/cli.go:116\nmsgid \"The custom config file (if not specified the default will be used).\"\nmsgstr \"The custom config file (if not specified the default will be used).\"\n\n#: commands/debug/dap.go:375\nmsgid \"The debug session has already been launched\"\nmsgstr \"The debug session has already been launched\"\n\n#: commands/debug/dap.go:368\nmsgid \"The debug session has not been launched\"\nmsgstr \"The debug session has not been launched\"\n\n#: cli/core/install.go:66\nmsgid \"The flags --run-post-install and --skip-post-install can't be both set at the same time.\"\nmsgstr \"The flags --run-post-install and --skip-post-install can't be both set at the same time.\"\n\n#: commands/errors.go:566\nmsgid \"The growth of %[1]s usage exceeds the threshold of %[2]s\"\nmsgstr \"The growth of %[1]s usage exceeds the threshold of %[2]s\"\n\n#: cli/config/add.go:51\nmsgid \"The key '%[1]v' is not a list of items, can't add to it.\\n\"\n\"Maybe use '%[2]s'?\"\nmsgstr \"The key '%[1]v' is not a list of items, can't add to it.\\n\"\n\"Maybe use '%[2]s'?\"\n\n#: cli/config/remove.go:51\nmsgid \"The key '%[1]v' is not a list of items, can't remove from it.\\n\"\n\"Maybe use '%[2]s'?\"\nmsgstr \"The key '%[1]v' is not a list of items, can't remove from it.\\n\"\n\"Maybe use '%[2]s'?\"\n\n#: cli/cli.go:114\n#: cli/cli.go:115\nmsgid \"The output format for the logs, can be: %s\"\nmsgstr \"The output format for the logs, can be: %s\"\n\n#: legacy/builder/phases/libraries_builder.go:151\nmsgid \"The platform does not support '{0}' for precompiled libraries.\"\nmsgstr \"The platform does not support '{0}' for precompiled libraries.\"\n\n#: cli/bundle/import.go:40\nmsgid \"The tarballs are extracted in the data directory, while the folders are used in place and must not be moved or removed.\"\nmsgstr \"The tarballs are extracted in the data directory, while the folders are used in place and must not be moved or removed.\"\n\n#: cli/lib/upgrade.go:34\nmsgid \"This command upgrades an installed library to the latest available version. Multiple libraries can be passed separated by a space. If no arguments are provided, the command will upgrade all the installed libraries where an update is available.\"\nmsgstr \"This command upgrades an installed library to the latest available version. Multiple libraries can be passed separated by a space. If no arguments are provided, the command will upgrade all the installed libraries where an update is available.\"\n\n#: cli/outdated/outdated.go:39\nmsgid \"This commands shows a list of installed cores and/or libraries\\n\"\n\"that can be upgraded. If nothing needs to be updated the output is empty.\"\nmsgstr \"This commands shows a list of installed cores and/or libraries\\n\"\n\"that can be upgraded. If nothing needs to be updated the output is empty.\"\n\n#: commands/bundle/export.go:103\nmsgid \"Tool %[1]s is not available for %[2]s\"\nmsgstr \"Tool %[1]s is not available for %[2]s\"\n\n#: commands/sketch/install_deps.go:305\nmsgid \"Tool %[1]s required by platform %[2]s doesn't match the sketch lock file\"\nmsgstr \"Tool %[1]s required by platform %[2]s doesn't match the sketch lock file\"\n\n#: commands/bundled_tools.go:42\n#: commands/core/install.go:79\n#: commands/instances.go:972\nmsgid \"Tool %s already installed\"\nmsgstr \"Tool %s already installed\"\n\n#: commands/core/uninstall.go:100\nmsgid \"Tool %s uninstalled\"\nmsgstr \"Tool %s uninstalled\"\n\n#: cli/bundle/export.go:122\nmsgid \"Tool:\"\nmsgstr \"Tool:\"\n\n#: commands/debug/debug.go:201\nmsgid \"Toolchain '%s' is not supported\"\nmsgstr \"Toolchain '%s' is not supported\"\n\n#: cli/debug/debug.go:197\nmsgid \"Toolchain custom configurations\"\nmsgstr \"Toolchain custom configurations\"\n\n#: cli/debug/debug.go:191\nmsgid \"Toolchain path\"\nmsgstr \"Toolchain path\"\n\n#: cli/debug/debug.go:192\nmsgid \"Toolchain prefix\"\nmsgstr \"Toolchain prefix\"\n\n#: cli/debug/debug.go:190\nmsgid \"Toolchain type\"\nmsgstr \"Toolchain type\"\n\n#: cli/core/trust.go:53\nmsgid \"Trusts a key to sign the package indexes downloaded from an URL.\"\nmsgstr \"Trusts a key to sign the package indexes downloaded from an URL.\"\n\n#: cli/core/trust.go:54\nmsgid \"Trusts the OpenPGP public key, armored or binary, contained in the given file to sign the package indexes downloaded from an URL or, if the URL ends with a slash, from all the URLs starting with it.\"\nmsgstr \"Trusts the OpenPGP public key, armored or binary, contained in the given file to sign the package indexes downloaded from an URL or, if the URL ends with a slash, from all the URLs starting with it.\"\n\n#: cli/burnbootloader/burnbootloader.go:56\nmsgid \"Turns on verbose mode.\"\nmsgstr \"Turns on verbose mode.\"\n\n#: cli/board/list.go:87\n#: cli/board/list.go:125\nmsgid \"Type\"\nmsgstr \"Type\"\n\n#: cli/lib/search.go:172\nmsgid \"Types: %s\"\nmsgstr \"Types: %s\"\n\n#: cli/core/trust.go:52\n#: cli/core/trust.go:119\n#: cli/core/trust.go:187\n#: cli/core/update_index.go:135\nmsgid \"URL\"\nmsgstr \"URL\"\n\n#: cli/board/details.go:169\nmsgid \"URL:\"\nmsgstr \"URL:\"\n\n#: legacy/builder/phases/core_builder.go:132\nmsgid \"Unable to cache built core, please tell {0} maintainers to follow %s\"\nmsgstr \"Unable to cache built core, please tell {0} maintainers to follow %s\"\n\n#: configuration/configuration.go:126\nmsgid \"Unable to get Documents Folder: %v\"\nmsgstr \"Unable to get Documents Folder: %v\"\n\n#: configuration/configuration.go:101\nmsgid \"Unable to get Local App Data Folder: %v\"\nmsgstr \"Unable to get Local App Data Folder: %v\"\n\n#: configuration/configuration.go:89\n#: configuration/configuration.go:114\nmsgid \"Unable to get user home dir: %v\"\nmsgstr \"Unable to get user home dir: %v\"\n\n#: cli/cli.go:205\nmsgid \"Unable to open file for logging: %s\"\nmsgstr \"Unable to open file for logging: %s\"\n\n#: commands/core/uninstall.go:76\n#: commands/lib/uninstall.go:38\nmsgid \"Uninstalling %s\"\nmsgstr \"Uninstalling %s\"\n\n#: commands/core/uninstall.go:92\nmsgid \"Uninstalling %s, tool is no more required\"\nmsgstr \"Uninstalling %s, tool is no more required\"\n\n#: commands/instances.go:1037\nmsgid \"Uninstalling %s: tool is no more required\"\nmsgstr \"Uninstalling %s: tool is no more required\"\n\n#: cli/core/uninstall.go:37\n#: cli/core/uninstall.go:38\nmsgid \"Uninstalls one or more cores and corresponding tool dependencies if no longer used.\"\nmsgstr \"Uninstalls one or more cores and corresponding tool dependencies if no longer used.\"\n\n#: cli/lib/uninstall.go:36\n#: cli/lib/uninstall.go:37\nmsgid \"Uninstalls one or more libraries.\"\nmsgstr \"Uninstalls one or more libraries.\"\n\n#: cli/board/list.go:161\nmsgid \"Unknown\"\nmsgstr \"Unknown\"\n\n#: commands/errors.go:142\nmsgid \"Unknown FQBN\"\nmsgstr \"Unknown FQBN\"\n\n#: cli/core/update_index.go:135\nmsgid \"Updated\"\nmsgstr \"Updated\"\n\n#: cli/update/update.go:41\nmsgid \"Updates the index of cores and libraries\"\nmsgstr \"Updates the index of cores and libraries\"\n\n#: cli/update/update.go:42\nmsgid \"Updates the index of cores and libraries to the latest versions.\"\nmsgstr \"Updates the index of cores and libraries to the latest versions.\"\n\n#: cli/core/update_index.go:41\nmsgid \"Updates the index of cores to the latest version.\"\nmsgstr \"Updates the index of cores to the latest version.\"\n\n#: cli/core/update_index.go:40\nmsgid \"Updates the index of cores.\"\nmsgstr \"Updates the index of cores.\"\n\n#: cli/lib/update_index.go:36\nmsgid \"Updates the libraries index to the latest version.\"\nmsgstr \"Updates the libraries index to the latest version.\"\n\n#: cli/lib/update_index.go:35\nmsgid \"Updates the libraries index.\"\nmsgstr \"Updates the libraries index.\"\n\n#: commands/instances.go:489\n#: commands/instances.go:586\n#: commands/instances.go:594\n#: commands/instances.go:652\nmsgid \"Updating index: %s\"\nmsgstr \"Updating index: %s\"\n\n#: commands/instances.go:407\n#: commands/instances.go:427\n#: commands/instances.go:429\nmsgid \"Updating index: library_index.json.gz\"\nmsgstr \"Updating index: library_index.json.gz\"\n\n#: commands/instances.go:436\nmsgid \"Updating index: library_index.json.sig\"\nmsgstr \"Updating index: library_index.json.sig\"\n\n#: commands/instances.go:994\nmsgid \"Updating platform %s\"\nmsgstr \"Updating platform %s\"\n\n#: commands/core/upgrade.go:54\nmsgid \"Upgrade doesn't accept parameters with version\"\nmsgstr \"Upgrade doesn't accept parameters with version\"\n\n#: cli/upgrade/upgrade.go:40\nmsgid \"Upgrades installed cores and libraries to latest version.\"\nmsgstr \"Upgrades installed cores and libraries to latest version.\"\n\n#: cli/upgrade/upgrade.go:39\nmsgid \"Upgrades installed cores and libraries.\"\nmsgstr \"Upgrades installed cores and libraries.\"\n\n#: cli/lib/upgrade.go:33\nmsgid \"Upgrades installed libraries.\"\nmsgstr \"Upgrades installed libraries.\"\n\n#: cli/core/upgrade.go:39\n#: cli/core/upgrade.go:40\nmsgid \"Upgrades one or all installed platforms to the latest version.\"\nmsgstr \"Upgrades one or all installed platforms to the latest version.\"\n\n#: commands/core/install.go:108\nmsgid \"Upgrading platform %[1]s with %[2]s\"\nmsgstr \"Upgrading platform %[1]s with %[2]s\"\n\n#: cli/upload/upload.go:50\nmsgid \"Upload Arduino sketches.\"\nmsgstr \"Upload Arduino sketches.\"\n\n#: cli/upload/upload.go:51\nmsgid \"Upload Arduino sketches. This does NOT compile the sketch prior to upload.\"\nmsgstr \"Upload Arduino sketches. This does NOT compile the sketch prior to upload.\"\n\n#: cli/arguments/port.go:44\nmsgid \"Upload port address, e.g.: COM3 or /dev/ttyACM2\"\nmsgstr \"Upload port address, e.g.: COM3 or /dev/ttyACM2\"\n\n#: commands/upload/upload.go:428\nmsgid \"Upload port found on %s\"\nmsgstr \"Upload port found on %s\"\n\n#: cli/arguments/port.go:45\nmsgid \"Upload port protocol, e.g: serial\"\nmsgstr \"Upload port protocol, e.g: serial\"\n\n#: cli/compile/compile.go:120\nmsgid \"Upload the binary after the compilation.\"\nmsgstr \"Upload the binary after the compilation.\"\n\n#: cli/burnbootloader/burnbootloader.go:47\nmsgid \"Upload the bootloader on the board using an external programmer.\"\nmsgstr \"Upload the bootloader on the board using an external programmer.\"\n\n#: cli/burnbootloader/burnbootloader.go:46\nmsgid \"Upload the bootloader.\"\nmsgstr \"Upload the bootloader.\"\n\n#: cli/compile/compile.go:407\n#: cli/upload/upload.go:127\nmsgid \"Uploading to specified board using %s protocol requires the following info:\"\nmsgstr \"Uploading to specified board using %s protocol requires the following info:\"\n\n#: cli/usage.go:26\nmsgid \"Usage:\"\nmsgstr \"Usage:\"\n\n#: cli/usage.go:33\nmsgid \"Use %s for more information about a command.\"\nmsgstr \"Use %s for more information about a command.\"\n\n#: cli/arguments/sketch_environment.go:44\nmsgid \"Use the platforms and libraries installed in the %s folder of the sketch: none, overlay (on top of the global ones) or isolated (instead of the global ones).\"\nmsgstr \"Use the platforms and libraries installed in the %s folder of the sketch: none, overlay (on top of the global ones) or isolated (instead of the global ones).\"\n\n#: cli/burnbootloader/burnbootloader.go:57\nmsgid \"Use the specified programmer to upload.\"\nmsgstr \"Use the specified programmer to upload.\"\n\n#: legacy/builder/print_used_and_not_used_libraries.go:51\nmsgid \"Used: {0}\"\nmsgstr \"Used: {0}\"\n\n#: arduino/libraries/librariesmanager/install.go:67\n#: arduino/libraries/librariesmanager/install.go:83\n#: arduino/libraries/librariesmanager/install.go:105\n#: arduino/libraries/librariesmanager/install.go:189\nmsgid \"User directory not set\"\nmsgstr \"User directory not set\"\n\n#: legacy/builder/target_board_resolver.go:47\nmsgid \"Using board '{0}' from platform in folder: {1}\"\nmsgstr \"Using board '{0}' from platform in folder: {1}\"\n\n#: legacy/builder/builder_utils/compilation_cache.go:273\nmsgid \"Using cached compilation of: {0}\"\nmsgstr \"Using cached compilation of: {0}\"\n\n#: legacy/builder/container_find_includes.go:437\nmsgid \"Using cached library dependencies for file: {0}\"\nmsgstr \"Using cached library dependencies for file: {0}\"\n\n#: legacy/builder/target_board_resolver.go:51\nmsgid \"Using core '{0}' from platform in folder: {1}\"\nmsgstr \"Using core '{0}' from platform in folder: {1}\"\n\n#: legacy/builder/resolve_library.go:68\nmsgid \"Using library %[1]s pinned for %[2]s\"\nmsgstr \"Using library %[1]s pinned for %[2]s\"\n\n#: legacy/builder/print_used_libraries_if_verbose.go:48\nmsgid \"Using library {0} at version {1} in folder: {2} {3}\"\nmsgstr \"Using library {0} at version {1} in folder: {2} {3}\"\n\n#: legacy/builder/print_used_libraries_if_verbose.go:42\nmsgid \"Using library {0} in folder: {1} {2}\"\nmsgstr \"Using library {0} in folder: {1} {2}\"\n\n#: legacy/builder/phases/core_builder.go:107\nmsgid \"Using precompiled core: {0}\"\nmsgstr \"Using precompiled core: {0}\"\n\n#: legacy/builder/phases/libraries_builder.go:98\n#: legacy/builder/phases/libraries_builder.go:106\nmsgid \"Using precompiled library in {0}\"\nmsgstr \"Using precompiled library in {0}\"\n\n#: legacy/builder/builder_utils/utils.go:273\n#: legacy/builder/builder_utils/utils.go:514\nmsgid \"Using previously compiled file: {0}\"\nmsgstr \"Using previously compiled file: {0}\"\n\n#: cli/core/download.go:36\n#: cli/core/install.go:37\nmsgid \"VERSION\"\nmsgstr \"VERSION\"\n\n#: cli/lib/check_deps.go:34\n#: cli/lib/install.go:38\nmsgid \"VERSION_NUMBER\"\nmsgstr \"VERSION_NUMBER\"\n\n#: cli/monitor/monitor.go:166\nmsgid \"Values\"\nmsgstr \"Values\"\n\n#: cli/burnbootloader/burnbootloader.go:55\n#: cli/compile/compile.go:123\n#: cli/upload/upload.go:63\nmsgid \"Verify uploaded binary after the upload.\"\nmsgstr \"Verify uploaded binary after the upload.\"\n\n#: cli/core/search.go:114\nmsgid \"Version\"\nmsgstr \"Version\"\n\n#: cli/lib/search.go:173\nmsgid \"Versions: %s\"\nmsgstr \"Versions: %s\"\n\n#: commands/core/install.go:163\nmsgid \"WARNING cannot configure platform: %s\"\nmsgstr \"WARNING cannot configure platform: %s\"\n\n#: commands/instances.go:1054\nmsgid \"WARNING: cannot run post install: %s\"\nmsgstr \"WARNING: cannot run post install: %s\"\n\n#: legacy/builder/warn_about_arch_incompatible_libraries.go:46\nmsgid \"WARNING: library {0} claims to run on {1} architecture(s) and may be incompatible with your current board which runs on {2} architecture(s).\"\nmsgstr \"WARNING: library {0} claims to run on {1} architecture(s) and may be incompatible with your current board which runs on {2} architecture(s).\"\n\n#: cli/debug/debug.go:173\nmsgid \"Waiting for a Debug Adapter Protocol client on %s\"\nmsgstr \"Waiting for a Debug Adapter Protocol client on %s\"\n\n#: commands/upload/upload.go:417\nmsgid \"Waiting for upload port...\"\nmsgstr \"Waiting for upload port...\"\n\n#: legacy/builder/add_build_board_property_if_missing.go:41\nmsgid \"Warning: Board {0}:{1}:{2} doesn''t define a %s preference. Auto-set to: {3}\"\nmsgstr \"Warning: Board {0}:{1}:{2} doesn''t define a %s preference. Auto-set to: {3}\"\n\n#: legacy/builder/warn_about_platform_rewrites.go:47\nmsgid \"Warning: platform.txt from core '{0}' contains deprecated {1}, automatically converted to {2}. Consider upgrading this core.\"\nmsgstr \"Warning: platform.txt from core '{0}' contains deprecated {1}, automatically converted to {2}. Consider upgrading this core.\"\n\n#: commands/upload/upload.go:313\nmsgid \"Warning: tool '%s' is not installed. It might not be available for your OS.\"\nmsgstr \"Warning: tool '%s' is not installed. It might not be available for your OS.\"\n\n#: cli/compile/watch.go:107\nmsgid \"Watching %s for changes, press CTRL-C to exit.\"\nmsgstr \"Watching %s for changes, press CTRL-C to exit.\"\n\n#: cli/lib/search.go:166\nmsgid \"Website: %s\"\nmsgstr \"Website: %s\"\n\n#: cli/compile/compile.go:124\nmsgid \"When specified, VID/PID specific build properties are used, if board supports them.\"\nmsgstr \"When specified, VID/PID specific build properties are used, if board supports them.\"\n\n#: cli/debug/debug.go:77\nmsgid \"Write the debug configuration for an editor into the sketch folder instead of starting the debugger: %s\"\nmsgstr \"Write the debug configuration for an editor into the sketch folder instead of starting the debugger: %s\"\n\n#: cli/config/init.go:41\nmsgid \"Writes current configuration to a configuration file.\"\nmsgstr \"Writes current configuration to a configuration file.\"\n\n#: cli/config/init.go:44\nmsgid \"Writes current configuration to the configuration file in the data directory.\"\nmsgstr \"Writes current configuration to the configuration file in the data directory.\"\n\n#: cli/config/set.go:76\nmsgid \"Writing config file: %v\"\nmsgstr \"Writing config file: %v\"\n\n#: commands/debug/export.go:329\nmsgid \"after starting the GDB server with: %s\"\nmsgstr \"after starting the GDB server with: %s\"\n\n#: commands/bundle/import.go:147\n#: commands/bundle/import.go:157\nmsgid \"archive %s is not in the bundle\"\nmsgstr \"archive %s is not in the bundle\"\n\n#: arduino/resources/checksums.go:80\nmsgid \"archive hash differs from hash in index\"\nmsgstr \"archive hash differs from hash in index\"\n\n#: arduino/libraries/librariesmanager/install.go:136\nmsgid \"archive is not valid: multiple files found in zip file top level\"\nmsgstr \"archive is not valid: multiple files found in zip file top level\"\n\n#: cli/sketch/archive.go:38\nmsgid \"archivePath\"\nmsgstr \"archivePath\"\n\n#: legacy/builder/preprocess_sketch.go:103\nmsgid \"arduino-preprocessor pattern is missing\"\nmsgstr \"arduino-preprocessor pattern is missing\"\n\n#: commands/upload/upload.go:574\nmsgid \"autodetect build artifact: %s\"\nmsgstr \"autodetect build artifact: %s\"\n\n#: commands/upload/upload.go:559\nmsgid \"binary file not found in %s\"\nmsgstr \"binary file not found in %s\"\n\n#: arduino/cores/packagemanager/package_manager.go:188\nmsgid \"board %s:%s not found\"\nmsgstr \"board %s:%s not found\"\n\n#: commands/board/list.go:42\nmsgid \"board not found\"\nmsgstr \"board not found\"\n\n#: cli/board/listall.go:35\n#: cli/board/search.go:36\nmsgid \"boardname\"\nmsgstr \"boardname\"\n\n#: arduino/buildcache/http.go:90\nmsgid \"cache server replied %[1]s to GET %[2]s\"\nmsgstr \"cache server replied %[1]s to GET %[2]s\"\n\n#: arduino/buildcache/http.go:117\nmsgid \"cache server replied %[1]s to PUT %[2]s\"\nmsgstr \"cache server replied %[1]s to PUT %[2]s\"\n\n#: arduino/discovery/discovery.go:297\n#: arduino/discovery/discovery.go:318\n#: arduino/discovery/discovery.go:338\n#: arduino/discovery/discovery.go:361\n#: arduino/discovery/discovery.go:384\n#: arduino/discovery/discovery.go:407\n#: arduino/monitor/monitor.go:298\n#: arduino/monitor/monitor.go:315\n#: arduino/monitor/monitor.go:329\n#: arduino/monitor/monitor.go:349\n#: arduino/monitor/monitor.go:371\n#: arduino/monitor/monitor.go:389\nmsgid \"calling %[1]s: %[2]w\"\nmsgstr \"calling %[1]s: %[2]w\"\n\n#: arduino/cores/status.go:123\nmsgid \"can't find latest release of %s\"\nmsgstr \"can't find latest release of %s\"\n\n#: arduino/sketch/sketch.go:118\nmsgid \"can't find main Sketch file in %s\"\nmsgstr \"can't find main Sketch file in %s\"\n\n#: arduino/cores/packagemanager/loader.go:768\nmsgid \"can't find pattern for discovery with id %s\"\nmsgstr \"can't find pattern for discovery with id %s\"\n\n#: executils/output.go:52\nmsgid \"can't retrieve standard error stream: %s\"\nmsgstr \"can't retrieve standard error stream: %s\"\n\n#: executils/output.go:34\nmsgid \"can't retrieve standard output stream: %s\"\nmsgstr \"can't retrieve standard output stream: %s\"\n\n#: legacy/builder/resolve_library.go:37\nmsgid \"candidates\"\nmsgstr \"candidates\"\n\n#: commands/debug/dap.go:422\nmsgid \"cannot connect to the board: %s\"\nmsgstr \"cannot connect to the board: %s\"\n\n#: commands/upload/upload.go:516\n#: commands/upload/upload.go:523\nmsgid \"cannot execute upload tool: %s\"\nmsgstr \"cannot execute upload tool: %s\"\n\n#: arduino/monitors/serial.go:283\nmsgid \"changing serial port settings\"\nmsgstr \"changing serial port settings\"\n\n#: arduino/resources/install.go:39\nmsgid \"checking local archive integrity\"\nmsgstr \"checking local archive integrity\"\n\n#: legacy/builder/wipeout_build_path_if_build_options_changed.go:85\n#: legacy/builder/wipeout_build_path_if_build_options_changed.go:89\nmsgid \"cleaning build path\"\nmsgstr \"cleaning build path\"\n\n#: cli/cli.go:76\nmsgid \"command\"\nmsgstr \"command\"\n\n#: arduino/monitor/monitor.go:225\nmsgid \"command '%[1]s' failed: %[2]s\"\nmsgstr \"command '%[1]s' failed: %[2]s\"\n\n#: arduino/discovery/discovery.go:301\n#: arduino/discovery/discovery.go:322\n#: arduino/discovery/discovery.go:342\n#: arduino/discovery/discovery.go:365\n#: arduino/discovery/discovery.go:388\n#: arduino/discovery/discovery.go:411\nmsgid \"command failed: %s\"\nmsgstr \"command failed: %s\"\n\n#: arduino/monitor/monitor.go:222\n#: arduino/monitor/monitor.go:228\nmsgid \"communication out of sync, expected '%[1]s', received '%[2]s'\"\nmsgstr \"communication out of sync, expected '%[1]s', received '%[2]s'\"\n\n#: arduino/discovery/discovery.go:299\nmsgid \"communication out of sync, expected 'hello', received '%s'\"\nmsgstr \"communication out of sync, expected 'hello', received '%s'\"\n\n#: arduino/discovery/discovery.go:386\nmsgid \"communication out of sync, expected 'list', received '%s'\"\nmsgstr \"communication out of sync, expected 'list', received '%s'\"\n\n#: arduino/discovery/discovery.go:363\nmsgid \"communication out of sync, expected 'quit', received '%s'\"\nmsgstr \"communication out of sync, expected 'quit', received '%s'\"\n\n#: arduino/discovery/discovery.go:320\nmsgid \"communication out of sync, expected 'start', received '%s'\"\nmsgstr \"communication out of sync, expected 'start', received '%s'\"\n\n#: arduino/discovery/discovery.go:409\nmsgid \"communication out of sync, expected 'start_sync', received '%s'\"\nmsgstr \"communication out of sync, expected 'start_sync', received '%s'\"\n\n#: arduino/discovery/discovery.go:340\nmsgid \"communication out of sync, expected 'stop', received '%s'\"\nmsgstr \"communication out of sync, expected 'stop', received '%s'\"\n\n#: arduino/resources/checksums.go:76\nmsgid \"computing hash: %s\"\nmsgstr \"computing hash: %s\"\n\n#: commands/upload/upload.go:631\nmsgid \"could not find a valid build artifact\"\nmsgstr \"could not find a valid build artifact\"\n\n#: arduino/cores/packageindex/cache.go:129\nmsgid \"creating directory %[1]s: %[2]s\"\nmsgstr \"creating directory %[1]s: %[2]s\"\n\n#: arduino/cores/packagemanager/loader.go:705\nmsgid \"creating discovery: %s\"\nmsgstr \"creating discovery: %s\"\n\n#: arduino/resources/index.go:141\n#: arduino/resources/index.go:148\nmsgid \"creating index file: %s\"\nmsgstr \"creating index file: %s\"\n\n#: arduino/cores/packagemanager/install_uninstall.go:45\nmsgid \"creating installed.json in %[1]s: %[2]s\"\nmsgstr \"creating installed.json in %[1]s: %[2]s\"\n\n#: arduino/resources/install.go:44\n#: arduino/resources/install.go:48\nmsgid \"creating temp dir for extraction: %s\"\nmsgstr \"creating temp dir for extraction: %s\"\n\n#: legacy/builder/phases/sizer.go:143\nmsgid \"data section exceeds available space in board\"\nmsgstr \"data section exceeds available space in board\"\n\n#: arduino/sketch/matrix.go:56\n#: arduino/sketch/matrix.go:59\n#: arduino/sketch/matrix.go:63\nmsgid \"decoding build matrix %[1]s: %[2]s\"\nmsgstr \"decoding build matrix %[1]s: %[2]s\"\n\n#: arduino/sketch/project.go:165\nmsgid \"decoding sketch lock file %[1]s: %[2]s\"\nmsgstr \"decoding sketch lock file %[1]s: %[2]s\"\n\n#: arduino/sketch/sketch.go:260\nmsgid \"decoding sketch metadata: %s\"\nmsgstr \"decoding sketch metadata: %s\"\n\n#: arduino/sketch/project.go:101\n#: arduino/sketch/project.go:106\n#: arduino/sketch/project.go:110\n#: arduino/sketch/project.go:115\nmsgid \"decoding sketch project file %[1]s: %[2]s\"\nmsgstr \"decoding sketch project file %[1]s: %[2]s\"\n\n#: arduino/resources/index.go:125\n#: arduino/resources/index.go:132\n#: arduino/resources/index.go:145\nmsgid \"decompressing downloaded index: %s\"\nmsgstr \"decompressing downloaded index: %s\"\n\n#: commands/lib/resolve_deps.go:54\nmsgid \"dependency '%s' is not available\"\nmsgstr \"dependency '%s' is not available\"\n\n#: legacy/builder/utils/utils.go:476\nmsgid \"destination already exists\"\nmsgstr \"destination already exists\"\n\n#: arduino/libraries/librariesmanager/install.go:74\nmsgid \"destination dir %s already exists, cannot install\"\nmsgstr \"destination dir %s already exists, cannot install\"\n\n#: cli/cache/info.go:92\nmsgid \"disabled\"\nmsgstr \"disabled\"\n\n#: arduino/discovery/discoverymanager/discoverymanager.go:112\nmsgid \"discovery %[1]s process not started: %[2]w\"\nmsgstr \"discovery %[1]s process not started: %[2]w\"\n\n#: arduino/cores/packagemanager/loader.go:696\nmsgid \"discovery not found: %s\"\nmsgstr \"discovery not found: %s\"\n\n#: arduino/cores/packagemanager/loader.go:700\nmsgid \"discovery not installed: %s\"\nmsgstr \"discovery not installed: %s\"\n\n#: arduino/cores/packagemanager/package_manager.go:492\nmsgid \"discovery release not found: %s\"\nmsgstr \"discovery release not found: %s\"\n\n#: cli/core/download.go:41\n#: cli/core/install.go:42\nmsgid \"download a specific version (in this case 1.6.9).\"\nmsgstr \"download a specific version (in this case 1.6.9).\"\n\n#: cli/core/download.go:40\n#: cli/core/install.go:40\nmsgid \"download the latest version of Arduino SAMD core.\"\nmsgstr \"download the latest version of Arduino SAMD core.\"\n\n#: commands/instances.go:130\nmsgid \"downloading %[1]s tool: %[2]s\"\nmsgstr \"downloading %[1]s tool: %[2]s\"\n\n#: arduino/resources/helpers.go:117\nmsgid \"downloading %[1]s: server responded with: %[2]s\"\nmsgstr \"downloading %[1]s: server responded with: %[2]s\"\n\n#: arduino/cores/fqbn.go:48\nmsgid \"empty board identifier\"\nmsgstr \"empty board identifier\"\n\n#: cli/cache/info.go:94\nmsgid \"enabled\"\nmsgstr \"enabled\"\n\n#: arduino/cores/packageindex/boards_db.go:165\nmsgid \"encoding boards database: %s\"\nmsgstr \"encoding boards database: %s\"\n\n#: arduino/cores/packageindex/cache.go:126\nmsgid \"encoding index metadata: %s\"\nmsgstr \"encoding index metadata: %s\"\n\n#: arduino/resources/index.go:66\nmsgid \"encoding index validators: %s\"\nmsgstr \"encoding index validators: %s\"\n\n#: arduino/libraries/librariesindex/json.go:121\nmsgid \"encoding library_index.json: %s\"\nmsgstr \"encoding library_index.json: %s\"\n\n#: arduino/sketch/project.go:174\nmsgid \"encoding sketch lock file: %s\"\nmsgstr \"encoding sketch lock file: %s\"\n\n#: arduino/sketch/sketch.go:249\nmsgid \"encoding sketch metadata: %s\"\nmsgstr \"encoding sketch metadata: %s\"\n\n#: arduino/monitors/serial.go:233\nmsgid \"error opening serial monitor\"\nmsgstr \"error opening serial monitor\"\n\n#: cli/config/set.go:68\nmsgid \"error parsing value: %v\"\nmsgstr \"error parsing value: %v\"\n\n#: commands/board/list.go:88\nmsgid \"error processing response from server\"\nmsgstr \"error processing response from server\"\n\n#: commands/board/list.go:103\nmsgid \"error querying Arduino Cloud Api\"\nmsgstr \"error querying Arduino Cloud Api\"\n\n#: cli/upload/upload.go:73\nmsgid \"error: %s and %s flags cannot be used together\"\nmsgstr \"error: %s and %s flags cannot be used together\"\n\n#: arduino/gdbmi/record.go:182\nmsgid \"expected '%c'\"\nmsgstr \"expected '%c'\"\n\n#: cli/compile/compile.go:476\n#: cli/compile/compile.go:488\nmsgid \"expected HEADER=LIBRARY[@VERSION][:PATH]\"\nmsgstr \"expected HEADER=LIBRARY[@VERSION][:PATH]\"\n\n#: arduino/gdbmi/record.go:269\nmsgid \"expected a value\"\nmsgstr \"expected a value\"\n\n#: arduino/resources/install.go:67\nmsgid \"extracting archive: %s\"\nmsgstr \"extracting archive: %s\"\n\n#: arduino/libraries/librariesmanager/install.go:124\nmsgid \"extracting archive: %w\"\nmsgstr \"extracting archive: %w\"\n\n#: arduino/resources/checksums.go:145\nmsgid \"failed to compute hash of file \\\"%s\\\"\"\nmsgstr \"failed to compute hash of file \\\"%s\\\"\"\n\n#: commands/board/list.go:71\nmsgid \"failed to initialize http client\"\nmsgstr \"failed to initialize http client\"\n\n#: arduino/resources/checksums.go:97\nmsgid \"fetched archive size differs from size specified in index\"\nmsgstr \"fetched archive size differs from size specified in index\"\n\n#: arduino/resources/install.go:132\nmsgid \"files in archive must be placed in a subdirectory\"\nmsgstr \"files in archive must be placed in a subdirectory\"\n\n#: arduino/cores/packagemanager/loader.go:66\nmsgid \"find abs path: %s\"\nmsgstr \"find abs path: %s\"\n\n#: commands/daemon/monitor.go:47\nmsgid \"first message must contain monitor configuration, not data\"\nmsgstr \"first message must contain monitor configuration, not data\"\n\n#: cli/cli.go:76\nmsgid \"flags\"\nmsgstr \"flags\"\n\n#: arduino/cores/packagemanager/loader.go:108\nmsgid \"following possible symlink %[1]s: %[2]s\"\nmsgstr \"following possible symlink %[1]s: %[2]s\"\n\n#: cli/lib/download.go:39\nmsgid \"for a specific version.\"\nmsgstr \"for a specific version.\"\n\n#: cli/lib/check_deps.go:38\n#: cli/lib/download.go:38\n#: cli/lib/install.go:42\nmsgid \"for the latest version.\"\nmsgstr \"for the latest version.\"\n\n#: cli/lib/check_deps.go:39\n#: cli/lib/install.go:43\nmsgid \"for the specific version.\"\nmsgstr \"for the specific version.\"\n\n#: inventory/inventory.go:68\nmsgid \"generating installation.id: %w\"\nmsgstr \"generating installation.id: %w\"\n\n#: inventory/inventory.go:74\nmsgid \"generating installation.secret: %w\"\nmsgstr \"generating installation.secret: %w\"\n\n#: arduino/resources/helpers.go:58\nmsgid \"getting archive file info: %s\"\nmsgstr \"getting archive file info: %s\"\n\n#: arduino/resources/checksums.go:94\nmsgid \"getting archive info: %s\"\nmsgstr \"getting archive info: %s\"\n\n#: arduino/resources/checksums.go:67\n#: arduino/resources/checksums.go:90\n#: arduino/resources/helpers.go:41\n#: arduino/resources/helpers.go:52\n#: arduino/resources/install.go:55\nmsgid \"getting archive path: %s\"\nmsgstr \"getting archive path: %s\"\n\n#: arduino/cores/packagemanager/package_manager.go:194\nmsgid \"getting build properties for board %[1]s: %[2]s\"\nmsgstr \"getting build properties for board %[1]s: %[2]s\"\n\n#: arduino/cores/packagemanager/download.go:103\nmsgid \"getting discovery dependencies for platform %[1]s: %[2]s\"\nmsgstr \"getting discovery dependencies for platform %[1]s: %[2]s\"\n\n#: arduino/cores/packagemanager/loader.go:649\nmsgid \"getting parent dir of %[1]s: %[2]s\"\nmsgstr \"getting parent dir of %[1]s: %[2]s\"\n\n#: arduino/cores/packagemanager/download.go:96\nmsgid \"getting tool dependencies for platform %[1]s: %[2]s\"\nmsgstr \"getting tool dependencies for platform %[1]s: %[2]s\"\n\n#: commands/compile/library_overrides.go:36\nmsgid \"header and library name are required\"\nmsgstr \"header and library name are required\"\n\n#: arduino/sketch/sketch.go:168\nmsgid \"importing sketch metadata: %s\"\nmsgstr \"importing sketch metadata: %s\"\n\n#: arduino/libraries/librariesmanager/install.go:91\nmsgid \"install directory not set\"\nmsgstr \"install directory not set\"\n\n#: commands/instances.go:134\nmsgid \"installing %[1]s tool: %[2]s\"\nmsgstr \"installing %[1]s tool: %[2]s\"\n\n#: arduino/cores/packagemanager/install_uninstall.go:37\nmsgid \"installing platform %[1]s: %[2]s\"\nmsgstr \"installing platform %[1]s: %[2]s\"\n\n#: arduino/discovery/discovery.go:184\nmsgid \"invalid 'add' message: missing port\"\nmsgstr \"invalid 'add' message: missing port\"\n\n#: arduino/monitor/monitor.go:318\nmsgid \"invalid 'describe' message: missing port description\"\nmsgstr \"invalid 'describe' message: missing port description\"\n\n#: arduino/discovery/discovery.go:195\nmsgid \"invalid 'remove' message: missing port\"\nmsgstr \"invalid 'remove' message: missing port\"\n\n#: arduino/gdbmi/record.go:177\nmsgid \"invalid GDB/MI record at column %d\"\nmsgstr \"invalid GDB/MI record at column %d\"\n\n#: arduino/cores/packageindex/boards_db.go:153\nmsgid \"invalid boards database %[1]s: %[2]s\"\nmsgstr \"invalid boards database %[1]s: %[2]s\"\n\n#: arduino/buildcache/http.go:155\n#: arduino/buildcache/http.go:165\n#: arduino/buildcache/http.go:168\n#: arduino/buildcache/http.go:172\nmsgid \"invalid cache entry: %s\"\nmsgstr \"invalid cache entry: %s\"\n\n#: arduino/buildcache/buildcache.go:66\n#: arduino/buildcache/http.go:69\nmsgid \"invalid cache key: %s\"\nmsgstr \"invalid cache key: %s\"\n\n#: arduino/buildcache/http.go:48\n#: arduino/buildcache/http.go:51\nmsgid \"invalid cache server URL: %s\"\nmsgstr \"invalid cache server URL: %s\"\n\n#: arduino/resources/checksums.go:45\nmsgid \"invalid checksum format: %s\"\nmsgstr \"invalid checksum format: %s\"\n\n#: arduino/cores/fqbn.go:54\n#: arduino/cores/fqbn.go:59\nmsgid \"invalid config option: %s\"\nmsgstr \"invalid config option: %s\"\n\n#: cli/arguments/reference.go:82\nmsgid \"invalid empty core architecture '%s'\"\nmsgstr \"invalid empty core architecture '%s'\"\n\n#: cli/arguments/reference.go:58\nmsgid \"invalid empty core argument\"\nmsgstr \"invalid empty core argument\"\n\n#: cli/arguments/reference.go:78\nmsgid \"invalid empty core name '%s'\"\nmsgstr \"invalid empty core name '%s'\"\n\n#: cli/arguments/reference.go:62\nmsgid \"invalid empty core reference '%s'\"\nmsgstr \"invalid empty core reference '%s'\"\n\n#: cli/arguments/reference.go:67\nmsgid \"invalid empty core version: '%s'\"\nmsgstr \"invalid empty core version: '%s'\"\n\n#: cli/lib/args.go:49\nmsgid \"invalid empty library name\"\nmsgstr \"invalid empty library name\"\n\n#: cli/lib/args.go:54\nmsgid \"invalid empty library version: %s\"\nmsgstr \"invalid empty library version: %s\"\n\n#: arduino/cores/board.go:123\nmsgid \"invalid empty option found\"\nmsgstr \"invalid empty option found\"\n\n#: arduino/libraries/librariesmanager/install.go:255\nmsgid \"invalid git url\"\nmsgstr \"invalid git url\"\n\n#: arduino/resources/checksums.go:49\nmsgid \"invalid hash '%[1]s': %[2]s\"\nmsgstr \"invalid hash '%[1]s': %[2]s\"\n\n#: arduino/cores/packageindex/cache.go:94\nmsgid \"invalid index metadata %[1]s: %[2]s\"\nmsgstr \"invalid index metadata %[1]s: %[2]s\"\n\n#: arduino/resources/index.go:57\nmsgid \"invalid index validators %[1]s: %[2]s\"\nmsgstr \"invalid index validators %[1]s: %[2]s\"\n\n#: cli/arguments/reference.go:75\nmsgid \"invalid item %s\"\nmsgstr \"invalid item %s\"\n\n#: commands/debug/dap.go:382\nmsgid \"invalid launch arguments: %s\"\nmsgstr \"invalid launch arguments: %s\"\n\n#: arduino/libraries/libraries_layout.go:53\nmsgid \"invalid library layout value: %d\"\nmsgstr \"invalid library layout value: %d\"\n\n#: arduino/libraries/libraries_layout.go:68\nmsgid \"invalid library layout: %s\"\nmsgstr \"invalid library layout: %s\"\n\n#: arduino/libraries/libraries_location.go:73\nmsgid \"invalid library location value: %d\"\nmsgstr \"invalid library location value: %d\"\n\n#: arduino/libraries/libraries_location.go:94\nmsgid \"invalid library location: %s\"\nmsgstr \"invalid library location: %s\"\n\n#: commands/debug/dap.go:777\nmsgid \"invalid memory address: %s\"\nmsgstr \"invalid memory address: %s\"\n\n#: commands/debug/dap.go:787\nmsgid \"invalid memory contents: %s\"\nmsgstr \"invalid memory contents: %s\"\n\n#: arduino/cores/board.go:125\nmsgid \"invalid option '%s'\"\nmsgstr \"invalid option '%s'\"\n\n#: inventory/inventory.go:88\nmsgid \"invalid path creating config dir: %[1]s error: %[2]w\"\nmsgstr \"invalid path creating config dir: %[1]s error: %[2]w\"\n\n#: inventory/inventory.go:94\nmsgid \"invalid path writing inventory file: %[1]s error: %[2]w\"\nmsgstr \"invalid path writing inventory file: %[1]s error: %[2]w\"\n\n#: arduino/cores/packageindex/index.go:287\nmsgid \"invalid platform archive size: %s\"\nmsgstr \"invalid platform archive size: %s\"\n\n#: arduino/sketch/project.go:60\nmsgid \"invalid platform identifier: %s\"\nmsgstr \"invalid platform identifier: %s\"\n\n#: commands/upload/upload.go:503\nmsgid \"invalid recipe '%[1]s': %[2]s\"\nmsgstr \"invalid recipe '%[1]s': %[2]s\"\n\n#: arduino/gdbmi/record.go:163\nmsgid \"invalid record type '%c'\"\nmsgstr \"invalid record type '%c'\"\n\n#: arduino/monitors/serial.go:138\nmsgid \"invalid setting: %s\"\nmsgstr \"invalid setting: %s\"\n\n#: arduino/buildcache/buildcache.go:248\nmsgid \"invalid size: %s\"\nmsgstr \"invalid size: %s\"\n\n#: commands/compile/test.go:245\nmsgid \"invalid test: %s\"\nmsgstr \"invalid test: %s\"\n\n#: arduino/sizereport/compare.go:161\n#: arduino/sizereport/compare.go:167\nmsgid \"invalid threshold: %s\"\nmsgstr \"invalid threshold: %s\"\n\n#: commands/daemon/monitor.go:224\nmsgid \"invalid type for %s in serial monitor configuration\"\nmsgstr \"invalid type for %s in serial monitor configuration\"\n\n#: arduino/cores/board.go:109\nmsgid \"invalid value '%[1]s' for option '%[2]s'\"\nmsgstr \"invalid value '%[1]s' for option '%[2]s'\"\n\n#: arduino/monitors/serial.go:95\nmsgid \"invalid value for setting %[1]s: %[2]s\"\nmsgstr \"invalid value for setting %[1]s: %[2]s\"\n\n#: commands/compile/library_overrides.go:48\nmsgid \"invalid version %[1]s for library %[2]s: %[3]s\"\nmsgstr \"invalid version %[1]s for library %[2]s: %[3]s\"\n\n#: arduino/cores/packagemanager/loader.go:280\nmsgid \"invalid version dir %[1]s: %[2]s\"\nmsgstr \"invalid version dir %[1]s: %[2]s\"\n\n#: commands/daemon/settings.go:108\nmsgid \"key not found in settings\"\nmsgstr \"key not found in settings\"\n\n#: cli/core/search.go:48\nmsgid \"keywords\"\nmsgstr \"keywords\"\n\n#: legacy/builder/resolve_library.go:54\nmsgid \"library %[1]s is pinned to provide %[2]s, but library %[3]s providing %[2]s too is already in use\"\nmsgstr \"library %[1]s is pinned to provide %[2]s, but library %[3]s providing %[2]s too is already in use\"\n\n#: arduino/libraries/librariesresolver/overrides.go:81\nmsgid \"library %[1]s, required to provide %[2]s, not found: %[2]s is provided by %[3]s\"\nmsgstr \"library %[1]s, required to provide %[2]s, not found: %[2]s is provided by %[3]s\"\n\n#: arduino/libraries/librariesresolver/overrides.go:78\nmsgid \"library %[1]s, required to provide %[2]s, not found: no installed library provides %[2]s\"\nmsgstr \"library %[1]s, required to provide %[2]s, not found: no installed library provides %[2]s\"\n\n#: arduino/libraries/librariesmanager/install.go:162\n#: arduino/libraries/librariesmanager/install.go:205\nmsgid \"library %s already installed\"\nmsgstr \"library %s already installed\"\n\n#: arduino/libraries/librariesmanager/install.go:37\nmsgid \"library already installed\"\nmsgstr \"library already installed\"\n\n#: arduino/libraries/librariesmanager/install.go:274\nmsgid \"library is not valid: missing file \\\"library.properties\\\"\"\nmsgstr \"library is not valid: missing file \\\"library.properties\\\"\"\n\n#: arduino/libraries/librariesmanager/install.go:269\nmsgid \"library is not valid: missing header file \\\"%s\\\"\"\nmsgstr \"library is not valid: missing header file \\\"%s\\\"\"\n\n#: arduino/libraries/librariesmanager/librariesmanager.go:229\nmsgid \"library path does not exist: %s\"\nmsgstr \"library path does not exist: %s\"\n\n#: arduino/discovery/discoverymanager/discoverymanager.go:222\nmsgid \"listing ports from discovery %[1]s: %[2]w\"\nmsgstr \"listing ports from discovery %[1]s: %[2]w\"\n\n#: arduino/serialutils/serialutils.go:61\nmsgid \"listing serial ports\"\nmsgstr \"listing serial ports\"\n\n#: arduino/cores/packagemanager/loader.go:307\n#: arduino/cores/packagemanager/loader.go:316\n#: arduino/cores/packagemanager/loader.go:321\nmsgid \"loading %[1]s: %[2]s\"\nmsgstr \"loading %[1]s: %[2]s\"\n\n#: arduino/cores/packagemanager/loader.go:356\nmsgid \"loading boards: %s\"\nmsgstr \"loading boards: %s\"\n\n#: arduino/cores/packagemanager/loader.go:604\nmsgid \"loading bundled tools from %[1]s: %[2]s\"\nmsgstr \"loading bundled tools from %[1]s: %[2]s\"\n\n#: arduino/cores/packagemanager/package_manager.go:231\n#: arduino/cores/packagemanager/package_manager.go:256\nmsgid \"loading json index file %[1]s: %[2]s\"\nmsgstr \"loading json index file %[1]s: %[2]s\"\n\n#: arduino/libraries/librariesmanager/librariesmanager.go:208\n#: arduino/libraries/librariesmanager/librariesmanager.go:234\nmsgid \"loading library from %[1]s: %[2]s\"\nmsgstr \"loading library from %[1]s: %[2]s\"\n\n#: arduino/libraries/lint.go:92\n#: arduino/libraries/loader.go:47\nmsgid \"loading library.properties: %s\"\nmsgstr \"loading library.properties: %s\"\n\n#: arduino/cores/packagemanager/loader.go:256\n#: arduino/cores/packagemanager/loader.go:284\nmsgid \"loading platform release %[1]s: %[2]s\"\nmsgstr \"loading platform release %[1]s: %[2]s\"\n\n#: arduino/cores/packagemanager/loader.go:207\nmsgid \"loading platform.txt: %v\"\nmsgstr \"loading platform.txt: %v\"\n\n#: arduino/cores/packagemanager/loader.go:571\nmsgid \"loading tool release in %[1]s: %[2]s\"\nmsgstr \"loading tool release in %[1]s: %[2]s\"\n\n#: arduino/cores/packagemanager/loader.go:200\nmsgid \"looking for boards.txt in %[1]s: %[2]s\"\nmsgstr \"looking for boards.txt in %[1]s: %[2]s\"\n\n#: legacy/builder/container_setup.go:74\nmsgid \"main file missing from sketch\"\nmsgstr \"main file missing from sketch\"\n\n#: arduino/cores/packageindex/cache.go:164\n#: arduino/cores/packageindex/cache.go:167\n#: arduino/cores/packageindex/cache.go:170\n#: arduino/cores/packageindex/cache.go:175\n#: arduino/cores/packageindex/cache.go:184\nmsgid \"migrating index %[1]s: %[2]s\"\nmsgstr \"migrating index %[1]s: %[2]s\"\n\n#: arduino/sketch/matrix.go:63\nmsgid \"missing board FQBN\"\nmsgstr \"missing board FQBN\"\n\n#: arduino/resources/checksums.go:41\nmsgid \"missing checksum for: %s\"\nmsgstr \"missing checksum for: %s\"\n\n#: arduino/sketch/project.go:115\nmsgid \"missing library name\"\nmsgstr \"missing library name\"\n\n#: legacy/builder/builder_utils/compilation_cache.go:189\n#: legacy/builder/builder_utils/compilation_cache.go:199\nmsgid \"missing object file in command line\"\nmsgstr \"missing object file in command line\"\n\n#: arduino/cores/packagemanager/package_manager.go:206\nmsgid \"missing package %[1]s referenced by board %[2]s\"\nmsgstr \"missing package %[1]s referenced by board %[2]s\"\n\n#: arduino/cores/packagemanager/package_manager.go:211\nmsgid \"missing platform %[1]s:%[2]s referenced by board %[3]s\"\nmsgstr \"missing platform %[1]s:%[2]s referenced by board %[3]s\"\n\n#: arduino/cores/packagemanager/package_manager.go:216\nmsgid \"missing platform release %[1]s:%[2]s referenced by board %[3]s\"\nmsgstr \"missing platform release %[1]s:%[2]s referenced by board %[3]s\"\n\n#: arduino/gdbmi/record.go:149\nmsgid \"missing record class\"\nmsgstr \"missing record class\"\n\n#: arduino/gdbmi/record.go:131\nmsgid \"missing record type\"\nmsgstr \"missing record type\"\n\n#: arduino/gdbmi/record.go:210\nmsgid \"missing result name\"\nmsgstr \"missing result name\"\n\n#: commands/monitor/monitor.go:284\nmsgid \"monitor not found: %s\"\nmsgstr \"monitor not found: %s\"\n\n#: commands/monitor/monitor.go:288\nmsgid \"monitor not installed: %s\"\nmsgstr \"monitor not installed: %s\"\n\n#: arduino/libraries/librariesmanager/install.go:179\n#: arduino/resources/install.go:94\nmsgid \"moving extracted archive to destination dir: %s\"\nmsgstr \"moving extracted archive to destination dir: %s\"\n\n#: commands/upload/upload.go:626\nmsgid \"multiple build artifacts found: '%[1]s' and '%[2]s'\"\nmsgstr \"multiple build artifacts found: '%[1]s' and '%[2]s'\"\n\n#: arduino/sketch/sketch.go:90\nmsgid \"multiple main sketch files found (%[1]v, %[2]v)\"\nmsgstr \"multiple main sketch files found (%[1]v, %[2]v)\"\n\n#: arduino/sketch/matrix.go:59\nmsgid \"no boards\"\nmsgstr \"no boards\"\n\n#: arduino/cores/packagemanager/install_uninstall.go:127\nmsgid \"no compatible version of %s tools found for the current os\"\nmsgstr \"no compatible version of %s tools found for the current os\"\n\n#: executils/process.go:37\nmsgid \"no executable specified\"\nmsgstr \"no executable specified\"\n\n#: commands/daemon/daemon.go:99\nmsgid \"no instance specified\"\nmsgstr \"no instance specified\"\n\n#: commands/upload/upload.go:581\nmsgid \"no sketch or build directory/file specified\"\nmsgstr \"no sketch or build directory/file specified\"\n\n#: arduino/resources/install.go:128\nmsgid \"no unique root dir in archive, found '%[1]s' and '%[2]s'\"\nmsgstr \"no unique root dir in archive, found '%[1]s' and '%[2]s'\"\n\n#: commands/upload/upload.go:498\nmsgid \"no upload port provided\"\nmsgstr \"no upload port provided\"\n\n#: arduino/sketch/sketch.go:312\nmsgid \"no valid sketch found in %[1]s: missing %[2]s\"\nmsgstr \"no valid sketch found in %[1]s: missing %[2]s\"\n\n#: commands/core/download.go:105\nmsgid \"no versions available for the current OS\"\nmsgstr \"no versions available for the current OS\"\n\n#: arduino/resources/checksums.go:72\n#: arduino/resources/install.go:59\nmsgid \"opening archive file: %s\"\nmsgstr \"opening archive file: %s\"\n\n#: arduino/cores/packagemanager/loader.go:273\nmsgid \"opening boards.txt: %s\"\nmsgstr \"opening boards.txt: %s\"\n\n#: arduino/resources/index.go:118\nmsgid \"opening downloaded index: %s\"\nmsgstr \"opening downloaded index: %s\"\n\n#: arduino/serialutils/serialutils.go:37\nmsgid \"opening port at 1200bps\"\nmsgstr \"opening port at 1200bps\"\n\n#: arduino/security/signatures.go:135\nmsgid \"opening signature file: %s\"\nmsgstr \"opening signature file: %s\"\n\n#: arduino/security/signatures.go:130\nmsgid \"opening target file: %s\"\nmsgstr \"opening target file: %s\"\n\n#: arduino/cores/packagemanager/download.go:73\n#: arduino/cores/status.go:88\n#: arduino/cores/status.go:113\nmsgid \"package %s not found\"\nmsgstr \"package %s not found\"\n\n#: arduino/cores/packagemanager/package_manager.go:273\nmsgid \"package '%s' not found\"\nmsgstr \"package '%s' not found\"\n\n#: arduino/cores/status.go:167\nmsgid \"package not found\"\nmsgstr \"package not found\"\n\n#: arduino/cores/packagemanager/loader.go:227\nmsgid \"parsing IDE bundled index: %s\"\nmsgstr \"parsing IDE bundled index: %s\"\n\n#: arduino/cores/board.go:139\n#: arduino/cores/packagemanager/package_manager.go:135\nmsgid \"parsing fqbn: %s\"\nmsgstr \"parsing fqbn: %s\"\n\n#: arduino/libraries/librariesindex/json.go:69\nmsgid \"parsing library_index.json: %s\"\nmsgstr \"parsing library_index.json: %s\"\n\n#: arduino/cores/packagemanager/loader.go:189\nmsgid \"path is not a platform directory: %s\"\nmsgstr \"path is not a platform directory: %s\"\n\n#: cli/compile/library_resolutions.go:79\nmsgid \"pinned by a library override\"\nmsgstr \"pinned by a library override\"\n\n#: arduino/cores/packagemanager/download.go:77\nmsgid \"platform %[1]s not found in package %[2]s\"\nmsgstr \"platform %[1]s not found in package %[2]s\"\n\n#: arduino/cores/packagemanager/download.go:89\nmsgid \"platform %s has no available releases\"\nmsgstr \"platform %s has no available releases\"\n\n#: arduino/cores/packagemanager/package_manager.go:181\nmsgid \"platform %s is not installed\"\nmsgstr \"platform %s is not installed\"\n\n#: arduino/cores/packagemanager/install_uninstall.go:65\n#: arduino/cores/packagemanager/install_uninstall.go:108\n#: arduino/cores/packagemanager/loader.go:433\n#: commands/compile/compile.go:165\nmsgid \"platform not installed\"\nmsgstr \"platform not installed\"\n\n#: cli/compile/compile.go:166\nmsgid \"please use --build-property instead.\"\nmsgstr \"please use --build-property instead.\"\n\n#: arduino/discovery/discoverymanager/discoverymanager.go:67\nmsgid \"pluggable discovery already added: %s\"\nmsgstr \"pluggable discovery already added: %s\"\n\n#: cli/board/attach.go:35\nmsgid \"port\"\nmsgstr \"port\"\n\n#: cli/arguments/port.go:122\nmsgid \"port not found: %[1]s %[2]s\"\nmsgstr \"port not found: %[1]s %[2]s\"\n\n#: arduino/discovery/discovery.go:303\n#: arduino/monitor/monitor.go:300\nmsgid \"protocol version not supported: requested 1, got %d\"\nmsgstr \"protocol version not supported: requested 1, got %d\"\n\n#: arduino/discovery/discoverymanager/discoverymanager.go:188\nmsgid \"quitting discovery %[1]s: %[2]w\"\nmsgstr \"quitting discovery %[1]s: %[2]w\"\n\n#: cli/cache/info.go:102\nmsgid \"read only\"\nmsgstr \"read only\"\n\n#: arduino/cores/packagemanager/loader.go:78\nmsgid \"reading %[1]s directory: %[2]s\"\nmsgstr \"reading %[1]s directory: %[2]s\"\n\n#: arduino/cores/packagemanager/loader.go:654\nmsgid \"reading %[1]s: %[2]s\"\nmsgstr \"reading %[1]s: %[2]s\"\n\n#: arduino/sizereport/sizereport.go:95\nmsgid \"reading ELF file %[1]s: %[2]s\"\nmsgstr \"reading ELF file %[1]s: %[2]s\"\n\n#: arduino/cores/packageindex/boards_db.go:149\nmsgid \"reading boards database: %s\"\nmsgstr \"reading boards database: %s\"\n\n#: arduino/sketch/matrix.go:52\nmsgid \"reading build matrix %[1]s: %[2]s\"\nmsgstr \"reading build matrix %[1]s: %[2]s\"\n\n#: arduino/cores/packagemanager/loader.go:267\n#: arduino/libraries/librariesmanager/librariesmanager.go:199\n#: arduino/libraries/lint.go:120\nmsgid \"reading dir %[1]s: %[2]s\"\nmsgstr \"reading dir %[1]s: %[2]s\"\n\n#: arduino/cores/packageindex/cache.go:110\n#: arduino/cores/packagemanager/loader.go:162\n#: arduino/cores/packagemanager/loader.go:562\nmsgid \"reading directory %[1]s: %[2]s\"\nmsgstr \"reading directory %[1]s: %[2]s\"\n\n#: arduino/builder/sketch.go:76\nmsgid \"reading file %[1]s: %[2]s\"\nmsgstr \"reading file %[1]s: %[2]s\"\n\n#: arduino/sketch/sketch.go:282\nmsgid \"reading files: %v\"\nmsgstr \"reading files: %v\"\n\n#: arduino/cores/packageindex/cache.go:90\nmsgid \"reading index metadata: %s\"\nmsgstr \"reading index metadata: %s\"\n\n#: arduino/resources/index.go:53\nmsgid \"reading index validators: %s\"\nmsgstr \"reading index validators: %s\"\n\n#: inventory/inventory.go:58\nmsgid \"reading inventory file: %w\"\nmsgstr \"reading inventory file: %w\"\n\n#: arduino/libraries/librariesresolver/cpp.go:62\nmsgid \"reading lib headers: %s\"\nmsgstr \"reading lib headers: %s\"\n\n#: arduino/libraries/libraries.go:234\nmsgid \"reading lib src dir: %s\"\nmsgstr \"reading lib src dir: %s\"\n\n#: arduino/libraries/libraries.go:114\nmsgid \"reading library headers: %w\"\nmsgstr \"reading library headers: %w\"\n\n#: arduino/libraries/librariesindex/json.go:63\nmsgid \"reading library_index.json: %s\"\nmsgstr \"reading library_index.json: %s\"\n\n#: arduino/resources/install.go:118\nmsgid \"reading package root dir: %s\"\nmsgstr \"reading package root dir: %s\"\n\n#: arduino/security/signatures.go:113\n#: arduino/security/signatures.go:122\nmsgid \"reading public key %[1]s: %[2]s\"\nmsgstr \"reading public key %[1]s: %[2]s\"\n\n#: arduino/sketch/project.go:161\nmsgid \"reading sketch lock file %[1]s: %[2]s\"\nmsgstr \"reading sketch lock file %[1]s: %[2]s\"\n\n#: arduino/sketch/sketch.go:241\nmsgid \"reading sketch metadata %[1]s: %[2]s\"\nmsgstr \"reading sketch metadata %[1]s: %[2]s\"\n\n#: arduino/sketch/project.go:96\nmsgid \"reading sketch project file %[1]s: %[2]s\"\nmsgstr \"reading sketch project file %[1]s: %[2]s\"\n\n#: arduino/sizereport/sizereport.go:133\nmsgid \"reading symbols of %[1]s: %[2]s\"\nmsgstr \"reading symbols of %[1]s: %[2]s\"\n\n#: commands/upload/upload.go:492\nmsgid \"recipe not found '%s'\"\nmsgstr \"recipe not found '%s'\"\n\n#: httpclient/httpclient.go:53\nmsgid \"redirect to local file %s not allowed\"\nmsgstr \"redirect to local file %s not allowed\"\n\n#: arduino/cores/packagemanager/package_manager.go:349\nmsgid \"release %[1]s not found for tool %[2]s\"\nmsgstr \"release %[1]s not found for tool %[2]s\"\n\n#: arduino/cores/status.go:82\n#: arduino/cores/status.go:106\nmsgid \"release cannot be nil\"\nmsgstr \"release cannot be nil\"\n\n#: arduino/cores/status.go:183\nmsgid \"release not found\"\nmsgstr \"release not found\"\n\n#: arduino/resources/helpers.go:66\nmsgid \"removing corrupted archive file: %s\"\nmsgstr \"removing corrupted archive file: %s\"\n\n#: arduino/libraries/librariesmanager/install.go:94\nmsgid \"removing lib directory: %s\"\nmsgstr \"removing lib directory: %s\"\n\n#: arduino/cores/packagemanager/install_uninstall.go:117\nmsgid \"removing platform files: %s\"\nmsgstr \"removing platform files: %s\"\n\n#: arduino/cores/packagemanager/install_uninstall.go:169\nmsgid \"removing tool files: %s\"\nmsgstr \"removing tool files: %s\"\n\n#: arduino/cores/packagemanager/download.go:84\nmsgid \"required version %[1]s not found for platform %[2]s\"\nmsgstr \"required version %[1]s not found for platform %[2]s\"\n\n#: arduino/security/signatures.go:73\n#: arduino/security/signatures.go:96\nmsgid \"retrieving Arduino public keys: %s\"\nmsgstr \"retrieving Arduino public keys: %s\"\n\n#: arduino/libraries/loader.go:109\n#: arduino/libraries/loader.go:140\nmsgid \"scanning examples: %s\"\nmsgstr \"scanning examples: %s\"\n\n#: arduino/cores/packagemanager/loader.go:640\nmsgid \"searching for builtin_tools_versions.txt in %[1]s: %[2]s\"\nmsgstr \"searching for builtin_tools_versions.txt in %[1]s: %[2]s\"\n\n#: arduino/resources/install.go:73\nmsgid \"searching package root dir: %s\"\nmsgstr \"searching package root dir: %s\"\n\n#: arduino/monitors/serial.go:248\n#: arduino/monitors/serial.go:275\nmsgid \"setting DTR\"\nmsgstr \"setting DTR\"\n\n#: arduino/serialutils/serialutils.go:43\nmsgid \"setting DTR to OFF\"\nmsgstr \"setting DTR to OFF\"\n\n#: arduino/monitors/serial.go:251\n#: arduino/monitors/serial.go:279\nmsgid \"setting RTS\"\nmsgstr \"setting RTS\"\n\n#: arduino/sketch/sketch.go:75\nmsgid \"sketch path is not valid\"\nmsgstr \"sketch path is not valid\"\n\n#: cli/board/attach.go:35\n#: cli/sketch/archive.go:38\n#: cli/sketch/install_deps.go:41\nmsgid \"sketchPath\"\nmsgstr \"sketchPath\"\n\n#: arduino/cores/packagemanager/loader.go:496\nmsgid \"skipping loading of boards %s: malformed custom board options\"\nmsgstr \"skipping loading of boards %s: malformed custom board options\"\n\n#: legacy/builder/utils/utils.go:468\nmsgid \"source is not a directory\"\nmsgstr \"source is not a directory\"\n\n#: arduino/discovery/discoverymanager/discoverymanager.go:149\nmsgid \"start syncing discovery %[1]s: %[2]w\"\nmsgstr \"start syncing discovery %[1]s: %[2]w\"\n\n#: arduino/discovery/discoverymanager/discoverymanager.go:128\nmsgid \"starting discovery %[1]s: %[2]w\"\nmsgstr \"starting discovery %[1]s: %[2]w\"\n\n#: httpclient/httpclient.go:56\nmsgid \"stopped after 10 redirects\"\nmsgstr \"stopped after 10 redirects\"\n\n#: commands/board/list.go:357\nmsgid \"stopping discoveries: %s\"\nmsgstr \"stopping discoveries: %s\"\n\n#: arduino/discovery/discoverymanager/discoverymanager.go:172\nmsgid \"stopping discovery %[1]s: %[2]w\"\nmsgstr \"stopping discovery %[1]s: %[2]w\"\n\n#: commands/compile/test.go:307\nmsgid \"test crashed: %v\"\nmsgstr \"test crashed: %v\"\n\n#: commands/compile/test.go:301\nmsgid \"test interrupted\"\nmsgstr \"test interrupted\"\n\n#: commands/compile/test.go:298\nmsgid \"test timed out after %s\"\nmsgstr \"test timed out after %s\"\n\n#: arduino/resources/checksums.go:119\nmsgid \"testing archive checksum: %s\"\nmsgstr \"testing archive checksum: %s\"\n\n#: arduino/resources/checksums.go:112\nmsgid \"testing archive size: %s\"\nmsgstr \"testing archive size: %s\"\n\n#: arduino/resources/checksums.go:106\nmsgid \"testing if archive is cached: %s\"\nmsgstr \"testing if archive is cached: %s\"\n\n#: arduino/resources/install.go:37\nmsgid \"testing local archive integrity: %s\"\nmsgstr \"testing local archive integrity: %s\"\n\n#: legacy/builder/phases/sizer.go:137\nmsgid \"text section exceeds available space in board\"\nmsgstr \"text section exceeds available space in board\"\n\n#: cli/compile/library_resolutions.go:87\nmsgid \"the first in alphabetical order among the libraries with the highest priority\"\nmsgstr \"the first in alphabetical order among the libraries with the highest priority\"\n\n#: cli/compile/library_resolutions.go:85\nmsgid \"the library whose name best matches the header among the ones with the highest priority\"\nmsgstr \"the library whose name best matches the header among the ones with the highest priority\"\n\n#: cli/compile/library_resolutions.go:83\nmsgid \"the library with the highest priority\"\nmsgstr \"the library with the highest priority\"\n\n#: cli/compile/library_resolutions.go:81\nmsgid \"the only library that provides the header\"\nmsgstr \"the only library that provides the header\"\n\n#: commands/core/list.go:57\nmsgid \"the platform has no releases\"\nmsgstr \"the platform has no releases\"\n\n#: commands/board/list.go:79\nmsgid \"the server responded with status %s\"\nmsgstr \"the server responded with status %s\"\n\n#: arduino/cores/packagemanager/package_manager.go:234\n#: arduino/cores/packagemanager/package_manager.go:259\nmsgid \"the signature of the json index file %s is missing or not trusted\"\nmsgstr \"the signature of the json index file %s is missing or not trusted\"\n\n#: arduino/discovery/discovery.go:228\n#: arduino/monitor/monitor.go:215\nmsgid \"timeout waiting for message from %s\"\nmsgstr \"timeout waiting for message from %s\"\n\n#: arduino/cores/packagemanager/install_uninstall.go:165\nmsgid \"tool %s is not managed by package manager\"\nmsgstr \"tool %s is not managed by package manager\"\n\n#: arduino/cores/status.go:92\n#: arduino/cores/status.go:117\nmsgid \"tool %s not found\"\nmsgstr \"tool %s not found\"\n\n#: arduino/cores/packagemanager/package_manager.go:299\nmsgid \"tool '%[1]s' not found in package '%[2]s'\"\nmsgstr \"tool '%[1]s' not found in package '%[2]s'\"\n\n#: arduino/cores/packagemanager/download.go:114\nmsgid \"tool not available for your OS\"\nmsgstr \"tool not available for your OS\"\n\n#: arduino/cores/status.go:171\nmsgid \"tool not found\"\nmsgstr \"tool not found\"\n\n#: arduino/cores/packagemanager/install_uninstall.go:160\nmsgid \"tool not installed\"\nmsgstr \"tool not installed\"\n\n#: arduino/cores/packagemanager/package_manager.go:481\n#: arduino/cores/packagemanager/package_manager.go:547\nmsgid \"tool release not found: %s\"\nmsgstr \"tool release not found: %s\"\n\n#: arduino/cores/status.go:96\nmsgid \"tool version %s not found\"\nmsgstr \"tool version %s not found\"\n\n#: commands/lib/install.go:58\n#: commands/sketch/install_deps.go:251\nmsgid \"two different versions of the library %[1]s are required: %[2]s and %[3]s\"\nmsgstr \"two different versions of the library %[1]s are required: %[2]s and %[3]s\"\n\n#: arduino/builder/sketch.go:69\n#: arduino/builder/sketch.go:117\nmsgid \"unable to compute relative path to the sketch for the item\"\nmsgstr \"unable to compute relative path to the sketch for the item\"\n\n#: arduino/builder/sketch.go:49\nmsgid \"unable to create a folder to save the sketch\"\nmsgstr \"unable to create a folder to save the sketch\"\n\n#: arduino/builder/sketch.go:111\nmsgid \"unable to create a folder to save the sketch files\"\nmsgstr \"unable to create a folder to save the sketch files\"\n\n#: arduino/builder/sketch.go:123\nmsgid \"unable to create the folder containing the item\"\nmsgstr \"unable to create the folder containing the item\"\n\n#: cli/config/dump.go:53\nmsgid \"unable to marshal config to YAML: %v\"\nmsgstr \"unable to marshal config to YAML: %v\"\n\n#: arduino/builder/sketch.go:161\nmsgid \"unable to read contents of the destination item\"\nmsgstr \"unable to read contents of the destination item\"\n\n#: arduino/builder/sketch.go:134\nmsgid \"unable to read contents of the source item\"\nmsgstr \"unable to read contents of the source item\"\n\n#: arduino/builder/sketch.go:55\nmsgid \"unable to save the sketch on disk\"\nmsgstr \"unable to save the sketch on disk\"\n\n#: arduino/builder/sketch.go:144\nmsgid \"unable to write to destination file\"\nmsgstr \"unable to write to destination file\"\n\n#: arduino/gdbmi/record.go:166\nmsgid \"unexpected characters at the end of the record\"\nmsgstr \"unexpected characters at the end of the record\"\n\n#: arduino/gdbmi/record.go:139\nmsgid \"unexpected token in stream record\"\nmsgstr \"unexpected token in stream record\"\n\n#: arduino/cores/packagemanager/package_manager.go:169\nmsgid \"unknown package %s\"\nmsgstr \"unknown package %s\"\n\n#: arduino/cores/packagemanager/package_manager.go:176\nmsgid \"unknown platform %s:%s\"\nmsgstr \"unknown platform %s:%s\"\n\n#: arduino/sketch/sketch.go:159\nmsgid \"unknown sketch file extension '%s'\"\nmsgstr \"unknown sketch file extension '%s'\"\n\n#: arduino/resources/checksums.go:62\nmsgid \"unsupported hash algorithm: %s\"\nmsgstr \"unsupported hash algorithm: %s\"\n\n#: arduino/gdbmi/record.go:286\n#: arduino/gdbmi/record.go:314\nmsgid \"unterminated string\"\nmsgstr \"unterminated string\"\n\n#: cli/core/upgrade.go:44\nmsgid \"upgrade arduino:samd to the latest version\"\nmsgstr \"upgrade arduino:samd to the latest version\"\n\n#: cli/core/upgrade.go:42\nmsgid \"upgrade everything to the latest version\"\nmsgstr \"upgrade everything to the latest version\"\n\n#: commands/upload/upload.go:527\nmsgid \"uploading error: %s\"\nmsgstr \"uploading error: %s\"\n\n#: arduino/cores/packageindex/boards_db.go:168\nmsgid \"writing boards database: %s\"\nmsgstr \"writing boards database: %s\"\n\n#: arduino/cores/packageindex/cache.go:132\nmsgid \"writing index metadata: %s\"\nmsgstr \"writing index metadata: %s\"\n\n#: arduino/resources/index.go:69\nmsgid \"writing index validators: %s\"\nmsgstr \"writing index validators: %s\"\n\n#: arduino/libraries/librariesindex/json.go:124\nmsgid \"writing library_index.json: %s\"\nmsgstr \"writing library_index.json: %s\"\n\n#: arduino/sketch/project.go:178\nmsgid \"writing sketch lock file %[1]s: %[2]s\"\nmsgstr \"writing sketch lock file %[1]s: %[2]s\"\n\n#: arduino/sketch/sketch.go:265\nmsgid \"writing sketch metadata %[1]s: %[2]s\"\nmsgstr \"writing sketch metadata %[1]s: %[2]s\"\n\n#: commands/board/list.go:95\nmsgid \"wrong format in server response\"\nmsgstr \"wrong format in server response\"\n\n#: legacy/builder/wipeout_build_path_if_build_options_changed.go:49\nmsgid \"{0} invalid, rebuilding all\"\nmsgstr \"{0} invalid, rebuilding all\"\n\n#: legacy/builder/builder_utils/utils.go:329\n#: legacy/builder/builder_utils/utils.go:335\n#: legacy/builder/builder_utils/utils.go:399\nmsgid \"{0} newer than {1}\"\nmsgstr \"{0} newer than {1}\"\n\n"),
 	}
 	file4 := &embedded.EmbeddedFile{
 		Filename:    "it_IT.po",
-		FileModTime: time.Unix(1594311934, 0),
+		FileModTime: time.Unix(1631544375, 0),
 
 		Content: string("# \n# Translators:\n# Cristian Maglie <c.maglie@arduino.cc>, 2020\n# \nmsgid \"\"\nmsgstr \"\"\n\"Last-Translator: Cristian Maglie <c.maglie@arduino.cc>, 2020\\n\"\n\"Language-Team: Italian (Italy) (https://www.transifex.com/arduino-1/teams/108174/it_IT/)\\n\"\n\"Language: it_IT\\n\"\n\"Plural-Forms: nplurals=2; plural=(n != 1);\\n\"\n\n#: cli/usage.go:31\nmsgid \"Additional help topics:\"\nmsgstr \"Informazioni aggiuntive:\"\n\n#: cli/usage.go:26\nmsgid \"Aliases:\"\nmsgstr \"Alias:\"\n\n#: cli/usage.go:28\nmsgid \"Available Commands:\"\nmsgstr \"Comandi disponibili:\"\n\n#: cli/board/details.go:98\nmsgid \"Board name:\"\nmsgstr \"\"\n\n#: cli/board/details.go:100\nmsgid \"Board version:\"\nmsgstr \"\"\n\n#: cli/board/details.go:141\nmsgid \"Checksum:\"\nmsgstr \"\"\n\n#: cli/board/details.go:55 cli/board/details.go:65\nmsgid \"Error getting board details: %v\"\nmsgstr \"\"\n\n#: cli/usage.go:27\nmsgid \"Examples:\"\nmsgstr \"Esempi:\"\n\n#: cli/board/details.go:139\nmsgid \"File:\"\nmsgstr \"\"\n\n#: cli/usage.go:29\nmsgid \"Flags:\"\nmsgstr \"\"\n\n#: cli/usage.go:30\nmsgid \"Global Flags:\"\nmsgstr \"\"\n\n#: cli/board/details.go:111\nmsgid \"Identification properties:\"\nmsgstr \"\"\n\n#: cli/board/details.go:138\nmsgid \"OS:\"\nmsgstr \"\"\n\n#: cli/board/details.go:104\nmsgid \"Official Arduino board:\"\nmsgstr \"\"\n\n#: cli/board/details.go:150\nmsgid \"Option:\"\nmsgstr \"\"\n\n#: cli/board/details.go:120\nmsgid \"Package URL:\"\nmsgstr \"\"\n\n#: cli/board/details.go:119\nmsgid \"Package maintainer:\"\nmsgstr \"\"\n\n#: cli/board/details.go:118\nmsgid \"Package name:\"\nmsgstr \"\"\n\n#: cli/board/details.go:122\nmsgid \"Package online help:\"\nmsgstr \"\"\n\n#: cli/board/details.go:121\nmsgid \"Package website:\"\nmsgstr \"\"\n\n#: cli/board/details.go:128\nmsgid \"Platform URL:\"\nmsgstr \"\"\n\n#: cli/board/details.go:127\nmsgid \"Platform architecture:\"\nmsgstr \"\"\n\n#: cli/board/details.go:126\nmsgid \"Platform category:\"\nmsgstr \"\"\n\n#: cli/board/details.go:131\nmsgid \"Platform checksum:\"\nmsgstr \"\"\n\n#: cli/board/details.go:129\nmsgid \"Platform file name:\"\nmsgstr \"\"\n\n#: cli/board/details.go:125\nmsgid \"Platform name:\"\nmsgstr \"\"\n\n#: cli/board/details.go:130\nmsgid \"Platform size (bytes):\"\nmsgstr \"\"\n\n#: cli/board/details.go:40\nmsgid \"Print details about a board.\"\nmsgstr \"\"\n\n#: cli/board/details.go:135\nmsgid \"Required tool:\"\nmsgstr \"\"\n\n#: cli/board/details.go:47\nmsgid \"Show full board details\"\nmsgstr \"\"\n\n#: cli/board/details.go:41\nmsgid \"\"\n\"Show information about a board, in particular if the board has options to be\"\n\" specified in the FQBN.\"\nmsgstr \"\"\n\n#: cli/board/details.go:140\nmsgid \"Size (bytes):\"\nmsgstr \"\"\n\n#: cli/usage.go:25\nmsgid \"Usage:\"\nmsgstr \"\"\n\n#: cli/usage.go:32\nmsgid \"Use %s for more information about a command.\"\nmsgstr \"\"\n"),
 	}
 	file5 := &embedded.EmbeddedFile{
 		Filename:    "pt_BR.po",
-		FileModTime: time.Unix(1594311934, 0),
+		FileModTime: time.Unix(1631544375, 0),
 
 		Content: string("# \n# Translators:\n# Henrique Diniz <henrique.diniz@toptal.com>, 2020\n# \nmsgid \"\"\nmsgstr \"\"\n\"Last-Translator: Henrique Diniz <henrique.diniz@toptal.com>, 2020\\n\"\n\"Language-Team: Portuguese (Brazil) (https://www.transifex.com/arduino-1/teams/108174/pt_BR/)\\n\"\n\"Language: pt_BR\\n\"\n\"Plural-Forms: nplurals=2; plural=(n > 1);\\n\"\n\n#: cli/usage.go:31\nmsgid \"Additional help topics:\"\nmsgstr \"\"\n\n#: cli/usage.go:26\nmsgid \"Aliases:\"\nmsgstr \"\"\n\n#: cli/usage.go:28\nmsgid \"Available Commands:\"\nmsgstr \"\"\n\n#: cli/board/details.go:98\nmsgid \"Board name:\"\nmsgstr \"\"\n\n#: cli/board/details.go:100\nmsgid \"Board version:\"\nmsgstr \"\"\n\n#: cli/board/details.go:141\nmsgid \"Checksum:\"\nmsgstr \"\"\n\n#: cli/board/details.go:55 cli/board/details.go:65\nmsgid \"Error getting board details: %v\"\nmsgstr \"\"\n\n#: cli/usage.go:27\nmsgid \"Examples:\"\nmsgstr \"\"\n\n#: cli/board/details.go:139\nmsgid \"File:\"\nmsgstr \"\"\n\n#: cli/usage.go:29\nmsgid \"Flags:\"\nmsgstr \"\"\n\n#: cli/usage.go:30\nmsgid \"Global Flags:\"\nmsgstr \"\"\n\n#: cli/board/details.go:111\nmsgid \"Identification properties:\"\nmsgstr \"\"\n\n#: cli/board/details.go:138\nmsgid \"OS:\"\nmsgstr \"\"\n\n#: cli/board/details.go:104\nmsgid \"Official Arduino board:\"\nmsgstr \"\"\n\n#: cli/board/details.go:150\nmsgid \"Option:\"\nmsgstr \"\"\n\n#: cli/board/details.go:120\nmsgid \"Package URL:\"\nmsgstr \"\"\n\n#: cli/board/details.go:119\nmsgid \"Package maintainer:\"\nmsgstr \"\"\n\n#: cli/board/details.go:118\nmsgid \"Package name:\"\nmsgstr \"\"\n\n#: cli/board/details.go:122\nmsgid \"Package online help:\"\nmsgstr \"\"\n\n#: cli/board/details.go:121\nmsgid \"Package website:\"\nmsgstr \"\"\n\n#: cli/board/details.go:128\nmsgid \"Platform URL:\"\nmsgstr \"\"\n\n#: cli/board/details.go:127\nmsgid \"Platform architecture:\"\nmsgstr \"\"\n\n#: cli/board/details.go:126\nmsgid \"Platform category:\"\nmsgstr \"\"\n\n#: cli/board/details.go:131\nmsgid \"Platform checksum:\"\nmsgstr \"\"\n\n#: cli/board/details.go:129\nmsgid \"Platform file name:\"\nmsgstr \"\"\n\n#: cli/board/details.go:125\nmsgid \"Platform name:\"\nmsgstr \"\"\n\n#: cli/board/details.go:130\nmsgid \"Platform size (bytes):\"\nmsgstr \"\"\n\n#: cli/board/details.go:40\nmsgid \"Print details about a board.\"\nmsgstr \"\"\n\n#: cli/board/details.go:135\nmsgid \"Required tool:\"\nmsgstr \"\"\n\n#: cli/board/details.go:47\nmsgid \"Show full board details\"\nmsgstr \"\"\n\n#: cli/board/details.go:41\nmsgid \"\"\n\"Show information about a board, in particular if the board has options to be\"\n\" specified in the FQBN.\"\nmsgstr \"\"\n\n#: cli/board/details.go:140\nmsgid \"Size (bytes):\"\nmsgstr \"\"\n\n#: cli/usage.go:25\nmsgid \"Usage:\"\nmsgstr \"\"\n\n#: cli/usage.go:32\nmsgid \"Use %s for more information about a command.\"\nmsgstr \"Use %s para mais informações sobre um comando.\"\n"),
 	}
@@ -38,7 +38,7 @@ func init() {
 	// define dirs
 	dir1 := &embedded.EmbeddedDir{
 		Filename:   "",
-		DirModTime: time.Unix(1631540712, 0),
+		DirModTime: time.Unix(1631544375, 0),
 		ChildFiles: []*embedded.EmbeddedFile{
 			file2, // ".gitkeep"
 			file3, // "en.po"
@@ -54,7 +54,7 @@ func init() {
 	// register embeddedBox
 	embedded.RegisterEmbeddedBox(`./data`, &embedded.EmbeddedBox{
 		Name: `./data`,
-		Time: time.Unix(1631540712, 0),
+		Time: time.Unix(1631544375, 0),
 		Dirs: map[string]*embedded.EmbeddedDir{
 			"": dir1,
 		},
@@ -0,0 +1,40 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package builder
+
+import (
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/legacy/builder/types"
+	"github.com/arduino/go-paths-helper"
+)
+
+// AddWatchedLocations lists in ctx.WatchedLocations the folders whose changes
+// require a new build: the sketch folder and the unmanaged libraries used by
+// the sketch. The libraries installed by the library manager are not watched.
+type AddWatchedLocations struct{}
+
+func (s *AddWatchedLocations) Run(ctx *types.Context) error {
+	ctx.WatchedLocations = paths.PathList{}
+	if ctx.Sketch != nil {
+		ctx.WatchedLocations.Add(ctx.Sketch.FullPath)
+	}
+	for _, library := range ctx.ImportedLibraries {
+		if library.Location == libraries.Unmanaged && library.InstallDir != nil {
+			ctx.WatchedLocations.AddIfMissing(library.InstallDir)
+		}
+	}
+	return nil
+}
@@ -108,6 +108,8 @@ func (s *Builder) Run(ctx *types.Context) error {
 
 		&PrintUsedLibrariesIfVerbose{},
 
+		&AddWatchedLocations{},
+
 		&ExportProjectCMake{SketchError: mainErr != nil},
 
 		&phases.Sizer{SketchError: mainErr != nil},
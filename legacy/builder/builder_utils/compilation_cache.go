@@ -0,0 +1,309 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package builder_utils
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/arduino/arduino-cli/arduino/buildcache"
+	"github.com/arduino/arduino-cli/legacy/builder/constants"
+	"github.com/arduino/arduino-cli/legacy/builder/types"
+	"github.com/arduino/arduino-cli/legacy/builder/utils"
+	"github.com/arduino/go-paths-helper"
+	"github.com/pkg/errors"
+)
+
+// The object file path is different for every build path, so it's replaced
+// with this placeholder in the cache key and in the cached dependency files
+const compilationCacheObjectPlaceholder = "{object_file}"
+
+const (
+	compilationCacheObjectFile = "object"
+	compilationCacheDepsFile   = "deps"
+	compilationCacheStderrFile = "stderr"
+)
+
+// compilerHashes caches the hashes of the compilers content, indexed by
+// path, size and modification time of the executable
+var compilerHashes = map[string]string{}
+var compilerHashesMutex sync.Mutex
+
+// compilerHash returns the hash of the content of the compiler executable
+func compilerHash(compiler string) (string, error) {
+	info, err := os.Stat(compiler)
+	if err != nil {
+		return "", err
+	}
+	id := fmt.Sprintf("%s\x00%d\x00%d", compiler, info.Size(), info.ModTime().UnixNano())
+	compilerHashesMutex.Lock()
+	defer compilerHashesMutex.Unlock()
+	if hash, ok := compilerHashes[id]; ok {
+		return hash, nil
+	}
+	file, err := os.Open(compiler)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	compilerHashes[id] = hex.EncodeToString(hash.Sum(nil))
+	return compilerHashes[id], nil
+}
+
+// compilationCacheRoot is a folder, containing the sources or the build
+// results, whose path is different on every machine and build. The path is
+// replaced with the placeholder in the cache key and in the cached dependency
+// files, and the placeholder is replaced back with the path of the current
+// build when the results are restored.
+type compilationCacheRoot struct {
+	placeholder string
+	path        string
+}
+
+// compilationCacheRoots returns the roots of the build
+func compilationCacheRoots(ctx *types.Context) []*compilationCacheRoot {
+	roots := []*compilationCacheRoot{}
+	addRoot := func(placeholder string, dir *paths.Path) {
+		if dir == nil {
+			return
+		}
+		if abs, err := dir.Abs(); err == nil {
+			roots = append(roots, &compilationCacheRoot{placeholder: "{" + placeholder + "}", path: abs.String()})
+		}
+	}
+	addRoots := func(placeholder string, dirs paths.PathList) {
+		for i, dir := range dirs {
+			addRoot(fmt.Sprintf("%s.%d", placeholder, i), dir)
+		}
+	}
+	addRoot("build.path", ctx.BuildPath)
+	addRoot("build.cache_path", ctx.BuildCachePath)
+	if ctx.SketchLocation != nil {
+		addRoot("sketch.path", ctx.SketchLocation.Parent())
+	}
+	addRoots("hardware", ctx.HardwareDirs)
+	addRoots("tools.builtin", ctx.BuiltInToolsDirs)
+	addRoots("libraries.builtin", ctx.BuiltInLibrariesDirs)
+	addRoots("libraries.other", ctx.OtherLibrariesDirs)
+	addRoots("libraries", ctx.LibraryDirs)
+	// Longer roots first, so that nested folders are replaced entirely
+	sort.SliceStable(roots, func(i, j int) bool { return len(roots[i].path) > len(roots[j].path) })
+	return roots
+}
+
+// relativizeCompilationPaths replaces the roots in s with their placeholders.
+// On Windows the roots are also looked for with escaped backslashes, as they
+// are written in the line markers of the preprocessed sources.
+func relativizeCompilationPaths(s string, roots []*compilationCacheRoot) string {
+	for _, root := range roots {
+		s = strings.ReplaceAll(s, root.path+string(filepath.Separator), root.placeholder+"/")
+		if filepath.Separator == '\\' {
+			s = strings.ReplaceAll(s, strings.ReplaceAll(root.path, `\`, `\\`)+`\\`, root.placeholder+"/")
+		}
+		if strings.HasSuffix(s, root.path) {
+			s = strings.TrimSuffix(s, root.path) + root.placeholder
+		}
+	}
+	return s
+}
+
+// relativizeDependencies replaces the roots in the content of a dependency
+// file with their placeholders. The paths in dependency files are escaped.
+func relativizeDependencies(deps []byte, roots []*compilationCacheRoot) []byte {
+	for _, root := range roots {
+		deps = bytes.ReplaceAll(deps, []byte(escapeDep(root.path)+string(filepath.Separator)), []byte(root.placeholder+"/"))
+	}
+	return deps
+}
+
+// rebaseDependencies replaces the placeholders in the content of a dependency
+// file with the roots of the current build
+func rebaseDependencies(deps []byte, roots []*compilationCacheRoot) []byte {
+	for _, root := range roots {
+		deps = bytes.ReplaceAll(deps, []byte(root.placeholder+"/"), []byte(escapeDep(root.path)+string(filepath.Separator)))
+	}
+	return deps
+}
+
+// debugInfoFlag matches the compiler flags enabling the debug information
+var debugInfoFlag = regexp.MustCompile(`^-g([1-3]|gdb[0-3]?|dwarf(-[2-5])?|stabs\+?[0-3]?)?$`)
+
+// hasDebugInfo returns true if the command produces objects with debug
+// information, that contains the paths of the sources and of the build
+func hasDebugInfo(command *exec.Cmd) bool {
+	debugInfo := false
+	for _, arg := range command.Args[1:] {
+		if arg == "-g0" {
+			debugInfo = false
+		} else if debugInfoFlag.MatchString(arg) {
+			debugInfo = true
+		}
+	}
+	return debugInfo
+}
+
+// compilationCacheKey computes the key of the compilation performed by command.
+// The key is the hash of the compiler content, of the command line and of the
+// preprocessed source, obtained by running the same command line with -E. The
+// roots are replaced with a placeholder in the command line and in the line
+// markers of the preprocessed source, so that the same compilation has the same
+// key in any build path and on any machine. The path of the object file, as
+// passed to the compiler, is returned too.
+func compilationCacheKey(command *exec.Cmd, roots []*compilationCacheRoot) (string, string, error) {
+	objectArg := ""
+	preprocArgs := []string{}
+	args := command.Args[1:]
+	for i := 0; i < len(args); i++ {
+		switch arg := args[i]; arg {
+		case "-o":
+			if i+1 == len(args) {
+				return "", "", errors.New(tr("missing object file in command line"))
+			}
+			i++
+			objectArg = args[i]
+		case "-c", "-MMD", "-MD", "-MP":
+		default:
+			preprocArgs = append(preprocArgs, arg)
+		}
+	}
+	if objectArg == "" {
+		return "", "", errors.New(tr("missing object file in command line"))
+	}
+
+	compiler, err := compilerHash(command.Path)
+	if err != nil {
+		return "", "", errors.WithStack(err)
+	}
+
+	hash := sha256.New()
+	fmt.Fprintf(hash, "compiler\x00%s\x00", compiler)
+	for _, arg := range command.Args[1:] {
+		arg = strings.ReplaceAll(arg, objectArg, compilationCacheObjectPlaceholder)
+		fmt.Fprintf(hash, "arg\x00%s\x00", relativizeCompilationPaths(arg, roots))
+	}
+	fmt.Fprint(hash, "source\x00")
+
+	preprocessed := &bytes.Buffer{}
+	preproc := exec.Command(command.Path, append(preprocArgs, "-E")...)
+	preproc.Dir = command.Dir
+	preproc.Env = command.Env
+	preproc.Stdout = preprocessed
+	preproc.Stderr = ioutil.Discard
+	if err := preproc.Run(); err != nil {
+		return "", "", errors.WithStack(err)
+	}
+	scanner := bufio.NewScanner(preprocessed)
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "# ") || strings.HasPrefix(line, "#line ") {
+			// Line markers contain the paths of the source files
+			line = relativizeCompilationPaths(line, roots)
+		}
+		fmt.Fprintln(hash, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return "", "", errors.WithStack(err)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), objectArg, nil
+}
+
+// execCommandWithCompilationCache runs the compilation command, unless its
+// results are already in the compilation cache, and returns the compiler
+// output. The output is shown while the compiler runs and it's stored in the
+// cache together with the object and dependency files, so warnings are shown
+// again when the cached results are used. The objects with debug information contain the paths of the build,
+// so they are shared only between builds having the same roots.
+func execCommandWithCompilationCache(ctx *types.Context, command *exec.Cmd, objectFile, depsFile *paths.Path) ([]byte, error) {
+	logger := ctx.GetLogger()
+	cache := ctx.CompilationCache
+
+	roots := compilationCacheRoots(ctx)
+	if hasDebugInfo(command) {
+		roots = nil
+	}
+	key, objectArg, err := compilationCacheKey(command, roots)
+	if err != nil {
+		if ctx.DebugLevel >= 20 {
+			logger.Fprintln(os.Stdout, constants.LOG_LEVEL_DEBUG, tr("Compilation of {0} can't be cached: {1}"), objectFile, err)
+		}
+		_, stderr, err := utils.ExecCommand(ctx, command, utils.ShowIfVerbose /* stdout */, utils.ShowAndCapture /* stderr */)
+		return stderr, err
+	}
+
+	if !ctx.Clean {
+		entry, err := cache.Get(key)
+		if err != nil {
+			logger.Fprintln(os.Stdout, constants.LOG_LEVEL_WARN, tr("Error reading compilation cache: {0}"), err)
+		} else if entry != nil {
+			if err := restoreFromCompilationCache(entry, objectArg, roots, objectFile, depsFile); err != nil {
+				return nil, errors.WithStack(err)
+			}
+			if ctx.Verbose {
+				logger.Println(constants.LOG_LEVEL_INFO, tr("Using cached compilation of: {0}"), objectFile)
+			}
+			showCompilerOutput(ctx, entry[compilationCacheStderrFile])
+			return entry[compilationCacheStderrFile], nil
+		}
+	}
+
+	_, stderr, err := utils.ExecCommand(ctx, command, utils.ShowIfVerbose /* stdout */, utils.ShowAndCapture /* stderr */)
+	if err != nil {
+		return stderr, err
+	}
+
+	entry := buildcache.Entry{compilationCacheStderrFile: stderr}
+	if entry[compilationCacheObjectFile], err = objectFile.ReadFile(); err != nil {
+		return stderr, errors.WithStack(err)
+	}
+	if deps, err := depsFile.ReadFile(); err == nil {
+		deps = bytes.Replace(deps, []byte(escapeDep(objectArg)), []byte(compilationCacheObjectPlaceholder), 1)
+		entry[compilationCacheDepsFile] = relativizeDependencies(deps, roots)
+	}
+	if err := cache.Put(key, entry); err != nil {
+		logger.Fprintln(os.Stdout, constants.LOG_LEVEL_WARN, tr("Error writing compilation cache: {0}"), err)
+	}
+	return stderr, nil
+}
+
+func restoreFromCompilationCache(entry buildcache.Entry, objectArg string, roots []*compilationCacheRoot, objectFile, depsFile *paths.Path) error {
+	if err := objectFile.WriteFile(entry[compilationCacheObjectFile]); err != nil {
+		return err
+	}
+	deps, ok := entry[compilationCacheDepsFile]
+	if !ok {
+		return nil
+	}
+	deps = bytes.Replace(deps, []byte(compilationCacheObjectPlaceholder), []byte(escapeDep(objectArg)), 1)
+	return depsFile.WriteFile(rebaseDependencies(deps, roots))
+}
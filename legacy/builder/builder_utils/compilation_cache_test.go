@@ -0,0 +1,206 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package builder_utils
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino/buildcache"
+	"github.com/arduino/arduino-cli/legacy/builder/types"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+// testCacheBackend is an in memory cache backend counting the requests
+type testCacheBackend struct {
+	entries map[string]buildcache.Entry
+	gets    int
+	puts    int
+}
+
+func (b *testCacheBackend) Get(key string) (buildcache.Entry, error) {
+	b.gets++
+	return b.entries[key], nil
+}
+
+func (b *testCacheBackend) Put(key string, entry buildcache.Entry) error {
+	b.puts++
+	b.entries[key] = entry
+	return nil
+}
+
+// prepareTestCompilation writes in a new build dir a source file including a
+// header with the given content, and returns the build dir and the command
+// compiling the source file
+func prepareTestCompilation(t *testing.T, header string) (*paths.Path, *exec.Cmd) {
+	compiler, err := exec.LookPath("gcc")
+	if err != nil {
+		t.Skip("gcc not available")
+	}
+	buildPath, err := paths.MkTempDir("", "compilation_cache_test")
+	require.NoError(t, err)
+	t.Cleanup(func() { buildPath.RemoveAll() })
+
+	require.NoError(t, buildPath.Join("include").MkdirAll())
+	require.NoError(t, buildPath.Join("include", "test.h").WriteFile([]byte(header)))
+	require.NoError(t, buildPath.Join("sketch").MkdirAll())
+	require.NoError(t, buildPath.Join("sketch", "test.cpp").WriteFile([]byte("#include \"test.h\"\nint f() { return VALUE; }\n")))
+	command := exec.Command(compiler, "-c", "-MMD",
+		"-I"+buildPath.Join("include").String(),
+		buildPath.Join("sketch", "test.cpp").String(),
+		"-o", buildPath.Join("sketch", "test.cpp.o").String())
+	return buildPath, command
+}
+
+// testCompilationCacheRoots returns the roots of a build in buildPath
+func testCompilationCacheRoots(buildPath *paths.Path) []*compilationCacheRoot {
+	return compilationCacheRoots(&types.Context{BuildPath: buildPath})
+}
+
+func TestCompilationCacheKey(t *testing.T) {
+	buildPath1, command1 := prepareTestCompilation(t, "#define VALUE 1\n")
+	buildPath2, command2 := prepareTestCompilation(t, "#define VALUE 1\n")
+
+	// The same compilation has the same key in different build paths
+	key1, objectArg, err := compilationCacheKey(command1, testCompilationCacheRoots(buildPath1))
+	require.NoError(t, err)
+	require.Equal(t, buildPath1.Join("sketch", "test.cpp.o").String(), objectArg)
+	key2, _, err := compilationCacheKey(command2, testCompilationCacheRoots(buildPath2))
+	require.NoError(t, err)
+	require.Equal(t, key1, key2)
+
+	// unless the build paths are part of the key
+	key2, _, err = compilationCacheKey(command2, nil)
+	require.NoError(t, err)
+	require.NotEqual(t, key1, key2)
+
+	// The content of the included files is part of the key
+	buildPath3, command3 := prepareTestCompilation(t, "#define VALUE 2\n")
+	key3, _, err := compilationCacheKey(command3, testCompilationCacheRoots(buildPath3))
+	require.NoError(t, err)
+	require.NotEqual(t, key1, key3)
+
+	// as well as the command line
+	command1.Args = append(command1.Args, "-Os")
+	key4, _, err := compilationCacheKey(command1, testCompilationCacheRoots(buildPath1))
+	require.NoError(t, err)
+	require.NotEqual(t, key1, key4)
+}
+
+func TestExecCommandWithCompilationCache(t *testing.T) {
+	backend := &testCacheBackend{entries: map[string]buildcache.Entry{}}
+	buildPath, command := prepareTestCompilation(t, "#define VALUE 1\n")
+	ctx := &types.Context{BuildPath: buildPath, CompilationCache: backend}
+	objectFile := buildPath.Join("sketch", "test.cpp.o")
+	depsFile := buildPath.Join("sketch", "test.cpp.d")
+
+	// Cache miss: the file is compiled and the results are stored in the cache
+	_, err := execCommandWithCompilationCache(ctx, command, objectFile, depsFile)
+	require.NoError(t, err)
+	require.Equal(t, 1, backend.gets)
+	require.Equal(t, 1, backend.puts)
+	require.Len(t, backend.entries, 1)
+	object, err := objectFile.ReadFile()
+	require.NoError(t, err)
+	for _, entry := range backend.entries {
+		require.Equal(t, object, entry[compilationCacheObjectFile])
+		require.Contains(t, string(entry[compilationCacheDepsFile]), compilationCacheObjectPlaceholder)
+		entry[compilationCacheObjectFile] = []byte("cached object")
+	}
+
+	// Cache hit from another build path: the results are restored from the cache
+	buildPath2, command2 := prepareTestCompilation(t, "#define VALUE 1\n")
+	ctx2 := &types.Context{BuildPath: buildPath2, CompilationCache: backend}
+	objectFile2 := buildPath2.Join("sketch", "test.cpp.o")
+	depsFile2 := buildPath2.Join("sketch", "test.cpp.d")
+	_, err = execCommandWithCompilationCache(ctx2, command2, objectFile2, depsFile2)
+	require.NoError(t, err)
+	require.Equal(t, 2, backend.gets)
+	require.Equal(t, 1, backend.puts)
+	object, err = objectFile2.ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, "cached object", string(object))
+	deps, err := depsFile2.ReadFile()
+	require.NoError(t, err)
+	require.Contains(t, string(deps), objectFile2.String()+":")
+	require.Contains(t, string(deps), buildPath2.Join("include", "test.h").String())
+	require.NotContains(t, string(deps), buildPath.String())
+
+	// The restored dependencies are the ones of the current build path, so
+	// changing its headers triggers a rebuild
+	sourceFile2 := buildPath2.Join("sketch", "test.cpp")
+	upToDate, err := ObjFileIsUpToDate(ctx2, sourceFile2, objectFile2, depsFile2)
+	require.NoError(t, err)
+	require.True(t, upToDate)
+	future := time.Now().Add(time.Hour)
+	require.NoError(t, os.Chtimes(buildPath2.Join("include", "test.h").String(), future, future))
+	upToDate, err = ObjFileIsUpToDate(ctx2, sourceFile2, objectFile2, depsFile2)
+	require.NoError(t, err)
+	require.False(t, upToDate)
+
+	// Clean builds don't read the cache, but update it
+	ctx2.Clean = true
+	_, err = execCommandWithCompilationCache(ctx2, command2, objectFile2, depsFile2)
+	require.NoError(t, err)
+	require.Equal(t, 2, backend.gets)
+	require.Equal(t, 2, backend.puts)
+	object, err = objectFile2.ReadFile()
+	require.NoError(t, err)
+	require.NotEqual(t, "cached object", string(object))
+}
+
+func TestCompilationCacheWithDebugInfo(t *testing.T) {
+	backend := &testCacheBackend{entries: map[string]buildcache.Entry{}}
+	buildPath, command := prepareTestCompilation(t, "#define VALUE 1\n")
+	command.Args = append(command.Args, "-g")
+	ctx := &types.Context{BuildPath: buildPath, CompilationCache: backend}
+	_, err := execCommandWithCompilationCache(ctx, command, buildPath.Join("sketch", "test.cpp.o"), buildPath.Join("sketch", "test.cpp.d"))
+	require.NoError(t, err)
+	require.Equal(t, 1, backend.puts)
+
+	// The objects with debug information contain the build path, so they are
+	// not used by other build paths
+	buildPath2, command2 := prepareTestCompilation(t, "#define VALUE 1\n")
+	command2.Args = append(command2.Args, "-g")
+	ctx2 := &types.Context{BuildPath: buildPath2, CompilationCache: backend}
+	_, err = execCommandWithCompilationCache(ctx2, command2, buildPath2.Join("sketch", "test.cpp.o"), buildPath2.Join("sketch", "test.cpp.d"))
+	require.NoError(t, err)
+	require.Equal(t, 2, backend.puts)
+	require.Len(t, backend.entries, 2)
+
+	// but they are used again by the same build path
+	_, err = execCommandWithCompilationCache(ctx2, command2, buildPath2.Join("sketch", "test.cpp.o"), buildPath2.Join("sketch", "test.cpp.d"))
+	require.NoError(t, err)
+	require.Equal(t, 2, backend.puts)
+}
+
+func TestHasDebugInfo(t *testing.T) {
+	for args, debugInfo := range map[string]bool{
+		"gcc -c -Os":          false,
+		"gcc -c -g -Os":       true,
+		"gcc -c -g3":          true,
+		"gcc -c -ggdb":        true,
+		"gcc -c -gdwarf-4":    true,
+		"gcc -c -g -g0":       false,
+		"gcc -c -gc-sections": false,
+	} {
+		require.Equal(t, debugInfo, hasDebugInfo(exec.Command("gcc", strings.Fields(args)[1:]...)), args)
+	}
+}
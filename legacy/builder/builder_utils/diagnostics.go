@@ -0,0 +1,83 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package builder_utils
+
+import (
+	"io"
+	"os"
+
+	"github.com/arduino/arduino-cli/arduino/builder"
+	"github.com/arduino/arduino-cli/legacy/builder/types"
+	"github.com/arduino/go-paths-helper"
+)
+
+// ReportCompilerOutput shows the output of the compiler and, if requested,
+// reports the diagnostics it contains through ctx.CompilerDiagnosticsCB. It's
+// used for the output that has not been streamed while the compiler was
+// running, such as the one restored from the compilation cache.
+func ReportCompilerOutput(ctx *types.Context, output []byte) {
+	showCompilerOutput(ctx, output)
+	reportCompilerDiagnostics(ctx, output)
+}
+
+// showCompilerOutput writes the output of the compiler on ctx.ExecStderr
+func showCompilerOutput(ctx *types.Context, output []byte) {
+	if len(output) == 0 {
+		return
+	}
+	var stderr io.Writer = os.Stderr
+	if ctx.ExecStderr != nil {
+		stderr = ctx.ExecStderr
+	}
+	stderr.Write(output)
+}
+
+// reportCompilerDiagnostics reports the diagnostics contained in the output of
+// the compiler through ctx.CompilerDiagnosticsCB, if set. The locations in the
+// files of the sketch build folder are mapped back to the original sketch
+// files using the #line directives of the generated files.
+func reportCompilerDiagnostics(ctx *types.Context, output []byte) {
+	if len(output) == 0 || ctx.CompilerDiagnosticsCB == nil {
+		return
+	}
+	lineMaps := map[string]*builder.LineMap{}
+	mapLocation := func(l builder.Location) builder.Location {
+		if l.File == "" || l.Line == 0 || ctx.SketchBuildPath == nil {
+			return l
+		}
+		lineMap, ok := lineMaps[l.File]
+		if !ok {
+			file := paths.New(l.File)
+			if inside, err := file.IsInsideDir(ctx.SketchBuildPath); err == nil && inside {
+				if source, err := file.ReadFile(); err == nil {
+					lineMap = builder.NewLineMap(source)
+				}
+			}
+			lineMaps[l.File] = lineMap
+		}
+		if lineMap == nil {
+			return l
+		}
+		if file, line, ok := lineMap.Map(l.Line); ok {
+			return builder.Location{File: file, Line: line, Column: l.Column}
+		}
+		return l
+	}
+	for _, diagnostic := range builder.ParseCompilerDiagnostics(output) {
+		diagnostic.MapLocations(mapLocation)
+		ctx.CompilerDiagnosticsCB(diagnostic)
+	}
+}
@@ -258,7 +258,13 @@ func compileFileWithRecipe(ctx *types.Context, sourcePath *paths.Path, source *p
 		ctx.CompilationDatabase.Add(source, command)
 	}
 	if !objIsUpToDate && !ctx.OnlyUpdateCompilationDatabase {
-		_, _, err = utils.ExecCommand(ctx, command, utils.ShowIfVerbose /* stdout */, utils.Show /* stderr */)
+		var compilerOutput []byte
+		if ctx.CompilationCache != nil {
+			compilerOutput, err = execCommandWithCompilationCache(ctx, command, objectFile, depsFile)
+		} else {
+			_, compilerOutput, err = utils.ExecCommand(ctx, command, utils.ShowIfVerbose /* stdout */, utils.ShowAndCapture /* stderr */)
+		}
+		reportCompilerDiagnostics(ctx, compilerOutput)
 		if err != nil {
 			return nil, errors.WithStack(err)
 		}
@@ -408,6 +414,15 @@ func unescapeDep(s string) string {
 	return s
 }
 
+// escapeDep escapes a path as it's written in the dependency files
+func escapeDep(s string) string {
+	s = strings.Replace(s, "$", "$$", -1)
+	s = strings.Replace(s, "#", "\\#", -1)
+	s = strings.Replace(s, "\t", "\\\t", -1)
+	s = strings.Replace(s, " ", "\\ ", -1)
+	return s
+}
+
 func removeEndingBackSlash(s string) string {
 	if strings.HasSuffix(s, "\\") {
 		s = s[:len(s)-1]
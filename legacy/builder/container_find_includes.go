@@ -45,6 +45,18 @@ be processed as well. When the preprocessor completes without showing an
 the next. When no library can be found for a included filename, an error
 is shown and the process is aborted.
 
+Parallel detection
+
+Running the preprocessor is by far the slowest part of the process, so the
+first files in the queue (as many as the number of parallel jobs) are
+preprocessed concurrently, before being processed in turn. The results are
+used only if the include path didn't change since they were computed: when
+a library is added to the include path, the remaining results are discarded
+and the following files are preprocessed again with the updated include
+path. In this way the files are processed in the same order, with the same
+include path and with the same outcome of a sequential run, and the cache
+contents are deterministic.
+
 Caching
 
 Since this process is fairly slow (requiring at least one invocation of
@@ -95,8 +107,9 @@ package builder
 import (
 	"encoding/json"
 	"fmt"
-	"os"
 	"os/exec"
+	"runtime"
+	"sync"
 	"time"
 
 	"github.com/arduino/arduino-cli/arduino/libraries"
@@ -132,12 +145,30 @@ func (s *ContainerFindIncludes) Run(ctx *types.Context) error {
 	if srcSubfolderPath.IsDir() {
 		queueSourceFilesFromFolder(ctx, sourceFilePaths, sketch, srcSubfolderPath, true /* recurse */)
 	}
+	if testSubfolderPath := ctx.SketchBuildPath.Join("test"); ctx.CompileSketchTests && testSubfolderPath.IsDir() {
+		queueSourceFilesFromFolder(ctx, sourceFilePaths, sketch, testSubfolderPath, true /* recurse */)
+	}
 
+	jobs := ctx.Jobs
+	if jobs == 0 {
+		jobs = runtime.NumCPU()
+	}
 	for !sourceFilePaths.Empty() {
-		err := findIncludesUntilDone(ctx, cache, sourceFilePaths.Pop())
-		if err != nil {
-			cachePath.Remove()
-			return errors.WithStack(err)
+		batch := *sourceFilePaths
+		if len(batch) > jobs {
+			batch = batch[:jobs]
+		}
+		for _, preprocessed := range preprocessSourceFiles(ctx, cache, batch) {
+			includeFoldersCount := len(ctx.IncludeFolders)
+			err := findIncludesUntilDone(ctx, cache, sourceFilePaths.Pop(), preprocessed)
+			if err != nil {
+				cachePath.Remove()
+				return errors.WithStack(err)
+			}
+			if len(ctx.IncludeFolders) != includeFoldersCount {
+				// The include path changed, the remaining results are stale
+				break
+			}
 		}
 	}
 
@@ -284,7 +315,75 @@ func writeCache(cache *includeCache, path *paths.Path) error {
 	return nil
 }
 
-func findIncludesUntilDone(ctx *types.Context, cache *includeCache, sourceFile types.SourceFile) error {
+// preprocessResult is the outcome of the preprocessor run on a source file
+// concurrently with the other files of the queue
+type preprocessResult struct {
+	// Is the object file of the source file up to date?
+	unchanged bool
+	// Has the preprocessor been run? It's not run if the includes are
+	// expected to be read from the cache.
+	ran bool
+	// Number of entries of ctx.IncludeFolders used to run the preprocessor
+	includeFoldersCount int
+	stderr              []byte
+	err                 error
+}
+
+// preprocessSourceFiles runs the preprocessor in parallel on the given source
+// files using the current include path. The results are returned in the same
+// order of the files, a nil result means that the file must be processed
+// from scratch.
+func preprocessSourceFiles(ctx *types.Context, cache *includeCache, sourceFiles []types.SourceFile) []*preprocessResult {
+	results := make([]*preprocessResult, len(sourceFiles))
+	cacheValid := cache.valid
+	var wg sync.WaitGroup
+	for i, sourceFile := range sourceFiles {
+		wg.Add(1)
+		go func(i int, sourceFile types.SourceFile) {
+			defer wg.Done()
+			results[i] = preprocessSourceFile(ctx, cacheValid, sourceFile)
+		}(i, sourceFile)
+	}
+	wg.Wait()
+	return results
+}
+
+func preprocessSourceFile(ctx *types.Context, cacheValid bool, sourceFile types.SourceFile) *preprocessResult {
+	sourcePath := sourceFile.SourcePath(ctx)
+	unchanged, err := builder_utils.ObjFileIsUpToDate(ctx, sourcePath, sourceFile.ObjectPath(ctx), sourceFile.DepfilePath(ctx))
+	if err != nil {
+		// The error is reported when the file is processed
+		return nil
+	}
+
+	result := &preprocessResult{unchanged: unchanged, includeFoldersCount: len(ctx.IncludeFolders)}
+	if unchanged && cacheValid {
+		return result
+	}
+	if library, ok := sourceFile.Origin.(*libraries.Library); ok && library.Precompiled && library.PrecompiledWithSources {
+		return result
+	}
+	result.stderr, result.err = GCCPreprocRunnerForDiscoveringIncludes(ctx, sourcePath, paths.NullPath(), sourceFileIncludes(ctx, sourceFile))
+	result.ran = true
+	return result
+}
+
+// sourceFileIncludes returns the include path to use to preprocess the given
+// source file
+func sourceFileIncludes(ctx *types.Context, sourceFile types.SourceFile) paths.PathList {
+	includes := ctx.IncludeFolders
+	if library, ok := sourceFile.Origin.(*libraries.Library); ok && library.UtilityDir != nil {
+		// Make a copy to not alter ctx.IncludeFolders from concurrent jobs
+		includes = append(includes.Clone(), library.UtilityDir)
+	}
+	return includes
+}
+
+// findIncludesUntilDone processes the given source file adding the libraries
+// it needs to the include path. If not nil, the preprocessed result is used
+// instead of running the preprocessor the first time, as long as it has been
+// computed with the current include path.
+func findIncludesUntilDone(ctx *types.Context, cache *includeCache, sourceFile types.SourceFile, preprocessed *preprocessResult) error {
 	sourcePath := sourceFile.SourcePath(ctx)
 	targetFilePath := paths.NullPath()
 	depPath := sourceFile.DepfilePath(ctx)
@@ -302,9 +401,13 @@ func findIncludesUntilDone(ctx *types.Context, cache *includeCache, sourceFile t
 	// TODO: This reads the dependency file, but the actual building
 	// does it again. Should the result be somehow cached? Perhaps
 	// remove the object file if it is found to be stale?
-	unchanged, err := builder_utils.ObjFileIsUpToDate(ctx, sourcePath, objPath, depPath)
-	if err != nil {
+	var unchanged bool
+	if preprocessed != nil {
+		unchanged = preprocessed.unchanged
+	} else if u, err := builder_utils.ObjFileIsUpToDate(ctx, sourcePath, objPath, depPath); err != nil {
 		return errors.WithStack(err)
+	} else {
+		unchanged = u
 	}
 
 	first := true
@@ -312,10 +415,7 @@ func findIncludesUntilDone(ctx *types.Context, cache *includeCache, sourceFile t
 		var include string
 		cache.ExpectFile(sourcePath)
 
-		includes := ctx.IncludeFolders
-		if library, ok := sourceFile.Origin.(*libraries.Library); ok && library.UtilityDir != nil {
-			includes = append(includes, library.UtilityDir)
-		}
+		includes := sourceFileIncludes(ctx, sourceFile)
 
 		if library, ok := sourceFile.Origin.(*libraries.Library); ok {
 			if library.Precompiled && library.PrecompiledWithSources {
@@ -337,7 +437,11 @@ func findIncludesUntilDone(ctx *types.Context, cache *includeCache, sourceFile t
 				ctx.GetLogger().Println(constants.LOG_LEVEL_INFO, tr("Using cached library dependencies for file: {0}"), sourcePath)
 			}
 		} else {
-			preproc_stderr, preproc_err = GCCPreprocRunnerForDiscoveringIncludes(ctx, sourcePath, targetFilePath, includes)
+			if first && preprocessed != nil && preprocessed.ran && preprocessed.includeFoldersCount == len(ctx.IncludeFolders) {
+				preproc_stderr, preproc_err = preprocessed.stderr, preprocessed.err
+			} else {
+				preproc_stderr, preproc_err = GCCPreprocRunnerForDiscoveringIncludes(ctx, sourcePath, targetFilePath, includes)
+			}
 			// Unwrap error and see if it is an ExitError.
 			_, is_exit_error := errors.Cause(preproc_err).(*exec.ExitError)
 			if preproc_err == nil {
@@ -362,7 +466,10 @@ func findIncludesUntilDone(ctx *types.Context, cache *includeCache, sourceFile t
 			return nil
 		}
 
-		library := ResolveLibrary(ctx, include)
+		library, err := ResolveLibrary(ctx, include)
+		if err != nil {
+			return errors.WithStack(err)
+		}
 		if library == nil {
 			// Library could not be resolved, show error
 			// err := runCommand(ctx, &GCCPreprocRunner{SourceFilePath: sourcePath, TargetFileName: paths.New(constants.FILE_CTAGS_TARGET_FOR_GCC_MINUS_E), Includes: includes})
@@ -378,7 +485,7 @@ func findIncludesUntilDone(ctx *types.Context, cache *includeCache, sourceFile t
 					return errors.New(tr("Internal error in cache"))
 				}
 			}
-			os.Stderr.Write(preproc_stderr)
+			builder_utils.ReportCompilerOutput(ctx, preproc_stderr)
 			return errors.WithStack(preproc_err)
 		}
 
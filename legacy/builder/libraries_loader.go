@@ -16,8 +16,6 @@
 package builder
 
 import (
-	"os"
-
 	"github.com/arduino/arduino-cli/arduino/libraries"
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesresolver"
@@ -28,20 +26,53 @@ import (
 type LibrariesLoader struct{}
 
 func (s *LibrariesLoader) Run(ctx *types.Context) error {
-	lm := librariesmanager.NewLibraryManager(nil, nil)
+	var lm *librariesmanager.LibrariesManager
+	var err error
+	if cache := ctx.LibrariesManagersCache; cache != nil {
+		key := ctx.ActualPlatform.String() + " " + ctx.TargetPlatform.String()
+		lm, err = cache.Get(key, func() (*librariesmanager.LibrariesManager, error) {
+			lm, err := loadLibraries(ctx)
+			if err != nil {
+				return nil, err
+			}
+			// Read the headers of the libraries now, the cached libraries
+			// are shared by concurrent builds
+			if err := librariesresolver.NewCppResolver().ScanFromLibrariesManager(lm); err != nil {
+				return nil, errors.WithStack(err)
+			}
+			return lm, nil
+		})
+	} else {
+		lm, err = loadLibraries(ctx)
+	}
+	if err != nil {
+		return err
+	}
 	ctx.LibrariesManager = lm
 
+	resolver := librariesresolver.NewCppResolver()
+	if err := resolver.ScanFromLibrariesManager(lm); err != nil {
+		return errors.WithStack(err)
+	}
+	for _, override := range ctx.LibraryOverrides {
+		resolver.AddOverride(override)
+	}
+	ctx.LibrariesResolver = resolver
+
+	return nil
+}
+
+func loadLibraries(ctx *types.Context) (*librariesmanager.LibrariesManager, error) {
+	lm := librariesmanager.NewLibraryManager(nil, nil)
+
 	builtInLibrariesFolders := ctx.BuiltInLibrariesDirs
 	if err := builtInLibrariesFolders.ToAbs(); err != nil {
-		return errors.WithStack(err)
+		return nil, errors.WithStack(err)
 	}
 	for _, folder := range builtInLibrariesFolders {
 		lm.AddLibrariesDir(folder, libraries.IDEBuiltIn)
 	}
 
-	debugLevel := ctx.DebugLevel
-	logger := ctx.GetLogger()
-
 	actualPlatform := ctx.ActualPlatform
 	platform := ctx.TargetPlatform
 	if actualPlatform != platform {
@@ -51,7 +82,7 @@ func (s *LibrariesLoader) Run(ctx *types.Context) error {
 
 	librariesFolders := ctx.OtherLibrariesDirs
 	if err := librariesFolders.ToAbs(); err != nil {
-		return errors.WithStack(err)
+		return nil, errors.WithStack(err)
 	}
 	for _, folder := range librariesFolders {
 		lm.AddLibrariesDir(folder, libraries.User)
@@ -64,35 +95,15 @@ func (s *LibrariesLoader) Run(ctx *types.Context) error {
 		// I have no intention right now to start a refactoring of the legacy package too, so
 		// here's this shitty solution for now.
 		// When we're gonna refactor the legacy package this will be gone.
-		return errors.WithStack(errs[0].Err())
+		return nil, errors.WithStack(errs[0].Err())
 	}
 
 	for _, dir := range ctx.LibraryDirs {
 		// Libraries specified this way have top priority
 		if err := lm.LoadLibraryFromDir(dir, libraries.Unmanaged); err != nil {
-			return err
-		}
-	}
-
-	if debugLevel > 0 {
-		for _, lib := range lm.Libraries {
-			for _, libAlt := range lib.Alternatives {
-				warnings, err := libAlt.Lint()
-				if err != nil {
-					return errors.WithStack(err)
-				}
-				for _, warning := range warnings {
-					logger.Fprintln(os.Stdout, "warn", warning)
-				}
-			}
+			return nil, err
 		}
 	}
 
-	resolver := librariesresolver.NewCppResolver()
-	if err := resolver.ScanFromLibrariesManager(lm); err != nil {
-		return errors.WithStack(err)
-	}
-	ctx.LibrariesResolver = resolver
-
-	return nil
+	return lm, nil
 }
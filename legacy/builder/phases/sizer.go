@@ -17,17 +17,27 @@ package phases
 
 import (
 	"fmt"
+	"path/filepath"
 	"regexp"
 	"strconv"
+	"strings"
 
+	"github.com/arduino/arduino-cli/arduino/sizereport"
 	"github.com/arduino/arduino-cli/legacy/builder/builder_utils"
 	"github.com/arduino/arduino-cli/legacy/builder/constants"
 	"github.com/arduino/arduino-cli/legacy/builder/types"
 	"github.com/arduino/arduino-cli/legacy/builder/utils"
+	"github.com/arduino/go-paths-helper"
 	"github.com/arduino/go-properties-orderedmap"
 	"github.com/pkg/errors"
 )
 
+// Names of the libraries used in the size report for the sketch and the core
+const (
+	sizeReportSketch = "sketch"
+	sizeReportCore   = "core"
+)
+
 type Sizer struct {
 	SketchError bool
 }
@@ -42,6 +52,11 @@ func (s *Sizer) Run(ctx *types.Context) error {
 
 	buildProperties := ctx.BuildProperties
 
+	// The report is computed first, so it's available even if the sketch is too big
+	if ctx.ComputeSizeReport {
+		computeSizeReport(ctx, buildProperties)
+	}
+
 	err := checkSize(ctx, buildProperties)
 	if err != nil {
 		return errors.WithStack(err)
@@ -141,6 +156,73 @@ func checkSize(ctx *types.Context, buildProperties *properties.Map) error {
 	return nil
 }
 
+func computeSizeReport(ctx *types.Context, buildProperties *properties.Map) {
+	logger := ctx.GetLogger()
+
+	elfFile := ctx.BuildPath.Join(buildProperties.Get("build.project_name") + ".elf")
+	if !elfFile.Exist() {
+		logger.Println(constants.LOG_LEVEL_WARN, tr("Couldn't compute the size report: {0} not found"), elfFile)
+		return
+	}
+
+	objectName := func(objectFile *paths.Path) string {
+		if rel, err := ctx.BuildPath.RelTo(objectFile); err == nil {
+			if inside, _ := objectFile.IsInsideDir(ctx.BuildPath); inside {
+				return filepath.ToSlash(rel.String())
+			}
+		}
+		return objectFile.Base()
+	}
+	objects := []*sizereport.ObjectFile{}
+	addObjects := func(objectFiles paths.PathList, library string) {
+		for _, objectFile := range objectFiles {
+			objects = append(objects, &sizereport.ObjectFile{Path: objectFile, Name: objectName(objectFile), Library: library})
+		}
+	}
+	addObjects(ctx.SketchObjectFiles, sizeReportSketch)
+	addObjects(ctx.CoreObjectsFiles, sizeReportCore)
+	if ctx.CoreArchiveFilePath != nil {
+		// The archive may come from the core cache, use always the same name
+		objects = append(objects, &sizereport.ObjectFile{Path: ctx.CoreArchiveFilePath, Name: "core/core.a", Library: sizeReportCore})
+	}
+	for _, objectFile := range ctx.LibrariesObjectFiles {
+		// The object files are stored in LibrariesBuildPath/<library name>/...
+		library := ""
+		if rel, err := ctx.LibrariesBuildPath.RelTo(objectFile); err == nil {
+			library = strings.SplitN(filepath.ToSlash(rel.String()), "/", 2)[0]
+		}
+		addObjects(paths.NewPathList(objectFile.String()), library)
+	}
+
+	sourceDirs := []*sizereport.SourceDir{
+		{Path: ctx.SketchBuildPath, Library: sizeReportSketch},
+	}
+	if ctx.Sketch != nil {
+		sourceDirs = append(sourceDirs, &sizereport.SourceDir{Path: ctx.Sketch.FullPath, Library: sizeReportSketch})
+	}
+	for _, key := range []string{"build.core.path", "build.variant.path"} {
+		if dir := buildProperties.Get(key); dir != "" {
+			sourceDirs = append(sourceDirs, &sizereport.SourceDir{Path: paths.New(dir), Library: sizeReportCore})
+		}
+	}
+	for _, library := range ctx.ImportedLibraries {
+		sourceDirs = append(sourceDirs, &sizereport.SourceDir{Path: library.InstallDir, Library: library.Name})
+	}
+
+	report, err := sizereport.Analyze(elfFile, objects, sourceDirs, ctx.SizeReportMaxSymbols)
+	if err != nil {
+		logger.Println(constants.LOG_LEVEL_WARN, tr("Couldn't compute the size report: {0}"), err)
+		return
+	}
+	if maxSize, err := strconv.ParseInt(buildProperties.Get(constants.PROPERTY_UPLOAD_MAX_SIZE), 10, 64); err == nil {
+		report.MaxFlashSize = maxSize
+	}
+	if maxSize, err := strconv.ParseInt(buildProperties.Get(constants.PROPERTY_UPLOAD_MAX_DATA_SIZE), 10, 64); err == nil {
+		report.MaxRAMSize = maxSize
+	}
+	ctx.SizeReport = report
+}
+
 func execSizeRecipe(ctx *types.Context, properties *properties.Map) (textSize int, dataSize int, eepromSize int, resErr error) {
 	command, err := builder_utils.PrepareCommandForRecipe(properties, constants.RECIPE_SIZE_PATTERN, false)
 	if err != nil {
@@ -48,6 +48,16 @@ func (s *SketchBuilder) Run(ctx *types.Context) error {
 		objectFiles.AddAll(srcObjectFiles)
 	}
 
+	// The "test/" subdirectory is compiled only when building the tests
+	sketchTestPath := sketchBuildPath.Join("test")
+	if ctx.CompileSketchTests && sketchTestPath.IsDir() {
+		testObjectFiles, err := builder_utils.CompileFiles(ctx, sketchTestPath, true, sketchTestPath, buildProperties, includes)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		objectFiles.AddAll(testObjectFiles)
+	}
+
 	ctx.SketchObjectFiles = objectFiles
 
 	return nil
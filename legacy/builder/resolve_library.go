@@ -21,9 +21,10 @@ import (
 	"github.com/arduino/arduino-cli/arduino/libraries"
 	"github.com/arduino/arduino-cli/legacy/builder/constants"
 	"github.com/arduino/arduino-cli/legacy/builder/types"
+	"github.com/pkg/errors"
 )
 
-func ResolveLibrary(ctx *types.Context, header string) *libraries.Library {
+func ResolveLibrary(ctx *types.Context, header string) (*libraries.Library, error) {
 	resolver := ctx.LibrariesResolver
 	importedLibraries := ctx.ImportedLibraries
 
@@ -36,17 +37,46 @@ func ResolveLibrary(ctx *types.Context, header string) *libraries.Library {
 		logger.Println(constants.LOG_LEVEL_INFO, fmt.Sprintf("  -> %s: %s", tr("candidates"), candidates))
 	}
 
+	// A library pinned to the header must be available
+	pinned, err := resolver.ResolveOverride(header, ctx.TargetPlatform.Platform.Architecture)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
 	if candidates == nil || len(candidates) == 0 {
-		return nil
+		return nil, nil
+	}
+
+	// The library pinned to the header must be used even if another library
+	// providing it has already been included for other headers
+	for _, candidate := range candidates {
+		if pinned != nil && candidate != pinned && importedLibraries.Contains(candidate) {
+			return nil, errors.Errorf(tr("library %[1]s is pinned to provide %[2]s, but library %[3]s providing %[2]s too is already in use"),
+				pinned, header, candidate)
+		}
 	}
 
 	for _, candidate := range candidates {
 		if importedLibraries.Contains(candidate) {
-			return nil
+			return nil, nil
 		}
 	}
 
-	selected := resolver.ResolveFor(header, ctx.TargetPlatform.Platform.Architecture)
+	selected := pinned
+	if selected != nil {
+		if ctx.Verbose {
+			logger.Println(constants.LOG_LEVEL_INFO, tr("Using library %[1]s pinned for %[2]s", selected, header))
+		}
+	} else {
+		selected = resolver.ResolveFor(header, ctx.TargetPlatform.Platform.Architecture)
+	}
+	if ctx.ExplainLibraries {
+		resolution, err := resolver.Explain(header, ctx.TargetPlatform.Platform.Architecture)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		ctx.LibrariesResolutions = append(ctx.LibrariesResolutions, resolution)
+	}
 	if alreadyImported := importedLibraries.FindByName(selected.Name); alreadyImported != nil {
 		// Certain libraries might have the same name but be different.
 		// This usually happens when the user includes two or more custom libraries that have
@@ -65,7 +95,7 @@ func ResolveLibrary(ctx *types.Context, header string) *libraries.Library {
 		NotUsedLibraries: filterOutLibraryFrom(candidates, selected),
 	}
 
-	return selected
+	return selected, nil
 }
 
 func filterOutLibraryFrom(libs libraries.List, libraryToRemove *libraries.Library) libraries.List {
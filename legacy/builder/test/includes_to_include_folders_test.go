@@ -18,6 +18,7 @@ package test
 import (
 	"path/filepath"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/arduino/arduino-cli/legacy/builder"
@@ -321,3 +322,49 @@ func TestIncludesToIncludeFoldersSubfolders(t *testing.T) {
 	require.Equal(t, "testlib2", importedLibraries[1].Name)
 	require.Equal(t, "testlib3", importedLibraries[2].Name)
 }
+
+func TestIncludesToIncludeFoldersParallelJobs(t *testing.T) {
+	DownloadCoresAndToolsAndLibraries(t)
+
+	findIncludes := func(jobs int) ([]string, string) {
+		ctx := &types.Context{
+			HardwareDirs:         paths.NewPathList(filepath.Join("..", "hardware"), "downloaded_hardware"),
+			BuiltInToolsDirs:     paths.NewPathList("downloaded_tools"),
+			BuiltInLibrariesDirs: paths.NewPathList("downloaded_libraries"),
+			OtherLibrariesDirs:   paths.NewPathList("dependent_libraries", "libraries"),
+			SketchLocation:       paths.New("sketch_with_dependend_libraries", "sketch_with_dependend_libraries.ino"),
+			FQBN:                 parseFQBN(t, "arduino:avr:leonardo"),
+			ArduinoAPIVersion:    "10600",
+			Jobs:                 jobs,
+		}
+
+		buildPath := SetupBuildPath(t, ctx)
+		defer buildPath.RemoveAll()
+
+		commands := []types.Command{
+			&builder.ContainerSetupHardwareToolsLibsSketchAndProps{},
+			&builder.ContainerMergeCopySketchFiles{},
+			&builder.ContainerFindIncludes{},
+		}
+		for _, command := range commands {
+			err := command.Run(ctx)
+			NoError(t, err)
+		}
+
+		names := []string{}
+		for _, library := range ctx.ImportedLibraries {
+			names = append(names, library.Name)
+		}
+		cache, err := buildPath.Join("includes.cache").ReadFile()
+		NoError(t, err)
+		return names, strings.ReplaceAll(string(cache), buildPath.String(), "{build.path}")
+	}
+
+	// The libraries are detected in the same order and the cache has the
+	// same contents when the source files are preprocessed in parallel
+	sequentialLibraries, sequentialCache := findIncludes(1)
+	require.Len(t, sequentialLibraries, 4)
+	parallelLibraries, parallelCache := findIncludes(8)
+	require.Equal(t, sequentialLibraries, parallelLibraries)
+	require.Equal(t, sequentialCache, parallelCache)
+}
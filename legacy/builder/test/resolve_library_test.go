@@ -0,0 +1,81 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package test
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesresolver"
+	"github.com/arduino/arduino-cli/legacy/builder"
+	"github.com/arduino/arduino-cli/legacy/builder/types"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveLibraryWithPinnedLibrary(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "resolve_library_test")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	// Both libraries provide Sensor.h, Fork provides also Fork.h
+	newLibrary := func(name string, headers ...string) *libraries.Library {
+		dir := tmp.Join(name)
+		require.NoError(t, dir.MkdirAll())
+		for _, header := range headers {
+			require.NoError(t, dir.Join(header).WriteFile([]byte{}))
+		}
+		return &libraries.Library{Name: name, InstallDir: dir, SourceDir: dir, Location: libraries.User}
+	}
+	sensor := newLibrary("Sensor", "Sensor.h")
+	fork := newLibrary("SensorFork", "Sensor.h", "Fork.h")
+
+	newContext := func() *types.Context {
+		resolver := librariesresolver.NewCppResolver()
+		require.NoError(t, resolver.ScanLibrary(sensor))
+		require.NoError(t, resolver.ScanLibrary(fork))
+		resolver.AddOverride(&librariesresolver.Override{Header: "Sensor.h", Library: "Sensor"})
+		return &types.Context{
+			LibrariesResolver:          resolver,
+			LibrariesResolutionResults: map[string]types.LibraryResolutionResult{},
+			TargetPlatform:             &cores.PlatformRelease{Platform: &cores.Platform{Architecture: "avr"}},
+		}
+	}
+
+	// The pinned library is selected
+	ctx := newContext()
+	lib, err := builder.ResolveLibrary(ctx, "Sensor.h")
+	require.NoError(t, err)
+	require.Equal(t, sensor, lib)
+
+	// and the header is not resolved again once it's in use
+	ctx.ImportedLibraries = append(ctx.ImportedLibraries, sensor)
+	lib, err = builder.ResolveLibrary(ctx, "Sensor.h")
+	require.NoError(t, err)
+	require.Nil(t, lib)
+
+	// The pinned library can't be used if another library providing the same
+	// header has already been included through another header
+	ctx = newContext()
+	lib, err = builder.ResolveLibrary(ctx, "Fork.h")
+	require.NoError(t, err)
+	require.Equal(t, fork, lib)
+	ctx.ImportedLibraries = append(ctx.ImportedLibraries, fork)
+	_, err = builder.ResolveLibrary(ctx, "Sensor.h")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "SensorFork")
+}
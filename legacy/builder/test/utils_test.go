@@ -16,9 +16,13 @@
 package test
 
 import (
+	"io"
+	"os/exec"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/arduino/arduino-cli/legacy/builder/types"
 	"github.com/arduino/arduino-cli/legacy/builder/utils"
 	"github.com/stretchr/testify/require"
 )
@@ -103,3 +107,41 @@ func TestParseCppString(t *testing.T) {
 	require.Equal(t, `/home/ççç/ /$sdsdd\`, str)
 	require.Equal(t, ``, rest)
 }
+
+// closeOnWrite closes the pipe when something is written
+type closeOnWrite struct {
+	pipe   io.Closer
+	output strings.Builder
+}
+
+func (w *closeOnWrite) Write(p []byte) (int, error) {
+	w.output.Write(p)
+	w.pipe.Close()
+	return len(p), nil
+}
+
+func TestExecCommandShowAndCapture(t *testing.T) {
+	// The command waits for its input to be closed, that happens when its
+	// output is shown, so the output must be shown while it's running
+	command := exec.Command("sh", "-c", "echo warning >&2; cat")
+	stdin, err := command.StdinPipe()
+	require.NoError(t, err)
+	stderr := &closeOnWrite{pipe: stdin}
+	ctx := &types.Context{ExecStderr: stderr}
+
+	var output []byte
+	done := make(chan struct{})
+	go func() {
+		_, output, err = utils.ExecCommand(ctx, command, utils.Ignore /* stdout */, utils.ShowAndCapture /* stderr */)
+		close(done)
+	}()
+	select {
+	case <-done:
+		require.NoError(t, err)
+		require.Equal(t, "warning\n", string(output))
+		require.Equal(t, "warning\n", stderr.output.String())
+	case <-time.After(10 * time.Second):
+		stdin.Close()
+		require.FailNow(t, "output not shown while the command is running")
+	}
+}
@@ -19,12 +19,14 @@ import (
 	"io"
 	"strings"
 
+	"github.com/arduino/arduino-cli/arduino/buildcache"
 	"github.com/arduino/arduino-cli/arduino/builder"
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
 	"github.com/arduino/arduino-cli/arduino/libraries"
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesresolver"
+	"github.com/arduino/arduino-cli/arduino/sizereport"
 	"github.com/arduino/arduino-cli/arduino/sketch"
 	"github.com/arduino/arduino-cli/legacy/builder/i18n"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
@@ -100,6 +102,7 @@ type Context struct {
 	SketchBuildPath              *paths.Path
 	CoreBuildPath                *paths.Path
 	CoreBuildCachePath           *paths.Path
+	CompilationCache             buildcache.Backend
 	CoreArchiveFilePath          *paths.Path
 	CoreObjectsFiles             paths.PathList
 	LibrariesBuildPath           *paths.Path
@@ -107,6 +110,9 @@ type Context struct {
 	PreprocPath                  *paths.Path
 	SketchObjectFiles            paths.PathList
 	IgnoreSketchFolderNameErrors bool
+	// Set CompileSketchTests to true to compile the test subfolder of the
+	// sketch too, recursively like the src one
+	CompileSketchTests bool
 
 	CollectedSourceFiles *UniqueSourceFileQueue
 
@@ -122,8 +128,15 @@ type Context struct {
 	LibrariesResolver          *librariesresolver.Cpp
 	ImportedLibraries          libraries.List
 	LibrariesResolutionResults map[string]LibraryResolutionResult
+	LibraryOverrides           []*librariesresolver.Override // Libraries pinned to headers
 	IncludeFolders             paths.PathList
 	//OutputGccMinusM            string
+	// Libraries shared with the builds for other boards, if not nil
+	LibrariesManagersCache *LibrariesManagersCache
+	// Set ExplainLibraries to true to record in LibrariesResolutions how the
+	// library providing each header has been selected
+	ExplainLibraries     bool
+	LibrariesResolutions []*librariesresolver.Resolution
 
 	// C++ Parsing
 	CTagsOutput                 string
@@ -165,8 +178,18 @@ type Context struct {
 	ExecStdout io.Writer
 	ExecStderr io.Writer
 
+	// Called for each diagnostic reported by the compiler, it may be called
+	// concurrently by the parallel compilation jobs
+	CompilerDiagnosticsCB func(*builder.CompilerDiagnostic)
+
 	// Sizer results
 	ExecutableSectionsSize ExecutablesFileSections
+	// Set ComputeSizeReport to true to obtain the detailed memory usage of the
+	// executable in SizeReport, with at most SizeReportMaxSymbols symbols (all
+	// of them if 0)
+	ComputeSizeReport    bool
+	SizeReportMaxSymbols int
+	SizeReport           *sizereport.Report
 
 	// Compilation Database to build/update
 	CompilationDatabase *builder.CompilationDatabase
@@ -0,0 +1,51 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package types
+
+import (
+	"sync"
+
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
+)
+
+// LibrariesManagersCache shares the libraries loaded by the builds of a
+// sketch for different boards, the boards of the same platform use the same
+// libraries. It's safe for concurrent use.
+type LibrariesManagersCache struct {
+	mutex    sync.Mutex
+	managers map[string]*librariesmanager.LibrariesManager
+}
+
+// NewLibrariesManagersCache creates an empty LibrariesManagersCache
+func NewLibrariesManagersCache() *LibrariesManagersCache {
+	return &LibrariesManagersCache{managers: map[string]*librariesmanager.LibrariesManager{}}
+}
+
+// Get returns the LibrariesManager cached with the given key, calling load
+// to create it if missing
+func (c *LibrariesManagersCache) Get(key string, load func() (*librariesmanager.LibrariesManager, error)) (*librariesmanager.LibrariesManager, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if lm, ok := c.managers[key]; ok {
+		return lm, nil
+	}
+	lm, err := load()
+	if err != nil {
+		return nil, err
+	}
+	c.managers[key] = lm
+	return lm, nil
+}
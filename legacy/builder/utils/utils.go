@@ -168,10 +168,11 @@ func PrintableCommand(parts []string) string {
 }
 
 const (
-	Ignore        = 0 // Redirect to null
-	Show          = 1 // Show on stdout/stderr as normal
-	ShowIfVerbose = 2 // Show if verbose is set, Ignore otherwise
-	Capture       = 3 // Capture into buffer
+	Ignore         = 0 // Redirect to null
+	Show           = 1 // Show on stdout/stderr as normal
+	ShowIfVerbose  = 2 // Show if verbose is set, Ignore otherwise
+	Capture        = 3 // Capture into buffer
+	ShowAndCapture = 4 // Show on stdout/stderr as normal and capture into buffer
 )
 
 func ExecCommand(ctx *types.Context, command *exec.Cmd, stdout int, stderr int) ([]byte, []byte, error) {
@@ -186,16 +187,20 @@ func ExecCommand(ctx *types.Context, command *exec.Cmd, stdout int, stderr int)
 		ctx.GetLogger().UnformattedFprintln(os.Stdout, PrintableCommand(command.Args))
 	}
 
+	stdoutBuffer := &bytes.Buffer{}
 	if stdout == Capture {
-		buffer := &bytes.Buffer{}
-		command.Stdout = buffer
+		command.Stdout = stdoutBuffer
+	} else if stdout == ShowAndCapture {
+		command.Stdout = io.MultiWriter(ctx.ExecStdout, stdoutBuffer)
 	} else if stdout == Show || stdout == ShowIfVerbose && ctx.Verbose {
 		command.Stdout = ctx.ExecStdout
 	}
 
+	stderrBuffer := &bytes.Buffer{}
 	if stderr == Capture {
-		buffer := &bytes.Buffer{}
-		command.Stderr = buffer
+		command.Stderr = stderrBuffer
+	} else if stderr == ShowAndCapture {
+		command.Stderr = io.MultiWriter(ctx.ExecStderr, stderrBuffer)
 	} else if stderr == Show || stderr == ShowIfVerbose && ctx.Verbose {
 		command.Stderr = ctx.ExecStderr
 	}
@@ -208,11 +213,11 @@ func ExecCommand(ctx *types.Context, command *exec.Cmd, stdout int, stderr int)
 	err = command.Wait()
 
 	var outbytes, errbytes []byte
-	if buf, ok := command.Stdout.(*bytes.Buffer); ok {
-		outbytes = buf.Bytes()
+	if stdout == Capture || stdout == ShowAndCapture {
+		outbytes = stdoutBuffer.Bytes()
 	}
-	if buf, ok := command.Stderr.(*bytes.Buffer); ok {
-		errbytes = buf.Bytes()
+	if stderr == Capture || stderr == ShowAndCapture {
+		errbytes = stderrBuffer.Bytes()
 	}
 
 	return outbytes, errbytes, errors.WithStack(err)
@@ -0,0 +1,544 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.16.0
+// source: cc/arduino/cli/commands/v1/bundle.proto
+
+package commands
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BundleExportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Arduino Core Service instance from the `Init` response.
+	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	// The FQBNs of the boards whose platforms, together with their tools, are
+	// exported.
+	Fqbns []string `protobuf:"bytes,2,rep,name=fqbns,proto3" json:"fqbns,omitempty"`
+	// The libraries, together with their dependencies, to export.
+	Libraries []*BundleLibrary `protobuf:"bytes,3,rep,name=libraries,proto3" json:"libraries,omitempty"`
+	// The operating systems and architectures, in the os/arch form (e.g.
+	// `linux/amd64`), the tools are exported for. If empty the ones of the
+	// running CLI are used.
+	Hosts []string `protobuf:"bytes,4,rep,name=hosts,proto3" json:"hosts,omitempty"`
+	// The folder where the bundle is exported or, if its name ends with
+	// `.tar.gz` or `.tgz`, the tarball where the bundle is written. It must
+	// not exist.
+	Output string `protobuf:"bytes,5,opt,name=output,proto3" json:"output,omitempty"`
+}
+
+func (x *BundleExportRequest) Reset() {
+	*x = BundleExportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_bundle_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BundleExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BundleExportRequest) ProtoMessage() {}
+
+func (x *BundleExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_bundle_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BundleExportRequest.ProtoReflect.Descriptor instead.
+func (*BundleExportRequest) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_bundle_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BundleExportRequest) GetInstance() *Instance {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+func (x *BundleExportRequest) GetFqbns() []string {
+	if x != nil {
+		return x.Fqbns
+	}
+	return nil
+}
+
+func (x *BundleExportRequest) GetLibraries() []*BundleLibrary {
+	if x != nil {
+		return x.Libraries
+	}
+	return nil
+}
+
+func (x *BundleExportRequest) GetHosts() []string {
+	if x != nil {
+		return x.Hosts
+	}
+	return nil
+}
+
+func (x *BundleExportRequest) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+type BundleLibrary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the library.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The version of the library to export, if empty the latest one is
+	// exported.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *BundleLibrary) Reset() {
+	*x = BundleLibrary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_bundle_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BundleLibrary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BundleLibrary) ProtoMessage() {}
+
+func (x *BundleLibrary) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_bundle_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BundleLibrary.ProtoReflect.Descriptor instead.
+func (*BundleLibrary) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_bundle_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BundleLibrary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BundleLibrary) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type BundleExportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Progress of the downloads of the archives.
+	Progress *DownloadProgress `protobuf:"bytes,1,opt,name=progress,proto3" json:"progress,omitempty"`
+	// The exported platforms, sent in the last message.
+	Platforms []string `protobuf:"bytes,2,rep,name=platforms,proto3" json:"platforms,omitempty"`
+	// The exported tools, sent in the last message.
+	Tools []string `protobuf:"bytes,3,rep,name=tools,proto3" json:"tools,omitempty"`
+	// The exported libraries, sent in the last message.
+	Libraries []string `protobuf:"bytes,4,rep,name=libraries,proto3" json:"libraries,omitempty"`
+}
+
+func (x *BundleExportResponse) Reset() {
+	*x = BundleExportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_bundle_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BundleExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BundleExportResponse) ProtoMessage() {}
+
+func (x *BundleExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_bundle_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BundleExportResponse.ProtoReflect.Descriptor instead.
+func (*BundleExportResponse) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_bundle_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BundleExportResponse) GetProgress() *DownloadProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *BundleExportResponse) GetPlatforms() []string {
+	if x != nil {
+		return x.Platforms
+	}
+	return nil
+}
+
+func (x *BundleExportResponse) GetTools() []string {
+	if x != nil {
+		return x.Tools
+	}
+	return nil
+}
+
+func (x *BundleExportResponse) GetLibraries() []string {
+	if x != nil {
+		return x.Libraries
+	}
+	return nil
+}
+
+type BundleImportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The bundle to import, a folder or a `.tar.gz` tarball.
+	BundlePath string `protobuf:"bytes,1,opt,name=bundle_path,json=bundlePath,proto3" json:"bundle_path,omitempty"`
+}
+
+func (x *BundleImportRequest) Reset() {
+	*x = BundleImportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_bundle_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BundleImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BundleImportRequest) ProtoMessage() {}
+
+func (x *BundleImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_bundle_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BundleImportRequest.ProtoReflect.Descriptor instead.
+func (*BundleImportRequest) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_bundle_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BundleImportRequest) GetBundlePath() string {
+	if x != nil {
+		return x.BundlePath
+	}
+	return ""
+}
+
+type BundleImportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The file URL of the package index of the bundle, to be added to the
+	// `board_manager.additional_urls` setting.
+	PackageIndexUrl string `protobuf:"bytes,1,opt,name=package_index_url,json=packageIndexUrl,proto3" json:"package_index_url,omitempty"`
+	// The file URL of the library index of the bundle, to be set as the
+	// `library.index_url` setting.
+	LibraryIndexUrl string `protobuf:"bytes,2,opt,name=library_index_url,json=libraryIndexUrl,proto3" json:"library_index_url,omitempty"`
+}
+
+func (x *BundleImportResponse) Reset() {
+	*x = BundleImportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cc_arduino_cli_commands_v1_bundle_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BundleImportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BundleImportResponse) ProtoMessage() {}
+
+func (x *BundleImportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cc_arduino_cli_commands_v1_bundle_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BundleImportResponse.ProtoReflect.Descriptor instead.
+func (*BundleImportResponse) Descriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_bundle_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BundleImportResponse) GetPackageIndexUrl() string {
+	if x != nil {
+		return x.PackageIndexUrl
+	}
+	return ""
+}
+
+func (x *BundleImportResponse) GetLibraryIndexUrl() string {
+	if x != nil {
+		return x.LibraryIndexUrl
+	}
+	return ""
+}
+
+var File_cc_arduino_cli_commands_v1_bundle_proto protoreflect.FileDescriptor
+
+var file_cc_arduino_cli_commands_v1_bundle_proto_rawDesc = []byte{
+	0x0a, 0x27, 0x63, 0x63, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2f, 0x63, 0x6c, 0x69,
+	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x75, 0x6e,
+	0x64, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1a, 0x63, 0x63, 0x2e, 0x61, 0x72,
+	0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x27, 0x63, 0x63, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e,
+	0x6f, 0x2f, 0x63, 0x6c, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2f, 0x76,
+	0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe4,
+	0x01, 0x0a, 0x13, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72,
+	0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x71, 0x62, 0x6e,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x71, 0x62, 0x6e, 0x73, 0x12, 0x47,
+	0x0a, 0x09, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63,
+	0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x09, 0x6c, 0x69,
+	0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x3d, 0x0a, 0x0d, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x4c,
+	0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0xb2, 0x01, 0x0a, 0x14, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2c, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x74, 0x66,
+	0x6f, 0x72, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x74,
+	0x66, 0x6f, 0x72, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6c,
+	0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x22, 0x36, 0x0a, 0x13, 0x42, 0x75, 0x6e,
+	0x64, 0x6c, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x50, 0x61, 0x74,
+	0x68, 0x22, 0x6e, 0x0a, 0x14, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x55, 0x72, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x55, 0x72,
+	0x6c, 0x42, 0x48, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2d,
+	0x63, 0x6c, 0x69, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x63, 0x63, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69,
+	0x6e, 0x6f, 0x2f, 0x63, 0x6c, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2f,
+	0x76, 0x31, 0x3b, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_cc_arduino_cli_commands_v1_bundle_proto_rawDescOnce sync.Once
+	file_cc_arduino_cli_commands_v1_bundle_proto_rawDescData = file_cc_arduino_cli_commands_v1_bundle_proto_rawDesc
+)
+
+func file_cc_arduino_cli_commands_v1_bundle_proto_rawDescGZIP() []byte {
+	file_cc_arduino_cli_commands_v1_bundle_proto_rawDescOnce.Do(func() {
+		file_cc_arduino_cli_commands_v1_bundle_proto_rawDescData = protoimpl.X.CompressGZIP(file_cc_arduino_cli_commands_v1_bundle_proto_rawDescData)
+	})
+	return file_cc_arduino_cli_commands_v1_bundle_proto_rawDescData
+}
+
+var file_cc_arduino_cli_commands_v1_bundle_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_cc_arduino_cli_commands_v1_bundle_proto_goTypes = []interface{}{
+	(*BundleExportRequest)(nil),  // 0: cc.arduino.cli.commands.v1.BundleExportRequest
+	(*BundleLibrary)(nil),        // 1: cc.arduino.cli.commands.v1.BundleLibrary
+	(*BundleExportResponse)(nil), // 2: cc.arduino.cli.commands.v1.BundleExportResponse
+	(*BundleImportRequest)(nil),  // 3: cc.arduino.cli.commands.v1.BundleImportRequest
+	(*BundleImportResponse)(nil), // 4: cc.arduino.cli.commands.v1.BundleImportResponse
+	(*Instance)(nil),             // 5: cc.arduino.cli.commands.v1.Instance
+	(*DownloadProgress)(nil),     // 6: cc.arduino.cli.commands.v1.DownloadProgress
+}
+var file_cc_arduino_cli_commands_v1_bundle_proto_depIdxs = []int32{
+	5, // 0: cc.arduino.cli.commands.v1.BundleExportRequest.instance:type_name -> cc.arduino.cli.commands.v1.Instance
+	1, // 1: cc.arduino.cli.commands.v1.BundleExportRequest.libraries:type_name -> cc.arduino.cli.commands.v1.BundleLibrary
+	6, // 2: cc.arduino.cli.commands.v1.BundleExportResponse.progress:type_name -> cc.arduino.cli.commands.v1.DownloadProgress
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_cc_arduino_cli_commands_v1_bundle_proto_init() }
+func file_cc_arduino_cli_commands_v1_bundle_proto_init() {
+	if File_cc_arduino_cli_commands_v1_bundle_proto != nil {
+		return
+	}
+	file_cc_arduino_cli_commands_v1_common_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_cc_arduino_cli_commands_v1_bundle_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BundleExportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_bundle_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BundleLibrary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_bundle_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BundleExportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_bundle_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BundleImportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cc_arduino_cli_commands_v1_bundle_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BundleImportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_cc_arduino_cli_commands_v1_bundle_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cc_arduino_cli_commands_v1_bundle_proto_goTypes,
+		DependencyIndexes: file_cc_arduino_cli_commands_v1_bundle_proto_depIdxs,
+		MessageInfos:      file_cc_arduino_cli_commands_v1_bundle_proto_msgTypes,
+	}.Build()
+	File_cc_arduino_cli_commands_v1_bundle_proto = out.File
+	file_cc_arduino_cli_commands_v1_bundle_proto_rawDesc = nil
+	file_cc_arduino_cli_commands_v1_bundle_proto_goTypes = nil
+	file_cc_arduino_cli_commands_v1_bundle_proto_depIdxs = nil
+}
@@ -41,6 +41,9 @@ type ArduinoCoreServiceClient interface {
 	LoadSketch(ctx context.Context, in *LoadSketchRequest, opts ...grpc.CallOption) (*LoadSketchResponse, error)
 	// Creates a zip file containing all files of specified Sketch
 	ArchiveSketch(ctx context.Context, in *ArchiveSketchRequest, opts ...grpc.CallOption) (*ArchiveSketchResponse, error)
+	// Install the platforms and libraries declared in the Sketch project file,
+	// using the exact releases recorded in the Sketch lock file if present.
+	SketchInstallDependencies(ctx context.Context, in *SketchInstallDependenciesRequest, opts ...grpc.CallOption) (ArduinoCoreService_SketchInstallDependenciesClient, error)
 	// Requests details about a board
 	BoardDetails(ctx context.Context, in *BoardDetailsRequest, opts ...grpc.CallOption) (*BoardDetailsResponse, error)
 	// Attach a board to a sketch. When the `fqbn` field of a request is not
@@ -56,6 +59,15 @@ type ArduinoCoreServiceClient interface {
 	BoardListWatch(ctx context.Context, opts ...grpc.CallOption) (ArduinoCoreService_BoardListWatchClient, error)
 	// Compile an Arduino sketch.
 	Compile(ctx context.Context, in *CompileRequest, opts ...grpc.CallOption) (ArduinoCoreService_CompileClient, error)
+	// Compile an Arduino sketch and compile it again each time the sketch or the
+	// unmanaged libraries it uses change, until the call is cancelled.
+	CompileWatch(ctx context.Context, in *CompileWatchRequest, opts ...grpc.CallOption) (ArduinoCoreService_CompileWatchClient, error)
+	// Compile an Arduino sketch for more boards and build properties, sending
+	// the result of each target as soon as its build ends.
+	CompileMatrix(ctx context.Context, in *CompileMatrixRequest, opts ...grpc.CallOption) (ArduinoCoreService_CompileMatrixClient, error)
+	// Build an Arduino sketch and its unit tests for the host, with a mock
+	// Arduino core, and run the tests.
+	Test(ctx context.Context, in *TestRequest, opts ...grpc.CallOption) (ArduinoCoreService_TestClient, error)
 	// Download and install a platform and its tool dependencies.
 	PlatformInstall(ctx context.Context, in *PlatformInstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_PlatformInstallClient, error)
 	// Download a platform and its tool dependencies to the `staging/packages`
@@ -98,6 +110,22 @@ type ArduinoCoreServiceClient interface {
 	LibrarySearch(ctx context.Context, in *LibrarySearchRequest, opts ...grpc.CallOption) (*LibrarySearchResponse, error)
 	// List the installed libraries.
 	LibraryList(ctx context.Context, in *LibraryListRequest, opts ...grpc.CallOption) (*LibraryListResponse, error)
+	// Check the formal correctness of a library.
+	LibraryLint(ctx context.Context, in *LibraryLintRequest, opts ...grpc.CallOption) (*LibraryLintResponse, error)
+	// Open a monitor connection to a board port. The port configuration may be
+	// changed while the port is open by sending a new `port_configuration`.
+	Monitor(ctx context.Context, opts ...grpc.CallOption) (ArduinoCoreService_MonitorClient, error)
+	// Returns the parameters that can be set in the MonitorRequest calls
+	EnumerateMonitorPortSettings(ctx context.Context, in *EnumerateMonitorPortSettingsRequest, opts ...grpc.CallOption) (*EnumerateMonitorPortSettingsResponse, error)
+	// Export a bundle, containing platforms and libraries with the indexes
+	// referencing them, to install them on machines without network access.
+	// The archives are downloaded to the staging directory if missing.
+	BundleExport(ctx context.Context, in *BundleExportRequest, opts ...grpc.CallOption) (ArduinoCoreService_BundleExportClient, error)
+	// Import a bundle exported with `BundleExport`. The settings are not
+	// changed: to install from the bundle add the returned package index URL
+	// to `board_manager.additional_urls`, set the returned library index URL
+	// as `library.index_url` and update the indexes.
+	BundleImport(ctx context.Context, in *BundleImportRequest, opts ...grpc.CallOption) (*BundleImportResponse, error)
 }
 
 type arduinoCoreServiceClient struct {
@@ -322,6 +350,38 @@ func (c *arduinoCoreServiceClient) ArchiveSketch(ctx context.Context, in *Archiv
 	return out, nil
 }
 
+func (c *arduinoCoreServiceClient) SketchInstallDependencies(ctx context.Context, in *SketchInstallDependenciesRequest, opts ...grpc.CallOption) (ArduinoCoreService_SketchInstallDependenciesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[5], "/cc.arduino.cli.commands.v1.ArduinoCoreService/SketchInstallDependencies", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreServiceSketchInstallDependenciesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCoreService_SketchInstallDependenciesClient interface {
+	Recv() (*SketchInstallDependenciesResponse, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreServiceSketchInstallDependenciesClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreServiceSketchInstallDependenciesClient) Recv() (*SketchInstallDependenciesResponse, error) {
+	m := new(SketchInstallDependenciesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *arduinoCoreServiceClient) BoardDetails(ctx context.Context, in *BoardDetailsRequest, opts ...grpc.CallOption) (*BoardDetailsResponse, error) {
 	out := new(BoardDetailsResponse)
 	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.v1.ArduinoCoreService/BoardDetails", in, out, opts...)
@@ -332,7 +392,7 @@ func (c *arduinoCoreServiceClient) BoardDetails(ctx context.Context, in *BoardDe
 }
 
 func (c *arduinoCoreServiceClient) BoardAttach(ctx context.Context, in *BoardAttachRequest, opts ...grpc.CallOption) (ArduinoCoreService_BoardAttachClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[5], "/cc.arduino.cli.commands.v1.ArduinoCoreService/BoardAttach", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[6], "/cc.arduino.cli.commands.v1.ArduinoCoreService/BoardAttach", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -391,7 +451,7 @@ func (c *arduinoCoreServiceClient) BoardSearch(ctx context.Context, in *BoardSea
 }
 
 func (c *arduinoCoreServiceClient) BoardListWatch(ctx context.Context, opts ...grpc.CallOption) (ArduinoCoreService_BoardListWatchClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[6], "/cc.arduino.cli.commands.v1.ArduinoCoreService/BoardListWatch", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[7], "/cc.arduino.cli.commands.v1.ArduinoCoreService/BoardListWatch", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -422,7 +482,7 @@ func (x *arduinoCoreServiceBoardListWatchClient) Recv() (*BoardListWatchResponse
 }
 
 func (c *arduinoCoreServiceClient) Compile(ctx context.Context, in *CompileRequest, opts ...grpc.CallOption) (ArduinoCoreService_CompileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[7], "/cc.arduino.cli.commands.v1.ArduinoCoreService/Compile", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[8], "/cc.arduino.cli.commands.v1.ArduinoCoreService/Compile", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -453,8 +513,104 @@ func (x *arduinoCoreServiceCompileClient) Recv() (*CompileResponse, error) {
 	return m, nil
 }
 
+func (c *arduinoCoreServiceClient) CompileWatch(ctx context.Context, in *CompileWatchRequest, opts ...grpc.CallOption) (ArduinoCoreService_CompileWatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[9], "/cc.arduino.cli.commands.v1.ArduinoCoreService/CompileWatch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreServiceCompileWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCoreService_CompileWatchClient interface {
+	Recv() (*CompileWatchResponse, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreServiceCompileWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreServiceCompileWatchClient) Recv() (*CompileWatchResponse, error) {
+	m := new(CompileWatchResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *arduinoCoreServiceClient) CompileMatrix(ctx context.Context, in *CompileMatrixRequest, opts ...grpc.CallOption) (ArduinoCoreService_CompileMatrixClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[10], "/cc.arduino.cli.commands.v1.ArduinoCoreService/CompileMatrix", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreServiceCompileMatrixClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCoreService_CompileMatrixClient interface {
+	Recv() (*CompileMatrixResponse, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreServiceCompileMatrixClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreServiceCompileMatrixClient) Recv() (*CompileMatrixResponse, error) {
+	m := new(CompileMatrixResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *arduinoCoreServiceClient) Test(ctx context.Context, in *TestRequest, opts ...grpc.CallOption) (ArduinoCoreService_TestClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[11], "/cc.arduino.cli.commands.v1.ArduinoCoreService/Test", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreServiceTestClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCoreService_TestClient interface {
+	Recv() (*TestResponse, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreServiceTestClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreServiceTestClient) Recv() (*TestResponse, error) {
+	m := new(TestResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *arduinoCoreServiceClient) PlatformInstall(ctx context.Context, in *PlatformInstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_PlatformInstallClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[8], "/cc.arduino.cli.commands.v1.ArduinoCoreService/PlatformInstall", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[12], "/cc.arduino.cli.commands.v1.ArduinoCoreService/PlatformInstall", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -486,7 +642,7 @@ func (x *arduinoCoreServicePlatformInstallClient) Recv() (*PlatformInstallRespon
 }
 
 func (c *arduinoCoreServiceClient) PlatformDownload(ctx context.Context, in *PlatformDownloadRequest, opts ...grpc.CallOption) (ArduinoCoreService_PlatformDownloadClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[9], "/cc.arduino.cli.commands.v1.ArduinoCoreService/PlatformDownload", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[13], "/cc.arduino.cli.commands.v1.ArduinoCoreService/PlatformDownload", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -518,7 +674,7 @@ func (x *arduinoCoreServicePlatformDownloadClient) Recv() (*PlatformDownloadResp
 }
 
 func (c *arduinoCoreServiceClient) PlatformUninstall(ctx context.Context, in *PlatformUninstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_PlatformUninstallClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[10], "/cc.arduino.cli.commands.v1.ArduinoCoreService/PlatformUninstall", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[14], "/cc.arduino.cli.commands.v1.ArduinoCoreService/PlatformUninstall", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -550,7 +706,7 @@ func (x *arduinoCoreServicePlatformUninstallClient) Recv() (*PlatformUninstallRe
 }
 
 func (c *arduinoCoreServiceClient) PlatformUpgrade(ctx context.Context, in *PlatformUpgradeRequest, opts ...grpc.CallOption) (ArduinoCoreService_PlatformUpgradeClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[11], "/cc.arduino.cli.commands.v1.ArduinoCoreService/PlatformUpgrade", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[15], "/cc.arduino.cli.commands.v1.ArduinoCoreService/PlatformUpgrade", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -582,7 +738,7 @@ func (x *arduinoCoreServicePlatformUpgradeClient) Recv() (*PlatformUpgradeRespon
 }
 
 func (c *arduinoCoreServiceClient) Upload(ctx context.Context, in *UploadRequest, opts ...grpc.CallOption) (ArduinoCoreService_UploadClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[12], "/cc.arduino.cli.commands.v1.ArduinoCoreService/Upload", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[16], "/cc.arduino.cli.commands.v1.ArduinoCoreService/Upload", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -614,7 +770,7 @@ func (x *arduinoCoreServiceUploadClient) Recv() (*UploadResponse, error) {
 }
 
 func (c *arduinoCoreServiceClient) UploadUsingProgrammer(ctx context.Context, in *UploadUsingProgrammerRequest, opts ...grpc.CallOption) (ArduinoCoreService_UploadUsingProgrammerClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[13], "/cc.arduino.cli.commands.v1.ArduinoCoreService/UploadUsingProgrammer", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[17], "/cc.arduino.cli.commands.v1.ArduinoCoreService/UploadUsingProgrammer", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -655,7 +811,7 @@ func (c *arduinoCoreServiceClient) ListProgrammersAvailableForUpload(ctx context
 }
 
 func (c *arduinoCoreServiceClient) BurnBootloader(ctx context.Context, in *BurnBootloaderRequest, opts ...grpc.CallOption) (ArduinoCoreService_BurnBootloaderClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[14], "/cc.arduino.cli.commands.v1.ArduinoCoreService/BurnBootloader", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[18], "/cc.arduino.cli.commands.v1.ArduinoCoreService/BurnBootloader", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -705,7 +861,7 @@ func (c *arduinoCoreServiceClient) PlatformList(ctx context.Context, in *Platfor
 }
 
 func (c *arduinoCoreServiceClient) LibraryDownload(ctx context.Context, in *LibraryDownloadRequest, opts ...grpc.CallOption) (ArduinoCoreService_LibraryDownloadClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[15], "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryDownload", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[19], "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryDownload", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -737,7 +893,7 @@ func (x *arduinoCoreServiceLibraryDownloadClient) Recv() (*LibraryDownloadRespon
 }
 
 func (c *arduinoCoreServiceClient) LibraryInstall(ctx context.Context, in *LibraryInstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_LibraryInstallClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[16], "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryInstall", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[20], "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryInstall", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -769,7 +925,7 @@ func (x *arduinoCoreServiceLibraryInstallClient) Recv() (*LibraryInstallResponse
 }
 
 func (c *arduinoCoreServiceClient) ZipLibraryInstall(ctx context.Context, in *ZipLibraryInstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_ZipLibraryInstallClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[17], "/cc.arduino.cli.commands.v1.ArduinoCoreService/ZipLibraryInstall", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[21], "/cc.arduino.cli.commands.v1.ArduinoCoreService/ZipLibraryInstall", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -801,7 +957,7 @@ func (x *arduinoCoreServiceZipLibraryInstallClient) Recv() (*ZipLibraryInstallRe
 }
 
 func (c *arduinoCoreServiceClient) GitLibraryInstall(ctx context.Context, in *GitLibraryInstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_GitLibraryInstallClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[18], "/cc.arduino.cli.commands.v1.ArduinoCoreService/GitLibraryInstall", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[22], "/cc.arduino.cli.commands.v1.ArduinoCoreService/GitLibraryInstall", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -833,7 +989,7 @@ func (x *arduinoCoreServiceGitLibraryInstallClient) Recv() (*GitLibraryInstallRe
 }
 
 func (c *arduinoCoreServiceClient) LibraryUninstall(ctx context.Context, in *LibraryUninstallRequest, opts ...grpc.CallOption) (ArduinoCoreService_LibraryUninstallClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[19], "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryUninstall", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[23], "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryUninstall", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -865,7 +1021,7 @@ func (x *arduinoCoreServiceLibraryUninstallClient) Recv() (*LibraryUninstallResp
 }
 
 func (c *arduinoCoreServiceClient) LibraryUpgradeAll(ctx context.Context, in *LibraryUpgradeAllRequest, opts ...grpc.CallOption) (ArduinoCoreService_LibraryUpgradeAllClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[20], "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryUpgradeAll", opts...)
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[24], "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryUpgradeAll", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -923,6 +1079,96 @@ func (c *arduinoCoreServiceClient) LibraryList(ctx context.Context, in *LibraryL
 	return out, nil
 }
 
+func (c *arduinoCoreServiceClient) LibraryLint(ctx context.Context, in *LibraryLintRequest, opts ...grpc.CallOption) (*LibraryLintResponse, error) {
+	out := new(LibraryLintResponse)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryLint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arduinoCoreServiceClient) Monitor(ctx context.Context, opts ...grpc.CallOption) (ArduinoCoreService_MonitorClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[25], "/cc.arduino.cli.commands.v1.ArduinoCoreService/Monitor", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreServiceMonitorClient{stream}
+	return x, nil
+}
+
+type ArduinoCoreService_MonitorClient interface {
+	Send(*MonitorRequest) error
+	Recv() (*MonitorResponse, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreServiceMonitorClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreServiceMonitorClient) Send(m *MonitorRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *arduinoCoreServiceMonitorClient) Recv() (*MonitorResponse, error) {
+	m := new(MonitorResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *arduinoCoreServiceClient) EnumerateMonitorPortSettings(ctx context.Context, in *EnumerateMonitorPortSettingsRequest, opts ...grpc.CallOption) (*EnumerateMonitorPortSettingsResponse, error) {
+	out := new(EnumerateMonitorPortSettingsResponse)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.v1.ArduinoCoreService/EnumerateMonitorPortSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arduinoCoreServiceClient) BundleExport(ctx context.Context, in *BundleExportRequest, opts ...grpc.CallOption) (ArduinoCoreService_BundleExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCoreService_ServiceDesc.Streams[26], "/cc.arduino.cli.commands.v1.ArduinoCoreService/BundleExport", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreServiceBundleExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCoreService_BundleExportClient interface {
+	Recv() (*BundleExportResponse, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreServiceBundleExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreServiceBundleExportClient) Recv() (*BundleExportResponse, error) {
+	m := new(BundleExportResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *arduinoCoreServiceClient) BundleImport(ctx context.Context, in *BundleImportRequest, opts ...grpc.CallOption) (*BundleImportResponse, error) {
+	out := new(BundleImportResponse)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.v1.ArduinoCoreService/BundleImport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ArduinoCoreServiceServer is the server API for ArduinoCoreService service.
 // All implementations must embed UnimplementedArduinoCoreServiceServer
 // for forward compatibility
@@ -950,6 +1196,9 @@ type ArduinoCoreServiceServer interface {
 	LoadSketch(context.Context, *LoadSketchRequest) (*LoadSketchResponse, error)
 	// Creates a zip file containing all files of specified Sketch
 	ArchiveSketch(context.Context, *ArchiveSketchRequest) (*ArchiveSketchResponse, error)
+	// Install the platforms and libraries declared in the Sketch project file,
+	// using the exact releases recorded in the Sketch lock file if present.
+	SketchInstallDependencies(*SketchInstallDependenciesRequest, ArduinoCoreService_SketchInstallDependenciesServer) error
 	// Requests details about a board
 	BoardDetails(context.Context, *BoardDetailsRequest) (*BoardDetailsResponse, error)
 	// Attach a board to a sketch. When the `fqbn` field of a request is not
@@ -965,6 +1214,15 @@ type ArduinoCoreServiceServer interface {
 	BoardListWatch(ArduinoCoreService_BoardListWatchServer) error
 	// Compile an Arduino sketch.
 	Compile(*CompileRequest, ArduinoCoreService_CompileServer) error
+	// Compile an Arduino sketch and compile it again each time the sketch or the
+	// unmanaged libraries it uses change, until the call is cancelled.
+	CompileWatch(*CompileWatchRequest, ArduinoCoreService_CompileWatchServer) error
+	// Compile an Arduino sketch for more boards and build properties, sending
+	// the result of each target as soon as its build ends.
+	CompileMatrix(*CompileMatrixRequest, ArduinoCoreService_CompileMatrixServer) error
+	// Build an Arduino sketch and its unit tests for the host, with a mock
+	// Arduino core, and run the tests.
+	Test(*TestRequest, ArduinoCoreService_TestServer) error
 	// Download and install a platform and its tool dependencies.
 	PlatformInstall(*PlatformInstallRequest, ArduinoCoreService_PlatformInstallServer) error
 	// Download a platform and its tool dependencies to the `staging/packages`
@@ -1007,6 +1265,22 @@ type ArduinoCoreServiceServer interface {
 	LibrarySearch(context.Context, *LibrarySearchRequest) (*LibrarySearchResponse, error)
 	// List the installed libraries.
 	LibraryList(context.Context, *LibraryListRequest) (*LibraryListResponse, error)
+	// Check the formal correctness of a library.
+	LibraryLint(context.Context, *LibraryLintRequest) (*LibraryLintResponse, error)
+	// Open a monitor connection to a board port. The port configuration may be
+	// changed while the port is open by sending a new `port_configuration`.
+	Monitor(ArduinoCoreService_MonitorServer) error
+	// Returns the parameters that can be set in the MonitorRequest calls
+	EnumerateMonitorPortSettings(context.Context, *EnumerateMonitorPortSettingsRequest) (*EnumerateMonitorPortSettingsResponse, error)
+	// Export a bundle, containing platforms and libraries with the indexes
+	// referencing them, to install them on machines without network access.
+	// The archives are downloaded to the staging directory if missing.
+	BundleExport(*BundleExportRequest, ArduinoCoreService_BundleExportServer) error
+	// Import a bundle exported with `BundleExport`. The settings are not
+	// changed: to install from the bundle add the returned package index URL
+	// to `board_manager.additional_urls`, set the returned library index URL
+	// as `library.index_url` and update the indexes.
+	BundleImport(context.Context, *BundleImportRequest) (*BundleImportResponse, error)
 	mustEmbedUnimplementedArduinoCoreServiceServer()
 }
 
@@ -1047,6 +1321,9 @@ func (UnimplementedArduinoCoreServiceServer) LoadSketch(context.Context, *LoadSk
 func (UnimplementedArduinoCoreServiceServer) ArchiveSketch(context.Context, *ArchiveSketchRequest) (*ArchiveSketchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ArchiveSketch not implemented")
 }
+func (UnimplementedArduinoCoreServiceServer) SketchInstallDependencies(*SketchInstallDependenciesRequest, ArduinoCoreService_SketchInstallDependenciesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SketchInstallDependencies not implemented")
+}
 func (UnimplementedArduinoCoreServiceServer) BoardDetails(context.Context, *BoardDetailsRequest) (*BoardDetailsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BoardDetails not implemented")
 }
@@ -1068,6 +1345,15 @@ func (UnimplementedArduinoCoreServiceServer) BoardListWatch(ArduinoCoreService_B
 func (UnimplementedArduinoCoreServiceServer) Compile(*CompileRequest, ArduinoCoreService_CompileServer) error {
 	return status.Errorf(codes.Unimplemented, "method Compile not implemented")
 }
+func (UnimplementedArduinoCoreServiceServer) CompileWatch(*CompileWatchRequest, ArduinoCoreService_CompileWatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method CompileWatch not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) CompileMatrix(*CompileMatrixRequest, ArduinoCoreService_CompileMatrixServer) error {
+	return status.Errorf(codes.Unimplemented, "method CompileMatrix not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) Test(*TestRequest, ArduinoCoreService_TestServer) error {
+	return status.Errorf(codes.Unimplemented, "method Test not implemented")
+}
 func (UnimplementedArduinoCoreServiceServer) PlatformInstall(*PlatformInstallRequest, ArduinoCoreService_PlatformInstallServer) error {
 	return status.Errorf(codes.Unimplemented, "method PlatformInstall not implemented")
 }
@@ -1125,6 +1411,21 @@ func (UnimplementedArduinoCoreServiceServer) LibrarySearch(context.Context, *Lib
 func (UnimplementedArduinoCoreServiceServer) LibraryList(context.Context, *LibraryListRequest) (*LibraryListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LibraryList not implemented")
 }
+func (UnimplementedArduinoCoreServiceServer) LibraryLint(context.Context, *LibraryLintRequest) (*LibraryLintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LibraryLint not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) Monitor(ArduinoCoreService_MonitorServer) error {
+	return status.Errorf(codes.Unimplemented, "method Monitor not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) EnumerateMonitorPortSettings(context.Context, *EnumerateMonitorPortSettingsRequest) (*EnumerateMonitorPortSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnumerateMonitorPortSettings not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) BundleExport(*BundleExportRequest, ArduinoCoreService_BundleExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method BundleExport not implemented")
+}
+func (UnimplementedArduinoCoreServiceServer) BundleImport(context.Context, *BundleImportRequest) (*BundleImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BundleImport not implemented")
+}
 func (UnimplementedArduinoCoreServiceServer) mustEmbedUnimplementedArduinoCoreServiceServer() {}
 
 // UnsafeArduinoCoreServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1351,6 +1652,27 @@ func _ArduinoCoreService_ArchiveSketch_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArduinoCoreService_SketchInstallDependencies_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SketchInstallDependenciesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServiceServer).SketchInstallDependencies(m, &arduinoCoreServiceSketchInstallDependenciesServer{stream})
+}
+
+type ArduinoCoreService_SketchInstallDependenciesServer interface {
+	Send(*SketchInstallDependenciesResponse) error
+	grpc.ServerStream
+}
+
+type arduinoCoreServiceSketchInstallDependenciesServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreServiceSketchInstallDependenciesServer) Send(m *SketchInstallDependenciesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _ArduinoCoreService_BoardDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BoardDetailsRequest)
 	if err := dec(in); err != nil {
@@ -1491,6 +1813,69 @@ func (x *arduinoCoreServiceCompileServer) Send(m *CompileResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ArduinoCoreService_CompileWatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CompileWatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServiceServer).CompileWatch(m, &arduinoCoreServiceCompileWatchServer{stream})
+}
+
+type ArduinoCoreService_CompileWatchServer interface {
+	Send(*CompileWatchResponse) error
+	grpc.ServerStream
+}
+
+type arduinoCoreServiceCompileWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreServiceCompileWatchServer) Send(m *CompileWatchResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ArduinoCoreService_CompileMatrix_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CompileMatrixRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServiceServer).CompileMatrix(m, &arduinoCoreServiceCompileMatrixServer{stream})
+}
+
+type ArduinoCoreService_CompileMatrixServer interface {
+	Send(*CompileMatrixResponse) error
+	grpc.ServerStream
+}
+
+type arduinoCoreServiceCompileMatrixServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreServiceCompileMatrixServer) Send(m *CompileMatrixResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ArduinoCoreService_Test_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TestRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServiceServer).Test(m, &arduinoCoreServiceTestServer{stream})
+}
+
+type ArduinoCoreService_TestServer interface {
+	Send(*TestResponse) error
+	grpc.ServerStream
+}
+
+type arduinoCoreServiceTestServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreServiceTestServer) Send(m *TestResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _ArduinoCoreService_PlatformInstall_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(PlatformInstallRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1872,6 +2257,107 @@ func _ArduinoCoreService_LibraryList_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArduinoCoreService_LibraryLint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LibraryLintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServiceServer).LibraryLint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.commands.v1.ArduinoCoreService/LibraryLint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServiceServer).LibraryLint(ctx, req.(*LibraryLintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArduinoCoreService_Monitor_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ArduinoCoreServiceServer).Monitor(&arduinoCoreServiceMonitorServer{stream})
+}
+
+type ArduinoCoreService_MonitorServer interface {
+	Send(*MonitorResponse) error
+	Recv() (*MonitorRequest, error)
+	grpc.ServerStream
+}
+
+type arduinoCoreServiceMonitorServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreServiceMonitorServer) Send(m *MonitorResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *arduinoCoreServiceMonitorServer) Recv() (*MonitorRequest, error) {
+	m := new(MonitorRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _ArduinoCoreService_EnumerateMonitorPortSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnumerateMonitorPortSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServiceServer).EnumerateMonitorPortSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.commands.v1.ArduinoCoreService/EnumerateMonitorPortSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServiceServer).EnumerateMonitorPortSettings(ctx, req.(*EnumerateMonitorPortSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArduinoCoreService_BundleExport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BundleExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServiceServer).BundleExport(m, &arduinoCoreServiceBundleExportServer{stream})
+}
+
+type ArduinoCoreService_BundleExportServer interface {
+	Send(*BundleExportResponse) error
+	grpc.ServerStream
+}
+
+type arduinoCoreServiceBundleExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreServiceBundleExportServer) Send(m *BundleExportResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ArduinoCoreService_BundleImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BundleImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServiceServer).BundleImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.commands.v1.ArduinoCoreService/BundleImport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServiceServer).BundleImport(ctx, req.(*BundleImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ArduinoCoreService_ServiceDesc is the grpc.ServiceDesc for ArduinoCoreService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1943,6 +2429,18 @@ var ArduinoCoreService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LibraryList",
 			Handler:    _ArduinoCoreService_LibraryList_Handler,
 		},
+		{
+			MethodName: "LibraryLint",
+			Handler:    _ArduinoCoreService_LibraryLint_Handler,
+		},
+		{
+			MethodName: "EnumerateMonitorPortSettings",
+			Handler:    _ArduinoCoreService_EnumerateMonitorPortSettings_Handler,
+		},
+		{
+			MethodName: "BundleImport",
+			Handler:    _ArduinoCoreService_BundleImport_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1970,6 +2468,11 @@ var ArduinoCoreService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ArduinoCoreService_Upgrade_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SketchInstallDependencies",
+			Handler:       _ArduinoCoreService_SketchInstallDependencies_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "BoardAttach",
 			Handler:       _ArduinoCoreService_BoardAttach_Handler,
@@ -1986,6 +2489,21 @@ var ArduinoCoreService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ArduinoCoreService_Compile_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "CompileWatch",
+			Handler:       _ArduinoCoreService_CompileWatch_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "CompileMatrix",
+			Handler:       _ArduinoCoreService_CompileMatrix_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Test",
+			Handler:       _ArduinoCoreService_Test_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "PlatformInstall",
 			Handler:       _ArduinoCoreService_PlatformInstall_Handler,
@@ -2051,6 +2569,17 @@ var ArduinoCoreService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ArduinoCoreService_LibraryUpgradeAll_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Monitor",
+			Handler:       _ArduinoCoreService_Monitor_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "BundleExport",
+			Handler:       _ArduinoCoreService_BundleExport_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "cc/arduino/cli/commands/v1/commands.proto",
 }
@@ -35,6 +35,60 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type SketchEnvironmentMode int32
+
+const (
+	// The sketch environment is not used, only the globally installed platforms
+	// and libraries are available.
+	SketchEnvironmentMode_SKETCH_ENVIRONMENT_MODE_DISABLED SketchEnvironmentMode = 0
+	// The platforms and libraries of the sketch environment take precedence over
+	// the globally installed ones.
+	SketchEnvironmentMode_SKETCH_ENVIRONMENT_MODE_OVERLAY SketchEnvironmentMode = 1
+	// Only the platforms and libraries of the sketch environment are available.
+	SketchEnvironmentMode_SKETCH_ENVIRONMENT_MODE_ISOLATED SketchEnvironmentMode = 2
+)
+
+// Enum value maps for SketchEnvironmentMode.
+var (
+	SketchEnvironmentMode_name = map[int32]string{
+		0: "SKETCH_ENVIRONMENT_MODE_DISABLED",
+		1: "SKETCH_ENVIRONMENT_MODE_OVERLAY",
+		2: "SKETCH_ENVIRONMENT_MODE_ISOLATED",
+	}
+	SketchEnvironmentMode_value = map[string]int32{
+		"SKETCH_ENVIRONMENT_MODE_DISABLED": 0,
+		"SKETCH_ENVIRONMENT_MODE_OVERLAY":  1,
+		"SKETCH_ENVIRONMENT_MODE_ISOLATED": 2,
+	}
+)
+
+func (x SketchEnvironmentMode) Enum() *SketchEnvironmentMode {
+	p := new(SketchEnvironmentMode)
+	*p = x
+	return p
+}
+
+func (x SketchEnvironmentMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SketchEnvironmentMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_cc_arduino_cli_commands_v1_common_proto_enumTypes[0].Descriptor()
+}
+
+func (SketchEnvironmentMode) Type() protoreflect.EnumType {
+	return &file_cc_arduino_cli_commands_v1_common_proto_enumTypes[0]
+}
+
+func (x SketchEnvironmentMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SketchEnvironmentMode.Descriptor instead.
+func (SketchEnvironmentMode) EnumDescriptor() ([]byte, []int) {
+	return file_cc_arduino_cli_commands_v1_common_proto_rawDescGZIP(), []int{0}
+}
+
 type Instance struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -540,12 +594,21 @@ var file_cc_arduino_cli_commands_v1_common_proto_rawDesc = []byte{
 	0x61, 0x74, 0x65, 0x64, 0x22, 0x2f, 0x0a, 0x05, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x12, 0x0a,
 	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
 	0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x66, 0x71, 0x62, 0x6e, 0x42, 0x48, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x72, 0x64, 0x75,
-	0x69, 0x6e, 0x6f, 0x2d, 0x63, 0x6c, 0x69, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x63, 0x63, 0x2f, 0x61,
-	0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2f, 0x63, 0x6c, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
-	0x6e, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x04, 0x66, 0x71, 0x62, 0x6e, 0x2a, 0x88, 0x01, 0x0a, 0x15, 0x53, 0x6b, 0x65, 0x74, 0x63, 0x68,
+	0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x24, 0x0a, 0x20, 0x53, 0x4b, 0x45, 0x54, 0x43, 0x48, 0x5f, 0x45, 0x4e, 0x56, 0x49, 0x52, 0x4f,
+	0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42,
+	0x4c, 0x45, 0x44, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x53, 0x4b, 0x45, 0x54, 0x43, 0x48, 0x5f,
+	0x45, 0x4e, 0x56, 0x49, 0x52, 0x4f, 0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45,
+	0x5f, 0x4f, 0x56, 0x45, 0x52, 0x4c, 0x41, 0x59, 0x10, 0x01, 0x12, 0x24, 0x0a, 0x20, 0x53, 0x4b,
+	0x45, 0x54, 0x43, 0x48, 0x5f, 0x45, 0x4e, 0x56, 0x49, 0x52, 0x4f, 0x4e, 0x4d, 0x45, 0x4e, 0x54,
+	0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x53, 0x4f, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02,
+	0x42, 0x48, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61,
+	0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2d, 0x63,
+	0x6c, 0x69, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x63, 0x63, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e,
+	0x6f, 0x2f, 0x63, 0x6c, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2f, 0x76,
+	0x31, 0x3b, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -560,17 +623,19 @@ func file_cc_arduino_cli_commands_v1_common_proto_rawDescGZIP() []byte {
 	return file_cc_arduino_cli_commands_v1_common_proto_rawDescData
 }
 
+var file_cc_arduino_cli_commands_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_cc_arduino_cli_commands_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_cc_arduino_cli_commands_v1_common_proto_goTypes = []interface{}{
-	(*Instance)(nil),         // 0: cc.arduino.cli.commands.v1.Instance
-	(*DownloadProgress)(nil), // 1: cc.arduino.cli.commands.v1.DownloadProgress
-	(*TaskProgress)(nil),     // 2: cc.arduino.cli.commands.v1.TaskProgress
-	(*Programmer)(nil),       // 3: cc.arduino.cli.commands.v1.Programmer
-	(*Platform)(nil),         // 4: cc.arduino.cli.commands.v1.Platform
-	(*Board)(nil),            // 5: cc.arduino.cli.commands.v1.Board
+	(SketchEnvironmentMode)(0), // 0: cc.arduino.cli.commands.v1.SketchEnvironmentMode
+	(*Instance)(nil),           // 1: cc.arduino.cli.commands.v1.Instance
+	(*DownloadProgress)(nil),   // 2: cc.arduino.cli.commands.v1.DownloadProgress
+	(*TaskProgress)(nil),       // 3: cc.arduino.cli.commands.v1.TaskProgress
+	(*Programmer)(nil),         // 4: cc.arduino.cli.commands.v1.Programmer
+	(*Platform)(nil),           // 5: cc.arduino.cli.commands.v1.Platform
+	(*Board)(nil),              // 6: cc.arduino.cli.commands.v1.Board
 }
 var file_cc_arduino_cli_commands_v1_common_proto_depIdxs = []int32{
-	5, // 0: cc.arduino.cli.commands.v1.Platform.boards:type_name -> cc.arduino.cli.commands.v1.Board
+	6, // 0: cc.arduino.cli.commands.v1.Platform.boards:type_name -> cc.arduino.cli.commands.v1.Board
 	1, // [1:1] is the sub-list for method output_type
 	1, // [1:1] is the sub-list for method input_type
 	1, // [1:1] is the sub-list for extension type_name
@@ -662,13 +727,14 @@ func file_cc_arduino_cli_commands_v1_common_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cc_arduino_cli_commands_v1_common_proto_rawDesc,
-			NumEnums:      0,
+			NumEnums:      1,
 			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_cc_arduino_cli_commands_v1_common_proto_goTypes,
 		DependencyIndexes: file_cc_arduino_cli_commands_v1_common_proto_depIdxs,
+		EnumInfos:         file_cc_arduino_cli_commands_v1_common_proto_enumTypes,
 		MessageInfos:      file_cc_arduino_cli_commands_v1_common_proto_msgTypes,
 	}.Build()
 	File_cc_arduino_cli_commands_v1_common_proto = out.File
@@ -493,9 +493,17 @@ def test_board_details(run_command):
     for programmer in gold_board_details["programmers"]:
         assert programmer in result["programmers"]
 
-    # Download samd core pinned to 1.8.8
+    # Download samd core pinned to 1.8.8, it doesn't declare the debug configuration
     run_command(["core", "install", "arduino:samd@1.8.8"])
 
+    result = run_command(["board", "details", "-b", "arduino:samd:nano_33_iot", "--format", "json"])
+    assert result.ok
+    result = json.loads(result.stdout)
+    assert not result.get("debugging_supported", False)
+
+    # Download samd core pinned to 1.8.11, it declares the debug configuration in platform.txt
+    run_command(["core", "install", "arduino:samd@1.8.11"])
+
     result = run_command(["board", "details", "-b", "arduino:samd:nano_33_iot", "--format", "json"])
     assert result.ok
     result = json.loads(result.stdout)
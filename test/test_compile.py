@@ -608,7 +608,8 @@ def test_compile_with_invalid_url(run_command, data_dir):
     assert res.ok
     lines = [l.strip() for l in res.stderr.splitlines()]
     assert "Error initializing instance: Loading index file: loading json index file" in lines[0]
-    expected_index_file = Path(data_dir, "package_example_index.json")
+    source_hash = hashlib.sha256(b"example.com/package_example_index.json").hexdigest()[:12]
+    expected_index_file = Path(data_dir, "package_indexes", f"example.com-{source_hash}", "package_example_index.json")
     assert f"loading json index file {expected_index_file}: " + f"open {expected_index_file}:" in lines[-1]
 
 
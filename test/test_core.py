@@ -196,6 +196,23 @@ def test_core_updateindex_internal_server_error(run_command, httpserver):
     )
 
 
+def test_core_updateindex_list(run_command, httpserver):
+    # Two sources publishing an index with the same file name
+    test_index = Path(__file__).parent / "testdata" / "test_index.json"
+    httpserver.expect_request("/first/test_index.json").respond_with_data(test_index.read_text())
+    httpserver.expect_request("/second/test_index.json").respond_with_data(test_index.read_text())
+    urls = [httpserver.url_for("/first/test_index.json"), httpserver.url_for("/second/test_index.json")]
+    assert run_command(["core", "update-index", f"--additional-urls={','.join(urls)}"])
+
+    result = run_command(["core", "update-index", "--list", "--format", "json"])
+    assert result.ok
+    indexes = json.loads(result.stdout)["indexes"]
+    assert [i["url"] for i in indexes] == sorted(["https://downloads.arduino.cc/packages/package_index.json"] + urls)
+    paths = [i["path"] for i in indexes]
+    assert len(set(paths)) == len(paths)
+    assert all(i["fetched_at"] for i in indexes)
+
+
 def test_core_install_without_updateindex(run_command):
     # Missing "core update-index"
     # Download samd core pinned to 1.8.6
@@ -522,7 +539,7 @@ def test_core_search_update_index_delay(run_command, data_dir):
     assert "Updating index" not in res.stdout
 
     # Change edit time of package index file
-    index_file = Path(data_dir, "package_index.json")
+    index_file = next(Path(data_dir, "package_indexes").glob("downloads.arduino.cc-*/package_index.json"))
     date = datetime.datetime.now() - datetime.timedelta(hours=25)
     mod_time = time.mktime(date.timetuple())
     os.utime(index_file, (mod_time, mod_time))
@@ -15,6 +15,8 @@
 
 from pathlib import Path
 
+from werkzeug.wrappers import Response
+
 
 def test_update(run_command):
     res = run_command(["update"])
@@ -45,14 +47,45 @@ def test_update_showing_outdated(run_command):
     assert result.ok
     lines = [l.strip() for l in result.stdout.splitlines()]
 
-    assert "Updating index: package_index.json downloaded" in lines
-    assert "Updating index: package_index.json.sig downloaded" in lines
-    assert "Updating index: library_index.json.gz downloaded" in lines
-    assert "Updating index: library_index.json.sig downloaded" in lines
+    # The indexes didn't change since they have been downloaded
+    assert "Updating index: package_index.json already downloaded" in lines
+    assert "Updating index: library_index.json.gz already downloaded" in lines
     assert lines[-5].startswith("Arduino AVR Boards")
     assert lines[-2].startswith("USBHost")
 
 
+def test_update_if_older_than(run_command):
+    assert run_command(["update"])
+
+    # The indexes refreshed recently are not downloaded again
+    res = run_command(["update", "--if-older-than", "1h"])
+    assert res.ok
+    lines = [l.strip() for l in res.stdout.splitlines()]
+    assert "Updating index: package_index.json already downloaded" in lines
+    assert "Updating index: library_index.json.gz already downloaded" in lines
+
+
+def test_update_index_not_modified(run_command, httpserver):
+    test_index = Path(__file__).parent / "testdata" / "test_index.json"
+
+    def handler(request):
+        if request.headers.get("If-None-Match") == '"v1"':
+            return Response(status=304)
+        return Response(test_index.read_text(), headers={"ETag": '"v1"'})
+
+    httpserver.expect_request("/test_index.json").respond_with_handler(handler)
+    url = httpserver.url_for("/test_index.json")
+
+    res = run_command(["core", "update-index", f"--additional-urls={url}"])
+    assert res.ok
+    assert "Updating index: test_index.json downloaded" in [l.strip() for l in res.stdout.splitlines()]
+
+    # The index didn't change on the server
+    res = run_command(["core", "update-index", f"--additional-urls={url}"])
+    assert res.ok
+    assert "Updating index: test_index.json already downloaded" in [l.strip() for l in res.stdout.splitlines()]
+
+
 def test_update_with_url_not_found(run_command, httpserver):
     assert run_command(["update"])
 